@@ -0,0 +1,204 @@
+package auth
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/apikey"
+	ent_user "github.com/mangaweb4/mangaweb4-backend/ent/user"
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The scopes of API keys.
+const (
+	// ScopeRead allows browsing and reading, the calls a guest may make.
+	ScopeRead = "read"
+	// ScopeProgressWrite allows recording the reading progress.
+	ScopeProgressWrite = "progress-write"
+	// ScopeAdmin allows every call the role of the user allows.
+	ScopeAdmin = "admin"
+)
+
+// apiKeyPrefix starts every API key, so that they are told apart from session
+// tokens and JSON web tokens.
+const apiKeyPrefix = "mwk_"
+
+// apiKeyUseInterval is the minimum time between two updates of the last used
+// time of a key, so that busy keys do not write on every call.
+const apiKeyUseInterval = time.Minute
+
+// ErrInvalidAPIKey is returned for unknown, revoked and expired API keys.
+var ErrInvalidAPIKey = status.Error(codes.Unauthenticated, "invalid or expired API key")
+
+// ProgressMethods are the methods allowed by ScopeProgressWrite.
+var ProgressMethods = map[string]bool{
+	grpc.Manga_SetProgress_FullMethodName: true,
+}
+
+var scopeToGrpc = map[string]grpc.ApiKeyScope{
+	ScopeRead:          grpc.ApiKeyScope_API_KEY_SCOPE_READ,
+	ScopeProgressWrite: grpc.ApiKeyScope_API_KEY_SCOPE_PROGRESS_WRITE,
+	ScopeAdmin:         grpc.ApiKeyScope_API_KEY_SCOPE_ADMIN,
+}
+
+// ScopesToGrpc converts API key scopes into their gRPC values.
+func ScopesToGrpc(scopes []string) []grpc.ApiKeyScope {
+	out := make([]grpc.ApiKeyScope, len(scopes))
+	for i, s := range scopes {
+		out[i] = scopeToGrpc[s]
+	}
+
+	return out
+}
+
+// ScopesFromGrpc converts gRPC API key scopes into their database values.
+// Unknown scopes fail.
+func ScopesFromGrpc(scopes []grpc.ApiKeyScope) (out []string, err error) {
+	for _, s := range scopes {
+		found := false
+		for k, v := range scopeToGrpc {
+			if v == s {
+				out, found = append(out, k), true
+			}
+		}
+
+		if !found {
+			err = status.Errorf(codes.InvalidArgument, "invalid API key scope: %v", s)
+			return
+		}
+	}
+
+	return
+}
+
+// CreateAPIKey creates an API key of the user. The returned key is not stored
+// and cannot be read back. Keys without an expire time do not expire.
+func CreateAPIKey(
+	ctx context.Context,
+	client *ent.Client,
+	u *ent.User,
+	name string,
+	scopes []string,
+	expireTime *time.Time,
+	now time.Time,
+) (key string, k *ent.APIKey, err error) {
+	if len(scopes) == 0 {
+		err = status.Error(codes.InvalidArgument, "an API key needs at least one scope")
+		return
+	}
+	for _, s := range scopes {
+		if _, ok := scopeToGrpc[s]; !ok {
+			err = status.Errorf(codes.InvalidArgument, "invalid API key scope: %s", s)
+			return
+		}
+	}
+	if expireTime != nil && !expireTime.After(now) {
+		err = status.Error(codes.InvalidArgument, "expire time must be in the future")
+		return
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return
+	}
+	key = apiKeyPrefix + token
+
+	slices.Sort(scopes)
+	k, err = client.APIKey.Create().
+		SetUser(u).
+		SetName(name).
+		SetKeyHash(hashToken(key)).
+		SetPrefix(key[:len(apiKeyPrefix)+6]).
+		SetScopes(slices.Compact(scopes)).
+		SetCreateTime(now).
+		SetNillableExpireTime(expireTime).
+		Save(ctx)
+
+	return
+}
+
+// ReadAPIKeys returns the API keys of the user by name.
+func ReadAPIKeys(ctx context.Context, client *ent.Client, u *ent.User) ([]*ent.APIKey, error) {
+	return u.QueryAPIKeys().Order(apikey.ByName(), apikey.ByID()).All(ctx)
+}
+
+// RevokeAPIKey deletes an API key of the user. Keys of other users are not
+// found.
+func RevokeAPIKey(ctx context.Context, client *ent.Client, u *ent.User, id int) error {
+	k, err := u.QueryAPIKeys().Where(apikey.ID(id)).Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	return client.APIKey.DeleteOne(k).Exec(ctx)
+}
+
+// ResolveAPIKey returns the active user and the unexpired key of an API key,
+// and records that the key was used.
+func ResolveAPIKey(ctx context.Context, client *ent.Client, key string, now time.Time) (u *ent.User, k *ent.APIKey, err error) {
+	k, err = client.APIKey.Query().
+		Where(
+			apikey.KeyHash(hashToken(key)),
+			apikey.Or(apikey.ExpireTimeIsNil(), apikey.ExpireTimeGT(now)),
+		).
+		WithUser(func(q *ent.UserQuery) { q.Where(ent_user.Active(true)) }).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		err = ErrInvalidAPIKey
+		return
+	} else if err != nil {
+		return
+	}
+
+	if k.Edges.User == nil {
+		err = ErrInvalidAPIKey
+		return
+	}
+
+	if k.LastUsedTime == nil || now.Sub(*k.LastUsedTime) >= apiKeyUseInterval {
+		if err = k.Update().SetLastUsedTime(now).Exec(ctx); err != nil {
+			return
+		}
+		k.LastUsedTime = &now
+	}
+
+	u = k.Edges.User
+	return
+}
+
+// isAPIKey reports whether a bearer token is an API key.
+func isAPIKey(token string) bool {
+	return strings.HasPrefix(token, apiKeyPrefix)
+}
+
+type scopesKey struct{}
+
+// withScopes returns a context limited to the scopes of an API key.
+func withScopes(ctx context.Context, scopes []string) context.Context {
+	return context.WithValue(ctx, scopesKey{}, scopes)
+}
+
+// allowedByScopes reports whether the scopes in the context allow the method.
+// Calls not made with an API key have no scopes and are not limited.
+func allowedByScopes(ctx context.Context, method string) error {
+	scopes, ok := ctx.Value(scopesKey{}).([]string)
+	if !ok {
+		return nil
+	}
+
+	for _, s := range scopes {
+		switch {
+		case s == ScopeAdmin,
+			s == ScopeRead && MethodRoles[method] == ent_user.RoleGuest,
+			s == ScopeProgressWrite && ProgressMethods[method]:
+			return nil
+		}
+	}
+
+	return status.Errorf(codes.PermissionDenied, "the API key does not allow %s", method)
+}
@@ -0,0 +1,122 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mangaweb4/mangaweb4-backend/configuration"
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+	"github.com/mangaweb4/mangaweb4-backend/user"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type APIKeyTestSuite struct {
+	suite.Suite
+}
+
+func TestAPIKeyTestSuite(t *testing.T) {
+	suite.Run(t, new(APIKeyTestSuite))
+}
+
+func (s *APIKeyTestSuite) TestCreateAndResolve() {
+	db, client, err := createTestDBClient(s)
+	s.Require().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+	now := time.Now()
+
+	u, err := user.GetUser(ctx, client, "reader@example.com")
+	s.Require().Nil(err)
+	other, err := user.GetUser(ctx, client, "other@example.com")
+	s.Require().Nil(err)
+
+	_, _, err = CreateAPIKey(ctx, client, u, "Empty", nil, nil, now)
+	s.Assert().Equal(codes.InvalidArgument, status.Code(err))
+	_, _, err = CreateAPIKey(ctx, client, u, "Unknown", []string{"write"}, nil, now)
+	s.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	key, k, err := CreateAPIKey(ctx, client, u, "Reader app", []string{ScopeRead, ScopeProgressWrite, ScopeRead}, nil, now)
+	s.Require().Nil(err)
+	s.Assert().True(isAPIKey(key))
+	s.Assert().NotEqual(key, k.KeyHash)
+	s.Assert().Equal([]string{ScopeProgressWrite, ScopeRead}, k.Scopes)
+	s.Assert().True(len(k.Prefix) < len(key))
+	s.Assert().Nil(k.LastUsedTime)
+
+	resolved, k, err := ResolveAPIKey(ctx, client, key, now)
+	s.Assert().Nil(err)
+	s.Assert().Equal(u.ID, resolved.ID)
+	s.Assert().Equal(now.Unix(), k.LastUsedTime.Unix())
+
+	expire := now.Add(time.Hour)
+	expiring, _, err := CreateAPIKey(ctx, client, u, "Script", []string{ScopeAdmin}, &expire, now)
+	s.Require().Nil(err)
+	_, _, err = ResolveAPIKey(ctx, client, expiring, now.Add(2*time.Hour))
+	s.Assert().Equal(ErrInvalidAPIKey, err)
+
+	keys, err := ReadAPIKeys(ctx, client, u)
+	s.Assert().Nil(err)
+	s.Assert().Equal([]string{"Reader app", "Script"}, []string{keys[0].Name, keys[1].Name})
+
+	s.Assert().NotNil(RevokeAPIKey(ctx, client, other, k.ID))
+	s.Assert().Nil(RevokeAPIKey(ctx, client, u, k.ID))
+	_, _, err = ResolveAPIKey(ctx, client, key, now)
+	s.Assert().Equal(ErrInvalidAPIKey, err)
+
+	_, err = user.SetActive(ctx, client, u, false)
+	s.Require().Nil(err)
+	_, _, err = ResolveAPIKey(ctx, client, expiring, now)
+	s.Assert().Equal(ErrInvalidAPIKey, err)
+}
+
+func (s *APIKeyTestSuite) TestScopes() {
+	db, client, err := createTestDBClient(s)
+	s.Require().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	old := configuration.Get()
+	defer configuration.Init(old)
+	configuration.Init(configuration.Config{AuthRequired: true})
+
+	ctx := context.Background()
+	now := time.Now()
+
+	u, err := user.GetUser(ctx, client, "reader@example.com")
+	s.Require().Nil(err)
+
+	readKey, _, err := CreateAPIKey(ctx, client, u, "Reader app", []string{ScopeRead}, nil, now)
+	s.Require().Nil(err)
+	progressKey, _, err := CreateAPIKey(ctx, client, u, "Sync", []string{ScopeProgressWrite}, nil, now)
+	s.Require().Nil(err)
+	adminKey, _, err := CreateAPIKey(ctx, client, u, "Script", []string{ScopeAdmin}, nil, now)
+	s.Require().Nil(err)
+
+	authorize := func(key string, method string) codes.Code {
+		ctx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+key))
+		authed, err := Authenticate(ctx, client, method, nil, now)
+		if err == nil {
+			err = Authorize(authed, method)
+		}
+
+		return status.Code(err)
+	}
+
+	s.Assert().Equal(codes.OK, authorize(readKey, grpc.Manga_List_FullMethodName))
+	s.Assert().Equal(codes.PermissionDenied, authorize(readKey, grpc.Manga_SetProgress_FullMethodName))
+	s.Assert().Equal(codes.PermissionDenied, authorize(readKey, grpc.ApiKey_Create_FullMethodName))
+
+	s.Assert().Equal(codes.OK, authorize(progressKey, grpc.Manga_SetProgress_FullMethodName))
+	s.Assert().Equal(codes.PermissionDenied, authorize(progressKey, grpc.Manga_List_FullMethodName))
+
+	s.Assert().Equal(codes.OK, authorize(adminKey, grpc.Manga_SetFavorite_FullMethodName))
+	s.Assert().Equal(codes.OK, authorize(adminKey, grpc.ApiKey_Create_FullMethodName))
+	// Keys cannot do more than the role of their user.
+	s.Assert().Equal(codes.PermissionDenied, authorize(adminKey, grpc.Maintenance_PurgeCache_FullMethodName))
+
+	s.Assert().Equal(codes.Unauthenticated, authorize("mwk_unknown", grpc.Manga_List_FullMethodName))
+}
@@ -0,0 +1,243 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	dialect_sql "entgo.io/ent/dialect/sql"
+	"github.com/mangaweb4/mangaweb4-backend/configuration"
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/enttest"
+	ent_user "github.com/mangaweb4/mangaweb4-backend/ent/user"
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+	"github.com/mangaweb4/mangaweb4-backend/user"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	_ "modernc.org/sqlite"
+)
+
+type AuthTestSuite struct {
+	suite.Suite
+}
+
+func TestAuthTestSuite(t *testing.T) {
+	suite.Run(t, new(AuthTestSuite))
+}
+
+func createTestDBClient(s suite.TestingSuite) (db *sql.DB, client *ent.Client, err error) {
+	db, err = sql.Open("sqlite", "file:ent?mode=memory&_fk=1&_pragma=foreign_keys(1)")
+	if err != nil {
+		return
+	}
+
+	client = enttest.NewClient(s.T(), enttest.WithOptions(ent.Driver(dialect_sql.OpenDB("sqlite3", db))))
+
+	return
+}
+
+func (s *AuthTestSuite) TestPassword() {
+	_, err := HashPassword("short")
+	s.Assert().NotNil(err)
+
+	hash, err := HashPassword("correct horse")
+	s.Assert().Nil(err)
+
+	match, err := VerifyPassword(hash, "correct horse")
+	s.Assert().Nil(err)
+	s.Assert().True(match)
+
+	match, err = VerifyPassword(hash, "battery staple")
+	s.Assert().Nil(err)
+	s.Assert().False(match)
+
+	match, err = VerifyPassword("", "")
+	s.Assert().Nil(err)
+	s.Assert().False(match)
+}
+
+func (s *AuthTestSuite) TestLoginAndLogout() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+	now := time.Now()
+
+	u, err := user.GetUser(ctx, client, "reader@example.com")
+	s.Assert().Nil(err)
+
+	// A user without a password cannot log in.
+	_, _, err = Login(ctx, client, u.Email, "", now)
+	s.Assert().Equal(ErrInvalidCredentials, err)
+
+	s.Assert().Nil(ChangePassword(ctx, client, u, "", "correct horse", ""))
+
+	_, _, err = Login(ctx, client, u.Email, "battery staple", now)
+	s.Assert().Equal(ErrInvalidCredentials, err)
+	_, _, err = Login(ctx, client, "nobody@example.com", "correct horse", now)
+	s.Assert().Equal(ErrInvalidCredentials, err)
+
+	token, session, err := Login(ctx, client, u.Email, "correct horse", now)
+	s.Assert().Nil(err)
+	s.Assert().Equal(u.ID, session.Edges.User.ID)
+	s.Assert().NotEqual(token, session.TokenHash)
+
+	resolved, err := Resolve(ctx, client, token, now)
+	s.Assert().Nil(err)
+	s.Assert().Equal(u.ID, resolved.ID)
+
+	_, err = Resolve(ctx, client, token, now.Add(SessionDuration()))
+	s.Assert().Equal(ErrInvalidSession, err)
+
+	s.Assert().Nil(Logout(ctx, client, token))
+	_, err = Resolve(ctx, client, token, now)
+	s.Assert().Equal(ErrInvalidSession, err)
+}
+
+func (s *AuthTestSuite) TestChangePassword() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+	now := time.Now()
+
+	u, err := user.GetUser(ctx, client, "reader@example.com")
+	s.Assert().Nil(err)
+	s.Assert().Nil(ChangePassword(ctx, client, u, "", "correct horse", ""))
+
+	kept, _, err := Login(ctx, client, u.Email, "correct horse", now)
+	s.Assert().Nil(err)
+	other, _, err := Login(ctx, client, u.Email, "correct horse", now)
+	s.Assert().Nil(err)
+
+	u, err = client.User.Get(ctx, u.ID)
+	s.Assert().Nil(err)
+
+	s.Assert().Equal(ErrInvalidCredentials, ChangePassword(ctx, client, u, "wrong password", "battery staple", kept))
+	s.Assert().NotNil(ChangePassword(ctx, client, u, "correct horse", "short", kept))
+	s.Assert().Nil(ChangePassword(ctx, client, u, "correct horse", "battery staple", kept))
+
+	_, err = Resolve(ctx, client, kept, now)
+	s.Assert().Nil(err)
+	_, err = Resolve(ctx, client, other, now)
+	s.Assert().Equal(ErrInvalidSession, err)
+
+	_, _, err = Login(ctx, client, u.Email, "battery staple", now)
+	s.Assert().Nil(err)
+}
+
+func (s *AuthTestSuite) TestAuthenticate() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	old := configuration.Get()
+	defer configuration.Init(old)
+
+	ctx := context.Background()
+	now := time.Now()
+
+	u, err := user.GetUser(ctx, client, "reader@example.com")
+	s.Assert().Nil(err)
+	s.Assert().Nil(ChangePassword(ctx, client, u, "", "correct horse", ""))
+	token, _, err := Login(ctx, client, u.Email, "correct horse", now)
+	s.Assert().Nil(err)
+
+	withToken := func(token string) context.Context {
+		return metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+	}
+
+	// Without required authentication, the user in the request is used.
+	configuration.Init(configuration.Config{})
+	authed, err := Authenticate(ctx, client, grpc.User_Info_FullMethodName, &grpc.UserInfoRequest{User: "other@example.com"}, now)
+	s.Assert().Nil(err)
+	id, ok := user.IDFromContext(authed)
+	s.Assert().True(ok)
+	other, err := user.Current(authed, client)
+	s.Assert().Nil(err)
+	s.Assert().Equal(id, other.ID)
+	s.Assert().Equal("other@example.com", other.Email)
+
+	// Users protected by a password, a higher role or restrictions cannot be
+	// named without a token; such calls act as the default user.
+	admin, err := user.Create(ctx, client, "admin@example.com", ent_user.RoleAdmin)
+	s.Assert().Nil(err)
+	rating := 12
+	restricted, err := user.Create(ctx, client, "kid@example.com", "")
+	s.Assert().Nil(err)
+	_, err = user.SetRestrictions(ctx, client, restricted, user.Restrictions{MaxAgeRating: &rating})
+	s.Assert().Nil(err)
+	for _, email := range []string{u.Email, admin.Email, restricted.Email} {
+		authed, err = Authenticate(ctx, client, grpc.User_Info_FullMethodName, &grpc.UserInfoRequest{User: email}, now)
+		s.Assert().Nil(err)
+		current, err := user.Current(authed, client)
+		s.Assert().Nil(err)
+		s.Assert().Equal(user.DEFAULT_EMAIL, current.Email, email)
+	}
+
+	// A session takes precedence over the user in the request.
+	authed, err = Authenticate(withToken(token), client, grpc.User_Info_FullMethodName, &grpc.UserInfoRequest{User: "other@example.com"}, now)
+	s.Assert().Nil(err)
+	current, err := user.Current(authed, client)
+	s.Assert().Nil(err)
+	s.Assert().Equal(u.ID, current.ID)
+
+	_, err = Authenticate(withToken("unknown"), client, grpc.User_Info_FullMethodName, &grpc.UserInfoRequest{}, now)
+	s.Assert().Equal(ErrInvalidSession, err)
+
+	configuration.Init(configuration.Config{AuthRequired: true})
+	_, err = Authenticate(ctx, client, grpc.User_Info_FullMethodName, &grpc.UserInfoRequest{User: u.Email}, now)
+	s.Assert().NotNil(err)
+
+	authed, err = Authenticate(ctx, client, grpc.Auth_Login_FullMethodName, &grpc.AuthLoginRequest{}, now)
+	s.Assert().Nil(err)
+	_, err = user.Current(authed, client)
+	s.Assert().NotNil(err)
+
+	authed, err = Authenticate(withToken(token), client, grpc.User_Info_FullMethodName, &grpc.UserInfoRequest{}, now)
+	s.Assert().Nil(err)
+	current, err = user.Current(authed, client)
+	s.Assert().Nil(err)
+	s.Assert().Equal(u.ID, current.ID)
+
+	// Deactivated users are rejected, with or without a session.
+	_, err = user.SetActive(ctx, client, current, false)
+	s.Assert().Nil(err)
+	_, err = Authenticate(withToken(token), client, grpc.User_Info_FullMethodName, &grpc.UserInfoRequest{}, now)
+	s.Assert().Equal(ErrInvalidSession, err)
+
+	configuration.Init(configuration.Config{})
+	_, err = Authenticate(ctx, client, grpc.User_Info_FullMethodName, &grpc.UserInfoRequest{User: u.Email}, now)
+	s.Assert().Equal(user.ErrInactive, err)
+}
+
+func (s *AuthTestSuite) TestCreateUser() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	// A rejected password leaves no user behind.
+	_, err = CreateUser(ctx, client, "short@example.com", ent_user.RoleReader, "short")
+	s.Assert().Equal(codes.InvalidArgument, status.Code(err))
+	exists, err := client.User.Query().Where(ent_user.Email("short@example.com")).Exist(ctx)
+	s.Assert().Nil(err)
+	s.Assert().False(exists)
+
+	u, err := CreateUser(ctx, client, "editor@example.com", ent_user.RoleEditor, "correct horse")
+	s.Assert().Nil(err)
+	s.Assert().Equal(ent_user.RoleEditor, u.Role)
+
+	_, _, err = Login(ctx, client, u.Email, "correct horse", time.Now())
+	s.Assert().Nil(err)
+
+	u, err = CreateUser(ctx, client, "reader@example.com", "", "")
+	s.Assert().Nil(err)
+	s.Assert().Empty(u.PasswordHash)
+}
@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	ent_user "github.com/mangaweb4/mangaweb4-backend/ent/user"
+)
+
+// Bootstrap makes the user with the email an admin, creating them if needed,
+// so that a new server has someone to manage it. The password is only set
+// when the user has none yet, so that it can be changed after the first login.
+func Bootstrap(ctx context.Context, client *ent.Client, email string, password string) (u *ent.User, err error) {
+	u, err = client.User.Query().Where(ent_user.Email(email)).Only(ctx)
+	if ent.IsNotFound(err) {
+		u, err = client.User.Create().SetEmail(email).Save(ctx)
+	}
+	if err != nil {
+		return
+	}
+
+	update := u.Update().SetRole(ent_user.RoleAdmin).SetActive(true)
+	if password != "" && u.PasswordHash == "" {
+		hash, err := HashPassword(password)
+		if err != nil {
+			return nil, err
+		}
+
+		update.SetPasswordHash(hash)
+	}
+
+	return update.Save(ctx)
+}
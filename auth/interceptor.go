@@ -0,0 +1,203 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/mangaweb4/mangaweb4-backend/configuration"
+	"github.com/mangaweb4/mangaweb4-backend/database"
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	ent_user "github.com/mangaweb4/mangaweb4-backend/ent/user"
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+	"github.com/mangaweb4/mangaweb4-backend/user"
+	"github.com/rs/zerolog/log"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// PublicMethods are the methods that can be called without logging in.
+var PublicMethods = map[string]bool{
+	grpc.Auth_Login_FullMethodName:  true,
+	grpc.System_Info_FullMethodName: true,
+}
+
+// TokenFromContext returns the session token in the `authorization: Bearer`
+// metadata of an incoming call, or an empty string.
+func TokenFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	for _, value := range md.Get("authorization") {
+		scheme, token, found := strings.Cut(value, " ")
+		if found && strings.EqualFold(scheme, "bearer") {
+			return strings.TrimSpace(token)
+		}
+	}
+
+	return ""
+}
+
+// UnaryInterceptor resolves the user making a unary call, checks that their
+// role allows the call, and stores them in the context of the handler.
+func UnaryInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpclib.UnaryServerInfo,
+	handler grpclib.UnaryHandler,
+) (resp any, err error) {
+	client := database.CreateEntClient()
+	ctx, err = Authenticate(ctx, client, info.FullMethod, req, time.Now())
+	log.Err(client.Close()).Msg("database client close on auth.UnaryInterceptor")
+	if err != nil {
+		return
+	}
+
+	if err = Authorize(ctx, info.FullMethod); err != nil {
+		return
+	}
+
+	resp, err = handler(ctx, req)
+	err = permissionError(err)
+
+	return
+}
+
+// StreamInterceptor resolves the user making a streaming call when the first
+// request message arrives, as the legacy user field is part of it.
+func StreamInterceptor(
+	srv any,
+	ss grpclib.ServerStream,
+	info *grpclib.StreamServerInfo,
+	handler grpclib.StreamHandler,
+) error {
+	return permissionError(handler(srv, &authStream{ServerStream: ss, method: info.FullMethod}))
+}
+
+type authStream struct {
+	grpclib.ServerStream
+	method string
+	ctx    context.Context
+}
+
+func (s *authStream) Context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+
+	return s.ServerStream.Context()
+}
+
+func (s *authStream) RecvMsg(m any) (err error) {
+	if err = s.ServerStream.RecvMsg(m); err != nil || s.ctx != nil {
+		return
+	}
+
+	client := database.CreateEntClient()
+	ctx, err := Authenticate(s.ServerStream.Context(), client, s.method, m, time.Now())
+	log.Err(client.Close()).Msg("database client close on auth.StreamInterceptor")
+	if err != nil {
+		return
+	}
+
+	if err = Authorize(ctx, s.method); err != nil {
+		return
+	}
+
+	s.ctx = ctx
+	return
+}
+
+// Authenticate returns the context of a call with the user making it.
+//
+// A call with a session token acts as the user of the session, a call with an
+// API key as the owner of the key, and a call with a token of the configured
+// identity provider as the user named by its user claim. Public methods
+// can be called without one. When authentication is not required, other calls
+// without a token act as the user named in the request, as before logins
+// existed, unless that user is protected by a password, a role above reader
+// or restrictions, in which case they act as the default user.
+func Authenticate(
+	ctx context.Context,
+	client *ent.Client,
+	method string,
+	req any,
+	now time.Time,
+) (context.Context, error) {
+	if token := TokenFromContext(ctx); token != "" {
+		if isAPIKey(token) {
+			u, k, err := ResolveAPIKey(ctx, client, token, now)
+			if err != nil {
+				return ctx, err
+			}
+
+			return withScopes(user.NewContext(ctx, u), k.Scopes), nil
+		}
+
+		var u *ent.User
+		var err error
+		if v := currentVerifier(ctx); v != nil && isJWT(token) {
+			u, err = v.Resolve(ctx, client, token, now)
+		} else {
+			u, err = Resolve(ctx, client, token, now)
+		}
+		if err != nil {
+			return ctx, err
+		}
+
+		return user.NewContext(ctx, u), nil
+	}
+
+	if PublicMethods[method] {
+		return ctx, nil
+	}
+
+	if configuration.Get().AuthRequired {
+		return ctx, status.Error(codes.Unauthenticated, "login required")
+	}
+
+	email := ""
+	if r, ok := req.(interface{ GetUser() string }); ok {
+		email = r.GetUser()
+	}
+
+	u, err := user.GetUser(ctx, client, email)
+	if err != nil {
+		return ctx, err
+	}
+
+	if u.Email != user.DEFAULT_EMAIL {
+		open, err := isOpenUser(ctx, u)
+		if err != nil {
+			return ctx, err
+		}
+
+		if !open {
+			if u, err = user.GetUser(ctx, client, ""); err != nil {
+				return ctx, err
+			}
+		}
+	}
+
+	return user.NewContext(ctx, u), nil
+}
+
+// isOpenUser reports whether a call without a token can act as the user:
+// users with a password, a role above reader or restrictions can only be
+// reached by logging in.
+func isOpenUser(ctx context.Context, u *ent.User) (bool, error) {
+	if u.PasswordHash != "" || user.HasRole(u.Role, ent_user.RoleEditor) {
+		return false, nil
+	}
+
+	if u.MaxAgeRating != nil || len(u.AllowedPaths) > 0 {
+		return false, nil
+	}
+
+	denied, err := u.QueryDeniedTags().Exist(ctx)
+	return !denied, err
+}
@@ -0,0 +1,164 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// JWK is a public key of a JSON web key set.
+type JWK struct {
+	ID        string
+	Algorithm string
+	Key       crypto.PublicKey
+}
+
+// KeySet is a JSON web key set.
+type KeySet []JWK
+
+// Find returns the keys with the ID, or every key when the ID is empty.
+func (set KeySet) Find(id string) (keys []JWK) {
+	for _, k := range set {
+		if id == "" || k.ID == id {
+			keys = append(keys, k)
+		}
+	}
+
+	return
+}
+
+// ParseKeySet parses the RSA and EC signing keys of a JSON web key set. Keys
+// of other types and encryption keys are skipped.
+func ParseKeySet(data []byte) (set KeySet, err error) {
+	var doc struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Use string `json:"use"`
+			Kid string `json:"kid"`
+			Alg string `json:"alg"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+			Crv string `json:"crv"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		} `json:"keys"`
+	}
+
+	if err = json.Unmarshal(data, &doc); err != nil {
+		return
+	}
+
+	for _, k := range doc.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		var key crypto.PublicKey
+		switch k.Kty {
+		case "RSA":
+			n, err := decodeBigInt(k.N)
+			if err != nil {
+				return nil, fmt.Errorf("invalid RSA key %q: %w", k.Kid, err)
+			}
+			e, err := decodeBigInt(k.E)
+			if err != nil || !e.IsInt64() {
+				return nil, fmt.Errorf("invalid RSA key %q: bad exponent", k.Kid)
+			}
+			key = &rsa.PublicKey{N: n, E: int(e.Int64())}
+
+		case "EC":
+			var curve elliptic.Curve
+			switch k.Crv {
+			case "P-256":
+				curve = elliptic.P256()
+			case "P-384":
+				curve = elliptic.P384()
+			case "P-521":
+				curve = elliptic.P521()
+			default:
+				continue
+			}
+
+			x, err := decodeBigInt(k.X)
+			if err != nil {
+				return nil, fmt.Errorf("invalid EC key %q: %w", k.Kid, err)
+			}
+			y, err := decodeBigInt(k.Y)
+			if err != nil {
+				return nil, fmt.Errorf("invalid EC key %q: %w", k.Kid, err)
+			}
+			if !curve.IsOnCurve(x, y) {
+				return nil, fmt.Errorf("invalid EC key %q: point is not on the curve", k.Kid)
+			}
+			key = &ecdsa.PublicKey{Curve: curve, X: x, Y: y}
+
+		default:
+			continue
+		}
+
+		set = append(set, JWK{ID: k.Kid, Algorithm: k.Alg, Key: key})
+	}
+
+	if len(set) == 0 {
+		err = fmt.Errorf("no signing keys in the key set")
+	}
+
+	return
+}
+
+// LoadKeySet reads a JSON web key set from a file, or downloads it when the
+// source is an http(s) URL.
+func LoadKeySet(ctx context.Context, source string) (set KeySet, err error) {
+	var data []byte
+
+	if strings.HasPrefix(source, "https://") || strings.HasPrefix(source, "http://") {
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("loading key set fails: %s", resp.Status)
+		}
+
+		if data, err = io.ReadAll(io.LimitReader(resp.Body, 1<<20)); err != nil {
+			return nil, err
+		}
+	} else if data, err = os.ReadFile(source); err != nil {
+		return
+	}
+
+	return ParseKeySet(data)
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, fmt.Errorf("empty value")
+	}
+
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,356 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mangaweb4/mangaweb4-backend/configuration"
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	ent_user "github.com/mangaweb4/mangaweb4-backend/ent/user"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// clockSkew is how far the clocks of the server and the identity provider may
+// differ when the times of a token are checked.
+const clockSkew = time.Minute
+
+// keySetRefreshInterval is the minimum time between two downloads of a key set
+// caused by tokens signed with unknown keys.
+const keySetRefreshInterval = time.Minute
+
+// Verifier checks the bearer tokens issued by an OpenID Connect provider.
+type Verifier struct {
+	config configuration.OIDC
+	reload singleflight.Group
+
+	mutex    sync.Mutex
+	keys     KeySet
+	loadTime time.Time
+}
+
+// NewVerifier loads the key set of the configuration and returns a verifier
+// for its tokens.
+func NewVerifier(ctx context.Context, c configuration.OIDC) (v *Verifier, err error) {
+	if c.Issuer == "" || c.Audience == "" {
+		err = fmt.Errorf("issuer and audience are required to verify tokens")
+		return
+	}
+
+	if c.UserClaim == "" {
+		c.UserClaim = configuration.DefaultOIDCUserClaim
+	}
+
+	keys, err := LoadKeySet(ctx, c.KeySet)
+	if err != nil {
+		return
+	}
+
+	v = &Verifier{config: c, keys: keys, loadTime: time.Now()}
+	return
+}
+
+// Verify checks the signature, issuer, audience and times of a token, and
+// returns its claims.
+func (v *Verifier) Verify(ctx context.Context, token string, now time.Time) (claims map[string]any, err error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		err = fmt.Errorf("malformed token")
+		return
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err = decodeSegment(parts[0], &header); err != nil {
+		return
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return
+	}
+
+	keys := v.findKeys(ctx, header.Kid)
+	if len(keys) == 0 {
+		err = fmt.Errorf("unknown key: %s", header.Kid)
+		return
+	}
+
+	verified := false
+	for _, k := range keys {
+		if k.Algorithm != "" && k.Algorithm != header.Alg {
+			continue
+		}
+
+		ok, verifyErr := verifySignature(header.Alg, k.Key, parts[0]+"."+parts[1], signature)
+		if verifyErr != nil {
+			err = verifyErr
+			return
+		}
+		if ok {
+			verified = true
+			break
+		}
+	}
+
+	if !verified {
+		err = fmt.Errorf("invalid signature")
+		return
+	}
+
+	if err = decodeSegment(parts[1], &claims); err != nil {
+		return
+	}
+
+	err = v.checkClaims(claims, now)
+	return
+}
+
+// User returns the active user named by the user claim. Unknown users are
+// created when auto provisioning is on. When the user claim is the email, the
+// provider must also have verified it, so that no one can sign in as a user
+// by registering their email with the provider.
+func (v *Verifier) User(ctx context.Context, client *ent.Client, claims map[string]any) (u *ent.User, err error) {
+	email, _ := claims[v.config.UserClaim].(string)
+	if email == "" {
+		err = status.Errorf(codes.Unauthenticated, "claim %s is missing", v.config.UserClaim)
+		return
+	}
+
+	if verified, _ := claims["email_verified"].(bool); v.config.UserClaim == "email" && !verified {
+		err = status.Errorf(codes.Unauthenticated, "email is not verified: %s", email)
+		return
+	}
+
+	u, err = client.User.Query().Where(ent_user.Email(email)).Only(ctx)
+	if ent.IsNotFound(err) && v.config.AutoProvision {
+		return client.User.Create().SetEmail(email).Save(ctx)
+	} else if ent.IsNotFound(err) {
+		err = status.Errorf(codes.Unauthenticated, "unknown user: %s", email)
+		return
+	} else if err != nil {
+		return
+	}
+
+	if !u.Active {
+		err = status.Errorf(codes.Unauthenticated, "inactive user: %s", email)
+	}
+
+	return
+}
+
+// Resolve returns the user of a valid token. Invalid tokens fail with
+// Unauthenticated.
+func (v *Verifier) Resolve(ctx context.Context, client *ent.Client, token string, now time.Time) (u *ent.User, err error) {
+	claims, err := v.Verify(ctx, token, now)
+	if err != nil {
+		err = status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+		return
+	}
+
+	return v.User(ctx, client, claims)
+}
+
+// findKeys returns the keys with the ID. When there are none, the key set is
+// loaded again, as the provider may have rotated its keys. Concurrent calls
+// wait for the same reload, and the lock is not held while it downloads.
+func (v *Verifier) findKeys(ctx context.Context, id string) []JWK {
+	v.mutex.Lock()
+	keys := v.keys.Find(id)
+	recent := time.Since(v.loadTime) < keySetRefreshInterval
+	v.mutex.Unlock()
+
+	if len(keys) > 0 || recent {
+		return keys
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case result := <-v.reload.DoChan("", v.reloadKeys):
+		if result.Err != nil {
+			return nil
+		}
+
+		return result.Val.(KeySet).Find(id)
+	}
+}
+
+// reloadKeys loads the key set again, unless it was loaded within the refresh
+// interval. The download outlives the call that started it, so it does not
+// use the context of the call.
+func (v *Verifier) reloadKeys() (any, error) {
+	v.mutex.Lock()
+	keys, recent := v.keys, time.Since(v.loadTime) < keySetRefreshInterval
+	v.mutex.Unlock()
+
+	if recent {
+		return keys, nil
+	}
+
+	keys, err := LoadKeySet(context.Background(), v.config.KeySet)
+
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	v.loadTime = time.Now()
+	if err != nil {
+		log.Error().Err(err).Str("source", v.config.KeySet).Msg("Reloading key set fails.")
+		return nil, err
+	}
+
+	v.keys = keys
+	return keys, nil
+}
+
+func (v *Verifier) checkClaims(claims map[string]any, now time.Time) error {
+	if iss, _ := claims["iss"].(string); iss != v.config.Issuer {
+		return fmt.Errorf("invalid issuer: %s", iss)
+	}
+
+	audienceMatch := false
+	switch aud := claims["aud"].(type) {
+	case string:
+		audienceMatch = aud == v.config.Audience
+	case []any:
+		for _, a := range aud {
+			if a == v.config.Audience {
+				audienceMatch = true
+			}
+		}
+	}
+	if !audienceMatch {
+		return fmt.Errorf("invalid audience")
+	}
+
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return fmt.Errorf("token has no expiry")
+	}
+	if now.Add(-clockSkew).After(time.Unix(int64(exp), 0)) {
+		return fmt.Errorf("token is expired")
+	}
+
+	if nbf, ok := claims["nbf"].(float64); ok && now.Add(clockSkew).Before(time.Unix(int64(nbf), 0)) {
+		return fmt.Errorf("token is not valid yet")
+	}
+
+	return nil
+}
+
+// verifySignature checks a signature made with the RSA and ECDSA algorithms of
+// JSON web signatures.
+func verifySignature(alg string, key crypto.PublicKey, signed string, signature []byte) (bool, error) {
+	var hash crypto.Hash
+	switch alg[min(len(alg), 2):] {
+	case "256":
+		hash = crypto.SHA256
+	case "384":
+		hash = crypto.SHA384
+	case "512":
+		hash = crypto.SHA512
+	default:
+		return false, fmt.Errorf("unsupported algorithm: %s", alg)
+	}
+
+	h := hash.New()
+	h.Write([]byte(signed))
+	digest := h.Sum(nil)
+
+	switch {
+	case strings.HasPrefix(alg, "RS"):
+		k, ok := key.(*rsa.PublicKey)
+		return ok && rsa.VerifyPKCS1v15(k, hash, digest, signature) == nil, nil
+
+	case strings.HasPrefix(alg, "PS"):
+		k, ok := key.(*rsa.PublicKey)
+		return ok && rsa.VerifyPSS(k, hash, digest, signature, nil) == nil, nil
+
+	case strings.HasPrefix(alg, "ES"):
+		k, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return false, nil
+		}
+
+		size := (k.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return false, nil
+		}
+
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		return ecdsa.Verify(k, digest, r, s), nil
+	}
+
+	return false, fmt.Errorf("unsupported algorithm: %s", alg)
+}
+
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+var (
+	verifierMutex    sync.Mutex
+	verifier         *Verifier
+	verifierSource   configuration.OIDC
+	verifierFailTime time.Time
+)
+
+// currentVerifier returns the verifier of the configured identity provider,
+// or nil when tokens of a provider are not accepted. When its key set fails to
+// load, it is tried again after keySetRefreshInterval at the earliest.
+func currentVerifier(ctx context.Context) *Verifier {
+	verifierMutex.Lock()
+	defer verifierMutex.Unlock()
+
+	c := configuration.Get().OIDC
+	if c.KeySet == "" {
+		return nil
+	}
+
+	if verifierSource == c {
+		if verifier != nil {
+			return verifier
+		}
+		if time.Since(verifierFailTime) < keySetRefreshInterval {
+			return nil
+		}
+	}
+
+	verifierSource = c
+	v, err := NewVerifier(ctx, c)
+	if err != nil {
+		log.Error().Err(err).Str("source", c.KeySet).Msg("Loading OIDC key set fails.")
+		verifier = nil
+		verifierFailTime = time.Now()
+		return nil
+	}
+
+	verifier = v
+
+	return verifier
+}
+
+// isJWT reports whether a bearer token is a JSON web token rather than a
+// session token, which never contains dots.
+func isJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
@@ -0,0 +1,307 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mangaweb4/mangaweb4-backend/configuration"
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+	"github.com/mangaweb4/mangaweb4-backend/user"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testIssuer   = "https://id.example.com"
+	testAudience = "mangaweb"
+)
+
+type OIDCTestSuite struct {
+	suite.Suite
+	rsaKey *rsa.PrivateKey
+	ecKey  *ecdsa.PrivateKey
+}
+
+func TestOIDCTestSuite(t *testing.T) {
+	suite.Run(t, new(OIDCTestSuite))
+}
+
+func (s *OIDCTestSuite) SetupSuite() {
+	var err error
+	s.rsaKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().Nil(err)
+	s.ecKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().Nil(err)
+}
+
+func (s *OIDCTestSuite) keySet(rsaID string) []byte {
+	b64 := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+
+	data, err := json.Marshal(map[string]any{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA", "use": "sig", "kid": rsaID, "alg": "RS256",
+				"n": b64(s.rsaKey.N.Bytes()), "e": b64(big.NewInt(int64(s.rsaKey.E)).Bytes()),
+			},
+			{
+				"kty": "EC", "kid": "ec", "crv": "P-256",
+				"x": b64(s.ecKey.X.FillBytes(make([]byte, 32))), "y": b64(s.ecKey.Y.FillBytes(make([]byte, 32))),
+			},
+			{"kty": "oct", "kid": "secret", "k": "c2VjcmV0"},
+		},
+	})
+	s.Require().Nil(err)
+
+	return data
+}
+
+func (s *OIDCTestSuite) writeKeySet(rsaID string) string {
+	path := filepath.Join(s.T().TempDir(), "jwks.json")
+	s.Require().Nil(os.WriteFile(path, s.keySet(rsaID), 0o600))
+
+	return path
+}
+
+func (s *OIDCTestSuite) sign(alg string, kid string, claims map[string]any) string {
+	header, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+
+	var signature []byte
+	switch alg {
+	case "RS256":
+		var err error
+		signature, err = rsa.SignPKCS1v15(rand.Reader, s.rsaKey, crypto.SHA256, digest[:])
+		s.Require().Nil(err)
+	case "ES256":
+		r, sig, err := ecdsa.Sign(rand.Reader, s.ecKey, digest[:])
+		s.Require().Nil(err)
+		signature = append(r.FillBytes(make([]byte, 32)), sig.FillBytes(make([]byte, 32))...)
+	}
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func claims(now time.Time, email string) map[string]any {
+	return map[string]any{
+		"iss":            testIssuer,
+		"aud":            []string{"other", testAudience},
+		"sub":            "12345",
+		"email":          email,
+		"email_verified": true,
+		"exp":            now.Add(time.Hour).Unix(),
+		"iat":            now.Unix(),
+	}
+}
+
+func (s *OIDCTestSuite) TestVerify() {
+	ctx := context.Background()
+	now := time.Now()
+
+	v, err := NewVerifier(ctx, configuration.OIDC{KeySet: s.writeKeySet("rsa"), Issuer: testIssuer, Audience: testAudience})
+	s.Require().Nil(err)
+
+	got, err := v.Verify(ctx, s.sign("RS256", "rsa", claims(now, "reader@example.com")), now)
+	s.Assert().Nil(err)
+	s.Assert().Equal("reader@example.com", got["email"])
+
+	_, err = v.Verify(ctx, s.sign("ES256", "ec", claims(now, "reader@example.com")), now)
+	s.Assert().Nil(err)
+
+	// The RSA key only signs RS256 tokens.
+	_, err = v.Verify(ctx, s.sign("ES256", "rsa", claims(now, "reader@example.com")), now)
+	s.Assert().NotNil(err)
+
+	_, err = v.Verify(ctx, s.sign("none", "rsa", claims(now, "reader@example.com")), now)
+	s.Assert().NotNil(err)
+
+	_, err = v.Verify(ctx, s.sign("RS256", "unknown", claims(now, "reader@example.com")), now)
+	s.Assert().NotNil(err)
+
+	_, err = v.Verify(ctx, s.sign("RS256", "rsa", claims(now, "reader@example.com")), now.Add(2*time.Hour))
+	s.Assert().NotNil(err)
+
+	c := claims(now, "reader@example.com")
+	c["iss"] = "https://evil.example.com"
+	_, err = v.Verify(ctx, s.sign("RS256", "rsa", c), now)
+	s.Assert().NotNil(err)
+
+	c = claims(now, "reader@example.com")
+	c["aud"] = "other"
+	_, err = v.Verify(ctx, s.sign("RS256", "rsa", c), now)
+	s.Assert().NotNil(err)
+
+	c = claims(now, "reader@example.com")
+	c["nbf"] = now.Add(time.Hour).Unix()
+	_, err = v.Verify(ctx, s.sign("RS256", "rsa", c), now)
+	s.Assert().NotNil(err)
+
+	// The claims cannot be changed without signing them again.
+	token := strings.Split(s.sign("RS256", "rsa", claims(now, "reader@example.com")), ".")
+	other := strings.Split(s.sign("RS256", "rsa", claims(now, "admin@example.com")), ".")
+	_, err = v.Verify(ctx, token[0]+"."+other[1]+"."+token[2], now)
+	s.Assert().NotNil(err)
+}
+
+func (s *OIDCTestSuite) TestKeySetURL() {
+	ctx := context.Background()
+	now := time.Now()
+
+	kid := "old"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(s.keySet(kid))
+	}))
+	defer server.Close()
+
+	v, err := NewVerifier(ctx, configuration.OIDC{KeySet: server.URL, Issuer: testIssuer, Audience: testAudience})
+	s.Require().Nil(err)
+
+	_, err = v.Verify(ctx, s.sign("RS256", "old", claims(now, "reader@example.com")), now)
+	s.Assert().Nil(err)
+
+	// A rotated key is picked up once the key set may be downloaded again.
+	kid = "new"
+	_, err = v.Verify(ctx, s.sign("RS256", "new", claims(now, "reader@example.com")), now)
+	s.Assert().NotNil(err)
+
+	v.loadTime = time.Time{}
+	_, err = v.Verify(ctx, s.sign("RS256", "new", claims(now, "reader@example.com")), now)
+	s.Assert().Nil(err)
+}
+
+func (s *OIDCTestSuite) TestKeySetReloadOutlivesCall() {
+	now := time.Now()
+
+	kid := "old"
+	downloads := atomic.Int32{}
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if downloads.Add(1) > 1 {
+			<-release
+		}
+		w.Write(s.keySet(kid))
+	}))
+	defer server.Close()
+
+	v, err := NewVerifier(context.Background(), configuration.OIDC{KeySet: server.URL, Issuer: testIssuer, Audience: testAudience})
+	s.Require().Nil(err)
+
+	kid = "new"
+	v.loadTime = time.Time{}
+
+	// The call gives up, but the download it started goes on.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = v.Verify(ctx, s.sign("RS256", "new", claims(now, "reader@example.com")), now)
+	s.Assert().NotNil(err)
+
+	close(release)
+	_, err = v.Verify(context.Background(), s.sign("RS256", "new", claims(now, "reader@example.com")), now)
+	s.Assert().Nil(err)
+	s.Assert().Equal(int32(2), downloads.Load())
+}
+
+func (s *OIDCTestSuite) TestAuthenticate() {
+	db, client, err := createTestDBClient(s)
+	s.Require().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	old := configuration.Get()
+	defer configuration.Init(old)
+
+	ctx := context.Background()
+	now := time.Now()
+
+	withToken := func(token string) context.Context {
+		return metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+	}
+
+	oidc := configuration.OIDC{
+		KeySet:    s.writeKeySet("rsa"),
+		Issuer:    testIssuer,
+		Audience:  testAudience,
+		UserClaim: "email",
+	}
+	configuration.Init(configuration.Config{AuthRequired: true, OIDC: oidc})
+
+	token := s.sign("RS256", "rsa", claims(now, "reader@example.com"))
+	_, err = Authenticate(withToken(token), client, grpc.User_Info_FullMethodName, &grpc.UserInfoRequest{}, now)
+	s.Assert().Equal(codes.Unauthenticated, status.Code(err))
+
+	u, err := user.GetUser(ctx, client, "reader@example.com")
+	s.Require().Nil(err)
+
+	authed, err := Authenticate(withToken(token), client, grpc.User_Info_FullMethodName, &grpc.UserInfoRequest{}, now)
+	s.Assert().Nil(err)
+	current, err := user.Current(authed, client)
+	s.Assert().Nil(err)
+	s.Assert().Equal(u.ID, current.ID)
+
+	_, err = Authenticate(withToken(token+"x"), client, grpc.User_Info_FullMethodName, &grpc.UserInfoRequest{}, now)
+	s.Assert().Equal(codes.Unauthenticated, status.Code(err))
+
+	// Emails the provider has not verified are not accepted.
+	unverified := claims(now, "reader@example.com")
+	unverified["email_verified"] = false
+	_, err = Authenticate(withToken(s.sign("RS256", "rsa", unverified)), client, grpc.User_Info_FullMethodName, &grpc.UserInfoRequest{}, now)
+	s.Assert().Equal(codes.Unauthenticated, status.Code(err))
+	delete(unverified, "email_verified")
+	_, err = Authenticate(withToken(s.sign("RS256", "rsa", unverified)), client, grpc.User_Info_FullMethodName, &grpc.UserInfoRequest{}, now)
+	s.Assert().Equal(codes.Unauthenticated, status.Code(err))
+
+	oidc.AutoProvision = true
+	configuration.Init(configuration.Config{AuthRequired: true, OIDC: oidc})
+
+	token = s.sign("ES256", "ec", claims(now, "new@example.com"))
+	authed, err = Authenticate(withToken(token), client, grpc.User_Info_FullMethodName, &grpc.UserInfoRequest{}, now)
+	s.Assert().Nil(err)
+	current, err = user.Current(authed, client)
+	s.Assert().Nil(err)
+	s.Assert().Equal("new@example.com", current.Email)
+
+	s.Assert().Nil(current.Update().SetActive(false).Exec(ctx))
+	_, err = Authenticate(withToken(token), client, grpc.User_Info_FullMethodName, &grpc.UserInfoRequest{}, now)
+	s.Assert().Equal(codes.Unauthenticated, status.Code(err))
+}
+
+func (s *OIDCTestSuite) TestCurrentVerifierBackoff() {
+	old := configuration.Get()
+	defer configuration.Init(old)
+	defer func() { verifier, verifierSource, verifierFailTime = nil, configuration.OIDC{}, time.Time{} }()
+
+	ctx := context.Background()
+
+	path := filepath.Join(s.T().TempDir(), "jwks.json")
+	configuration.Init(configuration.Config{OIDC: configuration.OIDC{
+		KeySet:   path,
+		Issuer:   testIssuer,
+		Audience: testAudience,
+	}})
+	s.Assert().Nil(currentVerifier(ctx))
+
+	// A key set that fails to load is not tried again right away.
+	s.Require().Nil(os.WriteFile(path, s.keySet("rsa"), 0o600))
+	s.Assert().Nil(currentVerifier(ctx))
+
+	verifierFailTime = time.Now().Add(-keySetRefreshInterval)
+	s.Assert().NotNil(currentVerifier(ctx))
+}
@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// MinPasswordLength is the minimum number of bytes of a password.
+const MinPasswordLength = 8
+
+// The argon2id parameters of new password hashes. Hashes store their own
+// parameters, so that they can be raised without invalidating old hashes.
+const (
+	argonMemory  = 64 * 1024
+	argonTime    = 3
+	argonThreads = 2
+	argonSaltLen = 16
+	argonKeyLen  = 32
+)
+
+// HashPassword returns the argon2id hash of the password in the PHC string
+// format, with a random salt.
+func HashPassword(password string) (encoded string, err error) {
+	if len(password) < MinPasswordLength {
+		err = fmt.Errorf("password must be at least %d characters", MinPasswordLength)
+		return
+	}
+
+	salt := make([]byte, argonSaltLen)
+	if _, err = rand.Read(salt); err != nil {
+		return
+	}
+
+	key := argon2.IDKey([]byte(password), salt, argonTime, argonMemory, argonThreads, argonKeyLen)
+	encoded = fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, argonMemory, argonTime, argonThreads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key))
+
+	return
+}
+
+// VerifyPassword reports whether the password matches the hash made by
+// HashPassword. An empty hash matches no password.
+func VerifyPassword(encoded string, password string) (match bool, err error) {
+	if encoded == "" {
+		return
+	}
+
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		err = fmt.Errorf("invalid password hash")
+		return
+	}
+
+	var version int
+	if _, err = fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return
+	}
+	if version != argon2.Version {
+		err = fmt.Errorf("unsupported argon2 version: %d", version)
+		return
+	}
+
+	var memory, time uint32
+	var threads uint8
+	if _, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &time, &threads); err != nil {
+		return
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return
+	}
+
+	other := argon2.IDKey([]byte(password), salt, time, memory, threads, uint32(len(key)))
+	match = subtle.ConstantTimeCompare(key, other) == 1
+
+	return
+}
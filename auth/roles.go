@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mangaweb4/mangaweb4-backend/ent/privacy"
+	ent_user "github.com/mangaweb4/mangaweb4-backend/ent/user"
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+	"github.com/mangaweb4/mangaweb4-backend/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MethodRoles are the least privileged roles that may call each method.
+// Methods that are not listed can only be called by admins.
+var MethodRoles = map[string]ent_user.Role{
+	// Browsing and reading, which also records the reading history.
+	grpc.Auth_Logout_FullMethodName:           ent_user.RoleGuest,
+	grpc.History_List_FullMethodName:          ent_user.RoleGuest,
+	grpc.Manga_List_FullMethodName:            ent_user.RoleGuest,
+	grpc.Manga_Detail_FullMethodName:          ent_user.RoleGuest,
+	grpc.Manga_Thumbnail_FullMethodName:       ent_user.RoleGuest,
+	grpc.Manga_PageImage_FullMethodName:       ent_user.RoleGuest,
+	grpc.Manga_PageImageStream_FullMethodName: ent_user.RoleGuest,
+	grpc.Manga_Download_FullMethodName:        ent_user.RoleGuest,
+	grpc.Search_Suggest_FullMethodName:        ent_user.RoleGuest,
+	grpc.Tag_List_FullMethodName:              ent_user.RoleGuest,
+	grpc.Tag_Detail_FullMethodName:            ent_user.RoleGuest,
+	grpc.Tag_Thumbnail_FullMethodName:         ent_user.RoleGuest,
+	grpc.Tag_Related_FullMethodName:           ent_user.RoleGuest,
+	grpc.User_Info_FullMethodName:             ent_user.RoleGuest,
+	grpc.User_GetPreferences_FullMethodName:   ent_user.RoleGuest,
+
+	// Settings of the users themselves.
+	grpc.ApiKey_Create_FullMethodName:          ent_user.RoleReader,
+	grpc.ApiKey_List_FullMethodName:            ent_user.RoleReader,
+	grpc.ApiKey_Revoke_FullMethodName:          ent_user.RoleReader,
+	grpc.Auth_ChangePassword_FullMethodName:    ent_user.RoleReader,
+	grpc.Manga_SetFavorite_FullMethodName:      ent_user.RoleReader,
+	grpc.Manga_SetProgress_FullMethodName:      ent_user.RoleReader,
+	grpc.Manga_SetBlocked_FullMethodName:       ent_user.RoleReader,
+	grpc.User_UpdatePreferences_FullMethodName: ent_user.RoleReader,
+	grpc.Tag_SetFavorite_FullMethodName:        ent_user.RoleReader,
+	grpc.Tag_SetBlocked_FullMethodName:         ent_user.RoleReader,
+	grpc.SavedSearch_Create_FullMethodName:     ent_user.RoleReader,
+	grpc.SavedSearch_List_FullMethodName:       ent_user.RoleReader,
+	grpc.SavedSearch_Update_FullMethodName:     ent_user.RoleReader,
+	grpc.SavedSearch_Delete_FullMethodName:     ent_user.RoleReader,
+	grpc.SavedSearch_Run_FullMethodName:        ent_user.RoleReader,
+
+	// Changes to the shared library.
+	grpc.Manga_UpdateCover_FullMethodName:  ent_user.RoleEditor,
+	grpc.Manga_Repair_FullMethodName:       ent_user.RoleEditor,
+	grpc.Manga_AddTag_FullMethodName:       ent_user.RoleEditor,
+	grpc.Manga_RemoveTag_FullMethodName:    ent_user.RoleEditor,
+	grpc.Manga_SetHidden_FullMethodName:    ent_user.RoleEditor,
+	grpc.Manga_SetAgeRating_FullMethodName: ent_user.RoleEditor,
+	grpc.Tag_Merge_FullMethodName:          ent_user.RoleEditor,
+	grpc.Tag_SetHidden_FullMethodName:      ent_user.RoleEditor,
+	grpc.Tag_Rename_FullMethodName:         ent_user.RoleEditor,
+	grpc.Tag_UpdateInfo_FullMethodName:     ent_user.RoleEditor,
+}
+
+// Authorize checks that the user of an authenticated context may call the
+// method, and that the scopes of their API key, if any, allow it.
+func Authorize(ctx context.Context, method string) error {
+	if PublicMethods[method] {
+		return nil
+	}
+
+	minimum, ok := MethodRoles[method]
+	if !ok {
+		minimum = ent_user.RoleAdmin
+	}
+
+	role, ok := user.RoleFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "login required")
+	}
+
+	if !user.HasRole(role, minimum) {
+		return status.Errorf(codes.PermissionDenied, "%s requires the %s role", method, minimum)
+	}
+
+	return allowedByScopes(ctx, method)
+}
+
+// permissionError reports the errors of privacy rules as PermissionDenied.
+func permissionError(err error) error {
+	if errors.Is(err, privacy.Deny) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	return err
+}
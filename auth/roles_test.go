@@ -0,0 +1,126 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mangaweb4/mangaweb4-backend/ent/privacy"
+	ent_user "github.com/mangaweb4/mangaweb4-backend/ent/user"
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+	"github.com/mangaweb4/mangaweb4-backend/user"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type RolesTestSuite struct {
+	suite.Suite
+}
+
+func TestRolesTestSuite(t *testing.T) {
+	suite.Run(t, new(RolesTestSuite))
+}
+
+func (s *RolesTestSuite) TestAuthorize() {
+	db, client, err := createTestDBClient(s)
+	s.Require().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	contextOf := func(email string, role ent_user.Role) context.Context {
+		u, err := user.GetUser(ctx, client, email)
+		s.Require().Nil(err)
+		u, err = u.Update().SetRole(role).Save(ctx)
+		s.Require().Nil(err)
+
+		return user.NewContext(ctx, u)
+	}
+
+	guest := contextOf("guest@example.com", ent_user.RoleGuest)
+	reader := contextOf("reader@example.com", ent_user.RoleReader)
+	editor := contextOf("editor@example.com", ent_user.RoleEditor)
+	admin := contextOf("admin@example.com", ent_user.RoleAdmin)
+
+	s.Assert().Nil(Authorize(ctx, grpc.System_Info_FullMethodName))
+	s.Assert().Equal(codes.Unauthenticated, status.Code(Authorize(ctx, grpc.Manga_List_FullMethodName)))
+
+	s.Assert().Nil(Authorize(guest, grpc.Manga_List_FullMethodName))
+	s.Assert().Equal(codes.PermissionDenied, status.Code(Authorize(guest, grpc.Manga_SetFavorite_FullMethodName)))
+
+	s.Assert().Nil(Authorize(reader, grpc.Manga_SetFavorite_FullMethodName))
+	s.Assert().Equal(codes.PermissionDenied, status.Code(Authorize(reader, grpc.Manga_UpdateCover_FullMethodName)))
+
+	s.Assert().Nil(Authorize(editor, grpc.Manga_Repair_FullMethodName))
+	s.Assert().Equal(codes.PermissionDenied, status.Code(Authorize(editor, grpc.Maintenance_UpdateLibrary_FullMethodName)))
+
+	s.Assert().Nil(Authorize(admin, grpc.Maintenance_PurgeCache_FullMethodName))
+	s.Assert().Nil(Authorize(admin, grpc.Maintenance_UpdateLibrary_FullMethodName))
+}
+
+func (s *RolesTestSuite) TestPrivacy() {
+	db, client, err := createTestDBClient(s)
+	s.Require().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	// Calls without a user belong to the server, which may change anything.
+	m, err := client.Meta.Create().SetName("Naruto 1.zip").Save(ctx)
+	s.Require().Nil(err)
+
+	reader, err := user.GetUser(ctx, client, "reader@example.com")
+	s.Require().Nil(err)
+	editor, err := user.GetUser(ctx, client, "editor@example.com")
+	s.Require().Nil(err)
+	editor, err = editor.Update().SetRole(ent_user.RoleEditor).Save(ctx)
+	s.Require().Nil(err)
+
+	readerCtx := user.NewContext(ctx, reader)
+	editorCtx := user.NewContext(ctx, editor)
+
+	err = client.Meta.UpdateOne(m).SetHidden(true).Exec(readerCtx)
+	s.Assert().ErrorIs(err, privacy.Deny)
+	s.Assert().Equal(codes.PermissionDenied, status.Code(permissionError(err)))
+	s.Assert().Nil(client.Meta.UpdateOne(m).SetHidden(true).Exec(editorCtx))
+
+	_, err = client.Tag.Create().SetName("ninja").Save(readerCtx)
+	s.Assert().ErrorIs(err, privacy.Deny)
+
+	// Users can change their own settings, but not their role, nor other
+	// users.
+	s.Assert().Nil(reader.Update().AddFavoriteItems(m).Exec(readerCtx))
+	s.Assert().ErrorIs(reader.Update().SetRole(ent_user.RoleAdmin).Exec(readerCtx), privacy.Deny)
+	s.Assert().ErrorIs(editor.Update().AddFavoriteItems(m).Exec(readerCtx), privacy.Deny)
+	s.Assert().ErrorIs(reader.Update().SetRole(ent_user.RoleAdmin).Exec(editorCtx), privacy.Deny)
+
+	guest, err := reader.Update().SetRole(ent_user.RoleGuest).Save(ctx)
+	s.Require().Nil(err)
+	s.Assert().ErrorIs(guest.Update().RemoveFavoriteItems(m).Exec(user.NewContext(ctx, guest)), privacy.Deny)
+}
+
+func (s *RolesTestSuite) TestBootstrap() {
+	db, client, err := createTestDBClient(s)
+	s.Require().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	u, err := user.GetUser(ctx, client, "admin@example.com")
+	s.Require().Nil(err)
+	s.Assert().Equal(ent_user.RoleReader, u.Role)
+
+	u, err = Bootstrap(ctx, client, "admin@example.com", "correct horse")
+	s.Assert().Nil(err)
+	s.Assert().Equal(ent_user.RoleAdmin, u.Role)
+
+	_, _, err = Login(ctx, client, "admin@example.com", "correct horse", time.Now())
+	s.Assert().Nil(err)
+
+	// An existing password is kept.
+	_, err = Bootstrap(ctx, client, "admin@example.com", "battery staple")
+	s.Assert().Nil(err)
+	_, _, err = Login(ctx, client, "admin@example.com", "correct horse", time.Now())
+	s.Assert().Nil(err)
+}
@@ -0,0 +1,221 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/mangaweb4/mangaweb4-backend/configuration"
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/session"
+	ent_user "github.com/mangaweb4/mangaweb4-backend/ent/user"
+	"github.com/mangaweb4/mangaweb4-backend/user"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrInvalidCredentials is returned by Login and ChangePassword when the
+	// email or password is wrong. It does not tell which one.
+	ErrInvalidCredentials = status.Error(codes.Unauthenticated, "invalid email or password")
+	// ErrInvalidSession is returned for unknown and expired session tokens.
+	ErrInvalidSession = status.Error(codes.Unauthenticated, "invalid or expired session")
+)
+
+// SessionDuration returns how long a login lasts.
+func SessionDuration() time.Duration {
+	days := configuration.Get().SessionDays
+	if days <= 0 {
+		days = configuration.DefaultSessionDays
+	}
+
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// Login checks the password of the active user with the email, and starts a
+// session for them. The returned token is not stored and cannot be read back.
+// The user of the session is loaded in its edges.
+func Login(
+	ctx context.Context,
+	client *ent.Client,
+	email string,
+	password string,
+	now time.Time,
+) (token string, s *ent.Session, err error) {
+	u, err := client.User.Query().Where(ent_user.Email(email), ent_user.Active(true)).Only(ctx)
+	if ent.IsNotFound(err) {
+		err = ErrInvalidCredentials
+		return
+	} else if err != nil {
+		return
+	}
+
+	match, err := VerifyPassword(u.PasswordHash, password)
+	if err != nil {
+		return
+	}
+	if !match {
+		err = ErrInvalidCredentials
+		return
+	}
+
+	if token, err = newToken(); err != nil {
+		return
+	}
+
+	s, err = client.Session.Create().
+		SetUser(u).
+		SetTokenHash(hashToken(token)).
+		SetCreateTime(now).
+		SetExpireTime(now.Add(SessionDuration())).
+		Save(ctx)
+	if err != nil {
+		return
+	}
+
+	s.Edges.User = u
+	return
+}
+
+// Logout ends the session of the token. Unknown tokens are ignored.
+func Logout(ctx context.Context, client *ent.Client, token string) (err error) {
+	_, err = client.Session.Delete().Where(session.TokenHash(hashToken(token))).Exec(ctx)
+	return
+}
+
+// Resolve returns the active user of an unexpired session token.
+func Resolve(ctx context.Context, client *ent.Client, token string, now time.Time) (u *ent.User, err error) {
+	s, err := client.Session.Query().
+		Where(session.TokenHash(hashToken(token)), session.ExpireTimeGT(now)).
+		WithUser().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		err = ErrInvalidSession
+		return
+	} else if err != nil {
+		return
+	}
+
+	if !s.Edges.User.Active {
+		err = ErrInvalidSession
+		return
+	}
+
+	u = s.Edges.User
+	return
+}
+
+// ChangePassword replaces the password of the user after checking the current
+// one. A user without a password can set one without it. Every other session
+// of the user ends, so only the session of the token stays logged in.
+func ChangePassword(
+	ctx context.Context,
+	client *ent.Client,
+	u *ent.User,
+	current string,
+	password string,
+	token string,
+) (err error) {
+	if u.PasswordHash != "" {
+		match, err := VerifyPassword(u.PasswordHash, current)
+		if err != nil {
+			return err
+		}
+		if !match {
+			return ErrInvalidCredentials
+		}
+	}
+
+	hash, err := HashPassword(password)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err = u.Update().SetPasswordHash(hash).Exec(ctx); err != nil {
+		return
+	}
+
+	_, err = client.Session.Delete().
+		Where(session.HasUserWith(ent_user.ID(u.ID)), session.TokenHashNEQ(hashToken(token))).
+		Exec(ctx)
+
+	return
+}
+
+// ResetPassword replaces the password of the user without the current one,
+// and ends every session of the user.
+func ResetPassword(ctx context.Context, client *ent.Client, u *ent.User, password string) (err error) {
+	hash, err := HashPassword(password)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err = u.Update().SetPasswordHash(hash).Exec(ctx); err != nil {
+		return
+	}
+
+	_, err = client.Session.Delete().Where(session.HasUserWith(ent_user.ID(u.ID))).Exec(ctx)
+	return
+}
+
+// CreateUser creates a user with the role and, when it is not empty, the
+// password. The password is checked before anything is written, and the user
+// is created with it in one transaction, so that a rejected password leaves
+// no user without one behind.
+func CreateUser(ctx context.Context, client *ent.Client, email string, role ent_user.Role, password string) (u *ent.User, err error) {
+	hash := ""
+	if password != "" {
+		if hash, err = HashPassword(password); err != nil {
+			err = status.Error(codes.InvalidArgument, err.Error())
+			return
+		}
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			log.Err(tx.Rollback()).Msg("rollback creating user")
+		}
+	}()
+
+	if u, err = user.Create(ctx, tx.Client(), email, role); err != nil {
+		return
+	}
+
+	if hash != "" {
+		if u, err = tx.User.UpdateOne(u).SetPasswordHash(hash).Save(ctx); err != nil {
+			return
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return
+	}
+
+	u = u.Unwrap()
+	return
+}
+
+// newToken returns a random session token.
+func newToken() (token string, err error) {
+	b := make([]byte, 32)
+	if _, err = rand.Read(b); err != nil {
+		return
+	}
+
+	token = base64.RawURLEncoding.EncodeToString(b)
+	return
+}
+
+// hashToken returns the hash a session token is stored as.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
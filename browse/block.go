@@ -0,0 +1,65 @@
+package browse
+
+import (
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/mangaweb4/mangaweb4-backend/ent/predicate"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/user"
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+	user_util "github.com/mangaweb4/mangaweb4-backend/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BlockedItems matches the items the user is not interested in, or that carry
+// a tag the user has blocked.
+func BlockedItems(u *ent.User) predicate.Meta {
+	return meta.Or(
+		meta.HasBlockedByUserWith(user.ID(u.ID)),
+		meta.HasTagsWith(BlockedTags(u)),
+	)
+}
+
+// BlockedTags matches the tags the user has blocked.
+func BlockedTags(u *ent.User) predicate.Tag {
+	return tag.HasBlockedByUserWith(user.ID(u.ID))
+}
+
+// CheckFilter checks that the user may list with the filter. Only editors and
+// admins, who hide items and tags, can list what is hidden.
+func CheckFilter(u *ent.User, filter grpc.Filter) error {
+	if filter == grpc.Filter_FILTER_HIDDEN && !user_util.HasRole(u.Role, user.RoleEditor) {
+		return status.Error(codes.PermissionDenied, "listing hidden items and tags requires the editor role")
+	}
+
+	return nil
+}
+
+// Items matches the items an item listing shows to the user. FILTER_HIDDEN
+// lists the hidden items and FILTER_BLOCKED the items the user has blocked,
+// every other filter lists the visible items the user has not blocked. Items
+// outside the restrictions of the user are never listed.
+func Items(u *ent.User, filter grpc.Filter) predicate.Meta {
+	switch filter {
+	case grpc.Filter_FILTER_HIDDEN:
+		return meta.And(HiddenItems(), AllowedItems(u))
+	case grpc.Filter_FILTER_BLOCKED:
+		return meta.And(VisibleItems(), AllowedItems(u), BlockedItems(u))
+	default:
+		return meta.And(VisibleItems(), AllowedItems(u), meta.Not(BlockedItems(u)))
+	}
+}
+
+// Tags matches the tags a tag listing shows to the user, following the same
+// rules as Items.
+func Tags(u *ent.User, filter grpc.Filter) predicate.Tag {
+	switch filter {
+	case grpc.Filter_FILTER_HIDDEN:
+		return tag.And(HiddenTags(), AllowedTags(u))
+	case grpc.Filter_FILTER_BLOCKED:
+		return tag.And(VisibleTags(), AllowedTags(u), BlockedTags(u))
+	default:
+		return tag.And(VisibleTags(), AllowedTags(u), tag.Not(BlockedTags(u)))
+	}
+}
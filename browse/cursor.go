@@ -0,0 +1,288 @@
+package browse
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SortKey is a term of the order of a listing. Listings end their keys with
+// the ID, so that the keys of a row are unique and a cursor can resume right
+// after it. Expr must never be NULL.
+type SortKey struct {
+	Expr func(s *sql.Selector) sql.Querier
+	Desc bool
+	// Prepare adds what Expr refers to, such as a join, to the selector.
+	Prepare func(s *sql.Selector)
+}
+
+// ColumnKey returns the sort key of a column that is never NULL.
+func ColumnKey(column string, desc bool) SortKey {
+	return SortKey{
+		Expr: func(s *sql.Selector) sql.Querier { return sql.Expr(s.C(column)) },
+		Desc: desc,
+	}
+}
+
+// TimeKey returns the sort key of a time column that is never NULL. SQLite
+// stores times as text, which is compared as it is stored rather than as the
+// time the driver parses it into.
+func TimeKey(column string, desc bool) SortKey {
+	return SortKey{
+		Expr: func(s *sql.Selector) sql.Querier {
+			if s.Dialect() == dialect.SQLite {
+				return sql.Expr(fmt.Sprintf("CAST(%s AS TEXT)", s.C(column)))
+			}
+
+			return sql.Expr(s.C(column))
+		},
+		Desc: desc,
+	}
+}
+
+// CoalesceKey returns the sort key of a column that may be NULL. NULL sorts
+// as the given zero value.
+func CoalesceKey(column string, zero any, desc bool) SortKey {
+	return SortKey{
+		Expr: func(s *sql.Selector) sql.Querier {
+			return sql.ExprFunc(func(b *sql.Builder) {
+				b.WriteString("COALESCE(").WriteString(s.C(column)).WriteString(", ").Arg(zero).WriteString(")")
+			})
+		},
+		Desc: desc,
+	}
+}
+
+func keyAlias(i int) string {
+	return fmt.Sprintf("sort_key_%d", i)
+}
+
+// Order orders the rows by the keys. The key values are selected along with
+// the rows, so that KeyValues can read the cursor of a row.
+func Order(keys ...SortKey) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		for i, k := range keys {
+			if k.Prepare != nil {
+				k.Prepare(s)
+			}
+
+			s.AppendSelectExprAs(k.Expr(s), keyAlias(i))
+			if k.Desc {
+				s.OrderBy(sql.Desc(keyAlias(i)))
+			} else {
+				s.OrderBy(sql.Asc(keyAlias(i)))
+			}
+		}
+	}
+}
+
+// After matches the rows that come after the key values in the order of the
+// keys.
+func After(values []any, keys ...SortKey) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		compare := func(k SortKey, op sql.Op, v any) *sql.Predicate {
+			return sql.P(func(b *sql.Builder) {
+				b.Join(k.Expr(s)).WriteOp(op).Arg(v)
+			})
+		}
+
+		after := make([]*sql.Predicate, len(keys))
+		for i, k := range keys {
+			terms := make([]*sql.Predicate, 0, i+1)
+			for j := 0; j < i; j++ {
+				terms = append(terms, compare(keys[j], sql.OpEQ, values[j]))
+			}
+
+			if k.Desc {
+				terms = append(terms, compare(k, sql.OpLT, values[i]))
+			} else {
+				terms = append(terms, compare(k, sql.OpGT, values[i]))
+			}
+
+			after[i] = sql.And(terms...)
+		}
+
+		s.Where(sql.Or(after...))
+	}
+}
+
+// KeyValues reads the values of the first n sort keys selected by Order.
+func KeyValues(row interface {
+	Value(string) (ent.Value, error)
+}, n int) (values []any, err error) {
+	values = make([]any, n)
+	for i := range values {
+		v, e := row.Value(keyAlias(i))
+		if e != nil {
+			err = e
+			return
+		}
+
+		if p, ok := v.(*any); ok {
+			v = *p
+		}
+		if b, ok := v.([]byte); ok {
+			v = string(b)
+		}
+
+		values[i] = v
+	}
+
+	return
+}
+
+// NextCursor returns the cursor of the page after rows, a page of at most
+// limit rows read with Order and n keys. It is empty when the page is the
+// last one.
+func NextCursor[T interface {
+	Value(string) (ent.Value, error)
+}](
+	rows []T, limit int, n int, sort grpc.SortField, order grpc.SortOrder,
+) (cursor string, err error) {
+	if limit <= 0 || len(rows) < limit {
+		return
+	}
+
+	values, err := KeyValues(rows[len(rows)-1], n)
+	if err != nil {
+		return
+	}
+
+	return EncodeCursor(sort, order, values)
+}
+
+// CursorError is an invalid or mismatched cursor token.
+type CursorError struct {
+	Msg string
+}
+
+func (e *CursorError) Error() string {
+	return "cursor: " + e.Msg
+}
+
+// GRPCStatus reports the error to gRPC clients as InvalidArgument.
+func (e *CursorError) GRPCStatus() *status.Status {
+	return status.New(codes.InvalidArgument, e.Error())
+}
+
+type cursorValue struct {
+	Kind  string `json:"k"`
+	Value any    `json:"v,omitempty"`
+}
+
+type cursorToken struct {
+	Sort   grpc.SortField `json:"s"`
+	Order  grpc.SortOrder `json:"o"`
+	Values []cursorValue  `json:"v"`
+}
+
+// EncodeCursor returns the opaque token of a position in a listing: the sort
+// key values of the last row of a page, and the order they belong to.
+func EncodeCursor(sort grpc.SortField, order grpc.SortOrder, values []any) (string, error) {
+	token := cursorToken{Sort: sort, Order: order, Values: make([]cursorValue, len(values))}
+	for i, v := range values {
+		switch v := v.(type) {
+		case nil:
+			token.Values[i] = cursorValue{Kind: "n"}
+		case string:
+			token.Values[i] = cursorValue{Kind: "s", Value: v}
+		case bool:
+			token.Values[i] = cursorValue{Kind: "b", Value: v}
+		case int:
+			token.Values[i] = cursorValue{Kind: "i", Value: int64(v)}
+		case int64:
+			token.Values[i] = cursorValue{Kind: "i", Value: v}
+		case float64:
+			token.Values[i] = cursorValue{Kind: "f", Value: v}
+		case time.Time:
+			token.Values[i] = cursorValue{Kind: "t", Value: v.Format(time.RFC3339Nano)}
+		default:
+			return "", fmt.Errorf("cursor: unsupported sort key value %T", v)
+		}
+	}
+
+	data, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodeCursor returns the sort key values of a cursor token. The token must
+// have been created for the same sort field and order, with n values.
+func DecodeCursor(cursor string, sort grpc.SortField, order grpc.SortOrder, n int) (values []any, err error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		err = &CursorError{Msg: "malformed token"}
+		return
+	}
+
+	token := cursorToken{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if e := decoder.Decode(&token); e != nil {
+		err = &CursorError{Msg: "malformed token"}
+		return
+	}
+
+	if token.Sort != sort || token.Order != order || len(token.Values) != n {
+		err = &CursorError{Msg: "token belongs to a different sort order"}
+		return
+	}
+
+	values = make([]any, n)
+	for i, v := range token.Values {
+		if values[i], err = v.decode(); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+func (v cursorValue) decode() (out any, err error) {
+	invalid := &CursorError{Msg: fmt.Sprintf("invalid %q value", v.Kind)}
+
+	switch v.Kind {
+	case "n":
+		return nil, nil
+	case "s":
+		if s, ok := v.Value.(string); ok {
+			return s, nil
+		}
+	case "b":
+		if b, ok := v.Value.(bool); ok {
+			return b, nil
+		}
+	case "i":
+		if n, ok := v.Value.(json.Number); ok {
+			if i, e := n.Int64(); e == nil {
+				return i, nil
+			}
+		}
+	case "f":
+		if n, ok := v.Value.(json.Number); ok {
+			if f, e := n.Float64(); e == nil {
+				return f, nil
+			}
+		}
+	case "t":
+		if s, ok := v.Value.(string); ok {
+			if t, e := time.Parse(time.RFC3339Nano, s); e == nil {
+				return t, nil
+			}
+		}
+	}
+
+	return nil, invalid
+}
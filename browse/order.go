@@ -0,0 +1,166 @@
+package browse
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/history"
+	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/mangaweb4/mangaweb4-backend/ent/progress"
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+)
+
+// randomModulus is the prime the seeded random order permutes item IDs
+// modulo. Item IDs are assumed to be below it.
+const randomModulus = 2147483647
+
+// ItemKeys returns the sort keys of an item sort field. The keys end with the
+// name and the ID as tie-breakers, so that pages do not overlap. Relevance is
+// not handled here, as it depends on the search text.
+func ItemKeys(u *ent.User, field grpc.SortField, order grpc.SortOrder, seed int64) (keys []SortKey, err error) {
+	desc := order == grpc.SortOrder_SORT_ORDER_DESCENDING
+
+	switch field {
+	case grpc.SortField_SORT_FIELD_NAME:
+	case grpc.SortField_SORT_FIELD_CREATION_TIME:
+		keys = []SortKey{TimeKey(meta.FieldCreateTime, desc)}
+	case grpc.SortField_SORT_FIELD_PAGECOUNT:
+		keys = []SortKey{PageCountKey(desc)}
+	case grpc.SortField_SORT_FIELD_SERIES:
+		keys = []SortKey{
+			CoalesceKey(meta.FieldSeries, "", desc),
+			CoalesceKey(meta.FieldVolume, 0.0, desc),
+			CoalesceKey(meta.FieldChapter, 0.0, desc),
+		}
+	case grpc.SortField_SORT_FIELD_LAST_READ:
+		keys = LastReadKeys(u, desc)
+	case grpc.SortField_SORT_FIELD_PROGRESS:
+		keys = []SortKey{ProgressKey(u, desc)}
+	case grpc.SortField_SORT_FIELD_POPULARITY:
+		keys = []SortKey{PopularityKey(desc)}
+	case grpc.SortField_SORT_FIELD_NATURAL_NAME:
+		keys = []SortKey{CoalesceKey(meta.FieldSortName, "", desc)}
+	case grpc.SortField_SORT_FIELD_RANDOM:
+		keys = []SortKey{RandomKey(seed)}
+	default:
+		err = fmt.Errorf("invalid sort value: %v", field)
+		return
+	}
+
+	keys = append(keys, ColumnKey(meta.FieldName, desc), ColumnKey(meta.FieldID, desc))
+	return
+}
+
+// PageCountKey orders the items by their number of pages.
+func PageCountKey(desc bool) SortKey {
+	return SortKey{
+		Expr: func(s *sql.Selector) sql.Querier {
+			return sql.ExprFunc(func(b *sql.Builder) { pageCount(b, s.C(meta.FieldFileIndices)) })
+		},
+		Desc: desc,
+	}
+}
+
+// LastReadKeys order the items by the last time the user read them. Items the
+// user has not read come last in both directions.
+func LastReadKeys(u *ent.User, desc bool) []SortKey {
+	lastRead := func(s *sql.Selector) *sql.Selector {
+		h := sql.Table(history.Table)
+		return sql.Dialect(s.Dialect()).
+			Select(sql.Max(h.C(history.FieldCreateTime))).
+			From(h).
+			Where(sql.And(
+				sql.ColumnsEQ(h.C(history.ItemColumn), s.C(meta.FieldID)),
+				sql.EQ(h.C(history.UserColumn), u.ID),
+			))
+	}
+
+	return []SortKey{
+		{
+			Expr: func(s *sql.Selector) sql.Querier {
+				return sql.ExprFunc(func(b *sql.Builder) {
+					b.WriteString("CASE WHEN ").Wrap(func(b *sql.Builder) { b.Join(lastRead(s)) }).
+						WriteString(" IS NULL THEN 1 ELSE 0 END")
+				})
+			},
+		},
+		{
+			Expr: func(s *sql.Selector) sql.Querier {
+				return sql.ExprFunc(func(b *sql.Builder) {
+					b.WriteString("COALESCE(").Wrap(func(b *sql.Builder) { b.Join(lastRead(s)) }).
+						WriteString(", ").Arg(time.Time{}).WriteString(")")
+				})
+			},
+			Desc: desc,
+		},
+	}
+}
+
+// ProgressKey orders the items by the share of their pages the user has read.
+// Items the user has not opened count as not read at all.
+func ProgressKey(u *ent.User, desc bool) SortKey {
+	return SortKey{
+		Expr: func(s *sql.Selector) sql.Querier {
+			p := sql.Table(progress.Table)
+			read := sql.Dialect(s.Dialect()).
+				Select().
+				From(p).
+				Where(sql.And(
+					sql.ColumnsEQ(p.C(progress.FieldItemID), s.C(meta.FieldID)),
+					sql.EQ(p.C(progress.FieldUserID), u.ID),
+				))
+			read.AppendSelectExpr(sql.ExprFunc(func(b *sql.Builder) {
+				b.WriteString("(").WriteString(p.C(progress.FieldMax)).WriteString(" + 1) * 1.0 / NULLIF(")
+				pageCount(b, s.C(meta.FieldFileIndices))
+				b.WriteString(", 0)")
+			}))
+
+			return sql.ExprFunc(func(b *sql.Builder) {
+				b.WriteString("COALESCE(").Wrap(func(b *sql.Builder) { b.Join(read) }).WriteString(", 0)")
+			})
+		},
+		Desc: desc,
+	}
+}
+
+// PopularityKey orders the items by the number of times they were read by any
+// user.
+func PopularityKey(desc bool) SortKey {
+	return SortKey{
+		Expr: func(s *sql.Selector) sql.Querier {
+			h := sql.Table(history.Table)
+			reads := sql.Dialect(s.Dialect()).
+				Select(sql.Count("*")).
+				From(h).
+				Where(sql.ColumnsEQ(h.C(history.ItemColumn), s.C(meta.FieldID)))
+
+			return sql.ExprFunc(func(b *sql.Builder) {
+				b.Wrap(func(b *sql.Builder) { b.Join(reads) })
+			})
+		},
+		Desc: desc,
+	}
+}
+
+// RandomKey orders the items in a random order that only depends on the seed,
+// so that every page of a listing follows the same order.
+func RandomKey(seed int64) SortKey {
+	multiplier := splitMix(uint64(seed))%(randomModulus-1) + 1
+	offset := splitMix(uint64(seed)+1) % randomModulus
+
+	return SortKey{
+		Expr: func(s *sql.Selector) sql.Querier {
+			return sql.Expr(fmt.Sprintf("(%s * %d + %d) %% %d", s.C(meta.FieldID), multiplier, offset, randomModulus))
+		},
+	}
+}
+
+// splitMix scrambles the seed, so that close seeds give unrelated orders.
+func splitMix(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
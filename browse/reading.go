@@ -0,0 +1,127 @@
+package browse
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mangaweb4/mangaweb4-backend/configuration"
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/history"
+	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/mangaweb4/mangaweb4-backend/ent/predicate"
+	"github.com/mangaweb4/mangaweb4-backend/ent/progress"
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+)
+
+// ReadingItems matches the items of the reading-state filters: unread, in
+// progress, completed, recently added and with new pages. It returns nil for
+// every other filter.
+func ReadingItems(u *ent.User, filter grpc.Filter, now time.Time) predicate.Meta {
+	switch filter {
+	case grpc.Filter_FILTER_UNREAD:
+		return UnreadItems(u)
+	case grpc.Filter_FILTER_IN_PROGRESS:
+		return InProgressItems(u)
+	case grpc.Filter_FILTER_COMPLETED:
+		return CompletedItems(u)
+	case grpc.Filter_FILTER_RECENTLY_ADDED:
+		return RecentItems(now)
+	case grpc.Filter_FILTER_NEW_PAGES:
+		return NewPageItems(u)
+	default:
+		return nil
+	}
+}
+
+// UnreadItems matches the items the user has not opened.
+func UnreadItems(u *ent.User) predicate.Meta {
+	return meta.Not(meta.HasProgressWith(progress.UserID(u.ID)))
+}
+
+// InProgressItems matches the items the user has opened but not read up to
+// the last page.
+func InProgressItems(u *ent.User) predicate.Meta {
+	return readUpTo(u, false)
+}
+
+// CompletedItems matches the items the user has read up to the last page.
+func CompletedItems(u *ent.User) predicate.Meta {
+	return readUpTo(u, true)
+}
+
+// RecentItems matches the items added within the configured number of days
+// before now.
+func RecentItems(now time.Time) predicate.Meta {
+	days := configuration.Get().RecentlyAddedDays
+	if days <= 0 {
+		days = configuration.DefaultRecentlyAddedDays
+	}
+
+	return meta.CreateTimeGTE(now.AddDate(0, 0, -days))
+}
+
+// NewPageItems matches the items the user has read whose pages changed after
+// the last time the user read them.
+func NewPageItems(u *ent.User) predicate.Meta {
+	return predicate.Meta(func(s *sql.Selector) {
+		read := func(since bool) *sql.Selector {
+			h := sql.Table(history.Table)
+			predicates := []*sql.Predicate{
+				sql.ColumnsEQ(h.C(history.ItemColumn), s.C(meta.FieldID)),
+				sql.EQ(h.C(history.UserColumn), u.ID),
+			}
+			if since {
+				predicates = append(predicates, sql.ColumnsGTE(h.C(history.FieldCreateTime), s.C(meta.FieldPagesUpdateTime)))
+			}
+
+			return sql.Dialect(s.Dialect()).Select(h.C(history.FieldID)).From(h).Where(sql.And(predicates...))
+		}
+
+		s.Where(sql.And(
+			sql.NotNull(s.C(meta.FieldPagesUpdateTime)),
+			sql.Exists(read(false)),
+			sql.NotExists(read(true)),
+		))
+	})
+}
+
+// readUpTo matches the items the user has opened, and whose furthest read page
+// is the last page when completed is true, or an earlier page otherwise.
+func readUpTo(u *ent.User, completed bool) predicate.Meta {
+	return predicate.Meta(func(s *sql.Selector) {
+		p := sql.Table(progress.Table)
+		last := sql.P(func(b *sql.Builder) {
+			b.WriteString(p.C(progress.FieldMax)).WriteString(" + 1")
+			if completed {
+				b.WriteOp(sql.OpGTE)
+			} else {
+				b.WriteOp(sql.OpLT)
+			}
+			pageCount(b, s.C(meta.FieldFileIndices))
+		})
+
+		s.Where(sql.Exists(
+			sql.Dialect(s.Dialect()).
+				Select(p.C(progress.FieldID)).
+				From(p).
+				Where(sql.And(
+					sql.ColumnsEQ(p.C(progress.FieldItemID), s.C(meta.FieldID)),
+					sql.EQ(p.C(progress.FieldUserID), u.ID),
+					last,
+				)),
+		))
+	})
+}
+
+// pageCount writes the length of the page index array in the column.
+func pageCount(b *sql.Builder, column string) {
+	switch b.Dialect() {
+	case dialect.Postgres:
+		b.WriteString("JSONB_ARRAY_LENGTH(").WriteString(column).WriteString(")")
+	case dialect.MySQL:
+		b.WriteString("JSON_LENGTH(").WriteString(column).WriteString(")")
+	default:
+		b.WriteString("JSON_ARRAY_LENGTH(").WriteString(column).WriteString(")")
+	}
+}
@@ -0,0 +1,51 @@
+package browse
+
+import (
+	"os"
+
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/mangaweb4/mangaweb4-backend/ent/predicate"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/user"
+)
+
+// DeniedTags matches the tags an admin has denied to the user.
+func DeniedTags(u *ent.User) predicate.Tag {
+	return tag.HasDeniedToUserWith(user.ID(u.ID))
+}
+
+// AllowedItems matches the items the restrictions of the user let them see:
+// items rated at most their maximum age rating, in one of their allowed
+// paths, and without any of their denied tags.
+func AllowedItems(u *ent.User) predicate.Meta {
+	predicates := []predicate.Meta{meta.Not(meta.HasTagsWith(DeniedTags(u)))}
+
+	// Unrated items could hold anything, so they are hidden from users with
+	// a maximum age rating too.
+	if u.MaxAgeRating != nil {
+		predicates = append(predicates, meta.AgeRatingGT(0), meta.AgeRatingLTE(*u.MaxAgeRating))
+	}
+
+	if len(u.AllowedPaths) > 0 {
+		paths := make([]predicate.Meta, 0, 2*len(u.AllowedPaths))
+		for _, p := range u.AllowedPaths {
+			paths = append(paths, meta.Name(p), meta.NameHasPrefix(p+string(os.PathSeparator)))
+		}
+
+		predicates = append(predicates, meta.Or(paths...))
+	}
+
+	return meta.And(predicates...)
+}
+
+// AllowedTags matches the tags the restrictions of the user let them see:
+// tags that are not denied to them and, when their items are restricted too,
+// that have an item they are allowed to see.
+func AllowedTags(u *ent.User) predicate.Tag {
+	if u.MaxAgeRating == nil && len(u.AllowedPaths) == 0 {
+		return tag.Not(DeniedTags(u))
+	}
+
+	return tag.And(tag.Not(DeniedTags(u)), tag.HasMetaWith(AllowedItems(u)))
+}
@@ -0,0 +1,45 @@
+// Package browse holds the predicates shared by the item and tag listings, so
+// that every listing hides the same items and tags.
+package browse
+
+import (
+	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/mangaweb4/mangaweb4-backend/ent/predicate"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+)
+
+// hidingTag matches tags that are hidden together with their items.
+func hidingTag() predicate.Tag {
+	return tag.And(tag.Hidden(true), tag.HideItems(true))
+}
+
+// VisibleItems matches the active items that are neither hidden themselves nor
+// carry a tag hidden together with its items.
+func VisibleItems() predicate.Meta {
+	return meta.And(
+		meta.Active(true),
+		meta.Hidden(false),
+		meta.Not(meta.HasTagsWith(hidingTag())),
+	)
+}
+
+// HiddenItems matches the active items that VisibleItems excludes.
+func HiddenItems() predicate.Meta {
+	return meta.And(
+		meta.Active(true),
+		meta.Or(
+			meta.Hidden(true),
+			meta.HasTagsWith(hidingTag()),
+		),
+	)
+}
+
+// VisibleTags matches the active tags that are not hidden.
+func VisibleTags() predicate.Tag {
+	return tag.And(tag.Active(true), tag.Hidden(false))
+}
+
+// HiddenTags matches the active tags that are hidden.
+func HiddenTags() predicate.Tag {
+	return tag.And(tag.Active(true), tag.Hidden(true))
+}
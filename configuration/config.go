@@ -1,10 +1,89 @@
 package configuration
 
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
 type Config struct {
 	DebugMode          bool
 	DataPath           string
 	CachePath          string
 	FirstLevelDirAsTag bool
+	TagRules           []TagRule
+	PathTemplates      []string
+	TagNormalization   TagNormalization
+	RecentlyAddedDays  int
+	// AuthRequired rejects calls without a session. Otherwise, such calls act
+	// as the user named in the request.
+	AuthRequired bool
+	SessionDays  int
+	OIDC         OIDC
+}
+
+// OIDC configures the bearer tokens issued by an OpenID Connect provider.
+// Such tokens are not accepted when KeySet is empty.
+type OIDC struct {
+	// KeySet is the path or the http(s) URL of the JSON web key set that
+	// signs the tokens.
+	KeySet   string
+	Issuer   string
+	Audience string
+	// UserClaim is the claim holding the email of the user.
+	UserClaim string
+	// AutoProvision creates the users of valid tokens that do not exist yet.
+	AutoProvision bool
+}
+
+// DefaultRecentlyAddedDays is the number of days an item counts as recently
+// added when RecentlyAddedDays is not set.
+const DefaultRecentlyAddedDays = 14
+
+// DefaultSessionDays is the number of days a login lasts when SessionDays is
+// not set.
+const DefaultSessionDays = 30
+
+// DefaultOIDCUserClaim is the claim holding the email of the user when
+// OIDC.UserClaim is not set.
+const DefaultOIDCUserClaim = "email"
+
+// TagNormalization selects how tag names are normalized before they are
+// compared to each other.
+type TagNormalization struct {
+	TrimSpace bool
+	CaseFold  bool
+	NFKC      bool
+}
+
+// ParseTagNormalization parses a comma separated list of normalization steps,
+// any of `trim`, `casefold` and `nfkc`.
+func ParseTagNormalization(value string) (n TagNormalization, err error) {
+	for _, step := range strings.Split(value, ",") {
+		switch strings.ToLower(strings.TrimSpace(step)) {
+		case "":
+			continue
+		case "trim":
+			n.TrimSpace = true
+		case "casefold":
+			n.CaseFold = true
+		case "nfkc":
+			n.NFKC = true
+		default:
+			err = fmt.Errorf("invalid tag normalization step: %s", step)
+			return
+		}
+	}
+
+	return
+}
+
+// TagRule maps a regular expression to a tag category. The first capture group
+// of the expression, or the group named `tag` if present, becomes the tag name.
+type TagRule struct {
+	Pattern  string `json:"pattern"`
+	Category string `json:"category"`
 }
 
 var config Config
@@ -16,3 +95,25 @@ func Init(c Config) {
 func Get() Config {
 	return config
 }
+
+// LoadTagRules reads an ordered list of tag rules from a JSON file.
+func LoadTagRules(path string) (rules []TagRule, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(data, &rules)
+	return
+}
+
+// LoadPathTemplates reads an ordered list of path templates from a JSON file.
+func LoadPathTemplates(path string) (templates []string, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(data, &templates)
+	return
+}
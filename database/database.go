@@ -13,6 +13,9 @@ import (
 	"github.com/mangaweb4/mangaweb4-backend/configuration"
 	"github.com/mangaweb4/mangaweb4-backend/ent"
 	"github.com/mangaweb4/mangaweb4-backend/ent/migrate"
+	// required by schema hooks.
+	_ "github.com/mangaweb4/mangaweb4-backend/ent/runtime"
+	"github.com/mangaweb4/mangaweb4-backend/search"
 	"github.com/rs/zerolog/log"
 	_ "modernc.org/sqlite"
 )
@@ -135,6 +138,7 @@ func CreateEntClient() *ent.Client {
 	}
 
 	client := ent.NewClient(options...)
+	search.NewIndex(databaseType).Use(client)
 
 	return client
 }
@@ -143,5 +147,30 @@ func CreateSchema(ctx context.Context) error {
 	client := CreateEntClient()
 	defer func() { log.Err(client.Close()).Msg("close database connection") }()
 
-	return client.Schema.Create(ctx, migrate.WithDropColumn(true), migrate.WithDropIndex(true))
+	if err := client.Schema.Create(ctx, migrate.WithDropColumn(true), migrate.WithDropIndex(true)); err != nil {
+		return err
+	}
+
+	index := search.NewIndex(databaseType)
+	if err := index.Create(ctx, client); err != nil {
+		return err
+	}
+
+	// The index is kept up to date by hooks, so it only needs to be filled
+	// when it was just created.
+	empty, err := index.Empty(ctx, client)
+	if err != nil || !empty {
+		return err
+	}
+
+	return index.Rebuild(ctx, client)
+}
+
+// RebuildSearchIndex replaces the content of the search index with the text
+// of every item and tag.
+func RebuildSearchIndex(ctx context.Context) error {
+	client := CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("close database connection") }()
+
+	return search.NewIndex(databaseType).Rebuild(ctx, client)
 }
@@ -12,10 +12,15 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/mangaweb4/mangaweb4-backend/ent/apikey"
 	"github.com/mangaweb4/mangaweb4-backend/ent/history"
 	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/mangaweb4/mangaweb4-backend/ent/metatag"
 	"github.com/mangaweb4/mangaweb4-backend/ent/progress"
+	"github.com/mangaweb4/mangaweb4-backend/ent/savedsearch"
+	"github.com/mangaweb4/mangaweb4-backend/ent/session"
 	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tagalias"
 	"github.com/mangaweb4/mangaweb4-backend/ent/user"
 )
 
@@ -77,11 +82,16 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			history.Table:  history.ValidColumn,
-			meta.Table:     meta.ValidColumn,
-			progress.Table: progress.ValidColumn,
-			tag.Table:      tag.ValidColumn,
-			user.Table:     user.ValidColumn,
+			apikey.Table:      apikey.ValidColumn,
+			history.Table:     history.ValidColumn,
+			meta.Table:        meta.ValidColumn,
+			metatag.Table:     metatag.ValidColumn,
+			progress.Table:    progress.ValidColumn,
+			savedsearch.Table: savedsearch.ValidColumn,
+			session.Table:     session.ValidColumn,
+			tag.Table:         tag.ValidColumn,
+			tagalias.Table:    tagalias.ValidColumn,
+			user.Table:        user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -1,3 +1,3 @@
 package ent
 
-//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate ./schema --feature sql/upsert
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate ./schema --feature privacy,sql/upsert,sql/modifier,sql/execquery
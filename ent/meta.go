@@ -20,6 +20,10 @@ type Meta struct {
 	ID int `json:"id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// SearchKey holds the value of the "search_key" field.
+	SearchKey string `json:"search_key,omitempty"`
+	// SortName holds the value of the "sort_name" field.
+	SortName string `json:"sort_name,omitempty"`
 	// CreateTime holds the value of the "create_time" field.
 	CreateTime time.Time `json:"create_time,omitempty"`
 	// Favorite holds the value of the "favorite" field.
@@ -28,6 +32,8 @@ type Meta struct {
 	Favorite bool `json:"favorite,omitempty"`
 	// FileIndices holds the value of the "file_indices" field.
 	FileIndices []int `json:"file_indices,omitempty"`
+	// PagesUpdateTime holds the value of the "pages_update_time" field.
+	PagesUpdateTime *time.Time `json:"pages_update_time,omitempty"`
 	// Read holds the value of the "read" field.
 	//
 	// Deprecated: use 'progress' or 'histories' edge instead.
@@ -48,6 +54,18 @@ type Meta struct {
 	ThumbnailWidth int `json:"thumbnail_width,omitempty"`
 	// ThumbnailHeight holds the value of the "thumbnail_height" field.
 	ThumbnailHeight int `json:"thumbnail_height,omitempty"`
+	// Series holds the value of the "series" field.
+	Series string `json:"series,omitempty"`
+	// Volume holds the value of the "volume" field.
+	Volume float64 `json:"volume,omitempty"`
+	// Chapter holds the value of the "chapter" field.
+	Chapter float64 `json:"chapter,omitempty"`
+	// Artist holds the value of the "artist" field.
+	Artist string `json:"artist,omitempty"`
+	// Year holds the value of the "year" field.
+	Year int `json:"year,omitempty"`
+	// AgeRating holds the value of the "age_rating" field.
+	AgeRating int `json:"age_rating,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the MetaQuery when eager-loading is set.
 	Edges        MetaEdges `json:"edges"`
@@ -58,15 +76,21 @@ type Meta struct {
 type MetaEdges struct {
 	// Tags holds the value of the tags edge.
 	Tags []*Tag `json:"tags,omitempty"`
+	// ExcludedTags holds the value of the excluded_tags edge.
+	ExcludedTags []*Tag `json:"excluded_tags,omitempty"`
 	// Histories holds the value of the histories edge.
 	Histories []*History `json:"histories,omitempty"`
 	// FavoriteOfUser holds the value of the favorite_of_user edge.
 	FavoriteOfUser []*User `json:"favorite_of_user,omitempty"`
 	// Progress holds the value of the progress edge.
 	Progress []*Progress `json:"progress,omitempty"`
+	// BlockedByUser holds the value of the blocked_by_user edge.
+	BlockedByUser []*User `json:"blocked_by_user,omitempty"`
+	// MetaTags holds the value of the meta_tags edge.
+	MetaTags []*MetaTag `json:"meta_tags,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [7]bool
 }
 
 // TagsOrErr returns the Tags value or an error if the edge
@@ -78,10 +102,19 @@ func (e MetaEdges) TagsOrErr() ([]*Tag, error) {
 	return nil, &NotLoadedError{edge: "tags"}
 }
 
+// ExcludedTagsOrErr returns the ExcludedTags value or an error if the edge
+// was not loaded in eager-loading.
+func (e MetaEdges) ExcludedTagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[1] {
+		return e.ExcludedTags, nil
+	}
+	return nil, &NotLoadedError{edge: "excluded_tags"}
+}
+
 // HistoriesOrErr returns the Histories value or an error if the edge
 // was not loaded in eager-loading.
 func (e MetaEdges) HistoriesOrErr() ([]*History, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.Histories, nil
 	}
 	return nil, &NotLoadedError{edge: "histories"}
@@ -90,7 +123,7 @@ func (e MetaEdges) HistoriesOrErr() ([]*History, error) {
 // FavoriteOfUserOrErr returns the FavoriteOfUser value or an error if the edge
 // was not loaded in eager-loading.
 func (e MetaEdges) FavoriteOfUserOrErr() ([]*User, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.FavoriteOfUser, nil
 	}
 	return nil, &NotLoadedError{edge: "favorite_of_user"}
@@ -99,12 +132,30 @@ func (e MetaEdges) FavoriteOfUserOrErr() ([]*User, error) {
 // ProgressOrErr returns the Progress value or an error if the edge
 // was not loaded in eager-loading.
 func (e MetaEdges) ProgressOrErr() ([]*Progress, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[4] {
 		return e.Progress, nil
 	}
 	return nil, &NotLoadedError{edge: "progress"}
 }
 
+// BlockedByUserOrErr returns the BlockedByUser value or an error if the edge
+// was not loaded in eager-loading.
+func (e MetaEdges) BlockedByUserOrErr() ([]*User, error) {
+	if e.loadedTypes[5] {
+		return e.BlockedByUser, nil
+	}
+	return nil, &NotLoadedError{edge: "blocked_by_user"}
+}
+
+// MetaTagsOrErr returns the MetaTags value or an error if the edge
+// was not loaded in eager-loading.
+func (e MetaEdges) MetaTagsOrErr() ([]*MetaTag, error) {
+	if e.loadedTypes[6] {
+		return e.MetaTags, nil
+	}
+	return nil, &NotLoadedError{edge: "meta_tags"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Meta) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -114,11 +165,13 @@ func (*Meta) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case meta.FieldFavorite, meta.FieldRead, meta.FieldActive, meta.FieldHidden:
 			values[i] = new(sql.NullBool)
-		case meta.FieldID, meta.FieldThumbnailIndex, meta.FieldThumbnailX, meta.FieldThumbnailY, meta.FieldThumbnailWidth, meta.FieldThumbnailHeight:
+		case meta.FieldVolume, meta.FieldChapter:
+			values[i] = new(sql.NullFloat64)
+		case meta.FieldID, meta.FieldThumbnailIndex, meta.FieldThumbnailX, meta.FieldThumbnailY, meta.FieldThumbnailWidth, meta.FieldThumbnailHeight, meta.FieldYear, meta.FieldAgeRating:
 			values[i] = new(sql.NullInt64)
-		case meta.FieldName, meta.FieldContainerType:
+		case meta.FieldName, meta.FieldSearchKey, meta.FieldSortName, meta.FieldContainerType, meta.FieldSeries, meta.FieldArtist:
 			values[i] = new(sql.NullString)
-		case meta.FieldCreateTime:
+		case meta.FieldCreateTime, meta.FieldPagesUpdateTime:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -147,6 +200,18 @@ func (_m *Meta) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Name = value.String
 			}
+		case meta.FieldSearchKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field search_key", values[i])
+			} else if value.Valid {
+				_m.SearchKey = value.String
+			}
+		case meta.FieldSortName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sort_name", values[i])
+			} else if value.Valid {
+				_m.SortName = value.String
+			}
 		case meta.FieldCreateTime:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field create_time", values[i])
@@ -167,6 +232,13 @@ func (_m *Meta) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field file_indices: %w", err)
 				}
 			}
+		case meta.FieldPagesUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field pages_update_time", values[i])
+			} else if value.Valid {
+				_m.PagesUpdateTime = new(time.Time)
+				*_m.PagesUpdateTime = value.Time
+			}
 		case meta.FieldRead:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field read", values[i])
@@ -221,6 +293,42 @@ func (_m *Meta) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ThumbnailHeight = int(value.Int64)
 			}
+		case meta.FieldSeries:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field series", values[i])
+			} else if value.Valid {
+				_m.Series = value.String
+			}
+		case meta.FieldVolume:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field volume", values[i])
+			} else if value.Valid {
+				_m.Volume = value.Float64
+			}
+		case meta.FieldChapter:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field chapter", values[i])
+			} else if value.Valid {
+				_m.Chapter = value.Float64
+			}
+		case meta.FieldArtist:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field artist", values[i])
+			} else if value.Valid {
+				_m.Artist = value.String
+			}
+		case meta.FieldYear:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field year", values[i])
+			} else if value.Valid {
+				_m.Year = int(value.Int64)
+			}
+		case meta.FieldAgeRating:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field age_rating", values[i])
+			} else if value.Valid {
+				_m.AgeRating = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -239,6 +347,11 @@ func (_m *Meta) QueryTags() *TagQuery {
 	return NewMetaClient(_m.config).QueryTags(_m)
 }
 
+// QueryExcludedTags queries the "excluded_tags" edge of the Meta entity.
+func (_m *Meta) QueryExcludedTags() *TagQuery {
+	return NewMetaClient(_m.config).QueryExcludedTags(_m)
+}
+
 // QueryHistories queries the "histories" edge of the Meta entity.
 func (_m *Meta) QueryHistories() *HistoryQuery {
 	return NewMetaClient(_m.config).QueryHistories(_m)
@@ -254,6 +367,16 @@ func (_m *Meta) QueryProgress() *ProgressQuery {
 	return NewMetaClient(_m.config).QueryProgress(_m)
 }
 
+// QueryBlockedByUser queries the "blocked_by_user" edge of the Meta entity.
+func (_m *Meta) QueryBlockedByUser() *UserQuery {
+	return NewMetaClient(_m.config).QueryBlockedByUser(_m)
+}
+
+// QueryMetaTags queries the "meta_tags" edge of the Meta entity.
+func (_m *Meta) QueryMetaTags() *MetaTagQuery {
+	return NewMetaClient(_m.config).QueryMetaTags(_m)
+}
+
 // Update returns a builder for updating this Meta.
 // Note that you need to call Meta.Unwrap() before calling this method if this Meta
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -280,6 +403,12 @@ func (_m *Meta) String() string {
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
 	builder.WriteString(", ")
+	builder.WriteString("search_key=")
+	builder.WriteString(_m.SearchKey)
+	builder.WriteString(", ")
+	builder.WriteString("sort_name=")
+	builder.WriteString(_m.SortName)
+	builder.WriteString(", ")
 	builder.WriteString("create_time=")
 	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -289,6 +418,11 @@ func (_m *Meta) String() string {
 	builder.WriteString("file_indices=")
 	builder.WriteString(fmt.Sprintf("%v", _m.FileIndices))
 	builder.WriteString(", ")
+	if v := _m.PagesUpdateTime; v != nil {
+		builder.WriteString("pages_update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("read=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Read))
 	builder.WriteString(", ")
@@ -315,6 +449,24 @@ func (_m *Meta) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("thumbnail_height=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ThumbnailHeight))
+	builder.WriteString(", ")
+	builder.WriteString("series=")
+	builder.WriteString(_m.Series)
+	builder.WriteString(", ")
+	builder.WriteString("volume=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Volume))
+	builder.WriteString(", ")
+	builder.WriteString("chapter=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Chapter))
+	builder.WriteString(", ")
+	builder.WriteString("artist=")
+	builder.WriteString(_m.Artist)
+	builder.WriteString(", ")
+	builder.WriteString("year=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Year))
+	builder.WriteString(", ")
+	builder.WriteString("age_rating=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AgeRating))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -17,12 +18,18 @@ const (
 	FieldID = "id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldSearchKey holds the string denoting the search_key field in the database.
+	FieldSearchKey = "search_key"
+	// FieldSortName holds the string denoting the sort_name field in the database.
+	FieldSortName = "sort_name"
 	// FieldCreateTime holds the string denoting the create_time field in the database.
 	FieldCreateTime = "create_time"
 	// FieldFavorite holds the string denoting the favorite field in the database.
 	FieldFavorite = "favorite"
 	// FieldFileIndices holds the string denoting the file_indices field in the database.
 	FieldFileIndices = "file_indices"
+	// FieldPagesUpdateTime holds the string denoting the pages_update_time field in the database.
+	FieldPagesUpdateTime = "pages_update_time"
 	// FieldRead holds the string denoting the read field in the database.
 	FieldRead = "read"
 	// FieldActive holds the string denoting the active field in the database.
@@ -41,14 +48,32 @@ const (
 	FieldThumbnailWidth = "thumbnail_width"
 	// FieldThumbnailHeight holds the string denoting the thumbnail_height field in the database.
 	FieldThumbnailHeight = "thumbnail_height"
+	// FieldSeries holds the string denoting the series field in the database.
+	FieldSeries = "series"
+	// FieldVolume holds the string denoting the volume field in the database.
+	FieldVolume = "volume"
+	// FieldChapter holds the string denoting the chapter field in the database.
+	FieldChapter = "chapter"
+	// FieldArtist holds the string denoting the artist field in the database.
+	FieldArtist = "artist"
+	// FieldYear holds the string denoting the year field in the database.
+	FieldYear = "year"
+	// FieldAgeRating holds the string denoting the age_rating field in the database.
+	FieldAgeRating = "age_rating"
 	// EdgeTags holds the string denoting the tags edge name in mutations.
 	EdgeTags = "tags"
+	// EdgeExcludedTags holds the string denoting the excluded_tags edge name in mutations.
+	EdgeExcludedTags = "excluded_tags"
 	// EdgeHistories holds the string denoting the histories edge name in mutations.
 	EdgeHistories = "histories"
 	// EdgeFavoriteOfUser holds the string denoting the favorite_of_user edge name in mutations.
 	EdgeFavoriteOfUser = "favorite_of_user"
 	// EdgeProgress holds the string denoting the progress edge name in mutations.
 	EdgeProgress = "progress"
+	// EdgeBlockedByUser holds the string denoting the blocked_by_user edge name in mutations.
+	EdgeBlockedByUser = "blocked_by_user"
+	// EdgeMetaTags holds the string denoting the meta_tags edge name in mutations.
+	EdgeMetaTags = "meta_tags"
 	// Table holds the table name of the meta in the database.
 	Table = "meta"
 	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
@@ -56,6 +81,11 @@ const (
 	// TagsInverseTable is the table name for the Tag entity.
 	// It exists in this package in order to avoid circular dependency with the "tag" package.
 	TagsInverseTable = "tags"
+	// ExcludedTagsTable is the table that holds the excluded_tags relation/edge. The primary key declared below.
+	ExcludedTagsTable = "meta_excluded_tags"
+	// ExcludedTagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	ExcludedTagsInverseTable = "tags"
 	// HistoriesTable is the table that holds the histories relation/edge.
 	HistoriesTable = "histories"
 	// HistoriesInverseTable is the table name for the History entity.
@@ -75,14 +105,29 @@ const (
 	ProgressInverseTable = "progresses"
 	// ProgressColumn is the table column denoting the progress relation/edge.
 	ProgressColumn = "item_id"
+	// BlockedByUserTable is the table that holds the blocked_by_user relation/edge. The primary key declared below.
+	BlockedByUserTable = "user_blocked_items"
+	// BlockedByUserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	BlockedByUserInverseTable = "users"
+	// MetaTagsTable is the table that holds the meta_tags relation/edge.
+	MetaTagsTable = "meta_tags"
+	// MetaTagsInverseTable is the table name for the MetaTag entity.
+	// It exists in this package in order to avoid circular dependency with the "metatag" package.
+	MetaTagsInverseTable = "meta_tags"
+	// MetaTagsColumn is the table column denoting the meta_tags relation/edge.
+	MetaTagsColumn = "meta_id"
 )
 
 // Columns holds all SQL columns for meta fields.
 var Columns = []string{
 	FieldID,
 	FieldName,
+	FieldSearchKey,
+	FieldSortName,
 	FieldCreateTime,
 	FieldFileIndices,
+	FieldPagesUpdateTime,
 	FieldActive,
 	FieldHidden,
 	FieldContainerType,
@@ -91,15 +136,27 @@ var Columns = []string{
 	FieldThumbnailY,
 	FieldThumbnailWidth,
 	FieldThumbnailHeight,
+	FieldSeries,
+	FieldVolume,
+	FieldChapter,
+	FieldArtist,
+	FieldYear,
+	FieldAgeRating,
 }
 
 var (
 	// TagsPrimaryKey and TagsColumn2 are the table columns denoting the
 	// primary key for the tags relation (M2M).
 	TagsPrimaryKey = []string{"meta_id", "tag_id"}
+	// ExcludedTagsPrimaryKey and ExcludedTagsColumn2 are the table columns denoting the
+	// primary key for the excluded_tags relation (M2M).
+	ExcludedTagsPrimaryKey = []string{"meta_id", "tag_id"}
 	// FavoriteOfUserPrimaryKey and FavoriteOfUserColumn2 are the table columns denoting the
 	// primary key for the favorite_of_user relation (M2M).
 	FavoriteOfUserPrimaryKey = []string{"user_id", "meta_id"}
+	// BlockedByUserPrimaryKey and BlockedByUserColumn2 are the table columns denoting the
+	// primary key for the blocked_by_user relation (M2M).
+	BlockedByUserPrimaryKey = []string{"user_id", "meta_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -117,7 +174,14 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/mangaweb4/mangaweb4-backend/ent/runtime"
 var (
+	Hooks  [3]ent.Hook
+	Policy ent.Policy
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
 	// DefaultCreateTime holds the default value on creation for the "create_time" field.
@@ -142,6 +206,10 @@ var (
 	DefaultThumbnailWidth int
 	// DefaultThumbnailHeight holds the default value on creation for the "thumbnail_height" field.
 	DefaultThumbnailHeight int
+	// DefaultAgeRating holds the default value on creation for the "age_rating" field.
+	DefaultAgeRating int
+	// AgeRatingValidator is a validator for the "age_rating" field. It is called by the builders before save.
+	AgeRatingValidator func(int) error
 )
 
 // ContainerType defines the type for the "container_type" enum field.
@@ -183,6 +251,16 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// BySearchKey orders the results by the search_key field.
+func BySearchKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSearchKey, opts...).ToFunc()
+}
+
+// BySortName orders the results by the sort_name field.
+func BySortName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSortName, opts...).ToFunc()
+}
+
 // ByCreateTime orders the results by the create_time field.
 func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
@@ -193,6 +271,11 @@ func ByFavorite(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFavorite, opts...).ToFunc()
 }
 
+// ByPagesUpdateTime orders the results by the pages_update_time field.
+func ByPagesUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPagesUpdateTime, opts...).ToFunc()
+}
+
 // ByRead orders the results by the read field.
 func ByRead(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRead, opts...).ToFunc()
@@ -238,6 +321,36 @@ func ByThumbnailHeight(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldThumbnailHeight, opts...).ToFunc()
 }
 
+// BySeries orders the results by the series field.
+func BySeries(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeries, opts...).ToFunc()
+}
+
+// ByVolume orders the results by the volume field.
+func ByVolume(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVolume, opts...).ToFunc()
+}
+
+// ByChapter orders the results by the chapter field.
+func ByChapter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChapter, opts...).ToFunc()
+}
+
+// ByArtist orders the results by the artist field.
+func ByArtist(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArtist, opts...).ToFunc()
+}
+
+// ByYear orders the results by the year field.
+func ByYear(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldYear, opts...).ToFunc()
+}
+
+// ByAgeRating orders the results by the age_rating field.
+func ByAgeRating(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAgeRating, opts...).ToFunc()
+}
+
 // ByTagsCount orders the results by tags count.
 func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -252,6 +365,20 @@ func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByExcludedTagsCount orders the results by excluded_tags count.
+func ByExcludedTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newExcludedTagsStep(), opts...)
+	}
+}
+
+// ByExcludedTags orders the results by excluded_tags terms.
+func ByExcludedTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newExcludedTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByHistoriesCount orders the results by histories count.
 func ByHistoriesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -293,6 +420,34 @@ func ByProgress(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newProgressStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByBlockedByUserCount orders the results by blocked_by_user count.
+func ByBlockedByUserCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlockedByUserStep(), opts...)
+	}
+}
+
+// ByBlockedByUser orders the results by blocked_by_user terms.
+func ByBlockedByUser(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlockedByUserStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMetaTagsCount orders the results by meta_tags count.
+func ByMetaTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMetaTagsStep(), opts...)
+	}
+}
+
+// ByMetaTags orders the results by meta_tags terms.
+func ByMetaTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMetaTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTagsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -300,6 +455,13 @@ func newTagsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, false, TagsTable, TagsPrimaryKey...),
 	)
 }
+func newExcludedTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ExcludedTagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, ExcludedTagsTable, ExcludedTagsPrimaryKey...),
+	)
+}
 func newHistoriesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -321,3 +483,17 @@ func newProgressStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ProgressTable, ProgressColumn),
 	)
 }
+func newBlockedByUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BlockedByUserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, BlockedByUserTable, BlockedByUserPrimaryKey...),
+	)
+}
+func newMetaTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MetaTagsInverseTable, MetaTagsColumn),
+		sqlgraph.Edge(sqlgraph.O2M, true, MetaTagsTable, MetaTagsColumn),
+	)
+}
@@ -60,6 +60,16 @@ func Name(v string) predicate.Meta {
 	return predicate.Meta(sql.FieldEQ(FieldName, v))
 }
 
+// SearchKey applies equality check predicate on the "search_key" field. It's identical to SearchKeyEQ.
+func SearchKey(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldEQ(FieldSearchKey, v))
+}
+
+// SortName applies equality check predicate on the "sort_name" field. It's identical to SortNameEQ.
+func SortName(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldEQ(FieldSortName, v))
+}
+
 // CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
 func CreateTime(v time.Time) predicate.Meta {
 	return predicate.Meta(sql.FieldEQ(FieldCreateTime, v))
@@ -70,6 +80,11 @@ func Favorite(v bool) predicate.Meta {
 	return predicate.Meta(sql.FieldEQ(FieldFavorite, v))
 }
 
+// PagesUpdateTime applies equality check predicate on the "pages_update_time" field. It's identical to PagesUpdateTimeEQ.
+func PagesUpdateTime(v time.Time) predicate.Meta {
+	return predicate.Meta(sql.FieldEQ(FieldPagesUpdateTime, v))
+}
+
 // Read applies equality check predicate on the "read" field. It's identical to ReadEQ.
 func Read(v bool) predicate.Meta {
 	return predicate.Meta(sql.FieldEQ(FieldRead, v))
@@ -110,6 +125,36 @@ func ThumbnailHeight(v int) predicate.Meta {
 	return predicate.Meta(sql.FieldEQ(FieldThumbnailHeight, v))
 }
 
+// Series applies equality check predicate on the "series" field. It's identical to SeriesEQ.
+func Series(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldEQ(FieldSeries, v))
+}
+
+// Volume applies equality check predicate on the "volume" field. It's identical to VolumeEQ.
+func Volume(v float64) predicate.Meta {
+	return predicate.Meta(sql.FieldEQ(FieldVolume, v))
+}
+
+// Chapter applies equality check predicate on the "chapter" field. It's identical to ChapterEQ.
+func Chapter(v float64) predicate.Meta {
+	return predicate.Meta(sql.FieldEQ(FieldChapter, v))
+}
+
+// Artist applies equality check predicate on the "artist" field. It's identical to ArtistEQ.
+func Artist(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldEQ(FieldArtist, v))
+}
+
+// Year applies equality check predicate on the "year" field. It's identical to YearEQ.
+func Year(v int) predicate.Meta {
+	return predicate.Meta(sql.FieldEQ(FieldYear, v))
+}
+
+// AgeRating applies equality check predicate on the "age_rating" field. It's identical to AgeRatingEQ.
+func AgeRating(v int) predicate.Meta {
+	return predicate.Meta(sql.FieldEQ(FieldAgeRating, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Meta {
 	return predicate.Meta(sql.FieldEQ(FieldName, v))
@@ -175,6 +220,156 @@ func NameContainsFold(v string) predicate.Meta {
 	return predicate.Meta(sql.FieldContainsFold(FieldName, v))
 }
 
+// SearchKeyEQ applies the EQ predicate on the "search_key" field.
+func SearchKeyEQ(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldEQ(FieldSearchKey, v))
+}
+
+// SearchKeyNEQ applies the NEQ predicate on the "search_key" field.
+func SearchKeyNEQ(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldNEQ(FieldSearchKey, v))
+}
+
+// SearchKeyIn applies the In predicate on the "search_key" field.
+func SearchKeyIn(vs ...string) predicate.Meta {
+	return predicate.Meta(sql.FieldIn(FieldSearchKey, vs...))
+}
+
+// SearchKeyNotIn applies the NotIn predicate on the "search_key" field.
+func SearchKeyNotIn(vs ...string) predicate.Meta {
+	return predicate.Meta(sql.FieldNotIn(FieldSearchKey, vs...))
+}
+
+// SearchKeyGT applies the GT predicate on the "search_key" field.
+func SearchKeyGT(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldGT(FieldSearchKey, v))
+}
+
+// SearchKeyGTE applies the GTE predicate on the "search_key" field.
+func SearchKeyGTE(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldGTE(FieldSearchKey, v))
+}
+
+// SearchKeyLT applies the LT predicate on the "search_key" field.
+func SearchKeyLT(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldLT(FieldSearchKey, v))
+}
+
+// SearchKeyLTE applies the LTE predicate on the "search_key" field.
+func SearchKeyLTE(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldLTE(FieldSearchKey, v))
+}
+
+// SearchKeyContains applies the Contains predicate on the "search_key" field.
+func SearchKeyContains(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldContains(FieldSearchKey, v))
+}
+
+// SearchKeyHasPrefix applies the HasPrefix predicate on the "search_key" field.
+func SearchKeyHasPrefix(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldHasPrefix(FieldSearchKey, v))
+}
+
+// SearchKeyHasSuffix applies the HasSuffix predicate on the "search_key" field.
+func SearchKeyHasSuffix(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldHasSuffix(FieldSearchKey, v))
+}
+
+// SearchKeyIsNil applies the IsNil predicate on the "search_key" field.
+func SearchKeyIsNil() predicate.Meta {
+	return predicate.Meta(sql.FieldIsNull(FieldSearchKey))
+}
+
+// SearchKeyNotNil applies the NotNil predicate on the "search_key" field.
+func SearchKeyNotNil() predicate.Meta {
+	return predicate.Meta(sql.FieldNotNull(FieldSearchKey))
+}
+
+// SearchKeyEqualFold applies the EqualFold predicate on the "search_key" field.
+func SearchKeyEqualFold(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldEqualFold(FieldSearchKey, v))
+}
+
+// SearchKeyContainsFold applies the ContainsFold predicate on the "search_key" field.
+func SearchKeyContainsFold(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldContainsFold(FieldSearchKey, v))
+}
+
+// SortNameEQ applies the EQ predicate on the "sort_name" field.
+func SortNameEQ(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldEQ(FieldSortName, v))
+}
+
+// SortNameNEQ applies the NEQ predicate on the "sort_name" field.
+func SortNameNEQ(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldNEQ(FieldSortName, v))
+}
+
+// SortNameIn applies the In predicate on the "sort_name" field.
+func SortNameIn(vs ...string) predicate.Meta {
+	return predicate.Meta(sql.FieldIn(FieldSortName, vs...))
+}
+
+// SortNameNotIn applies the NotIn predicate on the "sort_name" field.
+func SortNameNotIn(vs ...string) predicate.Meta {
+	return predicate.Meta(sql.FieldNotIn(FieldSortName, vs...))
+}
+
+// SortNameGT applies the GT predicate on the "sort_name" field.
+func SortNameGT(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldGT(FieldSortName, v))
+}
+
+// SortNameGTE applies the GTE predicate on the "sort_name" field.
+func SortNameGTE(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldGTE(FieldSortName, v))
+}
+
+// SortNameLT applies the LT predicate on the "sort_name" field.
+func SortNameLT(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldLT(FieldSortName, v))
+}
+
+// SortNameLTE applies the LTE predicate on the "sort_name" field.
+func SortNameLTE(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldLTE(FieldSortName, v))
+}
+
+// SortNameContains applies the Contains predicate on the "sort_name" field.
+func SortNameContains(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldContains(FieldSortName, v))
+}
+
+// SortNameHasPrefix applies the HasPrefix predicate on the "sort_name" field.
+func SortNameHasPrefix(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldHasPrefix(FieldSortName, v))
+}
+
+// SortNameHasSuffix applies the HasSuffix predicate on the "sort_name" field.
+func SortNameHasSuffix(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldHasSuffix(FieldSortName, v))
+}
+
+// SortNameIsNil applies the IsNil predicate on the "sort_name" field.
+func SortNameIsNil() predicate.Meta {
+	return predicate.Meta(sql.FieldIsNull(FieldSortName))
+}
+
+// SortNameNotNil applies the NotNil predicate on the "sort_name" field.
+func SortNameNotNil() predicate.Meta {
+	return predicate.Meta(sql.FieldNotNull(FieldSortName))
+}
+
+// SortNameEqualFold applies the EqualFold predicate on the "sort_name" field.
+func SortNameEqualFold(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldEqualFold(FieldSortName, v))
+}
+
+// SortNameContainsFold applies the ContainsFold predicate on the "sort_name" field.
+func SortNameContainsFold(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldContainsFold(FieldSortName, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Meta {
 	return predicate.Meta(sql.FieldEQ(FieldCreateTime, v))
@@ -225,6 +420,56 @@ func FavoriteNEQ(v bool) predicate.Meta {
 	return predicate.Meta(sql.FieldNEQ(FieldFavorite, v))
 }
 
+// PagesUpdateTimeEQ applies the EQ predicate on the "pages_update_time" field.
+func PagesUpdateTimeEQ(v time.Time) predicate.Meta {
+	return predicate.Meta(sql.FieldEQ(FieldPagesUpdateTime, v))
+}
+
+// PagesUpdateTimeNEQ applies the NEQ predicate on the "pages_update_time" field.
+func PagesUpdateTimeNEQ(v time.Time) predicate.Meta {
+	return predicate.Meta(sql.FieldNEQ(FieldPagesUpdateTime, v))
+}
+
+// PagesUpdateTimeIn applies the In predicate on the "pages_update_time" field.
+func PagesUpdateTimeIn(vs ...time.Time) predicate.Meta {
+	return predicate.Meta(sql.FieldIn(FieldPagesUpdateTime, vs...))
+}
+
+// PagesUpdateTimeNotIn applies the NotIn predicate on the "pages_update_time" field.
+func PagesUpdateTimeNotIn(vs ...time.Time) predicate.Meta {
+	return predicate.Meta(sql.FieldNotIn(FieldPagesUpdateTime, vs...))
+}
+
+// PagesUpdateTimeGT applies the GT predicate on the "pages_update_time" field.
+func PagesUpdateTimeGT(v time.Time) predicate.Meta {
+	return predicate.Meta(sql.FieldGT(FieldPagesUpdateTime, v))
+}
+
+// PagesUpdateTimeGTE applies the GTE predicate on the "pages_update_time" field.
+func PagesUpdateTimeGTE(v time.Time) predicate.Meta {
+	return predicate.Meta(sql.FieldGTE(FieldPagesUpdateTime, v))
+}
+
+// PagesUpdateTimeLT applies the LT predicate on the "pages_update_time" field.
+func PagesUpdateTimeLT(v time.Time) predicate.Meta {
+	return predicate.Meta(sql.FieldLT(FieldPagesUpdateTime, v))
+}
+
+// PagesUpdateTimeLTE applies the LTE predicate on the "pages_update_time" field.
+func PagesUpdateTimeLTE(v time.Time) predicate.Meta {
+	return predicate.Meta(sql.FieldLTE(FieldPagesUpdateTime, v))
+}
+
+// PagesUpdateTimeIsNil applies the IsNil predicate on the "pages_update_time" field.
+func PagesUpdateTimeIsNil() predicate.Meta {
+	return predicate.Meta(sql.FieldIsNull(FieldPagesUpdateTime))
+}
+
+// PagesUpdateTimeNotNil applies the NotNil predicate on the "pages_update_time" field.
+func PagesUpdateTimeNotNil() predicate.Meta {
+	return predicate.Meta(sql.FieldNotNull(FieldPagesUpdateTime))
+}
+
 // ReadEQ applies the EQ predicate on the "read" field.
 func ReadEQ(v bool) predicate.Meta {
 	return predicate.Meta(sql.FieldEQ(FieldRead, v))
@@ -525,6 +770,346 @@ func ThumbnailHeightNotNil() predicate.Meta {
 	return predicate.Meta(sql.FieldNotNull(FieldThumbnailHeight))
 }
 
+// SeriesEQ applies the EQ predicate on the "series" field.
+func SeriesEQ(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldEQ(FieldSeries, v))
+}
+
+// SeriesNEQ applies the NEQ predicate on the "series" field.
+func SeriesNEQ(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldNEQ(FieldSeries, v))
+}
+
+// SeriesIn applies the In predicate on the "series" field.
+func SeriesIn(vs ...string) predicate.Meta {
+	return predicate.Meta(sql.FieldIn(FieldSeries, vs...))
+}
+
+// SeriesNotIn applies the NotIn predicate on the "series" field.
+func SeriesNotIn(vs ...string) predicate.Meta {
+	return predicate.Meta(sql.FieldNotIn(FieldSeries, vs...))
+}
+
+// SeriesGT applies the GT predicate on the "series" field.
+func SeriesGT(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldGT(FieldSeries, v))
+}
+
+// SeriesGTE applies the GTE predicate on the "series" field.
+func SeriesGTE(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldGTE(FieldSeries, v))
+}
+
+// SeriesLT applies the LT predicate on the "series" field.
+func SeriesLT(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldLT(FieldSeries, v))
+}
+
+// SeriesLTE applies the LTE predicate on the "series" field.
+func SeriesLTE(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldLTE(FieldSeries, v))
+}
+
+// SeriesContains applies the Contains predicate on the "series" field.
+func SeriesContains(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldContains(FieldSeries, v))
+}
+
+// SeriesHasPrefix applies the HasPrefix predicate on the "series" field.
+func SeriesHasPrefix(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldHasPrefix(FieldSeries, v))
+}
+
+// SeriesHasSuffix applies the HasSuffix predicate on the "series" field.
+func SeriesHasSuffix(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldHasSuffix(FieldSeries, v))
+}
+
+// SeriesIsNil applies the IsNil predicate on the "series" field.
+func SeriesIsNil() predicate.Meta {
+	return predicate.Meta(sql.FieldIsNull(FieldSeries))
+}
+
+// SeriesNotNil applies the NotNil predicate on the "series" field.
+func SeriesNotNil() predicate.Meta {
+	return predicate.Meta(sql.FieldNotNull(FieldSeries))
+}
+
+// SeriesEqualFold applies the EqualFold predicate on the "series" field.
+func SeriesEqualFold(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldEqualFold(FieldSeries, v))
+}
+
+// SeriesContainsFold applies the ContainsFold predicate on the "series" field.
+func SeriesContainsFold(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldContainsFold(FieldSeries, v))
+}
+
+// VolumeEQ applies the EQ predicate on the "volume" field.
+func VolumeEQ(v float64) predicate.Meta {
+	return predicate.Meta(sql.FieldEQ(FieldVolume, v))
+}
+
+// VolumeNEQ applies the NEQ predicate on the "volume" field.
+func VolumeNEQ(v float64) predicate.Meta {
+	return predicate.Meta(sql.FieldNEQ(FieldVolume, v))
+}
+
+// VolumeIn applies the In predicate on the "volume" field.
+func VolumeIn(vs ...float64) predicate.Meta {
+	return predicate.Meta(sql.FieldIn(FieldVolume, vs...))
+}
+
+// VolumeNotIn applies the NotIn predicate on the "volume" field.
+func VolumeNotIn(vs ...float64) predicate.Meta {
+	return predicate.Meta(sql.FieldNotIn(FieldVolume, vs...))
+}
+
+// VolumeGT applies the GT predicate on the "volume" field.
+func VolumeGT(v float64) predicate.Meta {
+	return predicate.Meta(sql.FieldGT(FieldVolume, v))
+}
+
+// VolumeGTE applies the GTE predicate on the "volume" field.
+func VolumeGTE(v float64) predicate.Meta {
+	return predicate.Meta(sql.FieldGTE(FieldVolume, v))
+}
+
+// VolumeLT applies the LT predicate on the "volume" field.
+func VolumeLT(v float64) predicate.Meta {
+	return predicate.Meta(sql.FieldLT(FieldVolume, v))
+}
+
+// VolumeLTE applies the LTE predicate on the "volume" field.
+func VolumeLTE(v float64) predicate.Meta {
+	return predicate.Meta(sql.FieldLTE(FieldVolume, v))
+}
+
+// VolumeIsNil applies the IsNil predicate on the "volume" field.
+func VolumeIsNil() predicate.Meta {
+	return predicate.Meta(sql.FieldIsNull(FieldVolume))
+}
+
+// VolumeNotNil applies the NotNil predicate on the "volume" field.
+func VolumeNotNil() predicate.Meta {
+	return predicate.Meta(sql.FieldNotNull(FieldVolume))
+}
+
+// ChapterEQ applies the EQ predicate on the "chapter" field.
+func ChapterEQ(v float64) predicate.Meta {
+	return predicate.Meta(sql.FieldEQ(FieldChapter, v))
+}
+
+// ChapterNEQ applies the NEQ predicate on the "chapter" field.
+func ChapterNEQ(v float64) predicate.Meta {
+	return predicate.Meta(sql.FieldNEQ(FieldChapter, v))
+}
+
+// ChapterIn applies the In predicate on the "chapter" field.
+func ChapterIn(vs ...float64) predicate.Meta {
+	return predicate.Meta(sql.FieldIn(FieldChapter, vs...))
+}
+
+// ChapterNotIn applies the NotIn predicate on the "chapter" field.
+func ChapterNotIn(vs ...float64) predicate.Meta {
+	return predicate.Meta(sql.FieldNotIn(FieldChapter, vs...))
+}
+
+// ChapterGT applies the GT predicate on the "chapter" field.
+func ChapterGT(v float64) predicate.Meta {
+	return predicate.Meta(sql.FieldGT(FieldChapter, v))
+}
+
+// ChapterGTE applies the GTE predicate on the "chapter" field.
+func ChapterGTE(v float64) predicate.Meta {
+	return predicate.Meta(sql.FieldGTE(FieldChapter, v))
+}
+
+// ChapterLT applies the LT predicate on the "chapter" field.
+func ChapterLT(v float64) predicate.Meta {
+	return predicate.Meta(sql.FieldLT(FieldChapter, v))
+}
+
+// ChapterLTE applies the LTE predicate on the "chapter" field.
+func ChapterLTE(v float64) predicate.Meta {
+	return predicate.Meta(sql.FieldLTE(FieldChapter, v))
+}
+
+// ChapterIsNil applies the IsNil predicate on the "chapter" field.
+func ChapterIsNil() predicate.Meta {
+	return predicate.Meta(sql.FieldIsNull(FieldChapter))
+}
+
+// ChapterNotNil applies the NotNil predicate on the "chapter" field.
+func ChapterNotNil() predicate.Meta {
+	return predicate.Meta(sql.FieldNotNull(FieldChapter))
+}
+
+// ArtistEQ applies the EQ predicate on the "artist" field.
+func ArtistEQ(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldEQ(FieldArtist, v))
+}
+
+// ArtistNEQ applies the NEQ predicate on the "artist" field.
+func ArtistNEQ(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldNEQ(FieldArtist, v))
+}
+
+// ArtistIn applies the In predicate on the "artist" field.
+func ArtistIn(vs ...string) predicate.Meta {
+	return predicate.Meta(sql.FieldIn(FieldArtist, vs...))
+}
+
+// ArtistNotIn applies the NotIn predicate on the "artist" field.
+func ArtistNotIn(vs ...string) predicate.Meta {
+	return predicate.Meta(sql.FieldNotIn(FieldArtist, vs...))
+}
+
+// ArtistGT applies the GT predicate on the "artist" field.
+func ArtistGT(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldGT(FieldArtist, v))
+}
+
+// ArtistGTE applies the GTE predicate on the "artist" field.
+func ArtistGTE(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldGTE(FieldArtist, v))
+}
+
+// ArtistLT applies the LT predicate on the "artist" field.
+func ArtistLT(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldLT(FieldArtist, v))
+}
+
+// ArtistLTE applies the LTE predicate on the "artist" field.
+func ArtistLTE(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldLTE(FieldArtist, v))
+}
+
+// ArtistContains applies the Contains predicate on the "artist" field.
+func ArtistContains(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldContains(FieldArtist, v))
+}
+
+// ArtistHasPrefix applies the HasPrefix predicate on the "artist" field.
+func ArtistHasPrefix(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldHasPrefix(FieldArtist, v))
+}
+
+// ArtistHasSuffix applies the HasSuffix predicate on the "artist" field.
+func ArtistHasSuffix(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldHasSuffix(FieldArtist, v))
+}
+
+// ArtistIsNil applies the IsNil predicate on the "artist" field.
+func ArtistIsNil() predicate.Meta {
+	return predicate.Meta(sql.FieldIsNull(FieldArtist))
+}
+
+// ArtistNotNil applies the NotNil predicate on the "artist" field.
+func ArtistNotNil() predicate.Meta {
+	return predicate.Meta(sql.FieldNotNull(FieldArtist))
+}
+
+// ArtistEqualFold applies the EqualFold predicate on the "artist" field.
+func ArtistEqualFold(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldEqualFold(FieldArtist, v))
+}
+
+// ArtistContainsFold applies the ContainsFold predicate on the "artist" field.
+func ArtistContainsFold(v string) predicate.Meta {
+	return predicate.Meta(sql.FieldContainsFold(FieldArtist, v))
+}
+
+// YearEQ applies the EQ predicate on the "year" field.
+func YearEQ(v int) predicate.Meta {
+	return predicate.Meta(sql.FieldEQ(FieldYear, v))
+}
+
+// YearNEQ applies the NEQ predicate on the "year" field.
+func YearNEQ(v int) predicate.Meta {
+	return predicate.Meta(sql.FieldNEQ(FieldYear, v))
+}
+
+// YearIn applies the In predicate on the "year" field.
+func YearIn(vs ...int) predicate.Meta {
+	return predicate.Meta(sql.FieldIn(FieldYear, vs...))
+}
+
+// YearNotIn applies the NotIn predicate on the "year" field.
+func YearNotIn(vs ...int) predicate.Meta {
+	return predicate.Meta(sql.FieldNotIn(FieldYear, vs...))
+}
+
+// YearGT applies the GT predicate on the "year" field.
+func YearGT(v int) predicate.Meta {
+	return predicate.Meta(sql.FieldGT(FieldYear, v))
+}
+
+// YearGTE applies the GTE predicate on the "year" field.
+func YearGTE(v int) predicate.Meta {
+	return predicate.Meta(sql.FieldGTE(FieldYear, v))
+}
+
+// YearLT applies the LT predicate on the "year" field.
+func YearLT(v int) predicate.Meta {
+	return predicate.Meta(sql.FieldLT(FieldYear, v))
+}
+
+// YearLTE applies the LTE predicate on the "year" field.
+func YearLTE(v int) predicate.Meta {
+	return predicate.Meta(sql.FieldLTE(FieldYear, v))
+}
+
+// YearIsNil applies the IsNil predicate on the "year" field.
+func YearIsNil() predicate.Meta {
+	return predicate.Meta(sql.FieldIsNull(FieldYear))
+}
+
+// YearNotNil applies the NotNil predicate on the "year" field.
+func YearNotNil() predicate.Meta {
+	return predicate.Meta(sql.FieldNotNull(FieldYear))
+}
+
+// AgeRatingEQ applies the EQ predicate on the "age_rating" field.
+func AgeRatingEQ(v int) predicate.Meta {
+	return predicate.Meta(sql.FieldEQ(FieldAgeRating, v))
+}
+
+// AgeRatingNEQ applies the NEQ predicate on the "age_rating" field.
+func AgeRatingNEQ(v int) predicate.Meta {
+	return predicate.Meta(sql.FieldNEQ(FieldAgeRating, v))
+}
+
+// AgeRatingIn applies the In predicate on the "age_rating" field.
+func AgeRatingIn(vs ...int) predicate.Meta {
+	return predicate.Meta(sql.FieldIn(FieldAgeRating, vs...))
+}
+
+// AgeRatingNotIn applies the NotIn predicate on the "age_rating" field.
+func AgeRatingNotIn(vs ...int) predicate.Meta {
+	return predicate.Meta(sql.FieldNotIn(FieldAgeRating, vs...))
+}
+
+// AgeRatingGT applies the GT predicate on the "age_rating" field.
+func AgeRatingGT(v int) predicate.Meta {
+	return predicate.Meta(sql.FieldGT(FieldAgeRating, v))
+}
+
+// AgeRatingGTE applies the GTE predicate on the "age_rating" field.
+func AgeRatingGTE(v int) predicate.Meta {
+	return predicate.Meta(sql.FieldGTE(FieldAgeRating, v))
+}
+
+// AgeRatingLT applies the LT predicate on the "age_rating" field.
+func AgeRatingLT(v int) predicate.Meta {
+	return predicate.Meta(sql.FieldLT(FieldAgeRating, v))
+}
+
+// AgeRatingLTE applies the LTE predicate on the "age_rating" field.
+func AgeRatingLTE(v int) predicate.Meta {
+	return predicate.Meta(sql.FieldLTE(FieldAgeRating, v))
+}
+
 // HasTags applies the HasEdge predicate on the "tags" edge.
 func HasTags() predicate.Meta {
 	return predicate.Meta(func(s *sql.Selector) {
@@ -548,6 +1133,29 @@ func HasTagsWith(preds ...predicate.Tag) predicate.Meta {
 	})
 }
 
+// HasExcludedTags applies the HasEdge predicate on the "excluded_tags" edge.
+func HasExcludedTags() predicate.Meta {
+	return predicate.Meta(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, ExcludedTagsTable, ExcludedTagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExcludedTagsWith applies the HasEdge predicate on the "excluded_tags" edge with a given conditions (other predicates).
+func HasExcludedTagsWith(preds ...predicate.Tag) predicate.Meta {
+	return predicate.Meta(func(s *sql.Selector) {
+		step := newExcludedTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasHistories applies the HasEdge predicate on the "histories" edge.
 func HasHistories() predicate.Meta {
 	return predicate.Meta(func(s *sql.Selector) {
@@ -617,6 +1225,52 @@ func HasProgressWith(preds ...predicate.Progress) predicate.Meta {
 	})
 }
 
+// HasBlockedByUser applies the HasEdge predicate on the "blocked_by_user" edge.
+func HasBlockedByUser() predicate.Meta {
+	return predicate.Meta(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, BlockedByUserTable, BlockedByUserPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlockedByUserWith applies the HasEdge predicate on the "blocked_by_user" edge with a given conditions (other predicates).
+func HasBlockedByUserWith(preds ...predicate.User) predicate.Meta {
+	return predicate.Meta(func(s *sql.Selector) {
+		step := newBlockedByUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMetaTags applies the HasEdge predicate on the "meta_tags" edge.
+func HasMetaTags() predicate.Meta {
+	return predicate.Meta(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, MetaTagsTable, MetaTagsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMetaTagsWith applies the HasEdge predicate on the "meta_tags" edge with a given conditions (other predicates).
+func HasMetaTagsWith(preds ...predicate.MetaTag) predicate.Meta {
+	return predicate.Meta(func(s *sql.Selector) {
+		step := newMetaTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Meta) predicate.Meta {
 	return predicate.Meta(sql.AndPredicates(predicates...))
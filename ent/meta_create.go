@@ -32,6 +32,34 @@ func (_c *MetaCreate) SetName(v string) *MetaCreate {
 	return _c
 }
 
+// SetSearchKey sets the "search_key" field.
+func (_c *MetaCreate) SetSearchKey(v string) *MetaCreate {
+	_c.mutation.SetSearchKey(v)
+	return _c
+}
+
+// SetNillableSearchKey sets the "search_key" field if the given value is not nil.
+func (_c *MetaCreate) SetNillableSearchKey(v *string) *MetaCreate {
+	if v != nil {
+		_c.SetSearchKey(*v)
+	}
+	return _c
+}
+
+// SetSortName sets the "sort_name" field.
+func (_c *MetaCreate) SetSortName(v string) *MetaCreate {
+	_c.mutation.SetSortName(v)
+	return _c
+}
+
+// SetNillableSortName sets the "sort_name" field if the given value is not nil.
+func (_c *MetaCreate) SetNillableSortName(v *string) *MetaCreate {
+	if v != nil {
+		_c.SetSortName(*v)
+	}
+	return _c
+}
+
 // SetCreateTime sets the "create_time" field.
 func (_c *MetaCreate) SetCreateTime(v time.Time) *MetaCreate {
 	_c.mutation.SetCreateTime(v)
@@ -66,6 +94,20 @@ func (_c *MetaCreate) SetFileIndices(v []int) *MetaCreate {
 	return _c
 }
 
+// SetPagesUpdateTime sets the "pages_update_time" field.
+func (_c *MetaCreate) SetPagesUpdateTime(v time.Time) *MetaCreate {
+	_c.mutation.SetPagesUpdateTime(v)
+	return _c
+}
+
+// SetNillablePagesUpdateTime sets the "pages_update_time" field if the given value is not nil.
+func (_c *MetaCreate) SetNillablePagesUpdateTime(v *time.Time) *MetaCreate {
+	if v != nil {
+		_c.SetPagesUpdateTime(*v)
+	}
+	return _c
+}
+
 // SetRead sets the "read" field.
 func (_c *MetaCreate) SetRead(v bool) *MetaCreate {
 	_c.mutation.SetRead(v)
@@ -192,6 +234,90 @@ func (_c *MetaCreate) SetNillableThumbnailHeight(v *int) *MetaCreate {
 	return _c
 }
 
+// SetSeries sets the "series" field.
+func (_c *MetaCreate) SetSeries(v string) *MetaCreate {
+	_c.mutation.SetSeries(v)
+	return _c
+}
+
+// SetNillableSeries sets the "series" field if the given value is not nil.
+func (_c *MetaCreate) SetNillableSeries(v *string) *MetaCreate {
+	if v != nil {
+		_c.SetSeries(*v)
+	}
+	return _c
+}
+
+// SetVolume sets the "volume" field.
+func (_c *MetaCreate) SetVolume(v float64) *MetaCreate {
+	_c.mutation.SetVolume(v)
+	return _c
+}
+
+// SetNillableVolume sets the "volume" field if the given value is not nil.
+func (_c *MetaCreate) SetNillableVolume(v *float64) *MetaCreate {
+	if v != nil {
+		_c.SetVolume(*v)
+	}
+	return _c
+}
+
+// SetChapter sets the "chapter" field.
+func (_c *MetaCreate) SetChapter(v float64) *MetaCreate {
+	_c.mutation.SetChapter(v)
+	return _c
+}
+
+// SetNillableChapter sets the "chapter" field if the given value is not nil.
+func (_c *MetaCreate) SetNillableChapter(v *float64) *MetaCreate {
+	if v != nil {
+		_c.SetChapter(*v)
+	}
+	return _c
+}
+
+// SetArtist sets the "artist" field.
+func (_c *MetaCreate) SetArtist(v string) *MetaCreate {
+	_c.mutation.SetArtist(v)
+	return _c
+}
+
+// SetNillableArtist sets the "artist" field if the given value is not nil.
+func (_c *MetaCreate) SetNillableArtist(v *string) *MetaCreate {
+	if v != nil {
+		_c.SetArtist(*v)
+	}
+	return _c
+}
+
+// SetYear sets the "year" field.
+func (_c *MetaCreate) SetYear(v int) *MetaCreate {
+	_c.mutation.SetYear(v)
+	return _c
+}
+
+// SetNillableYear sets the "year" field if the given value is not nil.
+func (_c *MetaCreate) SetNillableYear(v *int) *MetaCreate {
+	if v != nil {
+		_c.SetYear(*v)
+	}
+	return _c
+}
+
+// SetAgeRating sets the "age_rating" field.
+func (_c *MetaCreate) SetAgeRating(v int) *MetaCreate {
+	_c.mutation.SetAgeRating(v)
+	return _c
+}
+
+// SetNillableAgeRating sets the "age_rating" field if the given value is not nil.
+func (_c *MetaCreate) SetNillableAgeRating(v *int) *MetaCreate {
+	if v != nil {
+		_c.SetAgeRating(*v)
+	}
+	return _c
+}
+
 // AddTagIDs adds the "tags" edge to the Tag entity by IDs.
 func (_c *MetaCreate) AddTagIDs(ids ...int) *MetaCreate {
 	_c.mutation.AddTagIDs(ids...)
@@ -207,6 +333,21 @@ func (_c *MetaCreate) AddTags(v ...*Tag) *MetaCreate {
 	return _c.AddTagIDs(ids...)
 }
 
+// AddExcludedTagIDs adds the "excluded_tags" edge to the Tag entity by IDs.
+func (_c *MetaCreate) AddExcludedTagIDs(ids ...int) *MetaCreate {
+	_c.mutation.AddExcludedTagIDs(ids...)
+	return _c
+}
+
+// AddExcludedTags adds the "excluded_tags" edges to the Tag entity.
+func (_c *MetaCreate) AddExcludedTags(v ...*Tag) *MetaCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddExcludedTagIDs(ids...)
+}
+
 // AddHistoryIDs adds the "histories" edge to the History entity by IDs.
 func (_c *MetaCreate) AddHistoryIDs(ids ...int) *MetaCreate {
 	_c.mutation.AddHistoryIDs(ids...)
@@ -252,6 +393,21 @@ func (_c *MetaCreate) AddProgress(v ...*Progress) *MetaCreate {
 	return _c.AddProgresIDs(ids...)
 }
 
+// AddBlockedByUserIDs adds the "blocked_by_user" edge to the User entity by IDs.
+func (_c *MetaCreate) AddBlockedByUserIDs(ids ...int) *MetaCreate {
+	_c.mutation.AddBlockedByUserIDs(ids...)
+	return _c
+}
+
+// AddBlockedByUser adds the "blocked_by_user" edges to the User entity.
+func (_c *MetaCreate) AddBlockedByUser(v ...*User) *MetaCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBlockedByUserIDs(ids...)
+}
+
 // Mutation returns the MetaMutation object of the builder.
 func (_c *MetaCreate) Mutation() *MetaMutation {
 	return _c.mutation
@@ -259,7 +415,9 @@ func (_c *MetaCreate) Mutation() *MetaMutation {
 
 // Save creates the Meta in the database.
 func (_c *MetaCreate) Save(ctx context.Context) (*Meta, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -286,8 +444,11 @@ func (_c *MetaCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *MetaCreate) defaults() {
+func (_c *MetaCreate) defaults() error {
 	if _, ok := _c.mutation.CreateTime(); !ok {
+		if meta.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized meta.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
 		v := meta.DefaultCreateTime()
 		_c.mutation.SetCreateTime(v)
 	}
@@ -335,6 +496,11 @@ func (_c *MetaCreate) defaults() {
 		v := meta.DefaultThumbnailHeight
 		_c.mutation.SetThumbnailHeight(v)
 	}
+	if _, ok := _c.mutation.AgeRating(); !ok {
+		v := meta.DefaultAgeRating
+		_c.mutation.SetAgeRating(v)
+	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -373,6 +539,14 @@ func (_c *MetaCreate) check() error {
 			return &ValidationError{Name: "container_type", err: fmt.Errorf(`ent: validator failed for field "Meta.container_type": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.AgeRating(); !ok {
+		return &ValidationError{Name: "age_rating", err: errors.New(`ent: missing required field "Meta.age_rating"`)}
+	}
+	if v, ok := _c.mutation.AgeRating(); ok {
+		if err := meta.AgeRatingValidator(v); err != nil {
+			return &ValidationError{Name: "age_rating", err: fmt.Errorf(`ent: validator failed for field "Meta.age_rating": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -404,6 +578,14 @@ func (_c *MetaCreate) createSpec() (*Meta, *sqlgraph.CreateSpec) {
 		_spec.SetField(meta.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := _c.mutation.SearchKey(); ok {
+		_spec.SetField(meta.FieldSearchKey, field.TypeString, value)
+		_node.SearchKey = value
+	}
+	if value, ok := _c.mutation.SortName(); ok {
+		_spec.SetField(meta.FieldSortName, field.TypeString, value)
+		_node.SortName = value
+	}
 	if value, ok := _c.mutation.CreateTime(); ok {
 		_spec.SetField(meta.FieldCreateTime, field.TypeTime, value)
 		_node.CreateTime = value
@@ -416,6 +598,10 @@ func (_c *MetaCreate) createSpec() (*Meta, *sqlgraph.CreateSpec) {
 		_spec.SetField(meta.FieldFileIndices, field.TypeJSON, value)
 		_node.FileIndices = value
 	}
+	if value, ok := _c.mutation.PagesUpdateTime(); ok {
+		_spec.SetField(meta.FieldPagesUpdateTime, field.TypeTime, value)
+		_node.PagesUpdateTime = &value
+	}
 	if value, ok := _c.mutation.Read(); ok {
 		_spec.SetField(meta.FieldRead, field.TypeBool, value)
 		_node.Read = value
@@ -452,6 +638,30 @@ func (_c *MetaCreate) createSpec() (*Meta, *sqlgraph.CreateSpec) {
 		_spec.SetField(meta.FieldThumbnailHeight, field.TypeInt, value)
 		_node.ThumbnailHeight = value
 	}
+	if value, ok := _c.mutation.Series(); ok {
+		_spec.SetField(meta.FieldSeries, field.TypeString, value)
+		_node.Series = value
+	}
+	if value, ok := _c.mutation.Volume(); ok {
+		_spec.SetField(meta.FieldVolume, field.TypeFloat64, value)
+		_node.Volume = value
+	}
+	if value, ok := _c.mutation.Chapter(); ok {
+		_spec.SetField(meta.FieldChapter, field.TypeFloat64, value)
+		_node.Chapter = value
+	}
+	if value, ok := _c.mutation.Artist(); ok {
+		_spec.SetField(meta.FieldArtist, field.TypeString, value)
+		_node.Artist = value
+	}
+	if value, ok := _c.mutation.Year(); ok {
+		_spec.SetField(meta.FieldYear, field.TypeInt, value)
+		_node.Year = value
+	}
+	if value, ok := _c.mutation.AgeRating(); ok {
+		_spec.SetField(meta.FieldAgeRating, field.TypeInt, value)
+		_node.AgeRating = value
+	}
 	if nodes := _c.mutation.TagsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -466,6 +676,26 @@ func (_c *MetaCreate) createSpec() (*Meta, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &MetaTagCreate{config: _c.config, mutation: newMetaTagMutation(_c.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ExcludedTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   meta.ExcludedTagsTable,
+			Columns: meta.ExcludedTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := _c.mutation.HistoriesIDs(); len(nodes) > 0 {
@@ -516,6 +746,22 @@ func (_c *MetaCreate) createSpec() (*Meta, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.BlockedByUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   meta.BlockedByUserTable,
+			Columns: meta.BlockedByUserPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -580,6 +826,42 @@ func (u *MetaUpsert) UpdateName() *MetaUpsert {
 	return u
 }
 
+// SetSearchKey sets the "search_key" field.
+func (u *MetaUpsert) SetSearchKey(v string) *MetaUpsert {
+	u.Set(meta.FieldSearchKey, v)
+	return u
+}
+
+// UpdateSearchKey sets the "search_key" field to the value that was provided on create.
+func (u *MetaUpsert) UpdateSearchKey() *MetaUpsert {
+	u.SetExcluded(meta.FieldSearchKey)
+	return u
+}
+
+// ClearSearchKey clears the value of the "search_key" field.
+func (u *MetaUpsert) ClearSearchKey() *MetaUpsert {
+	u.SetNull(meta.FieldSearchKey)
+	return u
+}
+
+// SetSortName sets the "sort_name" field.
+func (u *MetaUpsert) SetSortName(v string) *MetaUpsert {
+	u.Set(meta.FieldSortName, v)
+	return u
+}
+
+// UpdateSortName sets the "sort_name" field to the value that was provided on create.
+func (u *MetaUpsert) UpdateSortName() *MetaUpsert {
+	u.SetExcluded(meta.FieldSortName)
+	return u
+}
+
+// ClearSortName clears the value of the "sort_name" field.
+func (u *MetaUpsert) ClearSortName() *MetaUpsert {
+	u.SetNull(meta.FieldSortName)
+	return u
+}
+
 // SetCreateTime sets the "create_time" field.
 func (u *MetaUpsert) SetCreateTime(v time.Time) *MetaUpsert {
 	u.Set(meta.FieldCreateTime, v)
@@ -616,6 +898,24 @@ func (u *MetaUpsert) UpdateFileIndices() *MetaUpsert {
 	return u
 }
 
+// SetPagesUpdateTime sets the "pages_update_time" field.
+func (u *MetaUpsert) SetPagesUpdateTime(v time.Time) *MetaUpsert {
+	u.Set(meta.FieldPagesUpdateTime, v)
+	return u
+}
+
+// UpdatePagesUpdateTime sets the "pages_update_time" field to the value that was provided on create.
+func (u *MetaUpsert) UpdatePagesUpdateTime() *MetaUpsert {
+	u.SetExcluded(meta.FieldPagesUpdateTime)
+	return u
+}
+
+// ClearPagesUpdateTime clears the value of the "pages_update_time" field.
+func (u *MetaUpsert) ClearPagesUpdateTime() *MetaUpsert {
+	u.SetNull(meta.FieldPagesUpdateTime)
+	return u
+}
+
 // SetRead sets the "read" field.
 func (u *MetaUpsert) SetRead(v bool) *MetaUpsert {
 	u.Set(meta.FieldRead, v)
@@ -784,6 +1084,132 @@ func (u *MetaUpsert) ClearThumbnailHeight() *MetaUpsert {
 	return u
 }
 
+// SetSeries sets the "series" field.
+func (u *MetaUpsert) SetSeries(v string) *MetaUpsert {
+	u.Set(meta.FieldSeries, v)
+	return u
+}
+
+// UpdateSeries sets the "series" field to the value that was provided on create.
+func (u *MetaUpsert) UpdateSeries() *MetaUpsert {
+	u.SetExcluded(meta.FieldSeries)
+	return u
+}
+
+// ClearSeries clears the value of the "series" field.
+func (u *MetaUpsert) ClearSeries() *MetaUpsert {
+	u.SetNull(meta.FieldSeries)
+	return u
+}
+
+// SetVolume sets the "volume" field.
+func (u *MetaUpsert) SetVolume(v float64) *MetaUpsert {
+	u.Set(meta.FieldVolume, v)
+	return u
+}
+
+// UpdateVolume sets the "volume" field to the value that was provided on create.
+func (u *MetaUpsert) UpdateVolume() *MetaUpsert {
+	u.SetExcluded(meta.FieldVolume)
+	return u
+}
+
+// AddVolume adds v to the "volume" field.
+func (u *MetaUpsert) AddVolume(v float64) *MetaUpsert {
+	u.Add(meta.FieldVolume, v)
+	return u
+}
+
+// ClearVolume clears the value of the "volume" field.
+func (u *MetaUpsert) ClearVolume() *MetaUpsert {
+	u.SetNull(meta.FieldVolume)
+	return u
+}
+
+// SetChapter sets the "chapter" field.
+func (u *MetaUpsert) SetChapter(v float64) *MetaUpsert {
+	u.Set(meta.FieldChapter, v)
+	return u
+}
+
+// UpdateChapter sets the "chapter" field to the value that was provided on create.
+func (u *MetaUpsert) UpdateChapter() *MetaUpsert {
+	u.SetExcluded(meta.FieldChapter)
+	return u
+}
+
+// AddChapter adds v to the "chapter" field.
+func (u *MetaUpsert) AddChapter(v float64) *MetaUpsert {
+	u.Add(meta.FieldChapter, v)
+	return u
+}
+
+// ClearChapter clears the value of the "chapter" field.
+func (u *MetaUpsert) ClearChapter() *MetaUpsert {
+	u.SetNull(meta.FieldChapter)
+	return u
+}
+
+// SetArtist sets the "artist" field.
+func (u *MetaUpsert) SetArtist(v string) *MetaUpsert {
+	u.Set(meta.FieldArtist, v)
+	return u
+}
+
+// UpdateArtist sets the "artist" field to the value that was provided on create.
+func (u *MetaUpsert) UpdateArtist() *MetaUpsert {
+	u.SetExcluded(meta.FieldArtist)
+	return u
+}
+
+// ClearArtist clears the value of the "artist" field.
+func (u *MetaUpsert) ClearArtist() *MetaUpsert {
+	u.SetNull(meta.FieldArtist)
+	return u
+}
+
+// SetYear sets the "year" field.
+func (u *MetaUpsert) SetYear(v int) *MetaUpsert {
+	u.Set(meta.FieldYear, v)
+	return u
+}
+
+// UpdateYear sets the "year" field to the value that was provided on create.
+func (u *MetaUpsert) UpdateYear() *MetaUpsert {
+	u.SetExcluded(meta.FieldYear)
+	return u
+}
+
+// AddYear adds v to the "year" field.
+func (u *MetaUpsert) AddYear(v int) *MetaUpsert {
+	u.Add(meta.FieldYear, v)
+	return u
+}
+
+// ClearYear clears the value of the "year" field.
+func (u *MetaUpsert) ClearYear() *MetaUpsert {
+	u.SetNull(meta.FieldYear)
+	return u
+}
+
+// SetAgeRating sets the "age_rating" field.
+func (u *MetaUpsert) SetAgeRating(v int) *MetaUpsert {
+	u.Set(meta.FieldAgeRating, v)
+	return u
+}
+
+// UpdateAgeRating sets the "age_rating" field to the value that was provided on create.
+func (u *MetaUpsert) UpdateAgeRating() *MetaUpsert {
+	u.SetExcluded(meta.FieldAgeRating)
+	return u
+}
+
+// AddAgeRating adds v to the "age_rating" field.
+func (u *MetaUpsert) AddAgeRating(v int) *MetaUpsert {
+	u.Add(meta.FieldAgeRating, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -838,6 +1264,48 @@ func (u *MetaUpsertOne) UpdateName() *MetaUpsertOne {
 	})
 }
 
+// SetSearchKey sets the "search_key" field.
+func (u *MetaUpsertOne) SetSearchKey(v string) *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.SetSearchKey(v)
+	})
+}
+
+// UpdateSearchKey sets the "search_key" field to the value that was provided on create.
+func (u *MetaUpsertOne) UpdateSearchKey() *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.UpdateSearchKey()
+	})
+}
+
+// ClearSearchKey clears the value of the "search_key" field.
+func (u *MetaUpsertOne) ClearSearchKey() *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.ClearSearchKey()
+	})
+}
+
+// SetSortName sets the "sort_name" field.
+func (u *MetaUpsertOne) SetSortName(v string) *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.SetSortName(v)
+	})
+}
+
+// UpdateSortName sets the "sort_name" field to the value that was provided on create.
+func (u *MetaUpsertOne) UpdateSortName() *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.UpdateSortName()
+	})
+}
+
+// ClearSortName clears the value of the "sort_name" field.
+func (u *MetaUpsertOne) ClearSortName() *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.ClearSortName()
+	})
+}
+
 // SetCreateTime sets the "create_time" field.
 func (u *MetaUpsertOne) SetCreateTime(v time.Time) *MetaUpsertOne {
 	return u.Update(func(s *MetaUpsert) {
@@ -880,6 +1348,27 @@ func (u *MetaUpsertOne) UpdateFileIndices() *MetaUpsertOne {
 	})
 }
 
+// SetPagesUpdateTime sets the "pages_update_time" field.
+func (u *MetaUpsertOne) SetPagesUpdateTime(v time.Time) *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.SetPagesUpdateTime(v)
+	})
+}
+
+// UpdatePagesUpdateTime sets the "pages_update_time" field to the value that was provided on create.
+func (u *MetaUpsertOne) UpdatePagesUpdateTime() *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.UpdatePagesUpdateTime()
+	})
+}
+
+// ClearPagesUpdateTime clears the value of the "pages_update_time" field.
+func (u *MetaUpsertOne) ClearPagesUpdateTime() *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.ClearPagesUpdateTime()
+	})
+}
+
 // SetRead sets the "read" field.
 func (u *MetaUpsertOne) SetRead(v bool) *MetaUpsertOne {
 	return u.Update(func(s *MetaUpsert) {
@@ -1076,6 +1565,153 @@ func (u *MetaUpsertOne) ClearThumbnailHeight() *MetaUpsertOne {
 	})
 }
 
+// SetSeries sets the "series" field.
+func (u *MetaUpsertOne) SetSeries(v string) *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.SetSeries(v)
+	})
+}
+
+// UpdateSeries sets the "series" field to the value that was provided on create.
+func (u *MetaUpsertOne) UpdateSeries() *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.UpdateSeries()
+	})
+}
+
+// ClearSeries clears the value of the "series" field.
+func (u *MetaUpsertOne) ClearSeries() *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.ClearSeries()
+	})
+}
+
+// SetVolume sets the "volume" field.
+func (u *MetaUpsertOne) SetVolume(v float64) *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.SetVolume(v)
+	})
+}
+
+// AddVolume adds v to the "volume" field.
+func (u *MetaUpsertOne) AddVolume(v float64) *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.AddVolume(v)
+	})
+}
+
+// UpdateVolume sets the "volume" field to the value that was provided on create.
+func (u *MetaUpsertOne) UpdateVolume() *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.UpdateVolume()
+	})
+}
+
+// ClearVolume clears the value of the "volume" field.
+func (u *MetaUpsertOne) ClearVolume() *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.ClearVolume()
+	})
+}
+
+// SetChapter sets the "chapter" field.
+func (u *MetaUpsertOne) SetChapter(v float64) *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.SetChapter(v)
+	})
+}
+
+// AddChapter adds v to the "chapter" field.
+func (u *MetaUpsertOne) AddChapter(v float64) *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.AddChapter(v)
+	})
+}
+
+// UpdateChapter sets the "chapter" field to the value that was provided on create.
+func (u *MetaUpsertOne) UpdateChapter() *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.UpdateChapter()
+	})
+}
+
+// ClearChapter clears the value of the "chapter" field.
+func (u *MetaUpsertOne) ClearChapter() *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.ClearChapter()
+	})
+}
+
+// SetArtist sets the "artist" field.
+func (u *MetaUpsertOne) SetArtist(v string) *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.SetArtist(v)
+	})
+}
+
+// UpdateArtist sets the "artist" field to the value that was provided on create.
+func (u *MetaUpsertOne) UpdateArtist() *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.UpdateArtist()
+	})
+}
+
+// ClearArtist clears the value of the "artist" field.
+func (u *MetaUpsertOne) ClearArtist() *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.ClearArtist()
+	})
+}
+
+// SetYear sets the "year" field.
+func (u *MetaUpsertOne) SetYear(v int) *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.SetYear(v)
+	})
+}
+
+// AddYear adds v to the "year" field.
+func (u *MetaUpsertOne) AddYear(v int) *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.AddYear(v)
+	})
+}
+
+// UpdateYear sets the "year" field to the value that was provided on create.
+func (u *MetaUpsertOne) UpdateYear() *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.UpdateYear()
+	})
+}
+
+// ClearYear clears the value of the "year" field.
+func (u *MetaUpsertOne) ClearYear() *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.ClearYear()
+	})
+}
+
+// SetAgeRating sets the "age_rating" field.
+func (u *MetaUpsertOne) SetAgeRating(v int) *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.SetAgeRating(v)
+	})
+}
+
+// AddAgeRating adds v to the "age_rating" field.
+func (u *MetaUpsertOne) AddAgeRating(v int) *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.AddAgeRating(v)
+	})
+}
+
+// UpdateAgeRating sets the "age_rating" field to the value that was provided on create.
+func (u *MetaUpsertOne) UpdateAgeRating() *MetaUpsertOne {
+	return u.Update(func(s *MetaUpsert) {
+		s.UpdateAgeRating()
+	})
+}
+
 // Exec executes the query.
 func (u *MetaUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1294,6 +1930,48 @@ func (u *MetaUpsertBulk) UpdateName() *MetaUpsertBulk {
 	})
 }
 
+// SetSearchKey sets the "search_key" field.
+func (u *MetaUpsertBulk) SetSearchKey(v string) *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.SetSearchKey(v)
+	})
+}
+
+// UpdateSearchKey sets the "search_key" field to the value that was provided on create.
+func (u *MetaUpsertBulk) UpdateSearchKey() *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.UpdateSearchKey()
+	})
+}
+
+// ClearSearchKey clears the value of the "search_key" field.
+func (u *MetaUpsertBulk) ClearSearchKey() *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.ClearSearchKey()
+	})
+}
+
+// SetSortName sets the "sort_name" field.
+func (u *MetaUpsertBulk) SetSortName(v string) *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.SetSortName(v)
+	})
+}
+
+// UpdateSortName sets the "sort_name" field to the value that was provided on create.
+func (u *MetaUpsertBulk) UpdateSortName() *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.UpdateSortName()
+	})
+}
+
+// ClearSortName clears the value of the "sort_name" field.
+func (u *MetaUpsertBulk) ClearSortName() *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.ClearSortName()
+	})
+}
+
 // SetCreateTime sets the "create_time" field.
 func (u *MetaUpsertBulk) SetCreateTime(v time.Time) *MetaUpsertBulk {
 	return u.Update(func(s *MetaUpsert) {
@@ -1336,6 +2014,27 @@ func (u *MetaUpsertBulk) UpdateFileIndices() *MetaUpsertBulk {
 	})
 }
 
+// SetPagesUpdateTime sets the "pages_update_time" field.
+func (u *MetaUpsertBulk) SetPagesUpdateTime(v time.Time) *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.SetPagesUpdateTime(v)
+	})
+}
+
+// UpdatePagesUpdateTime sets the "pages_update_time" field to the value that was provided on create.
+func (u *MetaUpsertBulk) UpdatePagesUpdateTime() *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.UpdatePagesUpdateTime()
+	})
+}
+
+// ClearPagesUpdateTime clears the value of the "pages_update_time" field.
+func (u *MetaUpsertBulk) ClearPagesUpdateTime() *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.ClearPagesUpdateTime()
+	})
+}
+
 // SetRead sets the "read" field.
 func (u *MetaUpsertBulk) SetRead(v bool) *MetaUpsertBulk {
 	return u.Update(func(s *MetaUpsert) {
@@ -1532,6 +2231,153 @@ func (u *MetaUpsertBulk) ClearThumbnailHeight() *MetaUpsertBulk {
 	})
 }
 
+// SetSeries sets the "series" field.
+func (u *MetaUpsertBulk) SetSeries(v string) *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.SetSeries(v)
+	})
+}
+
+// UpdateSeries sets the "series" field to the value that was provided on create.
+func (u *MetaUpsertBulk) UpdateSeries() *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.UpdateSeries()
+	})
+}
+
+// ClearSeries clears the value of the "series" field.
+func (u *MetaUpsertBulk) ClearSeries() *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.ClearSeries()
+	})
+}
+
+// SetVolume sets the "volume" field.
+func (u *MetaUpsertBulk) SetVolume(v float64) *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.SetVolume(v)
+	})
+}
+
+// AddVolume adds v to the "volume" field.
+func (u *MetaUpsertBulk) AddVolume(v float64) *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.AddVolume(v)
+	})
+}
+
+// UpdateVolume sets the "volume" field to the value that was provided on create.
+func (u *MetaUpsertBulk) UpdateVolume() *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.UpdateVolume()
+	})
+}
+
+// ClearVolume clears the value of the "volume" field.
+func (u *MetaUpsertBulk) ClearVolume() *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.ClearVolume()
+	})
+}
+
+// SetChapter sets the "chapter" field.
+func (u *MetaUpsertBulk) SetChapter(v float64) *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.SetChapter(v)
+	})
+}
+
+// AddChapter adds v to the "chapter" field.
+func (u *MetaUpsertBulk) AddChapter(v float64) *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.AddChapter(v)
+	})
+}
+
+// UpdateChapter sets the "chapter" field to the value that was provided on create.
+func (u *MetaUpsertBulk) UpdateChapter() *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.UpdateChapter()
+	})
+}
+
+// ClearChapter clears the value of the "chapter" field.
+func (u *MetaUpsertBulk) ClearChapter() *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.ClearChapter()
+	})
+}
+
+// SetArtist sets the "artist" field.
+func (u *MetaUpsertBulk) SetArtist(v string) *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.SetArtist(v)
+	})
+}
+
+// UpdateArtist sets the "artist" field to the value that was provided on create.
+func (u *MetaUpsertBulk) UpdateArtist() *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.UpdateArtist()
+	})
+}
+
+// ClearArtist clears the value of the "artist" field.
+func (u *MetaUpsertBulk) ClearArtist() *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.ClearArtist()
+	})
+}
+
+// SetYear sets the "year" field.
+func (u *MetaUpsertBulk) SetYear(v int) *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.SetYear(v)
+	})
+}
+
+// AddYear adds v to the "year" field.
+func (u *MetaUpsertBulk) AddYear(v int) *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.AddYear(v)
+	})
+}
+
+// UpdateYear sets the "year" field to the value that was provided on create.
+func (u *MetaUpsertBulk) UpdateYear() *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.UpdateYear()
+	})
+}
+
+// ClearYear clears the value of the "year" field.
+func (u *MetaUpsertBulk) ClearYear() *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.ClearYear()
+	})
+}
+
+// SetAgeRating sets the "age_rating" field.
+func (u *MetaUpsertBulk) SetAgeRating(v int) *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.SetAgeRating(v)
+	})
+}
+
+// AddAgeRating adds v to the "age_rating" field.
+func (u *MetaUpsertBulk) AddAgeRating(v int) *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.AddAgeRating(v)
+	})
+}
+
+// UpdateAgeRating sets the "age_rating" field to the value that was provided on create.
+func (u *MetaUpsertBulk) UpdateAgeRating() *MetaUpsertBulk {
+	return u.Update(func(s *MetaUpsert) {
+		s.UpdateAgeRating()
+	})
+}
+
 // Exec executes the query.
 func (u *MetaUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
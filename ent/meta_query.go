@@ -5,6 +5,7 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 
@@ -14,6 +15,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/mangaweb4/mangaweb4-backend/ent/history"
 	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/mangaweb4/mangaweb4-backend/ent/metatag"
 	"github.com/mangaweb4/mangaweb4-backend/ent/predicate"
 	"github.com/mangaweb4/mangaweb4-backend/ent/progress"
 	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
@@ -28,9 +30,13 @@ type MetaQuery struct {
 	inters             []Interceptor
 	predicates         []predicate.Meta
 	withTags           *TagQuery
+	withExcludedTags   *TagQuery
 	withHistories      *HistoryQuery
 	withFavoriteOfUser *UserQuery
 	withProgress       *ProgressQuery
+	withBlockedByUser  *UserQuery
+	withMetaTags       *MetaTagQuery
+	modifiers          []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -89,6 +95,28 @@ func (_q *MetaQuery) QueryTags() *TagQuery {
 	return query
 }
 
+// QueryExcludedTags chains the current query on the "excluded_tags" edge.
+func (_q *MetaQuery) QueryExcludedTags() *TagQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(meta.Table, meta.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, meta.ExcludedTagsTable, meta.ExcludedTagsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryHistories chains the current query on the "histories" edge.
 func (_q *MetaQuery) QueryHistories() *HistoryQuery {
 	query := (&HistoryClient{config: _q.config}).Query()
@@ -155,6 +183,50 @@ func (_q *MetaQuery) QueryProgress() *ProgressQuery {
 	return query
 }
 
+// QueryBlockedByUser chains the current query on the "blocked_by_user" edge.
+func (_q *MetaQuery) QueryBlockedByUser() *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(meta.Table, meta.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, meta.BlockedByUserTable, meta.BlockedByUserPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMetaTags chains the current query on the "meta_tags" edge.
+func (_q *MetaQuery) QueryMetaTags() *MetaTagQuery {
+	query := (&MetaTagClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(meta.Table, meta.FieldID, selector),
+			sqlgraph.To(metatag.Table, metatag.MetaColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, meta.MetaTagsTable, meta.MetaTagsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Meta entity from the query.
 // Returns a *NotFoundError when no Meta was found.
 func (_q *MetaQuery) First(ctx context.Context) (*Meta, error) {
@@ -348,12 +420,16 @@ func (_q *MetaQuery) Clone() *MetaQuery {
 		inters:             append([]Interceptor{}, _q.inters...),
 		predicates:         append([]predicate.Meta{}, _q.predicates...),
 		withTags:           _q.withTags.Clone(),
+		withExcludedTags:   _q.withExcludedTags.Clone(),
 		withHistories:      _q.withHistories.Clone(),
 		withFavoriteOfUser: _q.withFavoriteOfUser.Clone(),
 		withProgress:       _q.withProgress.Clone(),
+		withBlockedByUser:  _q.withBlockedByUser.Clone(),
+		withMetaTags:       _q.withMetaTags.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -368,6 +444,17 @@ func (_q *MetaQuery) WithTags(opts ...func(*TagQuery)) *MetaQuery {
 	return _q
 }
 
+// WithExcludedTags tells the query-builder to eager-load the nodes that are connected to
+// the "excluded_tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MetaQuery) WithExcludedTags(opts ...func(*TagQuery)) *MetaQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withExcludedTags = query
+	return _q
+}
+
 // WithHistories tells the query-builder to eager-load the nodes that are connected to
 // the "histories" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *MetaQuery) WithHistories(opts ...func(*HistoryQuery)) *MetaQuery {
@@ -401,6 +488,28 @@ func (_q *MetaQuery) WithProgress(opts ...func(*ProgressQuery)) *MetaQuery {
 	return _q
 }
 
+// WithBlockedByUser tells the query-builder to eager-load the nodes that are connected to
+// the "blocked_by_user" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MetaQuery) WithBlockedByUser(opts ...func(*UserQuery)) *MetaQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBlockedByUser = query
+	return _q
+}
+
+// WithMetaTags tells the query-builder to eager-load the nodes that are connected to
+// the "meta_tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MetaQuery) WithMetaTags(opts ...func(*MetaTagQuery)) *MetaQuery {
+	query := (&MetaTagClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMetaTags = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -472,6 +581,12 @@ func (_q *MetaQuery) prepareQuery(ctx context.Context) error {
 		}
 		_q.sql = prev
 	}
+	if meta.Policy == nil {
+		return errors.New("ent: uninitialized meta.Policy (forgotten import ent/runtime?)")
+	}
+	if err := meta.Policy.EvalQuery(ctx, _q); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -479,11 +594,14 @@ func (_q *MetaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Meta, e
 	var (
 		nodes       = []*Meta{}
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [7]bool{
 			_q.withTags != nil,
+			_q.withExcludedTags != nil,
 			_q.withHistories != nil,
 			_q.withFavoriteOfUser != nil,
 			_q.withProgress != nil,
+			_q.withBlockedByUser != nil,
+			_q.withMetaTags != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -495,6 +613,9 @@ func (_q *MetaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Meta, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -511,6 +632,13 @@ func (_q *MetaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Meta, e
 			return nil, err
 		}
 	}
+	if query := _q.withExcludedTags; query != nil {
+		if err := _q.loadExcludedTags(ctx, query, nodes,
+			func(n *Meta) { n.Edges.ExcludedTags = []*Tag{} },
+			func(n *Meta, e *Tag) { n.Edges.ExcludedTags = append(n.Edges.ExcludedTags, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withHistories; query != nil {
 		if err := _q.loadHistories(ctx, query, nodes,
 			func(n *Meta) { n.Edges.Histories = []*History{} },
@@ -532,6 +660,20 @@ func (_q *MetaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Meta, e
 			return nil, err
 		}
 	}
+	if query := _q.withBlockedByUser; query != nil {
+		if err := _q.loadBlockedByUser(ctx, query, nodes,
+			func(n *Meta) { n.Edges.BlockedByUser = []*User{} },
+			func(n *Meta, e *User) { n.Edges.BlockedByUser = append(n.Edges.BlockedByUser, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMetaTags; query != nil {
+		if err := _q.loadMetaTags(ctx, query, nodes,
+			func(n *Meta) { n.Edges.MetaTags = []*MetaTag{} },
+			func(n *Meta, e *MetaTag) { n.Edges.MetaTags = append(n.Edges.MetaTags, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -596,6 +738,67 @@ func (_q *MetaQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Met
 	}
 	return nil
 }
+func (_q *MetaQuery) loadExcludedTags(ctx context.Context, query *TagQuery, nodes []*Meta, init func(*Meta), assign func(*Meta, *Tag)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Meta)
+	nids := make(map[int]map[*Meta]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(meta.ExcludedTagsTable)
+		s.Join(joinT).On(s.C(tag.FieldID), joinT.C(meta.ExcludedTagsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(meta.ExcludedTagsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(meta.ExcludedTagsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Meta]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Tag](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "excluded_tags" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 func (_q *MetaQuery) loadHistories(ctx context.Context, query *HistoryQuery, nodes []*Meta, init func(*Meta), assign func(*Meta, *History)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*Meta)
@@ -718,9 +921,103 @@ func (_q *MetaQuery) loadProgress(ctx context.Context, query *ProgressQuery, nod
 	}
 	return nil
 }
+func (_q *MetaQuery) loadBlockedByUser(ctx context.Context, query *UserQuery, nodes []*Meta, init func(*Meta), assign func(*Meta, *User)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Meta)
+	nids := make(map[int]map[*Meta]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(meta.BlockedByUserTable)
+		s.Join(joinT).On(s.C(user.FieldID), joinT.C(meta.BlockedByUserPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(meta.BlockedByUserPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(meta.BlockedByUserPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Meta]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*User](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "blocked_by_user" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *MetaQuery) loadMetaTags(ctx context.Context, query *MetaTagQuery, nodes []*Meta, init func(*Meta), assign func(*Meta, *MetaTag)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Meta)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(metatag.FieldMetaID)
+	}
+	query.Where(predicate.MetaTag(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(meta.MetaTagsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MetaID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "meta_id" returned %v for node %v`, fk, n)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *MetaQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -783,6 +1080,9 @@ func (_q *MetaQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -800,6 +1100,12 @@ func (_q *MetaQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *MetaQuery) Modify(modifiers ...func(s *sql.Selector)) *MetaSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // MetaGroupBy is the group-by builder for Meta entities.
 type MetaGroupBy struct {
 	selector
@@ -889,3 +1195,9 @@ func (_s *MetaSelect) sqlScan(ctx context.Context, root *MetaQuery, v any) error
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *MetaSelect) Modify(modifiers ...func(s *sql.Selector)) *MetaSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
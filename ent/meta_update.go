@@ -23,8 +23,9 @@ import (
 // MetaUpdate is the builder for updating Meta entities.
 type MetaUpdate struct {
 	config
-	hooks    []Hook
-	mutation *MetaMutation
+	hooks     []Hook
+	mutation  *MetaMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the MetaUpdate builder.
@@ -47,6 +48,46 @@ func (_u *MetaUpdate) SetNillableName(v *string) *MetaUpdate {
 	return _u
 }
 
+// SetSearchKey sets the "search_key" field.
+func (_u *MetaUpdate) SetSearchKey(v string) *MetaUpdate {
+	_u.mutation.SetSearchKey(v)
+	return _u
+}
+
+// SetNillableSearchKey sets the "search_key" field if the given value is not nil.
+func (_u *MetaUpdate) SetNillableSearchKey(v *string) *MetaUpdate {
+	if v != nil {
+		_u.SetSearchKey(*v)
+	}
+	return _u
+}
+
+// ClearSearchKey clears the value of the "search_key" field.
+func (_u *MetaUpdate) ClearSearchKey() *MetaUpdate {
+	_u.mutation.ClearSearchKey()
+	return _u
+}
+
+// SetSortName sets the "sort_name" field.
+func (_u *MetaUpdate) SetSortName(v string) *MetaUpdate {
+	_u.mutation.SetSortName(v)
+	return _u
+}
+
+// SetNillableSortName sets the "sort_name" field if the given value is not nil.
+func (_u *MetaUpdate) SetNillableSortName(v *string) *MetaUpdate {
+	if v != nil {
+		_u.SetSortName(*v)
+	}
+	return _u
+}
+
+// ClearSortName clears the value of the "sort_name" field.
+func (_u *MetaUpdate) ClearSortName() *MetaUpdate {
+	_u.mutation.ClearSortName()
+	return _u
+}
+
 // SetCreateTime sets the "create_time" field.
 func (_u *MetaUpdate) SetCreateTime(v time.Time) *MetaUpdate {
 	_u.mutation.SetCreateTime(v)
@@ -87,6 +128,26 @@ func (_u *MetaUpdate) AppendFileIndices(v []int) *MetaUpdate {
 	return _u
 }
 
+// SetPagesUpdateTime sets the "pages_update_time" field.
+func (_u *MetaUpdate) SetPagesUpdateTime(v time.Time) *MetaUpdate {
+	_u.mutation.SetPagesUpdateTime(v)
+	return _u
+}
+
+// SetNillablePagesUpdateTime sets the "pages_update_time" field if the given value is not nil.
+func (_u *MetaUpdate) SetNillablePagesUpdateTime(v *time.Time) *MetaUpdate {
+	if v != nil {
+		_u.SetPagesUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearPagesUpdateTime clears the value of the "pages_update_time" field.
+func (_u *MetaUpdate) ClearPagesUpdateTime() *MetaUpdate {
+	_u.mutation.ClearPagesUpdateTime()
+	return _u
+}
+
 // SetRead sets the "read" field.
 func (_u *MetaUpdate) SetRead(v bool) *MetaUpdate {
 	_u.mutation.SetRead(v)
@@ -278,6 +339,148 @@ func (_u *MetaUpdate) ClearThumbnailHeight() *MetaUpdate {
 	return _u
 }
 
+// SetSeries sets the "series" field.
+func (_u *MetaUpdate) SetSeries(v string) *MetaUpdate {
+	_u.mutation.SetSeries(v)
+	return _u
+}
+
+// SetNillableSeries sets the "series" field if the given value is not nil.
+func (_u *MetaUpdate) SetNillableSeries(v *string) *MetaUpdate {
+	if v != nil {
+		_u.SetSeries(*v)
+	}
+	return _u
+}
+
+// ClearSeries clears the value of the "series" field.
+func (_u *MetaUpdate) ClearSeries() *MetaUpdate {
+	_u.mutation.ClearSeries()
+	return _u
+}
+
+// SetVolume sets the "volume" field.
+func (_u *MetaUpdate) SetVolume(v float64) *MetaUpdate {
+	_u.mutation.ResetVolume()
+	_u.mutation.SetVolume(v)
+	return _u
+}
+
+// SetNillableVolume sets the "volume" field if the given value is not nil.
+func (_u *MetaUpdate) SetNillableVolume(v *float64) *MetaUpdate {
+	if v != nil {
+		_u.SetVolume(*v)
+	}
+	return _u
+}
+
+// AddVolume adds value to the "volume" field.
+func (_u *MetaUpdate) AddVolume(v float64) *MetaUpdate {
+	_u.mutation.AddVolume(v)
+	return _u
+}
+
+// ClearVolume clears the value of the "volume" field.
+func (_u *MetaUpdate) ClearVolume() *MetaUpdate {
+	_u.mutation.ClearVolume()
+	return _u
+}
+
+// SetChapter sets the "chapter" field.
+func (_u *MetaUpdate) SetChapter(v float64) *MetaUpdate {
+	_u.mutation.ResetChapter()
+	_u.mutation.SetChapter(v)
+	return _u
+}
+
+// SetNillableChapter sets the "chapter" field if the given value is not nil.
+func (_u *MetaUpdate) SetNillableChapter(v *float64) *MetaUpdate {
+	if v != nil {
+		_u.SetChapter(*v)
+	}
+	return _u
+}
+
+// AddChapter adds value to the "chapter" field.
+func (_u *MetaUpdate) AddChapter(v float64) *MetaUpdate {
+	_u.mutation.AddChapter(v)
+	return _u
+}
+
+// ClearChapter clears the value of the "chapter" field.
+func (_u *MetaUpdate) ClearChapter() *MetaUpdate {
+	_u.mutation.ClearChapter()
+	return _u
+}
+
+// SetArtist sets the "artist" field.
+func (_u *MetaUpdate) SetArtist(v string) *MetaUpdate {
+	_u.mutation.SetArtist(v)
+	return _u
+}
+
+// SetNillableArtist sets the "artist" field if the given value is not nil.
+func (_u *MetaUpdate) SetNillableArtist(v *string) *MetaUpdate {
+	if v != nil {
+		_u.SetArtist(*v)
+	}
+	return _u
+}
+
+// ClearArtist clears the value of the "artist" field.
+func (_u *MetaUpdate) ClearArtist() *MetaUpdate {
+	_u.mutation.ClearArtist()
+	return _u
+}
+
+// SetYear sets the "year" field.
+func (_u *MetaUpdate) SetYear(v int) *MetaUpdate {
+	_u.mutation.ResetYear()
+	_u.mutation.SetYear(v)
+	return _u
+}
+
+// SetNillableYear sets the "year" field if the given value is not nil.
+func (_u *MetaUpdate) SetNillableYear(v *int) *MetaUpdate {
+	if v != nil {
+		_u.SetYear(*v)
+	}
+	return _u
+}
+
+// AddYear adds value to the "year" field.
+func (_u *MetaUpdate) AddYear(v int) *MetaUpdate {
+	_u.mutation.AddYear(v)
+	return _u
+}
+
+// ClearYear clears the value of the "year" field.
+func (_u *MetaUpdate) ClearYear() *MetaUpdate {
+	_u.mutation.ClearYear()
+	return _u
+}
+
+// SetAgeRating sets the "age_rating" field.
+func (_u *MetaUpdate) SetAgeRating(v int) *MetaUpdate {
+	_u.mutation.ResetAgeRating()
+	_u.mutation.SetAgeRating(v)
+	return _u
+}
+
+// SetNillableAgeRating sets the "age_rating" field if the given value is not nil.
+func (_u *MetaUpdate) SetNillableAgeRating(v *int) *MetaUpdate {
+	if v != nil {
+		_u.SetAgeRating(*v)
+	}
+	return _u
+}
+
+// AddAgeRating adds value to the "age_rating" field.
+func (_u *MetaUpdate) AddAgeRating(v int) *MetaUpdate {
+	_u.mutation.AddAgeRating(v)
+	return _u
+}
+
 // AddTagIDs adds the "tags" edge to the Tag entity by IDs.
 func (_u *MetaUpdate) AddTagIDs(ids ...int) *MetaUpdate {
 	_u.mutation.AddTagIDs(ids...)
@@ -293,6 +496,21 @@ func (_u *MetaUpdate) AddTags(v ...*Tag) *MetaUpdate {
 	return _u.AddTagIDs(ids...)
 }
 
+// AddExcludedTagIDs adds the "excluded_tags" edge to the Tag entity by IDs.
+func (_u *MetaUpdate) AddExcludedTagIDs(ids ...int) *MetaUpdate {
+	_u.mutation.AddExcludedTagIDs(ids...)
+	return _u
+}
+
+// AddExcludedTags adds the "excluded_tags" edges to the Tag entity.
+func (_u *MetaUpdate) AddExcludedTags(v ...*Tag) *MetaUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddExcludedTagIDs(ids...)
+}
+
 // AddHistoryIDs adds the "histories" edge to the History entity by IDs.
 func (_u *MetaUpdate) AddHistoryIDs(ids ...int) *MetaUpdate {
 	_u.mutation.AddHistoryIDs(ids...)
@@ -338,6 +556,21 @@ func (_u *MetaUpdate) AddProgress(v ...*Progress) *MetaUpdate {
 	return _u.AddProgresIDs(ids...)
 }
 
+// AddBlockedByUserIDs adds the "blocked_by_user" edge to the User entity by IDs.
+func (_u *MetaUpdate) AddBlockedByUserIDs(ids ...int) *MetaUpdate {
+	_u.mutation.AddBlockedByUserIDs(ids...)
+	return _u
+}
+
+// AddBlockedByUser adds the "blocked_by_user" edges to the User entity.
+func (_u *MetaUpdate) AddBlockedByUser(v ...*User) *MetaUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBlockedByUserIDs(ids...)
+}
+
 // Mutation returns the MetaMutation object of the builder.
 func (_u *MetaUpdate) Mutation() *MetaMutation {
 	return _u.mutation
@@ -364,6 +597,27 @@ func (_u *MetaUpdate) RemoveTags(v ...*Tag) *MetaUpdate {
 	return _u.RemoveTagIDs(ids...)
 }
 
+// ClearExcludedTags clears all "excluded_tags" edges to the Tag entity.
+func (_u *MetaUpdate) ClearExcludedTags() *MetaUpdate {
+	_u.mutation.ClearExcludedTags()
+	return _u
+}
+
+// RemoveExcludedTagIDs removes the "excluded_tags" edge to Tag entities by IDs.
+func (_u *MetaUpdate) RemoveExcludedTagIDs(ids ...int) *MetaUpdate {
+	_u.mutation.RemoveExcludedTagIDs(ids...)
+	return _u
+}
+
+// RemoveExcludedTags removes "excluded_tags" edges to Tag entities.
+func (_u *MetaUpdate) RemoveExcludedTags(v ...*Tag) *MetaUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExcludedTagIDs(ids...)
+}
+
 // ClearHistories clears all "histories" edges to the History entity.
 func (_u *MetaUpdate) ClearHistories() *MetaUpdate {
 	_u.mutation.ClearHistories()
@@ -427,6 +681,27 @@ func (_u *MetaUpdate) RemoveProgress(v ...*Progress) *MetaUpdate {
 	return _u.RemoveProgresIDs(ids...)
 }
 
+// ClearBlockedByUser clears all "blocked_by_user" edges to the User entity.
+func (_u *MetaUpdate) ClearBlockedByUser() *MetaUpdate {
+	_u.mutation.ClearBlockedByUser()
+	return _u
+}
+
+// RemoveBlockedByUserIDs removes the "blocked_by_user" edge to User entities by IDs.
+func (_u *MetaUpdate) RemoveBlockedByUserIDs(ids ...int) *MetaUpdate {
+	_u.mutation.RemoveBlockedByUserIDs(ids...)
+	return _u
+}
+
+// RemoveBlockedByUser removes "blocked_by_user" edges to User entities.
+func (_u *MetaUpdate) RemoveBlockedByUser(v ...*User) *MetaUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBlockedByUserIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *MetaUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -466,9 +741,20 @@ func (_u *MetaUpdate) check() error {
 			return &ValidationError{Name: "container_type", err: fmt.Errorf(`ent: validator failed for field "Meta.container_type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AgeRating(); ok {
+		if err := meta.AgeRatingValidator(v); err != nil {
+			return &ValidationError{Name: "age_rating", err: fmt.Errorf(`ent: validator failed for field "Meta.age_rating": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MetaUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MetaUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *MetaUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -484,6 +770,18 @@ func (_u *MetaUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(meta.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.SearchKey(); ok {
+		_spec.SetField(meta.FieldSearchKey, field.TypeString, value)
+	}
+	if _u.mutation.SearchKeyCleared() {
+		_spec.ClearField(meta.FieldSearchKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.SortName(); ok {
+		_spec.SetField(meta.FieldSortName, field.TypeString, value)
+	}
+	if _u.mutation.SortNameCleared() {
+		_spec.ClearField(meta.FieldSortName, field.TypeString)
+	}
 	if value, ok := _u.mutation.CreateTime(); ok {
 		_spec.SetField(meta.FieldCreateTime, field.TypeTime, value)
 	}
@@ -498,6 +796,12 @@ func (_u *MetaUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			sqljson.Append(u, meta.FieldFileIndices, value)
 		})
 	}
+	if value, ok := _u.mutation.PagesUpdateTime(); ok {
+		_spec.SetField(meta.FieldPagesUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.PagesUpdateTimeCleared() {
+		_spec.ClearField(meta.FieldPagesUpdateTime, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Read(); ok {
 		_spec.SetField(meta.FieldRead, field.TypeBool, value)
 	}
@@ -555,6 +859,51 @@ func (_u *MetaUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ThumbnailHeightCleared() {
 		_spec.ClearField(meta.FieldThumbnailHeight, field.TypeInt)
 	}
+	if value, ok := _u.mutation.Series(); ok {
+		_spec.SetField(meta.FieldSeries, field.TypeString, value)
+	}
+	if _u.mutation.SeriesCleared() {
+		_spec.ClearField(meta.FieldSeries, field.TypeString)
+	}
+	if value, ok := _u.mutation.Volume(); ok {
+		_spec.SetField(meta.FieldVolume, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVolume(); ok {
+		_spec.AddField(meta.FieldVolume, field.TypeFloat64, value)
+	}
+	if _u.mutation.VolumeCleared() {
+		_spec.ClearField(meta.FieldVolume, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Chapter(); ok {
+		_spec.SetField(meta.FieldChapter, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedChapter(); ok {
+		_spec.AddField(meta.FieldChapter, field.TypeFloat64, value)
+	}
+	if _u.mutation.ChapterCleared() {
+		_spec.ClearField(meta.FieldChapter, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Artist(); ok {
+		_spec.SetField(meta.FieldArtist, field.TypeString, value)
+	}
+	if _u.mutation.ArtistCleared() {
+		_spec.ClearField(meta.FieldArtist, field.TypeString)
+	}
+	if value, ok := _u.mutation.Year(); ok {
+		_spec.SetField(meta.FieldYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedYear(); ok {
+		_spec.AddField(meta.FieldYear, field.TypeInt, value)
+	}
+	if _u.mutation.YearCleared() {
+		_spec.ClearField(meta.FieldYear, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AgeRating(); ok {
+		_spec.SetField(meta.FieldAgeRating, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAgeRating(); ok {
+		_spec.AddField(meta.FieldAgeRating, field.TypeInt, value)
+	}
 	if _u.mutation.TagsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -566,6 +915,10 @@ func (_u *MetaUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
 			},
 		}
+		createE := &MetaTagCreate{config: _u.config, mutation: newMetaTagMutation(_u.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
@@ -582,6 +935,10 @@ func (_u *MetaUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &MetaTagCreate{config: _u.config, mutation: newMetaTagMutation(_u.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
@@ -598,6 +955,55 @@ func (_u *MetaUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &MetaTagCreate{config: _u.config, mutation: newMetaTagMutation(_u.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ExcludedTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   meta.ExcludedTagsTable,
+			Columns: meta.ExcludedTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedExcludedTagsIDs(); len(nodes) > 0 && !_u.mutation.ExcludedTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   meta.ExcludedTagsTable,
+			Columns: meta.ExcludedTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExcludedTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   meta.ExcludedTagsTable,
+			Columns: meta.ExcludedTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	if _u.mutation.HistoriesCleared() {
@@ -735,6 +1141,52 @@ func (_u *MetaUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.BlockedByUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   meta.BlockedByUserTable,
+			Columns: meta.BlockedByUserPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBlockedByUserIDs(); len(nodes) > 0 && !_u.mutation.BlockedByUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   meta.BlockedByUserTable,
+			Columns: meta.BlockedByUserPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BlockedByUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   meta.BlockedByUserTable,
+			Columns: meta.BlockedByUserPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{meta.Label}
@@ -750,9 +1202,10 @@ func (_u *MetaUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // MetaUpdateOne is the builder for updating a single Meta entity.
 type MetaUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *MetaMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *MetaMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -769,6 +1222,46 @@ func (_u *MetaUpdateOne) SetNillableName(v *string) *MetaUpdateOne {
 	return _u
 }
 
+// SetSearchKey sets the "search_key" field.
+func (_u *MetaUpdateOne) SetSearchKey(v string) *MetaUpdateOne {
+	_u.mutation.SetSearchKey(v)
+	return _u
+}
+
+// SetNillableSearchKey sets the "search_key" field if the given value is not nil.
+func (_u *MetaUpdateOne) SetNillableSearchKey(v *string) *MetaUpdateOne {
+	if v != nil {
+		_u.SetSearchKey(*v)
+	}
+	return _u
+}
+
+// ClearSearchKey clears the value of the "search_key" field.
+func (_u *MetaUpdateOne) ClearSearchKey() *MetaUpdateOne {
+	_u.mutation.ClearSearchKey()
+	return _u
+}
+
+// SetSortName sets the "sort_name" field.
+func (_u *MetaUpdateOne) SetSortName(v string) *MetaUpdateOne {
+	_u.mutation.SetSortName(v)
+	return _u
+}
+
+// SetNillableSortName sets the "sort_name" field if the given value is not nil.
+func (_u *MetaUpdateOne) SetNillableSortName(v *string) *MetaUpdateOne {
+	if v != nil {
+		_u.SetSortName(*v)
+	}
+	return _u
+}
+
+// ClearSortName clears the value of the "sort_name" field.
+func (_u *MetaUpdateOne) ClearSortName() *MetaUpdateOne {
+	_u.mutation.ClearSortName()
+	return _u
+}
+
 // SetCreateTime sets the "create_time" field.
 func (_u *MetaUpdateOne) SetCreateTime(v time.Time) *MetaUpdateOne {
 	_u.mutation.SetCreateTime(v)
@@ -809,6 +1302,26 @@ func (_u *MetaUpdateOne) AppendFileIndices(v []int) *MetaUpdateOne {
 	return _u
 }
 
+// SetPagesUpdateTime sets the "pages_update_time" field.
+func (_u *MetaUpdateOne) SetPagesUpdateTime(v time.Time) *MetaUpdateOne {
+	_u.mutation.SetPagesUpdateTime(v)
+	return _u
+}
+
+// SetNillablePagesUpdateTime sets the "pages_update_time" field if the given value is not nil.
+func (_u *MetaUpdateOne) SetNillablePagesUpdateTime(v *time.Time) *MetaUpdateOne {
+	if v != nil {
+		_u.SetPagesUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearPagesUpdateTime clears the value of the "pages_update_time" field.
+func (_u *MetaUpdateOne) ClearPagesUpdateTime() *MetaUpdateOne {
+	_u.mutation.ClearPagesUpdateTime()
+	return _u
+}
+
 // SetRead sets the "read" field.
 func (_u *MetaUpdateOne) SetRead(v bool) *MetaUpdateOne {
 	_u.mutation.SetRead(v)
@@ -1000,6 +1513,148 @@ func (_u *MetaUpdateOne) ClearThumbnailHeight() *MetaUpdateOne {
 	return _u
 }
 
+// SetSeries sets the "series" field.
+func (_u *MetaUpdateOne) SetSeries(v string) *MetaUpdateOne {
+	_u.mutation.SetSeries(v)
+	return _u
+}
+
+// SetNillableSeries sets the "series" field if the given value is not nil.
+func (_u *MetaUpdateOne) SetNillableSeries(v *string) *MetaUpdateOne {
+	if v != nil {
+		_u.SetSeries(*v)
+	}
+	return _u
+}
+
+// ClearSeries clears the value of the "series" field.
+func (_u *MetaUpdateOne) ClearSeries() *MetaUpdateOne {
+	_u.mutation.ClearSeries()
+	return _u
+}
+
+// SetVolume sets the "volume" field.
+func (_u *MetaUpdateOne) SetVolume(v float64) *MetaUpdateOne {
+	_u.mutation.ResetVolume()
+	_u.mutation.SetVolume(v)
+	return _u
+}
+
+// SetNillableVolume sets the "volume" field if the given value is not nil.
+func (_u *MetaUpdateOne) SetNillableVolume(v *float64) *MetaUpdateOne {
+	if v != nil {
+		_u.SetVolume(*v)
+	}
+	return _u
+}
+
+// AddVolume adds value to the "volume" field.
+func (_u *MetaUpdateOne) AddVolume(v float64) *MetaUpdateOne {
+	_u.mutation.AddVolume(v)
+	return _u
+}
+
+// ClearVolume clears the value of the "volume" field.
+func (_u *MetaUpdateOne) ClearVolume() *MetaUpdateOne {
+	_u.mutation.ClearVolume()
+	return _u
+}
+
+// SetChapter sets the "chapter" field.
+func (_u *MetaUpdateOne) SetChapter(v float64) *MetaUpdateOne {
+	_u.mutation.ResetChapter()
+	_u.mutation.SetChapter(v)
+	return _u
+}
+
+// SetNillableChapter sets the "chapter" field if the given value is not nil.
+func (_u *MetaUpdateOne) SetNillableChapter(v *float64) *MetaUpdateOne {
+	if v != nil {
+		_u.SetChapter(*v)
+	}
+	return _u
+}
+
+// AddChapter adds value to the "chapter" field.
+func (_u *MetaUpdateOne) AddChapter(v float64) *MetaUpdateOne {
+	_u.mutation.AddChapter(v)
+	return _u
+}
+
+// ClearChapter clears the value of the "chapter" field.
+func (_u *MetaUpdateOne) ClearChapter() *MetaUpdateOne {
+	_u.mutation.ClearChapter()
+	return _u
+}
+
+// SetArtist sets the "artist" field.
+func (_u *MetaUpdateOne) SetArtist(v string) *MetaUpdateOne {
+	_u.mutation.SetArtist(v)
+	return _u
+}
+
+// SetNillableArtist sets the "artist" field if the given value is not nil.
+func (_u *MetaUpdateOne) SetNillableArtist(v *string) *MetaUpdateOne {
+	if v != nil {
+		_u.SetArtist(*v)
+	}
+	return _u
+}
+
+// ClearArtist clears the value of the "artist" field.
+func (_u *MetaUpdateOne) ClearArtist() *MetaUpdateOne {
+	_u.mutation.ClearArtist()
+	return _u
+}
+
+// SetYear sets the "year" field.
+func (_u *MetaUpdateOne) SetYear(v int) *MetaUpdateOne {
+	_u.mutation.ResetYear()
+	_u.mutation.SetYear(v)
+	return _u
+}
+
+// SetNillableYear sets the "year" field if the given value is not nil.
+func (_u *MetaUpdateOne) SetNillableYear(v *int) *MetaUpdateOne {
+	if v != nil {
+		_u.SetYear(*v)
+	}
+	return _u
+}
+
+// AddYear adds value to the "year" field.
+func (_u *MetaUpdateOne) AddYear(v int) *MetaUpdateOne {
+	_u.mutation.AddYear(v)
+	return _u
+}
+
+// ClearYear clears the value of the "year" field.
+func (_u *MetaUpdateOne) ClearYear() *MetaUpdateOne {
+	_u.mutation.ClearYear()
+	return _u
+}
+
+// SetAgeRating sets the "age_rating" field.
+func (_u *MetaUpdateOne) SetAgeRating(v int) *MetaUpdateOne {
+	_u.mutation.ResetAgeRating()
+	_u.mutation.SetAgeRating(v)
+	return _u
+}
+
+// SetNillableAgeRating sets the "age_rating" field if the given value is not nil.
+func (_u *MetaUpdateOne) SetNillableAgeRating(v *int) *MetaUpdateOne {
+	if v != nil {
+		_u.SetAgeRating(*v)
+	}
+	return _u
+}
+
+// AddAgeRating adds value to the "age_rating" field.
+func (_u *MetaUpdateOne) AddAgeRating(v int) *MetaUpdateOne {
+	_u.mutation.AddAgeRating(v)
+	return _u
+}
+
 // AddTagIDs adds the "tags" edge to the Tag entity by IDs.
 func (_u *MetaUpdateOne) AddTagIDs(ids ...int) *MetaUpdateOne {
 	_u.mutation.AddTagIDs(ids...)
@@ -1015,6 +1670,21 @@ func (_u *MetaUpdateOne) AddTags(v ...*Tag) *MetaUpdateOne {
 	return _u.AddTagIDs(ids...)
 }
 
+// AddExcludedTagIDs adds the "excluded_tags" edge to the Tag entity by IDs.
+func (_u *MetaUpdateOne) AddExcludedTagIDs(ids ...int) *MetaUpdateOne {
+	_u.mutation.AddExcludedTagIDs(ids...)
+	return _u
+}
+
+// AddExcludedTags adds the "excluded_tags" edges to the Tag entity.
+func (_u *MetaUpdateOne) AddExcludedTags(v ...*Tag) *MetaUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddExcludedTagIDs(ids...)
+}
+
 // AddHistoryIDs adds the "histories" edge to the History entity by IDs.
 func (_u *MetaUpdateOne) AddHistoryIDs(ids ...int) *MetaUpdateOne {
 	_u.mutation.AddHistoryIDs(ids...)
@@ -1060,6 +1730,21 @@ func (_u *MetaUpdateOne) AddProgress(v ...*Progress) *MetaUpdateOne {
 	return _u.AddProgresIDs(ids...)
 }
 
+// AddBlockedByUserIDs adds the "blocked_by_user" edge to the User entity by IDs.
+func (_u *MetaUpdateOne) AddBlockedByUserIDs(ids ...int) *MetaUpdateOne {
+	_u.mutation.AddBlockedByUserIDs(ids...)
+	return _u
+}
+
+// AddBlockedByUser adds the "blocked_by_user" edges to the User entity.
+func (_u *MetaUpdateOne) AddBlockedByUser(v ...*User) *MetaUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBlockedByUserIDs(ids...)
+}
+
 // Mutation returns the MetaMutation object of the builder.
 func (_u *MetaUpdateOne) Mutation() *MetaMutation {
 	return _u.mutation
@@ -1086,6 +1771,27 @@ func (_u *MetaUpdateOne) RemoveTags(v ...*Tag) *MetaUpdateOne {
 	return _u.RemoveTagIDs(ids...)
 }
 
+// ClearExcludedTags clears all "excluded_tags" edges to the Tag entity.
+func (_u *MetaUpdateOne) ClearExcludedTags() *MetaUpdateOne {
+	_u.mutation.ClearExcludedTags()
+	return _u
+}
+
+// RemoveExcludedTagIDs removes the "excluded_tags" edge to Tag entities by IDs.
+func (_u *MetaUpdateOne) RemoveExcludedTagIDs(ids ...int) *MetaUpdateOne {
+	_u.mutation.RemoveExcludedTagIDs(ids...)
+	return _u
+}
+
+// RemoveExcludedTags removes "excluded_tags" edges to Tag entities.
+func (_u *MetaUpdateOne) RemoveExcludedTags(v ...*Tag) *MetaUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExcludedTagIDs(ids...)
+}
+
 // ClearHistories clears all "histories" edges to the History entity.
 func (_u *MetaUpdateOne) ClearHistories() *MetaUpdateOne {
 	_u.mutation.ClearHistories()
@@ -1149,6 +1855,27 @@ func (_u *MetaUpdateOne) RemoveProgress(v ...*Progress) *MetaUpdateOne {
 	return _u.RemoveProgresIDs(ids...)
 }
 
+// ClearBlockedByUser clears all "blocked_by_user" edges to the User entity.
+func (_u *MetaUpdateOne) ClearBlockedByUser() *MetaUpdateOne {
+	_u.mutation.ClearBlockedByUser()
+	return _u
+}
+
+// RemoveBlockedByUserIDs removes the "blocked_by_user" edge to User entities by IDs.
+func (_u *MetaUpdateOne) RemoveBlockedByUserIDs(ids ...int) *MetaUpdateOne {
+	_u.mutation.RemoveBlockedByUserIDs(ids...)
+	return _u
+}
+
+// RemoveBlockedByUser removes "blocked_by_user" edges to User entities.
+func (_u *MetaUpdateOne) RemoveBlockedByUser(v ...*User) *MetaUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBlockedByUserIDs(ids...)
+}
+
 // Where appends a list predicates to the MetaUpdate builder.
 func (_u *MetaUpdateOne) Where(ps ...predicate.Meta) *MetaUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1201,9 +1928,20 @@ func (_u *MetaUpdateOne) check() error {
 			return &ValidationError{Name: "container_type", err: fmt.Errorf(`ent: validator failed for field "Meta.container_type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AgeRating(); ok {
+		if err := meta.AgeRatingValidator(v); err != nil {
+			return &ValidationError{Name: "age_rating", err: fmt.Errorf(`ent: validator failed for field "Meta.age_rating": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MetaUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MetaUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *MetaUpdateOne) sqlSave(ctx context.Context) (_node *Meta, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -1236,6 +1974,18 @@ func (_u *MetaUpdateOne) sqlSave(ctx context.Context) (_node *Meta, err error) {
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(meta.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.SearchKey(); ok {
+		_spec.SetField(meta.FieldSearchKey, field.TypeString, value)
+	}
+	if _u.mutation.SearchKeyCleared() {
+		_spec.ClearField(meta.FieldSearchKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.SortName(); ok {
+		_spec.SetField(meta.FieldSortName, field.TypeString, value)
+	}
+	if _u.mutation.SortNameCleared() {
+		_spec.ClearField(meta.FieldSortName, field.TypeString)
+	}
 	if value, ok := _u.mutation.CreateTime(); ok {
 		_spec.SetField(meta.FieldCreateTime, field.TypeTime, value)
 	}
@@ -1250,6 +2000,12 @@ func (_u *MetaUpdateOne) sqlSave(ctx context.Context) (_node *Meta, err error) {
 			sqljson.Append(u, meta.FieldFileIndices, value)
 		})
 	}
+	if value, ok := _u.mutation.PagesUpdateTime(); ok {
+		_spec.SetField(meta.FieldPagesUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.PagesUpdateTimeCleared() {
+		_spec.ClearField(meta.FieldPagesUpdateTime, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Read(); ok {
 		_spec.SetField(meta.FieldRead, field.TypeBool, value)
 	}
@@ -1307,6 +2063,51 @@ func (_u *MetaUpdateOne) sqlSave(ctx context.Context) (_node *Meta, err error) {
 	if _u.mutation.ThumbnailHeightCleared() {
 		_spec.ClearField(meta.FieldThumbnailHeight, field.TypeInt)
 	}
+	if value, ok := _u.mutation.Series(); ok {
+		_spec.SetField(meta.FieldSeries, field.TypeString, value)
+	}
+	if _u.mutation.SeriesCleared() {
+		_spec.ClearField(meta.FieldSeries, field.TypeString)
+	}
+	if value, ok := _u.mutation.Volume(); ok {
+		_spec.SetField(meta.FieldVolume, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVolume(); ok {
+		_spec.AddField(meta.FieldVolume, field.TypeFloat64, value)
+	}
+	if _u.mutation.VolumeCleared() {
+		_spec.ClearField(meta.FieldVolume, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Chapter(); ok {
+		_spec.SetField(meta.FieldChapter, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedChapter(); ok {
+		_spec.AddField(meta.FieldChapter, field.TypeFloat64, value)
+	}
+	if _u.mutation.ChapterCleared() {
+		_spec.ClearField(meta.FieldChapter, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Artist(); ok {
+		_spec.SetField(meta.FieldArtist, field.TypeString, value)
+	}
+	if _u.mutation.ArtistCleared() {
+		_spec.ClearField(meta.FieldArtist, field.TypeString)
+	}
+	if value, ok := _u.mutation.Year(); ok {
+		_spec.SetField(meta.FieldYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedYear(); ok {
+		_spec.AddField(meta.FieldYear, field.TypeInt, value)
+	}
+	if _u.mutation.YearCleared() {
+		_spec.ClearField(meta.FieldYear, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AgeRating(); ok {
+		_spec.SetField(meta.FieldAgeRating, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAgeRating(); ok {
+		_spec.AddField(meta.FieldAgeRating, field.TypeInt, value)
+	}
 	if _u.mutation.TagsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -1318,6 +2119,10 @@ func (_u *MetaUpdateOne) sqlSave(ctx context.Context) (_node *Meta, err error) {
 				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
 			},
 		}
+		createE := &MetaTagCreate{config: _u.config, mutation: newMetaTagMutation(_u.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
@@ -1334,6 +2139,10 @@ func (_u *MetaUpdateOne) sqlSave(ctx context.Context) (_node *Meta, err error) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &MetaTagCreate{config: _u.config, mutation: newMetaTagMutation(_u.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
@@ -1350,6 +2159,55 @@ func (_u *MetaUpdateOne) sqlSave(ctx context.Context) (_node *Meta, err error) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &MetaTagCreate{config: _u.config, mutation: newMetaTagMutation(_u.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ExcludedTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   meta.ExcludedTagsTable,
+			Columns: meta.ExcludedTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedExcludedTagsIDs(); len(nodes) > 0 && !_u.mutation.ExcludedTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   meta.ExcludedTagsTable,
+			Columns: meta.ExcludedTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExcludedTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   meta.ExcludedTagsTable,
+			Columns: meta.ExcludedTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	if _u.mutation.HistoriesCleared() {
@@ -1487,6 +2345,52 @@ func (_u *MetaUpdateOne) sqlSave(ctx context.Context) (_node *Meta, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.BlockedByUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   meta.BlockedByUserTable,
+			Columns: meta.BlockedByUserPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBlockedByUserIDs(); len(nodes) > 0 && !_u.mutation.BlockedByUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   meta.BlockedByUserTable,
+			Columns: meta.BlockedByUserPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BlockedByUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   meta.BlockedByUserTable,
+			Columns: meta.BlockedByUserPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Meta{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,164 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/mangaweb4/mangaweb4-backend/ent/metatag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+)
+
+// MetaTag is the model entity for the MetaTag schema.
+type MetaTag struct {
+	config `json:"-"`
+	// MetaID holds the value of the "meta_id" field.
+	MetaID int `json:"meta_id,omitempty"`
+	// TagID holds the value of the "tag_id" field.
+	TagID int `json:"tag_id,omitempty"`
+	// Source holds the value of the "source" field.
+	Source metatag.Source `json:"source,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MetaTagQuery when eager-loading is set.
+	Edges        MetaTagEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MetaTagEdges holds the relations/edges for other nodes in the graph.
+type MetaTagEdges struct {
+	// Meta holds the value of the meta edge.
+	Meta *Meta `json:"meta,omitempty"`
+	// Tag holds the value of the tag edge.
+	Tag *Tag `json:"tag,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// MetaOrErr returns the Meta value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MetaTagEdges) MetaOrErr() (*Meta, error) {
+	if e.Meta != nil {
+		return e.Meta, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: meta.Label}
+	}
+	return nil, &NotLoadedError{edge: "meta"}
+}
+
+// TagOrErr returns the Tag value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MetaTagEdges) TagOrErr() (*Tag, error) {
+	if e.Tag != nil {
+		return e.Tag, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: tag.Label}
+	}
+	return nil, &NotLoadedError{edge: "tag"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MetaTag) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case metatag.FieldMetaID, metatag.FieldTagID:
+			values[i] = new(sql.NullInt64)
+		case metatag.FieldSource:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MetaTag fields.
+func (_m *MetaTag) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case metatag.FieldMetaID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field meta_id", values[i])
+			} else if value.Valid {
+				_m.MetaID = int(value.Int64)
+			}
+		case metatag.FieldTagID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tag_id", values[i])
+			} else if value.Valid {
+				_m.TagID = int(value.Int64)
+			}
+		case metatag.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = metatag.Source(value.String)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MetaTag.
+// This includes values selected through modifiers, order, etc.
+func (_m *MetaTag) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMeta queries the "meta" edge of the MetaTag entity.
+func (_m *MetaTag) QueryMeta() *MetaQuery {
+	return NewMetaTagClient(_m.config).QueryMeta(_m)
+}
+
+// QueryTag queries the "tag" edge of the MetaTag entity.
+func (_m *MetaTag) QueryTag() *TagQuery {
+	return NewMetaTagClient(_m.config).QueryTag(_m)
+}
+
+// Update returns a builder for updating this MetaTag.
+// Note that you need to call MetaTag.Unwrap() before calling this method if this MetaTag
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *MetaTag) Update() *MetaTagUpdateOne {
+	return NewMetaTagClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the MetaTag entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *MetaTag) Unwrap() *MetaTag {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MetaTag is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *MetaTag) String() string {
+	var builder strings.Builder
+	builder.WriteString("MetaTag(")
+	builder.WriteString("meta_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MetaID))
+	builder.WriteString(", ")
+	builder.WriteString("tag_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagID))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Source))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MetaTags is a parsable slice of MetaTag.
+type MetaTags []*MetaTag
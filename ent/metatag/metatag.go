@@ -0,0 +1,146 @@
+// Code generated by ent, DO NOT EDIT.
+
+package metatag
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the metatag type in the database.
+	Label = "meta_tag"
+	// FieldMetaID holds the string denoting the meta_id field in the database.
+	FieldMetaID = "meta_id"
+	// FieldTagID holds the string denoting the tag_id field in the database.
+	FieldTagID = "tag_id"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// EdgeMeta holds the string denoting the meta edge name in mutations.
+	EdgeMeta = "meta"
+	// EdgeTag holds the string denoting the tag edge name in mutations.
+	EdgeTag = "tag"
+	// MetaFieldID holds the string denoting the ID field of the Meta.
+	MetaFieldID = "id"
+	// TagFieldID holds the string denoting the ID field of the Tag.
+	TagFieldID = "id"
+	// Table holds the table name of the metatag in the database.
+	Table = "meta_tags"
+	// MetaTable is the table that holds the meta relation/edge.
+	MetaTable = "meta_tags"
+	// MetaInverseTable is the table name for the Meta entity.
+	// It exists in this package in order to avoid circular dependency with the "meta" package.
+	MetaInverseTable = "meta"
+	// MetaColumn is the table column denoting the meta relation/edge.
+	MetaColumn = "meta_id"
+	// TagTable is the table that holds the tag relation/edge.
+	TagTable = "meta_tags"
+	// TagInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagInverseTable = "tags"
+	// TagColumn is the table column denoting the tag relation/edge.
+	TagColumn = "tag_id"
+)
+
+// Columns holds all SQL columns for metatag fields.
+var Columns = []string{
+	FieldMetaID,
+	FieldTagID,
+	FieldSource,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/mangaweb4/mangaweb4-backend/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+)
+
+// Source defines the type for the "source" enum field.
+type Source string
+
+// SourceParsed is the default value of the Source enum.
+const DefaultSource = SourceParsed
+
+// Source values.
+const (
+	SourceParsed    Source = "parsed"
+	SourceComicInfo Source = "comic_info"
+	SourceManual    Source = "manual"
+)
+
+func (s Source) String() string {
+	return string(s)
+}
+
+// SourceValidator is a validator for the "source" field enum values. It is called by the builders before save.
+func SourceValidator(s Source) error {
+	switch s {
+	case SourceParsed, SourceComicInfo, SourceManual:
+		return nil
+	default:
+		return fmt.Errorf("metatag: invalid enum value for source field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the MetaTag queries.
+type OrderOption func(*sql.Selector)
+
+// ByMetaID orders the results by the meta_id field.
+func ByMetaID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMetaID, opts...).ToFunc()
+}
+
+// ByTagID orders the results by the tag_id field.
+func ByTagID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTagID, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByMetaField orders the results by meta field.
+func ByMetaField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMetaStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTagField orders the results by tag field.
+func ByTagField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newMetaStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, MetaColumn),
+		sqlgraph.To(MetaInverseTable, MetaFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, MetaTable, MetaColumn),
+	)
+}
+func newTagStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, TagColumn),
+		sqlgraph.To(TagInverseTable, TagFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, TagTable, TagColumn),
+	)
+}
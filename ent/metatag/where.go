@@ -0,0 +1,140 @@
+// Code generated by ent, DO NOT EDIT.
+
+package metatag
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/mangaweb4/mangaweb4-backend/ent/predicate"
+)
+
+// MetaID applies equality check predicate on the "meta_id" field. It's identical to MetaIDEQ.
+func MetaID(v int) predicate.MetaTag {
+	return predicate.MetaTag(sql.FieldEQ(FieldMetaID, v))
+}
+
+// TagID applies equality check predicate on the "tag_id" field. It's identical to TagIDEQ.
+func TagID(v int) predicate.MetaTag {
+	return predicate.MetaTag(sql.FieldEQ(FieldTagID, v))
+}
+
+// MetaIDEQ applies the EQ predicate on the "meta_id" field.
+func MetaIDEQ(v int) predicate.MetaTag {
+	return predicate.MetaTag(sql.FieldEQ(FieldMetaID, v))
+}
+
+// MetaIDNEQ applies the NEQ predicate on the "meta_id" field.
+func MetaIDNEQ(v int) predicate.MetaTag {
+	return predicate.MetaTag(sql.FieldNEQ(FieldMetaID, v))
+}
+
+// MetaIDIn applies the In predicate on the "meta_id" field.
+func MetaIDIn(vs ...int) predicate.MetaTag {
+	return predicate.MetaTag(sql.FieldIn(FieldMetaID, vs...))
+}
+
+// MetaIDNotIn applies the NotIn predicate on the "meta_id" field.
+func MetaIDNotIn(vs ...int) predicate.MetaTag {
+	return predicate.MetaTag(sql.FieldNotIn(FieldMetaID, vs...))
+}
+
+// TagIDEQ applies the EQ predicate on the "tag_id" field.
+func TagIDEQ(v int) predicate.MetaTag {
+	return predicate.MetaTag(sql.FieldEQ(FieldTagID, v))
+}
+
+// TagIDNEQ applies the NEQ predicate on the "tag_id" field.
+func TagIDNEQ(v int) predicate.MetaTag {
+	return predicate.MetaTag(sql.FieldNEQ(FieldTagID, v))
+}
+
+// TagIDIn applies the In predicate on the "tag_id" field.
+func TagIDIn(vs ...int) predicate.MetaTag {
+	return predicate.MetaTag(sql.FieldIn(FieldTagID, vs...))
+}
+
+// TagIDNotIn applies the NotIn predicate on the "tag_id" field.
+func TagIDNotIn(vs ...int) predicate.MetaTag {
+	return predicate.MetaTag(sql.FieldNotIn(FieldTagID, vs...))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v Source) predicate.MetaTag {
+	return predicate.MetaTag(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v Source) predicate.MetaTag {
+	return predicate.MetaTag(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...Source) predicate.MetaTag {
+	return predicate.MetaTag(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...Source) predicate.MetaTag {
+	return predicate.MetaTag(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// HasMeta applies the HasEdge predicate on the "meta" edge.
+func HasMeta() predicate.MetaTag {
+	return predicate.MetaTag(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, MetaColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, MetaTable, MetaColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMetaWith applies the HasEdge predicate on the "meta" edge with a given conditions (other predicates).
+func HasMetaWith(preds ...predicate.Meta) predicate.MetaTag {
+	return predicate.MetaTag(func(s *sql.Selector) {
+		step := newMetaStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTag applies the HasEdge predicate on the "tag" edge.
+func HasTag() predicate.MetaTag {
+	return predicate.MetaTag(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, TagColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, TagTable, TagColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagWith applies the HasEdge predicate on the "tag" edge with a given conditions (other predicates).
+func HasTagWith(preds ...predicate.Tag) predicate.MetaTag {
+	return predicate.MetaTag(func(s *sql.Selector) {
+		step := newTagStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MetaTag) predicate.MetaTag {
+	return predicate.MetaTag(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MetaTag) predicate.MetaTag {
+	return predicate.MetaTag(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MetaTag) predicate.MetaTag {
+	return predicate.MetaTag(sql.NotPredicates(p))
+}
@@ -0,0 +1,603 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/mangaweb4/mangaweb4-backend/ent/metatag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+)
+
+// MetaTagCreate is the builder for creating a MetaTag entity.
+type MetaTagCreate struct {
+	config
+	mutation *MetaTagMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetMetaID sets the "meta_id" field.
+func (_c *MetaTagCreate) SetMetaID(v int) *MetaTagCreate {
+	_c.mutation.SetMetaID(v)
+	return _c
+}
+
+// SetTagID sets the "tag_id" field.
+func (_c *MetaTagCreate) SetTagID(v int) *MetaTagCreate {
+	_c.mutation.SetTagID(v)
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *MetaTagCreate) SetSource(v metatag.Source) *MetaTagCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *MetaTagCreate) SetNillableSource(v *metatag.Source) *MetaTagCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetMeta sets the "meta" edge to the Meta entity.
+func (_c *MetaTagCreate) SetMeta(v *Meta) *MetaTagCreate {
+	return _c.SetMetaID(v.ID)
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (_c *MetaTagCreate) SetTag(v *Tag) *MetaTagCreate {
+	return _c.SetTagID(v.ID)
+}
+
+// Mutation returns the MetaTagMutation object of the builder.
+func (_c *MetaTagCreate) Mutation() *MetaTagMutation {
+	return _c.mutation
+}
+
+// Save creates the MetaTag in the database.
+func (_c *MetaTagCreate) Save(ctx context.Context) (*MetaTag, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MetaTagCreate) SaveX(ctx context.Context) *MetaTag {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MetaTagCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MetaTagCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MetaTagCreate) defaults() error {
+	if _, ok := _c.mutation.Source(); !ok {
+		v := metatag.DefaultSource
+		_c.mutation.SetSource(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MetaTagCreate) check() error {
+	if _, ok := _c.mutation.MetaID(); !ok {
+		return &ValidationError{Name: "meta_id", err: errors.New(`ent: missing required field "MetaTag.meta_id"`)}
+	}
+	if _, ok := _c.mutation.TagID(); !ok {
+		return &ValidationError{Name: "tag_id", err: errors.New(`ent: missing required field "MetaTag.tag_id"`)}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "MetaTag.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := metatag.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "MetaTag.source": %w`, err)}
+		}
+	}
+	if len(_c.mutation.MetaIDs()) == 0 {
+		return &ValidationError{Name: "meta", err: errors.New(`ent: missing required edge "MetaTag.meta"`)}
+	}
+	if len(_c.mutation.TagIDs()) == 0 {
+		return &ValidationError{Name: "tag", err: errors.New(`ent: missing required edge "MetaTag.tag"`)}
+	}
+	return nil
+}
+
+func (_c *MetaTagCreate) sqlSave(ctx context.Context) (*MetaTag, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
+
+func (_c *MetaTagCreate) createSpec() (*MetaTag, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MetaTag{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(metatag.Table, nil)
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(metatag.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
+	if nodes := _c.mutation.MetaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   metatag.MetaTable,
+			Columns: []string{metatag.MetaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(meta.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MetaID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   metatag.TagTable,
+			Columns: []string{metatag.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TagID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MetaTag.Create().
+//		SetMetaID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MetaTagUpsert) {
+//			SetMetaID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MetaTagCreate) OnConflict(opts ...sql.ConflictOption) *MetaTagUpsertOne {
+	_c.conflict = opts
+	return &MetaTagUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MetaTag.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MetaTagCreate) OnConflictColumns(columns ...string) *MetaTagUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MetaTagUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// MetaTagUpsertOne is the builder for "upsert"-ing
+	//  one MetaTag node.
+	MetaTagUpsertOne struct {
+		create *MetaTagCreate
+	}
+
+	// MetaTagUpsert is the "OnConflict" setter.
+	MetaTagUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetMetaID sets the "meta_id" field.
+func (u *MetaTagUpsert) SetMetaID(v int) *MetaTagUpsert {
+	u.Set(metatag.FieldMetaID, v)
+	return u
+}
+
+// UpdateMetaID sets the "meta_id" field to the value that was provided on create.
+func (u *MetaTagUpsert) UpdateMetaID() *MetaTagUpsert {
+	u.SetExcluded(metatag.FieldMetaID)
+	return u
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *MetaTagUpsert) SetTagID(v int) *MetaTagUpsert {
+	u.Set(metatag.FieldTagID, v)
+	return u
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *MetaTagUpsert) UpdateTagID() *MetaTagUpsert {
+	u.SetExcluded(metatag.FieldTagID)
+	return u
+}
+
+// SetSource sets the "source" field.
+func (u *MetaTagUpsert) SetSource(v metatag.Source) *MetaTagUpsert {
+	u.Set(metatag.FieldSource, v)
+	return u
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *MetaTagUpsert) UpdateSource() *MetaTagUpsert {
+	u.SetExcluded(metatag.FieldSource)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.MetaTag.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *MetaTagUpsertOne) UpdateNewValues() *MetaTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MetaTag.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MetaTagUpsertOne) Ignore() *MetaTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MetaTagUpsertOne) DoNothing() *MetaTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MetaTagCreate.OnConflict
+// documentation for more info.
+func (u *MetaTagUpsertOne) Update(set func(*MetaTagUpsert)) *MetaTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MetaTagUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetMetaID sets the "meta_id" field.
+func (u *MetaTagUpsertOne) SetMetaID(v int) *MetaTagUpsertOne {
+	return u.Update(func(s *MetaTagUpsert) {
+		s.SetMetaID(v)
+	})
+}
+
+// UpdateMetaID sets the "meta_id" field to the value that was provided on create.
+func (u *MetaTagUpsertOne) UpdateMetaID() *MetaTagUpsertOne {
+	return u.Update(func(s *MetaTagUpsert) {
+		s.UpdateMetaID()
+	})
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *MetaTagUpsertOne) SetTagID(v int) *MetaTagUpsertOne {
+	return u.Update(func(s *MetaTagUpsert) {
+		s.SetTagID(v)
+	})
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *MetaTagUpsertOne) UpdateTagID() *MetaTagUpsertOne {
+	return u.Update(func(s *MetaTagUpsert) {
+		s.UpdateTagID()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *MetaTagUpsertOne) SetSource(v metatag.Source) *MetaTagUpsertOne {
+	return u.Update(func(s *MetaTagUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *MetaTagUpsertOne) UpdateSource() *MetaTagUpsertOne {
+	return u.Update(func(s *MetaTagUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// Exec executes the query.
+func (u *MetaTagUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MetaTagCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MetaTagUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// MetaTagCreateBulk is the builder for creating many MetaTag entities in bulk.
+type MetaTagCreateBulk struct {
+	config
+	err      error
+	builders []*MetaTagCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MetaTag entities in the database.
+func (_c *MetaTagCreateBulk) Save(ctx context.Context) ([]*MetaTag, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MetaTag, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MetaTagMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MetaTagCreateBulk) SaveX(ctx context.Context) []*MetaTag {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MetaTagCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MetaTagCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MetaTag.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MetaTagUpsert) {
+//			SetMetaID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MetaTagCreateBulk) OnConflict(opts ...sql.ConflictOption) *MetaTagUpsertBulk {
+	_c.conflict = opts
+	return &MetaTagUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MetaTag.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MetaTagCreateBulk) OnConflictColumns(columns ...string) *MetaTagUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MetaTagUpsertBulk{
+		create: _c,
+	}
+}
+
+// MetaTagUpsertBulk is the builder for "upsert"-ing
+// a bulk of MetaTag nodes.
+type MetaTagUpsertBulk struct {
+	create *MetaTagCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MetaTag.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *MetaTagUpsertBulk) UpdateNewValues() *MetaTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MetaTag.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MetaTagUpsertBulk) Ignore() *MetaTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MetaTagUpsertBulk) DoNothing() *MetaTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MetaTagCreateBulk.OnConflict
+// documentation for more info.
+func (u *MetaTagUpsertBulk) Update(set func(*MetaTagUpsert)) *MetaTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MetaTagUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetMetaID sets the "meta_id" field.
+func (u *MetaTagUpsertBulk) SetMetaID(v int) *MetaTagUpsertBulk {
+	return u.Update(func(s *MetaTagUpsert) {
+		s.SetMetaID(v)
+	})
+}
+
+// UpdateMetaID sets the "meta_id" field to the value that was provided on create.
+func (u *MetaTagUpsertBulk) UpdateMetaID() *MetaTagUpsertBulk {
+	return u.Update(func(s *MetaTagUpsert) {
+		s.UpdateMetaID()
+	})
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *MetaTagUpsertBulk) SetTagID(v int) *MetaTagUpsertBulk {
+	return u.Update(func(s *MetaTagUpsert) {
+		s.SetTagID(v)
+	})
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *MetaTagUpsertBulk) UpdateTagID() *MetaTagUpsertBulk {
+	return u.Update(func(s *MetaTagUpsert) {
+		s.UpdateTagID()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *MetaTagUpsertBulk) SetSource(v metatag.Source) *MetaTagUpsertBulk {
+	return u.Update(func(s *MetaTagUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *MetaTagUpsertBulk) UpdateSource() *MetaTagUpsertBulk {
+	return u.Update(func(s *MetaTagUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// Exec executes the query.
+func (u *MetaTagUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MetaTagCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MetaTagCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MetaTagUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
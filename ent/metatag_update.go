@@ -0,0 +1,483 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/mangaweb4/mangaweb4-backend/ent/metatag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/predicate"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+)
+
+// MetaTagUpdate is the builder for updating MetaTag entities.
+type MetaTagUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MetaTagMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MetaTagUpdate builder.
+func (_u *MetaTagUpdate) Where(ps ...predicate.MetaTag) *MetaTagUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetMetaID sets the "meta_id" field.
+func (_u *MetaTagUpdate) SetMetaID(v int) *MetaTagUpdate {
+	_u.mutation.SetMetaID(v)
+	return _u
+}
+
+// SetNillableMetaID sets the "meta_id" field if the given value is not nil.
+func (_u *MetaTagUpdate) SetNillableMetaID(v *int) *MetaTagUpdate {
+	if v != nil {
+		_u.SetMetaID(*v)
+	}
+	return _u
+}
+
+// SetTagID sets the "tag_id" field.
+func (_u *MetaTagUpdate) SetTagID(v int) *MetaTagUpdate {
+	_u.mutation.SetTagID(v)
+	return _u
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (_u *MetaTagUpdate) SetNillableTagID(v *int) *MetaTagUpdate {
+	if v != nil {
+		_u.SetTagID(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *MetaTagUpdate) SetSource(v metatag.Source) *MetaTagUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *MetaTagUpdate) SetNillableSource(v *metatag.Source) *MetaTagUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetMeta sets the "meta" edge to the Meta entity.
+func (_u *MetaTagUpdate) SetMeta(v *Meta) *MetaTagUpdate {
+	return _u.SetMetaID(v.ID)
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (_u *MetaTagUpdate) SetTag(v *Tag) *MetaTagUpdate {
+	return _u.SetTagID(v.ID)
+}
+
+// Mutation returns the MetaTagMutation object of the builder.
+func (_u *MetaTagUpdate) Mutation() *MetaTagMutation {
+	return _u.mutation
+}
+
+// ClearMeta clears the "meta" edge to the Meta entity.
+func (_u *MetaTagUpdate) ClearMeta() *MetaTagUpdate {
+	_u.mutation.ClearMeta()
+	return _u
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (_u *MetaTagUpdate) ClearTag() *MetaTagUpdate {
+	_u.mutation.ClearTag()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MetaTagUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MetaTagUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MetaTagUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MetaTagUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MetaTagUpdate) check() error {
+	if v, ok := _u.mutation.Source(); ok {
+		if err := metatag.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "MetaTag.source": %w`, err)}
+		}
+	}
+	if _u.mutation.MetaCleared() && len(_u.mutation.MetaIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MetaTag.meta"`)
+	}
+	if _u.mutation.TagCleared() && len(_u.mutation.TagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MetaTag.tag"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MetaTagUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MetaTagUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *MetaTagUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(metatag.Table, metatag.Columns, sqlgraph.NewFieldSpec(metatag.FieldMetaID, field.TypeInt), sqlgraph.NewFieldSpec(metatag.FieldTagID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(metatag.FieldSource, field.TypeEnum, value)
+	}
+	if _u.mutation.MetaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   metatag.MetaTable,
+			Columns: []string{metatag.MetaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(meta.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MetaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   metatag.MetaTable,
+			Columns: []string{metatag.MetaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(meta.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   metatag.TagTable,
+			Columns: []string{metatag.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   metatag.TagTable,
+			Columns: []string{metatag.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{metatag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MetaTagUpdateOne is the builder for updating a single MetaTag entity.
+type MetaTagUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MetaTagMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetMetaID sets the "meta_id" field.
+func (_u *MetaTagUpdateOne) SetMetaID(v int) *MetaTagUpdateOne {
+	_u.mutation.SetMetaID(v)
+	return _u
+}
+
+// SetNillableMetaID sets the "meta_id" field if the given value is not nil.
+func (_u *MetaTagUpdateOne) SetNillableMetaID(v *int) *MetaTagUpdateOne {
+	if v != nil {
+		_u.SetMetaID(*v)
+	}
+	return _u
+}
+
+// SetTagID sets the "tag_id" field.
+func (_u *MetaTagUpdateOne) SetTagID(v int) *MetaTagUpdateOne {
+	_u.mutation.SetTagID(v)
+	return _u
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (_u *MetaTagUpdateOne) SetNillableTagID(v *int) *MetaTagUpdateOne {
+	if v != nil {
+		_u.SetTagID(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *MetaTagUpdateOne) SetSource(v metatag.Source) *MetaTagUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *MetaTagUpdateOne) SetNillableSource(v *metatag.Source) *MetaTagUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetMeta sets the "meta" edge to the Meta entity.
+func (_u *MetaTagUpdateOne) SetMeta(v *Meta) *MetaTagUpdateOne {
+	return _u.SetMetaID(v.ID)
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (_u *MetaTagUpdateOne) SetTag(v *Tag) *MetaTagUpdateOne {
+	return _u.SetTagID(v.ID)
+}
+
+// Mutation returns the MetaTagMutation object of the builder.
+func (_u *MetaTagUpdateOne) Mutation() *MetaTagMutation {
+	return _u.mutation
+}
+
+// ClearMeta clears the "meta" edge to the Meta entity.
+func (_u *MetaTagUpdateOne) ClearMeta() *MetaTagUpdateOne {
+	_u.mutation.ClearMeta()
+	return _u
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (_u *MetaTagUpdateOne) ClearTag() *MetaTagUpdateOne {
+	_u.mutation.ClearTag()
+	return _u
+}
+
+// Where appends a list predicates to the MetaTagUpdate builder.
+func (_u *MetaTagUpdateOne) Where(ps ...predicate.MetaTag) *MetaTagUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MetaTagUpdateOne) Select(field string, fields ...string) *MetaTagUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MetaTag entity.
+func (_u *MetaTagUpdateOne) Save(ctx context.Context) (*MetaTag, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MetaTagUpdateOne) SaveX(ctx context.Context) *MetaTag {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MetaTagUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MetaTagUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MetaTagUpdateOne) check() error {
+	if v, ok := _u.mutation.Source(); ok {
+		if err := metatag.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "MetaTag.source": %w`, err)}
+		}
+	}
+	if _u.mutation.MetaCleared() && len(_u.mutation.MetaIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MetaTag.meta"`)
+	}
+	if _u.mutation.TagCleared() && len(_u.mutation.TagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MetaTag.tag"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MetaTagUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MetaTagUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *MetaTagUpdateOne) sqlSave(ctx context.Context) (_node *MetaTag, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(metatag.Table, metatag.Columns, sqlgraph.NewFieldSpec(metatag.FieldMetaID, field.TypeInt), sqlgraph.NewFieldSpec(metatag.FieldTagID, field.TypeInt))
+	if id, ok := _u.mutation.MetaID(); !ok {
+		return nil, &ValidationError{Name: "meta_id", err: errors.New(`ent: missing "MetaTag.meta_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[0].Value = id
+	}
+	if id, ok := _u.mutation.TagID(); !ok {
+		return nil, &ValidationError{Name: "tag_id", err: errors.New(`ent: missing "MetaTag.tag_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[1].Value = id
+	}
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, len(fields))
+		for i, f := range fields {
+			if !metatag.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			_spec.Node.Columns[i] = f
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(metatag.FieldSource, field.TypeEnum, value)
+	}
+	if _u.mutation.MetaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   metatag.MetaTable,
+			Columns: []string{metatag.MetaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(meta.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MetaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   metatag.MetaTable,
+			Columns: []string{metatag.MetaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(meta.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   metatag.TagTable,
+			Columns: []string{metatag.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   metatag.TagTable,
+			Columns: []string{metatag.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &MetaTag{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{metatag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
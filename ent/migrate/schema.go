@@ -8,6 +8,32 @@ import (
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "key_hash", Type: field.TypeString, Unique: true},
+		{Name: "prefix", Type: field.TypeString},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "expire_time", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_time", Type: field.TypeTime, Nullable: true},
+		{Name: "user_api_keys", Type: field.TypeInt},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_keys_users_api_keys",
+				Columns:    []*schema.Column{APIKeysColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// HistoriesColumns holds the columns for the "histories" table.
 	HistoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -39,9 +65,12 @@ var (
 	MetaColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "search_key", Type: field.TypeString, Nullable: true},
+		{Name: "sort_name", Type: field.TypeString, Nullable: true},
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "favorite", Type: field.TypeBool, Default: false},
 		{Name: "file_indices", Type: field.TypeJSON},
+		{Name: "pages_update_time", Type: field.TypeTime, Nullable: true},
 		{Name: "read", Type: field.TypeBool, Default: false},
 		{Name: "active", Type: field.TypeBool, Default: true},
 		{Name: "hidden", Type: field.TypeBool, Default: false},
@@ -51,12 +80,58 @@ var (
 		{Name: "thumbnail_y", Type: field.TypeInt, Nullable: true, Default: 0},
 		{Name: "thumbnail_width", Type: field.TypeInt, Nullable: true, Default: 0},
 		{Name: "thumbnail_height", Type: field.TypeInt, Nullable: true, Default: 0},
+		{Name: "series", Type: field.TypeString, Nullable: true},
+		{Name: "volume", Type: field.TypeFloat64, Nullable: true},
+		{Name: "chapter", Type: field.TypeFloat64, Nullable: true},
+		{Name: "artist", Type: field.TypeString, Nullable: true},
+		{Name: "year", Type: field.TypeInt, Nullable: true},
+		{Name: "age_rating", Type: field.TypeInt, Default: 0},
 	}
 	// MetaTable holds the schema information for the "meta" table.
 	MetaTable = &schema.Table{
 		Name:       "meta",
 		Columns:    MetaColumns,
 		PrimaryKey: []*schema.Column{MetaColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "meta_series_volume_chapter",
+				Unique:  false,
+				Columns: []*schema.Column{MetaColumns[17], MetaColumns[18], MetaColumns[19]},
+			},
+		},
+	}
+	// MetaTagsColumns holds the columns for the "meta_tags" table.
+	MetaTagsColumns = []*schema.Column{
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"parsed", "comic_info", "manual"}, Default: "parsed"},
+		{Name: "meta_id", Type: field.TypeInt},
+		{Name: "tag_id", Type: field.TypeInt},
+	}
+	// MetaTagsTable holds the schema information for the "meta_tags" table.
+	MetaTagsTable = &schema.Table{
+		Name:       "meta_tags",
+		Columns:    MetaTagsColumns,
+		PrimaryKey: []*schema.Column{MetaTagsColumns[1], MetaTagsColumns[2]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "meta_tags_meta_meta",
+				Columns:    []*schema.Column{MetaTagsColumns[1]},
+				RefColumns: []*schema.Column{MetaColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "meta_tags_tags_tag",
+				Columns:    []*schema.Column{MetaTagsColumns[2]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "metatag_tag_id_meta_id",
+				Unique:  false,
+				Columns: []*schema.Column{MetaTagsColumns[2], MetaTagsColumns[1]},
+			},
+		},
 	}
 	// ProgressesColumns holds the columns for the "progresses" table.
 	ProgressesColumns = []*schema.Column{
@@ -93,25 +168,127 @@ var (
 			},
 		},
 	}
+	// SavedSearchesColumns holds the columns for the "saved_searches" table.
+	SavedSearchesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "search", Type: field.TypeString, Nullable: true},
+		{Name: "query", Type: field.TypeString, Nullable: true},
+		{Name: "filter", Type: field.TypeInt, Default: 0},
+		{Name: "sort", Type: field.TypeInt, Default: 0},
+		{Name: "sort_order", Type: field.TypeInt, Default: 0},
+		{Name: "series", Type: field.TypeString, Nullable: true},
+		{Name: "include_tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "tag_match", Type: field.TypeInt, Default: 0},
+		{Name: "exclude_tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "random_seed", Type: field.TypeInt64, Default: 0},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "last_open_time", Type: field.TypeTime},
+		{Name: "user_saved_searches", Type: field.TypeInt},
+	}
+	// SavedSearchesTable holds the schema information for the "saved_searches" table.
+	SavedSearchesTable = &schema.Table{
+		Name:       "saved_searches",
+		Columns:    SavedSearchesColumns,
+		PrimaryKey: []*schema.Column{SavedSearchesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "saved_searches_users_saved_searches",
+				Columns:    []*schema.Column{SavedSearchesColumns[14]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// SessionsColumns holds the columns for the "sessions" table.
+	SessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "expire_time", Type: field.TypeTime},
+		{Name: "user_sessions", Type: field.TypeInt},
+	}
+	// SessionsTable holds the schema information for the "sessions" table.
+	SessionsTable = &schema.Table{
+		Name:       "sessions",
+		Columns:    SessionsColumns,
+		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sessions_users_sessions",
+				Columns:    []*schema.Column{SessionsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// TagsColumns holds the columns for the "tags" table.
 	TagsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString, Unique: true},
 		{Name: "favorite", Type: field.TypeBool, Default: false},
 		{Name: "hidden", Type: field.TypeBool, Default: false},
+		{Name: "hide_items", Type: field.TypeBool, Default: false},
+		{Name: "active", Type: field.TypeBool, Default: true},
 		{Name: "last_update", Type: field.TypeTime, Nullable: true},
+		{Name: "normalized_name", Type: field.TypeString, Nullable: true},
+		{Name: "search_key", Type: field.TypeString, Nullable: true},
+		{Name: "category", Type: field.TypeEnum, Nullable: true, Enums: []string{"artist", "circle", "event", "parody", "language", "group"}},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "links", Type: field.TypeJSON, Nullable: true},
 	}
 	// TagsTable holds the schema information for the "tags" table.
 	TagsTable = &schema.Table{
 		Name:       "tags",
 		Columns:    TagsColumns,
 		PrimaryKey: []*schema.Column{TagsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tag_normalized_name",
+				Unique:  false,
+				Columns: []*schema.Column{TagsColumns[7]},
+			},
+		},
+	}
+	// TagAliasColumns holds the columns for the "tag_alias" table.
+	TagAliasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "normalized_name", Type: field.TypeString, Nullable: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "tag_aliases", Type: field.TypeInt},
+	}
+	// TagAliasTable holds the schema information for the "tag_alias" table.
+	TagAliasTable = &schema.Table{
+		Name:       "tag_alias",
+		Columns:    TagAliasColumns,
+		PrimaryKey: []*schema.Column{TagAliasColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_alias_tags_aliases",
+				Columns:    []*schema.Column{TagAliasColumns[4]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tagalias_normalized_name",
+				Unique:  false,
+				Columns: []*schema.Column{TagAliasColumns[2]},
+			},
+		},
 	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "email", Type: field.TypeString, Unique: true},
 		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "password_hash", Type: field.TypeString, Nullable: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"guest", "reader", "editor", "admin"}, Default: "reader"},
+		{Name: "max_age_rating", Type: field.TypeInt, Nullable: true},
+		{Name: "allowed_paths", Type: field.TypeJSON, Nullable: true},
+		{Name: "preferences", Type: field.TypeJSON, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -119,26 +296,26 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
-	// MetaTagsColumns holds the columns for the "meta_tags" table.
-	MetaTagsColumns = []*schema.Column{
+	// MetaExcludedTagsColumns holds the columns for the "meta_excluded_tags" table.
+	MetaExcludedTagsColumns = []*schema.Column{
 		{Name: "meta_id", Type: field.TypeInt},
 		{Name: "tag_id", Type: field.TypeInt},
 	}
-	// MetaTagsTable holds the schema information for the "meta_tags" table.
-	MetaTagsTable = &schema.Table{
-		Name:       "meta_tags",
-		Columns:    MetaTagsColumns,
-		PrimaryKey: []*schema.Column{MetaTagsColumns[0], MetaTagsColumns[1]},
+	// MetaExcludedTagsTable holds the schema information for the "meta_excluded_tags" table.
+	MetaExcludedTagsTable = &schema.Table{
+		Name:       "meta_excluded_tags",
+		Columns:    MetaExcludedTagsColumns,
+		PrimaryKey: []*schema.Column{MetaExcludedTagsColumns[0], MetaExcludedTagsColumns[1]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "meta_tags_meta_id",
-				Columns:    []*schema.Column{MetaTagsColumns[0]},
+				Symbol:     "meta_excluded_tags_meta_id",
+				Columns:    []*schema.Column{MetaExcludedTagsColumns[0]},
 				RefColumns: []*schema.Column{MetaColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
-				Symbol:     "meta_tags_tag_id",
-				Columns:    []*schema.Column{MetaTagsColumns[1]},
+				Symbol:     "meta_excluded_tags_tag_id",
+				Columns:    []*schema.Column{MetaExcludedTagsColumns[1]},
 				RefColumns: []*schema.Column{TagsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -194,28 +371,123 @@ var (
 			},
 		},
 	}
+	// UserBlockedItemsColumns holds the columns for the "user_blocked_items" table.
+	UserBlockedItemsColumns = []*schema.Column{
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "meta_id", Type: field.TypeInt},
+	}
+	// UserBlockedItemsTable holds the schema information for the "user_blocked_items" table.
+	UserBlockedItemsTable = &schema.Table{
+		Name:       "user_blocked_items",
+		Columns:    UserBlockedItemsColumns,
+		PrimaryKey: []*schema.Column{UserBlockedItemsColumns[0], UserBlockedItemsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_blocked_items_user_id",
+				Columns:    []*schema.Column{UserBlockedItemsColumns[0]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "user_blocked_items_meta_id",
+				Columns:    []*schema.Column{UserBlockedItemsColumns[1]},
+				RefColumns: []*schema.Column{MetaColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// UserBlockedTagsColumns holds the columns for the "user_blocked_tags" table.
+	UserBlockedTagsColumns = []*schema.Column{
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "tag_id", Type: field.TypeInt},
+	}
+	// UserBlockedTagsTable holds the schema information for the "user_blocked_tags" table.
+	UserBlockedTagsTable = &schema.Table{
+		Name:       "user_blocked_tags",
+		Columns:    UserBlockedTagsColumns,
+		PrimaryKey: []*schema.Column{UserBlockedTagsColumns[0], UserBlockedTagsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_blocked_tags_user_id",
+				Columns:    []*schema.Column{UserBlockedTagsColumns[0]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "user_blocked_tags_tag_id",
+				Columns:    []*schema.Column{UserBlockedTagsColumns[1]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// UserDeniedTagsColumns holds the columns for the "user_denied_tags" table.
+	UserDeniedTagsColumns = []*schema.Column{
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "tag_id", Type: field.TypeInt},
+	}
+	// UserDeniedTagsTable holds the schema information for the "user_denied_tags" table.
+	UserDeniedTagsTable = &schema.Table{
+		Name:       "user_denied_tags",
+		Columns:    UserDeniedTagsColumns,
+		PrimaryKey: []*schema.Column{UserDeniedTagsColumns[0], UserDeniedTagsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_denied_tags_user_id",
+				Columns:    []*schema.Column{UserDeniedTagsColumns[0]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "user_denied_tags_tag_id",
+				Columns:    []*schema.Column{UserDeniedTagsColumns[1]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
 		HistoriesTable,
 		MetaTable,
+		MetaTagsTable,
 		ProgressesTable,
+		SavedSearchesTable,
+		SessionsTable,
 		TagsTable,
+		TagAliasTable,
 		UsersTable,
-		MetaTagsTable,
+		MetaExcludedTagsTable,
 		UserFavoriteItemsTable,
 		UserFavoriteTagsTable,
+		UserBlockedItemsTable,
+		UserBlockedTagsTable,
+		UserDeniedTagsTable,
 	}
 )
 
 func init() {
+	APIKeysTable.ForeignKeys[0].RefTable = UsersTable
 	HistoriesTable.ForeignKeys[0].RefTable = MetaTable
 	HistoriesTable.ForeignKeys[1].RefTable = UsersTable
-	ProgressesTable.ForeignKeys[0].RefTable = MetaTable
-	ProgressesTable.ForeignKeys[1].RefTable = UsersTable
 	MetaTagsTable.ForeignKeys[0].RefTable = MetaTable
 	MetaTagsTable.ForeignKeys[1].RefTable = TagsTable
+	ProgressesTable.ForeignKeys[0].RefTable = MetaTable
+	ProgressesTable.ForeignKeys[1].RefTable = UsersTable
+	SavedSearchesTable.ForeignKeys[0].RefTable = UsersTable
+	SessionsTable.ForeignKeys[0].RefTable = UsersTable
+	TagAliasTable.ForeignKeys[0].RefTable = TagsTable
+	MetaExcludedTagsTable.ForeignKeys[0].RefTable = MetaTable
+	MetaExcludedTagsTable.ForeignKeys[1].RefTable = TagsTable
 	UserFavoriteItemsTable.ForeignKeys[0].RefTable = UsersTable
 	UserFavoriteItemsTable.ForeignKeys[1].RefTable = MetaTable
 	UserFavoriteTagsTable.ForeignKeys[0].RefTable = UsersTable
 	UserFavoriteTagsTable.ForeignKeys[1].RefTable = TagsTable
+	UserBlockedItemsTable.ForeignKeys[0].RefTable = UsersTable
+	UserBlockedItemsTable.ForeignKeys[1].RefTable = MetaTable
+	UserBlockedTagsTable.ForeignKeys[0].RefTable = UsersTable
+	UserBlockedTagsTable.ForeignKeys[1].RefTable = TagsTable
+	UserDeniedTagsTable.ForeignKeys[0].RefTable = UsersTable
+	UserDeniedTagsTable.ForeignKeys[1].RefTable = TagsTable
 }
@@ -11,12 +11,18 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/mangaweb4/mangaweb4-backend/ent/apikey"
 	"github.com/mangaweb4/mangaweb4-backend/ent/history"
 	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/mangaweb4/mangaweb4-backend/ent/metatag"
 	"github.com/mangaweb4/mangaweb4-backend/ent/predicate"
 	"github.com/mangaweb4/mangaweb4-backend/ent/progress"
+	"github.com/mangaweb4/mangaweb4-backend/ent/savedsearch"
+	"github.com/mangaweb4/mangaweb4-backend/ent/session"
 	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tagalias"
 	"github.com/mangaweb4/mangaweb4-backend/ent/user"
+	"github.com/mangaweb4/mangaweb4-backend/preference"
 )
 
 const (
@@ -28,41 +34,51 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeHistory  = "History"
-	TypeMeta     = "Meta"
-	TypeProgress = "Progress"
-	TypeTag      = "Tag"
-	TypeUser     = "User"
+	TypeAPIKey      = "APIKey"
+	TypeHistory     = "History"
+	TypeMeta        = "Meta"
+	TypeMetaTag     = "MetaTag"
+	TypeProgress    = "Progress"
+	TypeSavedSearch = "SavedSearch"
+	TypeSession     = "Session"
+	TypeTag         = "Tag"
+	TypeTagAlias    = "TagAlias"
+	TypeUser        = "User"
 )
 
-// HistoryMutation represents an operation that mutates the History nodes in the graph.
-type HistoryMutation struct {
+// APIKeyMutation represents an operation that mutates the APIKey nodes in the graph.
+type APIKeyMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	create_time   *time.Time
-	clearedFields map[string]struct{}
-	item          *int
-	cleareditem   bool
-	user          *int
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*History, error)
-	predicates    []predicate.History
-}
-
-var _ ent.Mutation = (*HistoryMutation)(nil)
-
-// historyOption allows management of the mutation configuration using functional options.
-type historyOption func(*HistoryMutation)
-
-// newHistoryMutation creates new mutation for the History entity.
-func newHistoryMutation(c config, op Op, opts ...historyOption) *HistoryMutation {
-	m := &HistoryMutation{
+	op             Op
+	typ            string
+	id             *int
+	name           *string
+	key_hash       *string
+	prefix         *string
+	scopes         *[]string
+	appendscopes   []string
+	create_time    *time.Time
+	expire_time    *time.Time
+	last_used_time *time.Time
+	clearedFields  map[string]struct{}
+	user           *int
+	cleareduser    bool
+	done           bool
+	oldValue       func(context.Context) (*APIKey, error)
+	predicates     []predicate.APIKey
+}
+
+var _ ent.Mutation = (*APIKeyMutation)(nil)
+
+// apikeyOption allows management of the mutation configuration using functional options.
+type apikeyOption func(*APIKeyMutation)
+
+// newAPIKeyMutation creates new mutation for the APIKey entity.
+func newAPIKeyMutation(c config, op Op, opts ...apikeyOption) *APIKeyMutation {
+	m := &APIKeyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeHistory,
+		typ:           TypeAPIKey,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -71,20 +87,20 @@ func newHistoryMutation(c config, op Op, opts ...historyOption) *HistoryMutation
 	return m
 }
 
-// withHistoryID sets the ID field of the mutation.
-func withHistoryID(id int) historyOption {
-	return func(m *HistoryMutation) {
+// withAPIKeyID sets the ID field of the mutation.
+func withAPIKeyID(id int) apikeyOption {
+	return func(m *APIKeyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *History
+			value *APIKey
 		)
-		m.oldValue = func(ctx context.Context) (*History, error) {
+		m.oldValue = func(ctx context.Context) (*APIKey, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().History.Get(ctx, id)
+					value, err = m.Client().APIKey.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -93,10 +109,10 @@ func withHistoryID(id int) historyOption {
 	}
 }
 
-// withHistory sets the old History of the mutation.
-func withHistory(node *History) historyOption {
-	return func(m *HistoryMutation) {
-		m.oldValue = func(context.Context) (*History, error) {
+// withAPIKey sets the old APIKey of the mutation.
+func withAPIKey(node *APIKey) apikeyOption {
+	return func(m *APIKeyMutation) {
+		m.oldValue = func(context.Context) (*APIKey, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -105,7 +121,7 @@ func withHistory(node *History) historyOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m HistoryMutation) Client() *Client {
+func (m APIKeyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -113,7 +129,7 @@ func (m HistoryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m HistoryMutation) Tx() (*Tx, error) {
+func (m APIKeyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -124,7 +140,7 @@ func (m HistoryMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *HistoryMutation) ID() (id int, exists bool) {
+func (m *APIKeyMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -135,7 +151,7 @@ func (m *HistoryMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *HistoryMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *APIKeyMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -144,19 +160,178 @@ func (m *HistoryMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().History.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().APIKey.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
+// SetName sets the "name" field.
+func (m *APIKeyMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *APIKeyMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *APIKeyMutation) ResetName() {
+	m.name = nil
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (m *APIKeyMutation) SetKeyHash(s string) {
+	m.key_hash = &s
+}
+
+// KeyHash returns the value of the "key_hash" field in the mutation.
+func (m *APIKeyMutation) KeyHash() (r string, exists bool) {
+	v := m.key_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyHash returns the old "key_hash" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldKeyHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyHash: %w", err)
+	}
+	return oldValue.KeyHash, nil
+}
+
+// ResetKeyHash resets all changes to the "key_hash" field.
+func (m *APIKeyMutation) ResetKeyHash() {
+	m.key_hash = nil
+}
+
+// SetPrefix sets the "prefix" field.
+func (m *APIKeyMutation) SetPrefix(s string) {
+	m.prefix = &s
+}
+
+// Prefix returns the value of the "prefix" field in the mutation.
+func (m *APIKeyMutation) Prefix() (r string, exists bool) {
+	v := m.prefix
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrefix returns the old "prefix" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldPrefix(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrefix is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrefix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
+	}
+	return oldValue.Prefix, nil
+}
+
+// ResetPrefix resets all changes to the "prefix" field.
+func (m *APIKeyMutation) ResetPrefix() {
+	m.prefix = nil
+}
+
+// SetScopes sets the "scopes" field.
+func (m *APIKeyMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *APIKeyMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopes returns the old "scopes" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
+}
+
+// AppendScopes adds s to the "scopes" field.
+func (m *APIKeyMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
+}
+
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *APIKeyMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *APIKeyMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+}
+
 // SetCreateTime sets the "create_time" field.
-func (m *HistoryMutation) SetCreateTime(t time.Time) {
+func (m *APIKeyMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *HistoryMutation) CreateTime() (r time.Time, exists bool) {
+func (m *APIKeyMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -164,10 +339,10 @@ func (m *HistoryMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the History entity.
-// If the History object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoryMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *APIKeyMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -182,66 +357,125 @@ func (m *HistoryMutation) OldCreateTime(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *HistoryMutation) ResetCreateTime() {
+func (m *APIKeyMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
-// SetItemID sets the "item" edge to the Meta entity by id.
-func (m *HistoryMutation) SetItemID(id int) {
-	m.item = &id
+// SetExpireTime sets the "expire_time" field.
+func (m *APIKeyMutation) SetExpireTime(t time.Time) {
+	m.expire_time = &t
 }
 
-// ClearItem clears the "item" edge to the Meta entity.
-func (m *HistoryMutation) ClearItem() {
-	m.cleareditem = true
+// ExpireTime returns the value of the "expire_time" field in the mutation.
+func (m *APIKeyMutation) ExpireTime() (r time.Time, exists bool) {
+	v := m.expire_time
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ItemCleared reports if the "item" edge to the Meta entity was cleared.
-func (m *HistoryMutation) ItemCleared() bool {
-	return m.cleareditem
+// OldExpireTime returns the old "expire_time" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldExpireTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpireTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpireTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpireTime: %w", err)
+	}
+	return oldValue.ExpireTime, nil
 }
 
-// ItemID returns the "item" edge ID in the mutation.
-func (m *HistoryMutation) ItemID() (id int, exists bool) {
-	if m.item != nil {
-		return *m.item, true
+// ClearExpireTime clears the value of the "expire_time" field.
+func (m *APIKeyMutation) ClearExpireTime() {
+	m.expire_time = nil
+	m.clearedFields[apikey.FieldExpireTime] = struct{}{}
+}
+
+// ExpireTimeCleared returns if the "expire_time" field was cleared in this mutation.
+func (m *APIKeyMutation) ExpireTimeCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldExpireTime]
+	return ok
+}
+
+// ResetExpireTime resets all changes to the "expire_time" field.
+func (m *APIKeyMutation) ResetExpireTime() {
+	m.expire_time = nil
+	delete(m.clearedFields, apikey.FieldExpireTime)
+}
+
+// SetLastUsedTime sets the "last_used_time" field.
+func (m *APIKeyMutation) SetLastUsedTime(t time.Time) {
+	m.last_used_time = &t
+}
+
+// LastUsedTime returns the value of the "last_used_time" field in the mutation.
+func (m *APIKeyMutation) LastUsedTime() (r time.Time, exists bool) {
+	v := m.last_used_time
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ItemIDs returns the "item" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ItemID instead. It exists only for internal usage by the builders.
-func (m *HistoryMutation) ItemIDs() (ids []int) {
-	if id := m.item; id != nil {
-		ids = append(ids, *id)
+// OldLastUsedTime returns the old "last_used_time" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldLastUsedTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedTime is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedTime: %w", err)
+	}
+	return oldValue.LastUsedTime, nil
 }
 
-// ResetItem resets all changes to the "item" edge.
-func (m *HistoryMutation) ResetItem() {
-	m.item = nil
-	m.cleareditem = false
+// ClearLastUsedTime clears the value of the "last_used_time" field.
+func (m *APIKeyMutation) ClearLastUsedTime() {
+	m.last_used_time = nil
+	m.clearedFields[apikey.FieldLastUsedTime] = struct{}{}
+}
+
+// LastUsedTimeCleared returns if the "last_used_time" field was cleared in this mutation.
+func (m *APIKeyMutation) LastUsedTimeCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldLastUsedTime]
+	return ok
+}
+
+// ResetLastUsedTime resets all changes to the "last_used_time" field.
+func (m *APIKeyMutation) ResetLastUsedTime() {
+	m.last_used_time = nil
+	delete(m.clearedFields, apikey.FieldLastUsedTime)
 }
 
 // SetUserID sets the "user" edge to the User entity by id.
-func (m *HistoryMutation) SetUserID(id int) {
+func (m *APIKeyMutation) SetUserID(id int) {
 	m.user = &id
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *HistoryMutation) ClearUser() {
+func (m *APIKeyMutation) ClearUser() {
 	m.cleareduser = true
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *HistoryMutation) UserCleared() bool {
+func (m *APIKeyMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
 // UserID returns the "user" edge ID in the mutation.
-func (m *HistoryMutation) UserID() (id int, exists bool) {
+func (m *APIKeyMutation) UserID() (id int, exists bool) {
 	if m.user != nil {
 		return *m.user, true
 	}
@@ -251,7 +485,7 @@ func (m *HistoryMutation) UserID() (id int, exists bool) {
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *HistoryMutation) UserIDs() (ids []int) {
+func (m *APIKeyMutation) UserIDs() (ids []int) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -259,20 +493,20 @@ func (m *HistoryMutation) UserIDs() (ids []int) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *HistoryMutation) ResetUser() {
+func (m *APIKeyMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// Where appends a list predicates to the HistoryMutation builder.
-func (m *HistoryMutation) Where(ps ...predicate.History) {
+// Where appends a list predicates to the APIKeyMutation builder.
+func (m *APIKeyMutation) Where(ps ...predicate.APIKey) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the HistoryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the APIKeyMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *HistoryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.History, len(ps))
+func (m *APIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.APIKey, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -280,27 +514,45 @@ func (m *HistoryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *HistoryMutation) Op() Op {
+func (m *APIKeyMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *HistoryMutation) SetOp(op Op) {
+func (m *APIKeyMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (History).
-func (m *HistoryMutation) Type() string {
+// Type returns the node type of this mutation (APIKey).
+func (m *APIKeyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *HistoryMutation) Fields() []string {
-	fields := make([]string, 0, 1)
+func (m *APIKeyMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.name != nil {
+		fields = append(fields, apikey.FieldName)
+	}
+	if m.key_hash != nil {
+		fields = append(fields, apikey.FieldKeyHash)
+	}
+	if m.prefix != nil {
+		fields = append(fields, apikey.FieldPrefix)
+	}
+	if m.scopes != nil {
+		fields = append(fields, apikey.FieldScopes)
+	}
 	if m.create_time != nil {
-		fields = append(fields, history.FieldCreateTime)
+		fields = append(fields, apikey.FieldCreateTime)
+	}
+	if m.expire_time != nil {
+		fields = append(fields, apikey.FieldExpireTime)
+	}
+	if m.last_used_time != nil {
+		fields = append(fields, apikey.FieldLastUsedTime)
 	}
 	return fields
 }
@@ -308,10 +560,22 @@ func (m *HistoryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *HistoryMutation) Field(name string) (ent.Value, bool) {
+func (m *APIKeyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case history.FieldCreateTime:
+	case apikey.FieldName:
+		return m.Name()
+	case apikey.FieldKeyHash:
+		return m.KeyHash()
+	case apikey.FieldPrefix:
+		return m.Prefix()
+	case apikey.FieldScopes:
+		return m.Scopes()
+	case apikey.FieldCreateTime:
 		return m.CreateTime()
+	case apikey.FieldExpireTime:
+		return m.ExpireTime()
+	case apikey.FieldLastUsedTime:
+		return m.LastUsedTime()
 	}
 	return nil, false
 }
@@ -319,103 +583,183 @@ func (m *HistoryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *HistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *APIKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case history.FieldCreateTime:
+	case apikey.FieldName:
+		return m.OldName(ctx)
+	case apikey.FieldKeyHash:
+		return m.OldKeyHash(ctx)
+	case apikey.FieldPrefix:
+		return m.OldPrefix(ctx)
+	case apikey.FieldScopes:
+		return m.OldScopes(ctx)
+	case apikey.FieldCreateTime:
 		return m.OldCreateTime(ctx)
+	case apikey.FieldExpireTime:
+		return m.OldExpireTime(ctx)
+	case apikey.FieldLastUsedTime:
+		return m.OldLastUsedTime(ctx)
 	}
-	return nil, fmt.Errorf("unknown History field %s", name)
+	return nil, fmt.Errorf("unknown APIKey field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HistoryMutation) SetField(name string, value ent.Value) error {
+func (m *APIKeyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case history.FieldCreateTime:
-		v, ok := value.(time.Time)
+	case apikey.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreateTime(v)
+		m.SetName(v)
 		return nil
-	}
-	return fmt.Errorf("unknown History field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *HistoryMutation) AddedFields() []string {
-	return nil
-}
-
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *HistoryMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+	case apikey.FieldKeyHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyHash(v)
+		return nil
+	case apikey.FieldPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrefix(v)
+		return nil
+	case apikey.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case apikey.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case apikey.FieldExpireTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpireTime(v)
+		return nil
+	case apikey.FieldLastUsedTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown APIKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *APIKeyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *APIKeyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HistoryMutation) AddField(name string, value ent.Value) error {
+func (m *APIKeyMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown History numeric field %s", name)
+	return fmt.Errorf("unknown APIKey numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *HistoryMutation) ClearedFields() []string {
-	return nil
+func (m *APIKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(apikey.FieldExpireTime) {
+		fields = append(fields, apikey.FieldExpireTime)
+	}
+	if m.FieldCleared(apikey.FieldLastUsedTime) {
+		fields = append(fields, apikey.FieldLastUsedTime)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *HistoryMutation) FieldCleared(name string) bool {
+func (m *APIKeyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *HistoryMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown History nullable field %s", name)
+func (m *APIKeyMutation) ClearField(name string) error {
+	switch name {
+	case apikey.FieldExpireTime:
+		m.ClearExpireTime()
+		return nil
+	case apikey.FieldLastUsedTime:
+		m.ClearLastUsedTime()
+		return nil
+	}
+	return fmt.Errorf("unknown APIKey nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *HistoryMutation) ResetField(name string) error {
+func (m *APIKeyMutation) ResetField(name string) error {
 	switch name {
-	case history.FieldCreateTime:
+	case apikey.FieldName:
+		m.ResetName()
+		return nil
+	case apikey.FieldKeyHash:
+		m.ResetKeyHash()
+		return nil
+	case apikey.FieldPrefix:
+		m.ResetPrefix()
+		return nil
+	case apikey.FieldScopes:
+		m.ResetScopes()
+		return nil
+	case apikey.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
+	case apikey.FieldExpireTime:
+		m.ResetExpireTime()
+		return nil
+	case apikey.FieldLastUsedTime:
+		m.ResetLastUsedTime()
+		return nil
 	}
-	return fmt.Errorf("unknown History field %s", name)
+	return fmt.Errorf("unknown APIKey field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *HistoryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.item != nil {
-		edges = append(edges, history.EdgeItem)
-	}
+func (m *APIKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.user != nil {
-		edges = append(edges, history.EdgeUser)
+		edges = append(edges, apikey.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *HistoryMutation) AddedIDs(name string) []ent.Value {
+func (m *APIKeyMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case history.EdgeItem:
-		if id := m.item; id != nil {
-			return []ent.Value{*id}
-		}
-	case history.EdgeUser:
+	case apikey.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
@@ -424,36 +768,31 @@ func (m *HistoryMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *HistoryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *APIKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *HistoryMutation) RemovedIDs(name string) []ent.Value {
+func (m *APIKeyMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *HistoryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareditem {
-		edges = append(edges, history.EdgeItem)
-	}
+func (m *APIKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.cleareduser {
-		edges = append(edges, history.EdgeUser)
+		edges = append(edges, apikey.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *HistoryMutation) EdgeCleared(name string) bool {
+func (m *APIKeyMutation) EdgeCleared(name string) bool {
 	switch name {
-	case history.EdgeItem:
-		return m.cleareditem
-	case history.EdgeUser:
+	case apikey.EdgeUser:
 		return m.cleareduser
 	}
 	return false
@@ -461,86 +800,54 @@ func (m *HistoryMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *HistoryMutation) ClearEdge(name string) error {
+func (m *APIKeyMutation) ClearEdge(name string) error {
 	switch name {
-	case history.EdgeItem:
-		m.ClearItem()
-		return nil
-	case history.EdgeUser:
+	case apikey.EdgeUser:
 		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown History unique edge %s", name)
+	return fmt.Errorf("unknown APIKey unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *HistoryMutation) ResetEdge(name string) error {
+func (m *APIKeyMutation) ResetEdge(name string) error {
 	switch name {
-	case history.EdgeItem:
-		m.ResetItem()
-		return nil
-	case history.EdgeUser:
+	case apikey.EdgeUser:
 		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown History edge %s", name)
+	return fmt.Errorf("unknown APIKey edge %s", name)
 }
 
-// MetaMutation represents an operation that mutates the Meta nodes in the graph.
-type MetaMutation struct {
+// HistoryMutation represents an operation that mutates the History nodes in the graph.
+type HistoryMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *int
-	name                    *string
-	create_time             *time.Time
-	favorite                *bool
-	file_indices            *[]int
-	appendfile_indices      []int
-	read                    *bool
-	active                  *bool
-	hidden                  *bool
-	container_type          *meta.ContainerType
-	thumbnail_index         *int
-	addthumbnail_index      *int
-	thumbnail_x             *int
-	addthumbnail_x          *int
-	thumbnail_y             *int
-	addthumbnail_y          *int
-	thumbnail_width         *int
-	addthumbnail_width      *int
-	thumbnail_height        *int
-	addthumbnail_height     *int
-	clearedFields           map[string]struct{}
-	tags                    map[int]struct{}
-	removedtags             map[int]struct{}
-	clearedtags             bool
-	histories               map[int]struct{}
-	removedhistories        map[int]struct{}
-	clearedhistories        bool
-	favorite_of_user        map[int]struct{}
-	removedfavorite_of_user map[int]struct{}
-	clearedfavorite_of_user bool
-	progress                map[int]struct{}
-	removedprogress         map[int]struct{}
-	clearedprogress         bool
-	done                    bool
-	oldValue                func(context.Context) (*Meta, error)
-	predicates              []predicate.Meta
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	clearedFields map[string]struct{}
+	item          *int
+	cleareditem   bool
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*History, error)
+	predicates    []predicate.History
 }
 
-var _ ent.Mutation = (*MetaMutation)(nil)
+var _ ent.Mutation = (*HistoryMutation)(nil)
 
-// metaOption allows management of the mutation configuration using functional options.
-type metaOption func(*MetaMutation)
+// historyOption allows management of the mutation configuration using functional options.
+type historyOption func(*HistoryMutation)
 
-// newMetaMutation creates new mutation for the Meta entity.
-func newMetaMutation(c config, op Op, opts ...metaOption) *MetaMutation {
-	m := &MetaMutation{
+// newHistoryMutation creates new mutation for the History entity.
+func newHistoryMutation(c config, op Op, opts ...historyOption) *HistoryMutation {
+	m := &HistoryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMeta,
+		typ:           TypeHistory,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -549,20 +856,20 @@ func newMetaMutation(c config, op Op, opts ...metaOption) *MetaMutation {
 	return m
 }
 
-// withMetaID sets the ID field of the mutation.
-func withMetaID(id int) metaOption {
-	return func(m *MetaMutation) {
+// withHistoryID sets the ID field of the mutation.
+func withHistoryID(id int) historyOption {
+	return func(m *HistoryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Meta
+			value *History
 		)
-		m.oldValue = func(ctx context.Context) (*Meta, error) {
+		m.oldValue = func(ctx context.Context) (*History, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Meta.Get(ctx, id)
+					value, err = m.Client().History.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -571,10 +878,10 @@ func withMetaID(id int) metaOption {
 	}
 }
 
-// withMeta sets the old Meta of the mutation.
-func withMeta(node *Meta) metaOption {
-	return func(m *MetaMutation) {
-		m.oldValue = func(context.Context) (*Meta, error) {
+// withHistory sets the old History of the mutation.
+func withHistory(node *History) historyOption {
+	return func(m *HistoryMutation) {
+		m.oldValue = func(context.Context) (*History, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -583,7 +890,7 @@ func withMeta(node *Meta) metaOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MetaMutation) Client() *Client {
+func (m HistoryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -591,7 +898,7 @@ func (m MetaMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MetaMutation) Tx() (*Tx, error) {
+func (m HistoryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -602,7 +909,7 @@ func (m MetaMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MetaMutation) ID() (id int, exists bool) {
+func (m *HistoryMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -613,7 +920,7 @@ func (m *MetaMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MetaMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *HistoryMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -622,55 +929,19 @@ func (m *MetaMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Meta.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().History.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *MetaMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *MetaMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the Meta entity.
-// If the Meta object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetaMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *MetaMutation) ResetName() {
-	m.name = nil
-}
-
 // SetCreateTime sets the "create_time" field.
-func (m *MetaMutation) SetCreateTime(t time.Time) {
+func (m *HistoryMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *MetaMutation) CreateTime() (r time.Time, exists bool) {
+func (m *HistoryMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -678,10 +949,10 @@ func (m *MetaMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Meta entity.
-// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the History entity.
+// If the History object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetaMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *HistoryMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -696,701 +967,6550 @@ func (m *MetaMutation) OldCreateTime(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *MetaMutation) ResetCreateTime() {
+func (m *HistoryMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
-// SetFavorite sets the "favorite" field.
-func (m *MetaMutation) SetFavorite(b bool) {
-	m.favorite = &b
+// SetItemID sets the "item" edge to the Meta entity by id.
+func (m *HistoryMutation) SetItemID(id int) {
+	m.item = &id
 }
 
-// Favorite returns the value of the "favorite" field in the mutation.
-func (m *MetaMutation) Favorite() (r bool, exists bool) {
-	v := m.favorite
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldFavorite returns the old "favorite" field's value of the Meta entity.
-// If the Meta object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetaMutation) OldFavorite(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFavorite is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFavorite requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFavorite: %w", err)
-	}
-	return oldValue.Favorite, nil
-}
-
-// ResetFavorite resets all changes to the "favorite" field.
-func (m *MetaMutation) ResetFavorite() {
-	m.favorite = nil
+// ClearItem clears the "item" edge to the Meta entity.
+func (m *HistoryMutation) ClearItem() {
+	m.cleareditem = true
 }
 
-// SetFileIndices sets the "file_indices" field.
-func (m *MetaMutation) SetFileIndices(i []int) {
-	m.file_indices = &i
-	m.appendfile_indices = nil
+// ItemCleared reports if the "item" edge to the Meta entity was cleared.
+func (m *HistoryMutation) ItemCleared() bool {
+	return m.cleareditem
 }
 
-// FileIndices returns the value of the "file_indices" field in the mutation.
-func (m *MetaMutation) FileIndices() (r []int, exists bool) {
-	v := m.file_indices
-	if v == nil {
-		return
+// ItemID returns the "item" edge ID in the mutation.
+func (m *HistoryMutation) ItemID() (id int, exists bool) {
+	if m.item != nil {
+		return *m.item, true
 	}
-	return *v, true
+	return
 }
 
-// OldFileIndices returns the old "file_indices" field's value of the Meta entity.
-// If the Meta object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetaMutation) OldFileIndices(ctx context.Context) (v []int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileIndices is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileIndices requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileIndices: %w", err)
+// ItemIDs returns the "item" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *HistoryMutation) ItemIDs() (ids []int) {
+	if id := m.item; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.FileIndices, nil
+	return
 }
 
-// AppendFileIndices adds i to the "file_indices" field.
-func (m *MetaMutation) AppendFileIndices(i []int) {
-	m.appendfile_indices = append(m.appendfile_indices, i...)
+// ResetItem resets all changes to the "item" edge.
+func (m *HistoryMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
 }
 
-// AppendedFileIndices returns the list of values that were appended to the "file_indices" field in this mutation.
-func (m *MetaMutation) AppendedFileIndices() ([]int, bool) {
-	if len(m.appendfile_indices) == 0 {
-		return nil, false
-	}
-	return m.appendfile_indices, true
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *HistoryMutation) SetUserID(id int) {
+	m.user = &id
 }
 
-// ResetFileIndices resets all changes to the "file_indices" field.
-func (m *MetaMutation) ResetFileIndices() {
-	m.file_indices = nil
-	m.appendfile_indices = nil
+// ClearUser clears the "user" edge to the User entity.
+func (m *HistoryMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// SetRead sets the "read" field.
-func (m *MetaMutation) SetRead(b bool) {
-	m.read = &b
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *HistoryMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// Read returns the value of the "read" field in the mutation.
-func (m *MetaMutation) Read() (r bool, exists bool) {
-	v := m.read
-	if v == nil {
-		return
+// UserID returns the "user" edge ID in the mutation.
+func (m *HistoryMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
-	return *v, true
+	return
 }
 
-// OldRead returns the old "read" field's value of the Meta entity.
-// If the Meta object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetaMutation) OldRead(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRead is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRead requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRead: %w", err)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *HistoryMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.Read, nil
+	return
 }
 
-// ResetRead resets all changes to the "read" field.
-func (m *MetaMutation) ResetRead() {
-	m.read = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *HistoryMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// SetActive sets the "active" field.
-func (m *MetaMutation) SetActive(b bool) {
-	m.active = &b
+// Where appends a list predicates to the HistoryMutation builder.
+func (m *HistoryMutation) Where(ps ...predicate.History) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// Active returns the value of the "active" field in the mutation.
-func (m *MetaMutation) Active() (r bool, exists bool) {
-	v := m.active
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the HistoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.History, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldActive returns the old "active" field's value of the Meta entity.
-// If the Meta object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetaMutation) OldActive(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActive is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActive requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActive: %w", err)
-	}
-	return oldValue.Active, nil
+// Op returns the operation name.
+func (m *HistoryMutation) Op() Op {
+	return m.op
 }
 
-// ResetActive resets all changes to the "active" field.
-func (m *MetaMutation) ResetActive() {
-	m.active = nil
+// SetOp allows setting the mutation operation.
+func (m *HistoryMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetHidden sets the "hidden" field.
-func (m *MetaMutation) SetHidden(b bool) {
-	m.hidden = &b
+// Type returns the node type of this mutation (History).
+func (m *HistoryMutation) Type() string {
+	return m.typ
 }
 
-// Hidden returns the value of the "hidden" field in the mutation.
-func (m *MetaMutation) Hidden() (r bool, exists bool) {
-	v := m.hidden
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HistoryMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.create_time != nil {
+		fields = append(fields, history.FieldCreateTime)
 	}
-	return *v, true
+	return fields
 }
 
-// OldHidden returns the old "hidden" field's value of the Meta entity.
-// If the Meta object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetaMutation) OldHidden(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHidden is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHidden requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHidden: %w", err)
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HistoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case history.FieldCreateTime:
+		return m.CreateTime()
 	}
-	return oldValue.Hidden, nil
+	return nil, false
 }
 
-// ResetHidden resets all changes to the "hidden" field.
-func (m *MetaMutation) ResetHidden() {
-	m.hidden = nil
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case history.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown History field %s", name)
 }
 
-// SetContainerType sets the "container_type" field.
-func (m *MetaMutation) SetContainerType(mt meta.ContainerType) {
-	m.container_type = &mt
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HistoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case history.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown History field %s", name)
 }
 
-// ContainerType returns the value of the "container_type" field in the mutation.
-func (m *MetaMutation) ContainerType() (r meta.ContainerType, exists bool) {
-	v := m.container_type
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HistoryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HistoryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HistoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown History numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HistoryMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HistoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HistoryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown History nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HistoryMutation) ResetField(name string) error {
+	switch name {
+	case history.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	}
+	return fmt.Errorf("unknown History field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.item != nil {
+		edges = append(edges, history.EdgeItem)
+	}
+	if m.user != nil {
+		edges = append(edges, history.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HistoryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case history.EdgeItem:
+		if id := m.item; id != nil {
+			return []ent.Value{*id}
+		}
+	case history.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HistoryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareditem {
+		edges = append(edges, history.EdgeItem)
+	}
+	if m.cleareduser {
+		edges = append(edges, history.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HistoryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case history.EdgeItem:
+		return m.cleareditem
+	case history.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HistoryMutation) ClearEdge(name string) error {
+	switch name {
+	case history.EdgeItem:
+		m.ClearItem()
+		return nil
+	case history.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown History unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HistoryMutation) ResetEdge(name string) error {
+	switch name {
+	case history.EdgeItem:
+		m.ResetItem()
+		return nil
+	case history.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown History edge %s", name)
+}
+
+// MetaMutation represents an operation that mutates the Meta nodes in the graph.
+type MetaMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	name                    *string
+	search_key              *string
+	sort_name               *string
+	create_time             *time.Time
+	favorite                *bool
+	file_indices            *[]int
+	appendfile_indices      []int
+	pages_update_time       *time.Time
+	read                    *bool
+	active                  *bool
+	hidden                  *bool
+	container_type          *meta.ContainerType
+	thumbnail_index         *int
+	addthumbnail_index      *int
+	thumbnail_x             *int
+	addthumbnail_x          *int
+	thumbnail_y             *int
+	addthumbnail_y          *int
+	thumbnail_width         *int
+	addthumbnail_width      *int
+	thumbnail_height        *int
+	addthumbnail_height     *int
+	series                  *string
+	volume                  *float64
+	addvolume               *float64
+	chapter                 *float64
+	addchapter              *float64
+	artist                  *string
+	year                    *int
+	addyear                 *int
+	age_rating              *int
+	addage_rating           *int
+	clearedFields           map[string]struct{}
+	tags                    map[int]struct{}
+	removedtags             map[int]struct{}
+	clearedtags             bool
+	excluded_tags           map[int]struct{}
+	removedexcluded_tags    map[int]struct{}
+	clearedexcluded_tags    bool
+	histories               map[int]struct{}
+	removedhistories        map[int]struct{}
+	clearedhistories        bool
+	favorite_of_user        map[int]struct{}
+	removedfavorite_of_user map[int]struct{}
+	clearedfavorite_of_user bool
+	progress                map[int]struct{}
+	removedprogress         map[int]struct{}
+	clearedprogress         bool
+	blocked_by_user         map[int]struct{}
+	removedblocked_by_user  map[int]struct{}
+	clearedblocked_by_user  bool
+	done                    bool
+	oldValue                func(context.Context) (*Meta, error)
+	predicates              []predicate.Meta
+}
+
+var _ ent.Mutation = (*MetaMutation)(nil)
+
+// metaOption allows management of the mutation configuration using functional options.
+type metaOption func(*MetaMutation)
+
+// newMetaMutation creates new mutation for the Meta entity.
+func newMetaMutation(c config, op Op, opts ...metaOption) *MetaMutation {
+	m := &MetaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMeta,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMetaID sets the ID field of the mutation.
+func withMetaID(id int) metaOption {
+	return func(m *MetaMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Meta
+		)
+		m.oldValue = func(ctx context.Context) (*Meta, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Meta.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMeta sets the old Meta of the mutation.
+func withMeta(node *Meta) metaOption {
+	return func(m *MetaMutation) {
+		m.oldValue = func(context.Context) (*Meta, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MetaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MetaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MetaMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MetaMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Meta.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *MetaMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *MetaMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Meta entity.
+// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *MetaMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSearchKey sets the "search_key" field.
+func (m *MetaMutation) SetSearchKey(s string) {
+	m.search_key = &s
+}
+
+// SearchKey returns the value of the "search_key" field in the mutation.
+func (m *MetaMutation) SearchKey() (r string, exists bool) {
+	v := m.search_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSearchKey returns the old "search_key" field's value of the Meta entity.
+// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaMutation) OldSearchKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSearchKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSearchKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSearchKey: %w", err)
+	}
+	return oldValue.SearchKey, nil
+}
+
+// ClearSearchKey clears the value of the "search_key" field.
+func (m *MetaMutation) ClearSearchKey() {
+	m.search_key = nil
+	m.clearedFields[meta.FieldSearchKey] = struct{}{}
+}
+
+// SearchKeyCleared returns if the "search_key" field was cleared in this mutation.
+func (m *MetaMutation) SearchKeyCleared() bool {
+	_, ok := m.clearedFields[meta.FieldSearchKey]
+	return ok
+}
+
+// ResetSearchKey resets all changes to the "search_key" field.
+func (m *MetaMutation) ResetSearchKey() {
+	m.search_key = nil
+	delete(m.clearedFields, meta.FieldSearchKey)
+}
+
+// SetSortName sets the "sort_name" field.
+func (m *MetaMutation) SetSortName(s string) {
+	m.sort_name = &s
+}
+
+// SortName returns the value of the "sort_name" field in the mutation.
+func (m *MetaMutation) SortName() (r string, exists bool) {
+	v := m.sort_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSortName returns the old "sort_name" field's value of the Meta entity.
+// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaMutation) OldSortName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSortName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSortName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSortName: %w", err)
+	}
+	return oldValue.SortName, nil
+}
+
+// ClearSortName clears the value of the "sort_name" field.
+func (m *MetaMutation) ClearSortName() {
+	m.sort_name = nil
+	m.clearedFields[meta.FieldSortName] = struct{}{}
+}
+
+// SortNameCleared returns if the "sort_name" field was cleared in this mutation.
+func (m *MetaMutation) SortNameCleared() bool {
+	_, ok := m.clearedFields[meta.FieldSortName]
+	return ok
+}
+
+// ResetSortName resets all changes to the "sort_name" field.
+func (m *MetaMutation) ResetSortName() {
+	m.sort_name = nil
+	delete(m.clearedFields, meta.FieldSortName)
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *MetaMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *MetaMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Meta entity.
+// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *MetaMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetFavorite sets the "favorite" field.
+func (m *MetaMutation) SetFavorite(b bool) {
+	m.favorite = &b
+}
+
+// Favorite returns the value of the "favorite" field in the mutation.
+func (m *MetaMutation) Favorite() (r bool, exists bool) {
+	v := m.favorite
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFavorite returns the old "favorite" field's value of the Meta entity.
+// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaMutation) OldFavorite(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFavorite is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFavorite requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFavorite: %w", err)
+	}
+	return oldValue.Favorite, nil
+}
+
+// ResetFavorite resets all changes to the "favorite" field.
+func (m *MetaMutation) ResetFavorite() {
+	m.favorite = nil
+}
+
+// SetFileIndices sets the "file_indices" field.
+func (m *MetaMutation) SetFileIndices(i []int) {
+	m.file_indices = &i
+	m.appendfile_indices = nil
+}
+
+// FileIndices returns the value of the "file_indices" field in the mutation.
+func (m *MetaMutation) FileIndices() (r []int, exists bool) {
+	v := m.file_indices
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileIndices returns the old "file_indices" field's value of the Meta entity.
+// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaMutation) OldFileIndices(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileIndices is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileIndices requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileIndices: %w", err)
+	}
+	return oldValue.FileIndices, nil
+}
+
+// AppendFileIndices adds i to the "file_indices" field.
+func (m *MetaMutation) AppendFileIndices(i []int) {
+	m.appendfile_indices = append(m.appendfile_indices, i...)
+}
+
+// AppendedFileIndices returns the list of values that were appended to the "file_indices" field in this mutation.
+func (m *MetaMutation) AppendedFileIndices() ([]int, bool) {
+	if len(m.appendfile_indices) == 0 {
+		return nil, false
+	}
+	return m.appendfile_indices, true
+}
+
+// ResetFileIndices resets all changes to the "file_indices" field.
+func (m *MetaMutation) ResetFileIndices() {
+	m.file_indices = nil
+	m.appendfile_indices = nil
+}
+
+// SetPagesUpdateTime sets the "pages_update_time" field.
+func (m *MetaMutation) SetPagesUpdateTime(t time.Time) {
+	m.pages_update_time = &t
+}
+
+// PagesUpdateTime returns the value of the "pages_update_time" field in the mutation.
+func (m *MetaMutation) PagesUpdateTime() (r time.Time, exists bool) {
+	v := m.pages_update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPagesUpdateTime returns the old "pages_update_time" field's value of the Meta entity.
+// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaMutation) OldPagesUpdateTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPagesUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPagesUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPagesUpdateTime: %w", err)
+	}
+	return oldValue.PagesUpdateTime, nil
+}
+
+// ClearPagesUpdateTime clears the value of the "pages_update_time" field.
+func (m *MetaMutation) ClearPagesUpdateTime() {
+	m.pages_update_time = nil
+	m.clearedFields[meta.FieldPagesUpdateTime] = struct{}{}
+}
+
+// PagesUpdateTimeCleared returns if the "pages_update_time" field was cleared in this mutation.
+func (m *MetaMutation) PagesUpdateTimeCleared() bool {
+	_, ok := m.clearedFields[meta.FieldPagesUpdateTime]
+	return ok
+}
+
+// ResetPagesUpdateTime resets all changes to the "pages_update_time" field.
+func (m *MetaMutation) ResetPagesUpdateTime() {
+	m.pages_update_time = nil
+	delete(m.clearedFields, meta.FieldPagesUpdateTime)
+}
+
+// SetRead sets the "read" field.
+func (m *MetaMutation) SetRead(b bool) {
+	m.read = &b
+}
+
+// Read returns the value of the "read" field in the mutation.
+func (m *MetaMutation) Read() (r bool, exists bool) {
+	v := m.read
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRead returns the old "read" field's value of the Meta entity.
+// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaMutation) OldRead(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRead is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRead requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRead: %w", err)
+	}
+	return oldValue.Read, nil
+}
+
+// ResetRead resets all changes to the "read" field.
+func (m *MetaMutation) ResetRead() {
+	m.read = nil
+}
+
+// SetActive sets the "active" field.
+func (m *MetaMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the value of the "active" field in the mutation.
+func (m *MetaMutation) Active() (r bool, exists bool) {
+	v := m.active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActive returns the old "active" field's value of the Meta entity.
+// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaMutation) OldActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+	}
+	return oldValue.Active, nil
+}
+
+// ResetActive resets all changes to the "active" field.
+func (m *MetaMutation) ResetActive() {
+	m.active = nil
+}
+
+// SetHidden sets the "hidden" field.
+func (m *MetaMutation) SetHidden(b bool) {
+	m.hidden = &b
+}
+
+// Hidden returns the value of the "hidden" field in the mutation.
+func (m *MetaMutation) Hidden() (r bool, exists bool) {
+	v := m.hidden
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHidden returns the old "hidden" field's value of the Meta entity.
+// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaMutation) OldHidden(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHidden is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHidden requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHidden: %w", err)
+	}
+	return oldValue.Hidden, nil
+}
+
+// ResetHidden resets all changes to the "hidden" field.
+func (m *MetaMutation) ResetHidden() {
+	m.hidden = nil
+}
+
+// SetContainerType sets the "container_type" field.
+func (m *MetaMutation) SetContainerType(mt meta.ContainerType) {
+	m.container_type = &mt
+}
+
+// ContainerType returns the value of the "container_type" field in the mutation.
+func (m *MetaMutation) ContainerType() (r meta.ContainerType, exists bool) {
+	v := m.container_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContainerType returns the old "container_type" field's value of the Meta entity.
+// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaMutation) OldContainerType(ctx context.Context) (v meta.ContainerType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContainerType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContainerType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContainerType: %w", err)
+	}
+	return oldValue.ContainerType, nil
+}
+
+// ResetContainerType resets all changes to the "container_type" field.
+func (m *MetaMutation) ResetContainerType() {
+	m.container_type = nil
+}
+
+// SetThumbnailIndex sets the "thumbnail_index" field.
+func (m *MetaMutation) SetThumbnailIndex(i int) {
+	m.thumbnail_index = &i
+	m.addthumbnail_index = nil
+}
+
+// ThumbnailIndex returns the value of the "thumbnail_index" field in the mutation.
+func (m *MetaMutation) ThumbnailIndex() (r int, exists bool) {
+	v := m.thumbnail_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThumbnailIndex returns the old "thumbnail_index" field's value of the Meta entity.
+// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaMutation) OldThumbnailIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThumbnailIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThumbnailIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThumbnailIndex: %w", err)
+	}
+	return oldValue.ThumbnailIndex, nil
+}
+
+// AddThumbnailIndex adds i to the "thumbnail_index" field.
+func (m *MetaMutation) AddThumbnailIndex(i int) {
+	if m.addthumbnail_index != nil {
+		*m.addthumbnail_index += i
+	} else {
+		m.addthumbnail_index = &i
+	}
+}
+
+// AddedThumbnailIndex returns the value that was added to the "thumbnail_index" field in this mutation.
+func (m *MetaMutation) AddedThumbnailIndex() (r int, exists bool) {
+	v := m.addthumbnail_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearThumbnailIndex clears the value of the "thumbnail_index" field.
+func (m *MetaMutation) ClearThumbnailIndex() {
+	m.thumbnail_index = nil
+	m.addthumbnail_index = nil
+	m.clearedFields[meta.FieldThumbnailIndex] = struct{}{}
+}
+
+// ThumbnailIndexCleared returns if the "thumbnail_index" field was cleared in this mutation.
+func (m *MetaMutation) ThumbnailIndexCleared() bool {
+	_, ok := m.clearedFields[meta.FieldThumbnailIndex]
+	return ok
+}
+
+// ResetThumbnailIndex resets all changes to the "thumbnail_index" field.
+func (m *MetaMutation) ResetThumbnailIndex() {
+	m.thumbnail_index = nil
+	m.addthumbnail_index = nil
+	delete(m.clearedFields, meta.FieldThumbnailIndex)
+}
+
+// SetThumbnailX sets the "thumbnail_x" field.
+func (m *MetaMutation) SetThumbnailX(i int) {
+	m.thumbnail_x = &i
+	m.addthumbnail_x = nil
+}
+
+// ThumbnailX returns the value of the "thumbnail_x" field in the mutation.
+func (m *MetaMutation) ThumbnailX() (r int, exists bool) {
+	v := m.thumbnail_x
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThumbnailX returns the old "thumbnail_x" field's value of the Meta entity.
+// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaMutation) OldThumbnailX(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThumbnailX is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThumbnailX requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThumbnailX: %w", err)
+	}
+	return oldValue.ThumbnailX, nil
+}
+
+// AddThumbnailX adds i to the "thumbnail_x" field.
+func (m *MetaMutation) AddThumbnailX(i int) {
+	if m.addthumbnail_x != nil {
+		*m.addthumbnail_x += i
+	} else {
+		m.addthumbnail_x = &i
+	}
+}
+
+// AddedThumbnailX returns the value that was added to the "thumbnail_x" field in this mutation.
+func (m *MetaMutation) AddedThumbnailX() (r int, exists bool) {
+	v := m.addthumbnail_x
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearThumbnailX clears the value of the "thumbnail_x" field.
+func (m *MetaMutation) ClearThumbnailX() {
+	m.thumbnail_x = nil
+	m.addthumbnail_x = nil
+	m.clearedFields[meta.FieldThumbnailX] = struct{}{}
+}
+
+// ThumbnailXCleared returns if the "thumbnail_x" field was cleared in this mutation.
+func (m *MetaMutation) ThumbnailXCleared() bool {
+	_, ok := m.clearedFields[meta.FieldThumbnailX]
+	return ok
+}
+
+// ResetThumbnailX resets all changes to the "thumbnail_x" field.
+func (m *MetaMutation) ResetThumbnailX() {
+	m.thumbnail_x = nil
+	m.addthumbnail_x = nil
+	delete(m.clearedFields, meta.FieldThumbnailX)
+}
+
+// SetThumbnailY sets the "thumbnail_y" field.
+func (m *MetaMutation) SetThumbnailY(i int) {
+	m.thumbnail_y = &i
+	m.addthumbnail_y = nil
+}
+
+// ThumbnailY returns the value of the "thumbnail_y" field in the mutation.
+func (m *MetaMutation) ThumbnailY() (r int, exists bool) {
+	v := m.thumbnail_y
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThumbnailY returns the old "thumbnail_y" field's value of the Meta entity.
+// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaMutation) OldThumbnailY(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThumbnailY is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThumbnailY requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThumbnailY: %w", err)
+	}
+	return oldValue.ThumbnailY, nil
+}
+
+// AddThumbnailY adds i to the "thumbnail_y" field.
+func (m *MetaMutation) AddThumbnailY(i int) {
+	if m.addthumbnail_y != nil {
+		*m.addthumbnail_y += i
+	} else {
+		m.addthumbnail_y = &i
+	}
+}
+
+// AddedThumbnailY returns the value that was added to the "thumbnail_y" field in this mutation.
+func (m *MetaMutation) AddedThumbnailY() (r int, exists bool) {
+	v := m.addthumbnail_y
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearThumbnailY clears the value of the "thumbnail_y" field.
+func (m *MetaMutation) ClearThumbnailY() {
+	m.thumbnail_y = nil
+	m.addthumbnail_y = nil
+	m.clearedFields[meta.FieldThumbnailY] = struct{}{}
+}
+
+// ThumbnailYCleared returns if the "thumbnail_y" field was cleared in this mutation.
+func (m *MetaMutation) ThumbnailYCleared() bool {
+	_, ok := m.clearedFields[meta.FieldThumbnailY]
+	return ok
+}
+
+// ResetThumbnailY resets all changes to the "thumbnail_y" field.
+func (m *MetaMutation) ResetThumbnailY() {
+	m.thumbnail_y = nil
+	m.addthumbnail_y = nil
+	delete(m.clearedFields, meta.FieldThumbnailY)
+}
+
+// SetThumbnailWidth sets the "thumbnail_width" field.
+func (m *MetaMutation) SetThumbnailWidth(i int) {
+	m.thumbnail_width = &i
+	m.addthumbnail_width = nil
+}
+
+// ThumbnailWidth returns the value of the "thumbnail_width" field in the mutation.
+func (m *MetaMutation) ThumbnailWidth() (r int, exists bool) {
+	v := m.thumbnail_width
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThumbnailWidth returns the old "thumbnail_width" field's value of the Meta entity.
+// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaMutation) OldThumbnailWidth(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThumbnailWidth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThumbnailWidth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThumbnailWidth: %w", err)
+	}
+	return oldValue.ThumbnailWidth, nil
+}
+
+// AddThumbnailWidth adds i to the "thumbnail_width" field.
+func (m *MetaMutation) AddThumbnailWidth(i int) {
+	if m.addthumbnail_width != nil {
+		*m.addthumbnail_width += i
+	} else {
+		m.addthumbnail_width = &i
+	}
+}
+
+// AddedThumbnailWidth returns the value that was added to the "thumbnail_width" field in this mutation.
+func (m *MetaMutation) AddedThumbnailWidth() (r int, exists bool) {
+	v := m.addthumbnail_width
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearThumbnailWidth clears the value of the "thumbnail_width" field.
+func (m *MetaMutation) ClearThumbnailWidth() {
+	m.thumbnail_width = nil
+	m.addthumbnail_width = nil
+	m.clearedFields[meta.FieldThumbnailWidth] = struct{}{}
+}
+
+// ThumbnailWidthCleared returns if the "thumbnail_width" field was cleared in this mutation.
+func (m *MetaMutation) ThumbnailWidthCleared() bool {
+	_, ok := m.clearedFields[meta.FieldThumbnailWidth]
+	return ok
+}
+
+// ResetThumbnailWidth resets all changes to the "thumbnail_width" field.
+func (m *MetaMutation) ResetThumbnailWidth() {
+	m.thumbnail_width = nil
+	m.addthumbnail_width = nil
+	delete(m.clearedFields, meta.FieldThumbnailWidth)
+}
+
+// SetThumbnailHeight sets the "thumbnail_height" field.
+func (m *MetaMutation) SetThumbnailHeight(i int) {
+	m.thumbnail_height = &i
+	m.addthumbnail_height = nil
+}
+
+// ThumbnailHeight returns the value of the "thumbnail_height" field in the mutation.
+func (m *MetaMutation) ThumbnailHeight() (r int, exists bool) {
+	v := m.thumbnail_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThumbnailHeight returns the old "thumbnail_height" field's value of the Meta entity.
+// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaMutation) OldThumbnailHeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThumbnailHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThumbnailHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThumbnailHeight: %w", err)
+	}
+	return oldValue.ThumbnailHeight, nil
+}
+
+// AddThumbnailHeight adds i to the "thumbnail_height" field.
+func (m *MetaMutation) AddThumbnailHeight(i int) {
+	if m.addthumbnail_height != nil {
+		*m.addthumbnail_height += i
+	} else {
+		m.addthumbnail_height = &i
+	}
+}
+
+// AddedThumbnailHeight returns the value that was added to the "thumbnail_height" field in this mutation.
+func (m *MetaMutation) AddedThumbnailHeight() (r int, exists bool) {
+	v := m.addthumbnail_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearThumbnailHeight clears the value of the "thumbnail_height" field.
+func (m *MetaMutation) ClearThumbnailHeight() {
+	m.thumbnail_height = nil
+	m.addthumbnail_height = nil
+	m.clearedFields[meta.FieldThumbnailHeight] = struct{}{}
+}
+
+// ThumbnailHeightCleared returns if the "thumbnail_height" field was cleared in this mutation.
+func (m *MetaMutation) ThumbnailHeightCleared() bool {
+	_, ok := m.clearedFields[meta.FieldThumbnailHeight]
+	return ok
+}
+
+// ResetThumbnailHeight resets all changes to the "thumbnail_height" field.
+func (m *MetaMutation) ResetThumbnailHeight() {
+	m.thumbnail_height = nil
+	m.addthumbnail_height = nil
+	delete(m.clearedFields, meta.FieldThumbnailHeight)
+}
+
+// SetSeries sets the "series" field.
+func (m *MetaMutation) SetSeries(s string) {
+	m.series = &s
+}
+
+// Series returns the value of the "series" field in the mutation.
+func (m *MetaMutation) Series() (r string, exists bool) {
+	v := m.series
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeries returns the old "series" field's value of the Meta entity.
+// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaMutation) OldSeries(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeries is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeries requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeries: %w", err)
+	}
+	return oldValue.Series, nil
+}
+
+// ClearSeries clears the value of the "series" field.
+func (m *MetaMutation) ClearSeries() {
+	m.series = nil
+	m.clearedFields[meta.FieldSeries] = struct{}{}
+}
+
+// SeriesCleared returns if the "series" field was cleared in this mutation.
+func (m *MetaMutation) SeriesCleared() bool {
+	_, ok := m.clearedFields[meta.FieldSeries]
+	return ok
+}
+
+// ResetSeries resets all changes to the "series" field.
+func (m *MetaMutation) ResetSeries() {
+	m.series = nil
+	delete(m.clearedFields, meta.FieldSeries)
+}
+
+// SetVolume sets the "volume" field.
+func (m *MetaMutation) SetVolume(f float64) {
+	m.volume = &f
+	m.addvolume = nil
+}
+
+// Volume returns the value of the "volume" field in the mutation.
+func (m *MetaMutation) Volume() (r float64, exists bool) {
+	v := m.volume
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVolume returns the old "volume" field's value of the Meta entity.
+// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaMutation) OldVolume(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVolume is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVolume requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVolume: %w", err)
+	}
+	return oldValue.Volume, nil
+}
+
+// AddVolume adds f to the "volume" field.
+func (m *MetaMutation) AddVolume(f float64) {
+	if m.addvolume != nil {
+		*m.addvolume += f
+	} else {
+		m.addvolume = &f
+	}
+}
+
+// AddedVolume returns the value that was added to the "volume" field in this mutation.
+func (m *MetaMutation) AddedVolume() (r float64, exists bool) {
+	v := m.addvolume
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearVolume clears the value of the "volume" field.
+func (m *MetaMutation) ClearVolume() {
+	m.volume = nil
+	m.addvolume = nil
+	m.clearedFields[meta.FieldVolume] = struct{}{}
+}
+
+// VolumeCleared returns if the "volume" field was cleared in this mutation.
+func (m *MetaMutation) VolumeCleared() bool {
+	_, ok := m.clearedFields[meta.FieldVolume]
+	return ok
+}
+
+// ResetVolume resets all changes to the "volume" field.
+func (m *MetaMutation) ResetVolume() {
+	m.volume = nil
+	m.addvolume = nil
+	delete(m.clearedFields, meta.FieldVolume)
+}
+
+// SetChapter sets the "chapter" field.
+func (m *MetaMutation) SetChapter(f float64) {
+	m.chapter = &f
+	m.addchapter = nil
+}
+
+// Chapter returns the value of the "chapter" field in the mutation.
+func (m *MetaMutation) Chapter() (r float64, exists bool) {
+	v := m.chapter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChapter returns the old "chapter" field's value of the Meta entity.
+// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaMutation) OldChapter(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChapter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChapter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChapter: %w", err)
+	}
+	return oldValue.Chapter, nil
+}
+
+// AddChapter adds f to the "chapter" field.
+func (m *MetaMutation) AddChapter(f float64) {
+	if m.addchapter != nil {
+		*m.addchapter += f
+	} else {
+		m.addchapter = &f
+	}
+}
+
+// AddedChapter returns the value that was added to the "chapter" field in this mutation.
+func (m *MetaMutation) AddedChapter() (r float64, exists bool) {
+	v := m.addchapter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearChapter clears the value of the "chapter" field.
+func (m *MetaMutation) ClearChapter() {
+	m.chapter = nil
+	m.addchapter = nil
+	m.clearedFields[meta.FieldChapter] = struct{}{}
+}
+
+// ChapterCleared returns if the "chapter" field was cleared in this mutation.
+func (m *MetaMutation) ChapterCleared() bool {
+	_, ok := m.clearedFields[meta.FieldChapter]
+	return ok
+}
+
+// ResetChapter resets all changes to the "chapter" field.
+func (m *MetaMutation) ResetChapter() {
+	m.chapter = nil
+	m.addchapter = nil
+	delete(m.clearedFields, meta.FieldChapter)
+}
+
+// SetArtist sets the "artist" field.
+func (m *MetaMutation) SetArtist(s string) {
+	m.artist = &s
+}
+
+// Artist returns the value of the "artist" field in the mutation.
+func (m *MetaMutation) Artist() (r string, exists bool) {
+	v := m.artist
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArtist returns the old "artist" field's value of the Meta entity.
+// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaMutation) OldArtist(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArtist is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArtist requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArtist: %w", err)
+	}
+	return oldValue.Artist, nil
+}
+
+// ClearArtist clears the value of the "artist" field.
+func (m *MetaMutation) ClearArtist() {
+	m.artist = nil
+	m.clearedFields[meta.FieldArtist] = struct{}{}
+}
+
+// ArtistCleared returns if the "artist" field was cleared in this mutation.
+func (m *MetaMutation) ArtistCleared() bool {
+	_, ok := m.clearedFields[meta.FieldArtist]
+	return ok
+}
+
+// ResetArtist resets all changes to the "artist" field.
+func (m *MetaMutation) ResetArtist() {
+	m.artist = nil
+	delete(m.clearedFields, meta.FieldArtist)
+}
+
+// SetYear sets the "year" field.
+func (m *MetaMutation) SetYear(i int) {
+	m.year = &i
+	m.addyear = nil
+}
+
+// Year returns the value of the "year" field in the mutation.
+func (m *MetaMutation) Year() (r int, exists bool) {
+	v := m.year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldYear returns the old "year" field's value of the Meta entity.
+// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaMutation) OldYear(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldYear is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldYear requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldYear: %w", err)
+	}
+	return oldValue.Year, nil
+}
+
+// AddYear adds i to the "year" field.
+func (m *MetaMutation) AddYear(i int) {
+	if m.addyear != nil {
+		*m.addyear += i
+	} else {
+		m.addyear = &i
+	}
+}
+
+// AddedYear returns the value that was added to the "year" field in this mutation.
+func (m *MetaMutation) AddedYear() (r int, exists bool) {
+	v := m.addyear
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearYear clears the value of the "year" field.
+func (m *MetaMutation) ClearYear() {
+	m.year = nil
+	m.addyear = nil
+	m.clearedFields[meta.FieldYear] = struct{}{}
+}
+
+// YearCleared returns if the "year" field was cleared in this mutation.
+func (m *MetaMutation) YearCleared() bool {
+	_, ok := m.clearedFields[meta.FieldYear]
+	return ok
+}
+
+// ResetYear resets all changes to the "year" field.
+func (m *MetaMutation) ResetYear() {
+	m.year = nil
+	m.addyear = nil
+	delete(m.clearedFields, meta.FieldYear)
+}
+
+// SetAgeRating sets the "age_rating" field.
+func (m *MetaMutation) SetAgeRating(i int) {
+	m.age_rating = &i
+	m.addage_rating = nil
+}
+
+// AgeRating returns the value of the "age_rating" field in the mutation.
+func (m *MetaMutation) AgeRating() (r int, exists bool) {
+	v := m.age_rating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAgeRating returns the old "age_rating" field's value of the Meta entity.
+// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetaMutation) OldAgeRating(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAgeRating is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAgeRating requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAgeRating: %w", err)
+	}
+	return oldValue.AgeRating, nil
+}
+
+// AddAgeRating adds i to the "age_rating" field.
+func (m *MetaMutation) AddAgeRating(i int) {
+	if m.addage_rating != nil {
+		*m.addage_rating += i
+	} else {
+		m.addage_rating = &i
+	}
+}
+
+// AddedAgeRating returns the value that was added to the "age_rating" field in this mutation.
+func (m *MetaMutation) AddedAgeRating() (r int, exists bool) {
+	v := m.addage_rating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAgeRating resets all changes to the "age_rating" field.
+func (m *MetaMutation) ResetAgeRating() {
+	m.age_rating = nil
+	m.addage_rating = nil
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *MetaMutation) AddTagIDs(ids ...int) {
+	if m.tags == nil {
+		m.tags = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *MetaMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *MetaMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *MetaMutation) RemoveTagIDs(ids ...int) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *MetaMutation) RemovedTagsIDs() (ids []int) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *MetaMutation) TagsIDs() (ids []int) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *MetaMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// AddExcludedTagIDs adds the "excluded_tags" edge to the Tag entity by ids.
+func (m *MetaMutation) AddExcludedTagIDs(ids ...int) {
+	if m.excluded_tags == nil {
+		m.excluded_tags = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.excluded_tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearExcludedTags clears the "excluded_tags" edge to the Tag entity.
+func (m *MetaMutation) ClearExcludedTags() {
+	m.clearedexcluded_tags = true
+}
+
+// ExcludedTagsCleared reports if the "excluded_tags" edge to the Tag entity was cleared.
+func (m *MetaMutation) ExcludedTagsCleared() bool {
+	return m.clearedexcluded_tags
+}
+
+// RemoveExcludedTagIDs removes the "excluded_tags" edge to the Tag entity by IDs.
+func (m *MetaMutation) RemoveExcludedTagIDs(ids ...int) {
+	if m.removedexcluded_tags == nil {
+		m.removedexcluded_tags = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.excluded_tags, ids[i])
+		m.removedexcluded_tags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedExcludedTags returns the removed IDs of the "excluded_tags" edge to the Tag entity.
+func (m *MetaMutation) RemovedExcludedTagsIDs() (ids []int) {
+	for id := range m.removedexcluded_tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ExcludedTagsIDs returns the "excluded_tags" edge IDs in the mutation.
+func (m *MetaMutation) ExcludedTagsIDs() (ids []int) {
+	for id := range m.excluded_tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetExcludedTags resets all changes to the "excluded_tags" edge.
+func (m *MetaMutation) ResetExcludedTags() {
+	m.excluded_tags = nil
+	m.clearedexcluded_tags = false
+	m.removedexcluded_tags = nil
+}
+
+// AddHistoryIDs adds the "histories" edge to the History entity by ids.
+func (m *MetaMutation) AddHistoryIDs(ids ...int) {
+	if m.histories == nil {
+		m.histories = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.histories[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHistories clears the "histories" edge to the History entity.
+func (m *MetaMutation) ClearHistories() {
+	m.clearedhistories = true
+}
+
+// HistoriesCleared reports if the "histories" edge to the History entity was cleared.
+func (m *MetaMutation) HistoriesCleared() bool {
+	return m.clearedhistories
+}
+
+// RemoveHistoryIDs removes the "histories" edge to the History entity by IDs.
+func (m *MetaMutation) RemoveHistoryIDs(ids ...int) {
+	if m.removedhistories == nil {
+		m.removedhistories = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.histories, ids[i])
+		m.removedhistories[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHistories returns the removed IDs of the "histories" edge to the History entity.
+func (m *MetaMutation) RemovedHistoriesIDs() (ids []int) {
+	for id := range m.removedhistories {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HistoriesIDs returns the "histories" edge IDs in the mutation.
+func (m *MetaMutation) HistoriesIDs() (ids []int) {
+	for id := range m.histories {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHistories resets all changes to the "histories" edge.
+func (m *MetaMutation) ResetHistories() {
+	m.histories = nil
+	m.clearedhistories = false
+	m.removedhistories = nil
+}
+
+// AddFavoriteOfUserIDs adds the "favorite_of_user" edge to the User entity by ids.
+func (m *MetaMutation) AddFavoriteOfUserIDs(ids ...int) {
+	if m.favorite_of_user == nil {
+		m.favorite_of_user = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.favorite_of_user[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFavoriteOfUser clears the "favorite_of_user" edge to the User entity.
+func (m *MetaMutation) ClearFavoriteOfUser() {
+	m.clearedfavorite_of_user = true
+}
+
+// FavoriteOfUserCleared reports if the "favorite_of_user" edge to the User entity was cleared.
+func (m *MetaMutation) FavoriteOfUserCleared() bool {
+	return m.clearedfavorite_of_user
+}
+
+// RemoveFavoriteOfUserIDs removes the "favorite_of_user" edge to the User entity by IDs.
+func (m *MetaMutation) RemoveFavoriteOfUserIDs(ids ...int) {
+	if m.removedfavorite_of_user == nil {
+		m.removedfavorite_of_user = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.favorite_of_user, ids[i])
+		m.removedfavorite_of_user[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFavoriteOfUser returns the removed IDs of the "favorite_of_user" edge to the User entity.
+func (m *MetaMutation) RemovedFavoriteOfUserIDs() (ids []int) {
+	for id := range m.removedfavorite_of_user {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FavoriteOfUserIDs returns the "favorite_of_user" edge IDs in the mutation.
+func (m *MetaMutation) FavoriteOfUserIDs() (ids []int) {
+	for id := range m.favorite_of_user {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFavoriteOfUser resets all changes to the "favorite_of_user" edge.
+func (m *MetaMutation) ResetFavoriteOfUser() {
+	m.favorite_of_user = nil
+	m.clearedfavorite_of_user = false
+	m.removedfavorite_of_user = nil
+}
+
+// AddProgresIDs adds the "progress" edge to the Progress entity by ids.
+func (m *MetaMutation) AddProgresIDs(ids ...int) {
+	if m.progress == nil {
+		m.progress = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.progress[ids[i]] = struct{}{}
+	}
+}
+
+// ClearProgress clears the "progress" edge to the Progress entity.
+func (m *MetaMutation) ClearProgress() {
+	m.clearedprogress = true
+}
+
+// ProgressCleared reports if the "progress" edge to the Progress entity was cleared.
+func (m *MetaMutation) ProgressCleared() bool {
+	return m.clearedprogress
+}
+
+// RemoveProgresIDs removes the "progress" edge to the Progress entity by IDs.
+func (m *MetaMutation) RemoveProgresIDs(ids ...int) {
+	if m.removedprogress == nil {
+		m.removedprogress = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.progress, ids[i])
+		m.removedprogress[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedProgress returns the removed IDs of the "progress" edge to the Progress entity.
+func (m *MetaMutation) RemovedProgressIDs() (ids []int) {
+	for id := range m.removedprogress {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ProgressIDs returns the "progress" edge IDs in the mutation.
+func (m *MetaMutation) ProgressIDs() (ids []int) {
+	for id := range m.progress {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetProgress resets all changes to the "progress" edge.
+func (m *MetaMutation) ResetProgress() {
+	m.progress = nil
+	m.clearedprogress = false
+	m.removedprogress = nil
+}
+
+// AddBlockedByUserIDs adds the "blocked_by_user" edge to the User entity by ids.
+func (m *MetaMutation) AddBlockedByUserIDs(ids ...int) {
+	if m.blocked_by_user == nil {
+		m.blocked_by_user = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.blocked_by_user[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBlockedByUser clears the "blocked_by_user" edge to the User entity.
+func (m *MetaMutation) ClearBlockedByUser() {
+	m.clearedblocked_by_user = true
+}
+
+// BlockedByUserCleared reports if the "blocked_by_user" edge to the User entity was cleared.
+func (m *MetaMutation) BlockedByUserCleared() bool {
+	return m.clearedblocked_by_user
+}
+
+// RemoveBlockedByUserIDs removes the "blocked_by_user" edge to the User entity by IDs.
+func (m *MetaMutation) RemoveBlockedByUserIDs(ids ...int) {
+	if m.removedblocked_by_user == nil {
+		m.removedblocked_by_user = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.blocked_by_user, ids[i])
+		m.removedblocked_by_user[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBlockedByUser returns the removed IDs of the "blocked_by_user" edge to the User entity.
+func (m *MetaMutation) RemovedBlockedByUserIDs() (ids []int) {
+	for id := range m.removedblocked_by_user {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BlockedByUserIDs returns the "blocked_by_user" edge IDs in the mutation.
+func (m *MetaMutation) BlockedByUserIDs() (ids []int) {
+	for id := range m.blocked_by_user {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBlockedByUser resets all changes to the "blocked_by_user" edge.
+func (m *MetaMutation) ResetBlockedByUser() {
+	m.blocked_by_user = nil
+	m.clearedblocked_by_user = false
+	m.removedblocked_by_user = nil
+}
+
+// Where appends a list predicates to the MetaMutation builder.
+func (m *MetaMutation) Where(ps ...predicate.Meta) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MetaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MetaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Meta, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MetaMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MetaMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Meta).
+func (m *MetaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MetaMutation) Fields() []string {
+	fields := make([]string, 0, 22)
+	if m.name != nil {
+		fields = append(fields, meta.FieldName)
+	}
+	if m.search_key != nil {
+		fields = append(fields, meta.FieldSearchKey)
+	}
+	if m.sort_name != nil {
+		fields = append(fields, meta.FieldSortName)
+	}
+	if m.create_time != nil {
+		fields = append(fields, meta.FieldCreateTime)
+	}
+	if m.favorite != nil {
+		fields = append(fields, meta.FieldFavorite)
+	}
+	if m.file_indices != nil {
+		fields = append(fields, meta.FieldFileIndices)
+	}
+	if m.pages_update_time != nil {
+		fields = append(fields, meta.FieldPagesUpdateTime)
+	}
+	if m.read != nil {
+		fields = append(fields, meta.FieldRead)
+	}
+	if m.active != nil {
+		fields = append(fields, meta.FieldActive)
+	}
+	if m.hidden != nil {
+		fields = append(fields, meta.FieldHidden)
+	}
+	if m.container_type != nil {
+		fields = append(fields, meta.FieldContainerType)
+	}
+	if m.thumbnail_index != nil {
+		fields = append(fields, meta.FieldThumbnailIndex)
+	}
+	if m.thumbnail_x != nil {
+		fields = append(fields, meta.FieldThumbnailX)
+	}
+	if m.thumbnail_y != nil {
+		fields = append(fields, meta.FieldThumbnailY)
+	}
+	if m.thumbnail_width != nil {
+		fields = append(fields, meta.FieldThumbnailWidth)
+	}
+	if m.thumbnail_height != nil {
+		fields = append(fields, meta.FieldThumbnailHeight)
+	}
+	if m.series != nil {
+		fields = append(fields, meta.FieldSeries)
+	}
+	if m.volume != nil {
+		fields = append(fields, meta.FieldVolume)
+	}
+	if m.chapter != nil {
+		fields = append(fields, meta.FieldChapter)
+	}
+	if m.artist != nil {
+		fields = append(fields, meta.FieldArtist)
+	}
+	if m.year != nil {
+		fields = append(fields, meta.FieldYear)
+	}
+	if m.age_rating != nil {
+		fields = append(fields, meta.FieldAgeRating)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MetaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case meta.FieldName:
+		return m.Name()
+	case meta.FieldSearchKey:
+		return m.SearchKey()
+	case meta.FieldSortName:
+		return m.SortName()
+	case meta.FieldCreateTime:
+		return m.CreateTime()
+	case meta.FieldFavorite:
+		return m.Favorite()
+	case meta.FieldFileIndices:
+		return m.FileIndices()
+	case meta.FieldPagesUpdateTime:
+		return m.PagesUpdateTime()
+	case meta.FieldRead:
+		return m.Read()
+	case meta.FieldActive:
+		return m.Active()
+	case meta.FieldHidden:
+		return m.Hidden()
+	case meta.FieldContainerType:
+		return m.ContainerType()
+	case meta.FieldThumbnailIndex:
+		return m.ThumbnailIndex()
+	case meta.FieldThumbnailX:
+		return m.ThumbnailX()
+	case meta.FieldThumbnailY:
+		return m.ThumbnailY()
+	case meta.FieldThumbnailWidth:
+		return m.ThumbnailWidth()
+	case meta.FieldThumbnailHeight:
+		return m.ThumbnailHeight()
+	case meta.FieldSeries:
+		return m.Series()
+	case meta.FieldVolume:
+		return m.Volume()
+	case meta.FieldChapter:
+		return m.Chapter()
+	case meta.FieldArtist:
+		return m.Artist()
+	case meta.FieldYear:
+		return m.Year()
+	case meta.FieldAgeRating:
+		return m.AgeRating()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MetaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case meta.FieldName:
+		return m.OldName(ctx)
+	case meta.FieldSearchKey:
+		return m.OldSearchKey(ctx)
+	case meta.FieldSortName:
+		return m.OldSortName(ctx)
+	case meta.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case meta.FieldFavorite:
+		return m.OldFavorite(ctx)
+	case meta.FieldFileIndices:
+		return m.OldFileIndices(ctx)
+	case meta.FieldPagesUpdateTime:
+		return m.OldPagesUpdateTime(ctx)
+	case meta.FieldRead:
+		return m.OldRead(ctx)
+	case meta.FieldActive:
+		return m.OldActive(ctx)
+	case meta.FieldHidden:
+		return m.OldHidden(ctx)
+	case meta.FieldContainerType:
+		return m.OldContainerType(ctx)
+	case meta.FieldThumbnailIndex:
+		return m.OldThumbnailIndex(ctx)
+	case meta.FieldThumbnailX:
+		return m.OldThumbnailX(ctx)
+	case meta.FieldThumbnailY:
+		return m.OldThumbnailY(ctx)
+	case meta.FieldThumbnailWidth:
+		return m.OldThumbnailWidth(ctx)
+	case meta.FieldThumbnailHeight:
+		return m.OldThumbnailHeight(ctx)
+	case meta.FieldSeries:
+		return m.OldSeries(ctx)
+	case meta.FieldVolume:
+		return m.OldVolume(ctx)
+	case meta.FieldChapter:
+		return m.OldChapter(ctx)
+	case meta.FieldArtist:
+		return m.OldArtist(ctx)
+	case meta.FieldYear:
+		return m.OldYear(ctx)
+	case meta.FieldAgeRating:
+		return m.OldAgeRating(ctx)
+	}
+	return nil, fmt.Errorf("unknown Meta field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MetaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case meta.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case meta.FieldSearchKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSearchKey(v)
+		return nil
+	case meta.FieldSortName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSortName(v)
+		return nil
+	case meta.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case meta.FieldFavorite:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFavorite(v)
+		return nil
+	case meta.FieldFileIndices:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileIndices(v)
+		return nil
+	case meta.FieldPagesUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPagesUpdateTime(v)
+		return nil
+	case meta.FieldRead:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRead(v)
+		return nil
+	case meta.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
+		return nil
+	case meta.FieldHidden:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHidden(v)
+		return nil
+	case meta.FieldContainerType:
+		v, ok := value.(meta.ContainerType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContainerType(v)
+		return nil
+	case meta.FieldThumbnailIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThumbnailIndex(v)
+		return nil
+	case meta.FieldThumbnailX:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThumbnailX(v)
+		return nil
+	case meta.FieldThumbnailY:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThumbnailY(v)
+		return nil
+	case meta.FieldThumbnailWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThumbnailWidth(v)
+		return nil
+	case meta.FieldThumbnailHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThumbnailHeight(v)
+		return nil
+	case meta.FieldSeries:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeries(v)
+		return nil
+	case meta.FieldVolume:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVolume(v)
+		return nil
+	case meta.FieldChapter:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChapter(v)
+		return nil
+	case meta.FieldArtist:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArtist(v)
+		return nil
+	case meta.FieldYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetYear(v)
+		return nil
+	case meta.FieldAgeRating:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAgeRating(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Meta field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MetaMutation) AddedFields() []string {
+	var fields []string
+	if m.addthumbnail_index != nil {
+		fields = append(fields, meta.FieldThumbnailIndex)
+	}
+	if m.addthumbnail_x != nil {
+		fields = append(fields, meta.FieldThumbnailX)
+	}
+	if m.addthumbnail_y != nil {
+		fields = append(fields, meta.FieldThumbnailY)
+	}
+	if m.addthumbnail_width != nil {
+		fields = append(fields, meta.FieldThumbnailWidth)
+	}
+	if m.addthumbnail_height != nil {
+		fields = append(fields, meta.FieldThumbnailHeight)
+	}
+	if m.addvolume != nil {
+		fields = append(fields, meta.FieldVolume)
+	}
+	if m.addchapter != nil {
+		fields = append(fields, meta.FieldChapter)
+	}
+	if m.addyear != nil {
+		fields = append(fields, meta.FieldYear)
+	}
+	if m.addage_rating != nil {
+		fields = append(fields, meta.FieldAgeRating)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MetaMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case meta.FieldThumbnailIndex:
+		return m.AddedThumbnailIndex()
+	case meta.FieldThumbnailX:
+		return m.AddedThumbnailX()
+	case meta.FieldThumbnailY:
+		return m.AddedThumbnailY()
+	case meta.FieldThumbnailWidth:
+		return m.AddedThumbnailWidth()
+	case meta.FieldThumbnailHeight:
+		return m.AddedThumbnailHeight()
+	case meta.FieldVolume:
+		return m.AddedVolume()
+	case meta.FieldChapter:
+		return m.AddedChapter()
+	case meta.FieldYear:
+		return m.AddedYear()
+	case meta.FieldAgeRating:
+		return m.AddedAgeRating()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MetaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case meta.FieldThumbnailIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddThumbnailIndex(v)
+		return nil
+	case meta.FieldThumbnailX:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddThumbnailX(v)
+		return nil
+	case meta.FieldThumbnailY:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddThumbnailY(v)
+		return nil
+	case meta.FieldThumbnailWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddThumbnailWidth(v)
+		return nil
+	case meta.FieldThumbnailHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddThumbnailHeight(v)
+		return nil
+	case meta.FieldVolume:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVolume(v)
+		return nil
+	case meta.FieldChapter:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChapter(v)
+		return nil
+	case meta.FieldYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddYear(v)
+		return nil
+	case meta.FieldAgeRating:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAgeRating(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Meta numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MetaMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(meta.FieldSearchKey) {
+		fields = append(fields, meta.FieldSearchKey)
+	}
+	if m.FieldCleared(meta.FieldSortName) {
+		fields = append(fields, meta.FieldSortName)
+	}
+	if m.FieldCleared(meta.FieldPagesUpdateTime) {
+		fields = append(fields, meta.FieldPagesUpdateTime)
+	}
+	if m.FieldCleared(meta.FieldThumbnailIndex) {
+		fields = append(fields, meta.FieldThumbnailIndex)
+	}
+	if m.FieldCleared(meta.FieldThumbnailX) {
+		fields = append(fields, meta.FieldThumbnailX)
+	}
+	if m.FieldCleared(meta.FieldThumbnailY) {
+		fields = append(fields, meta.FieldThumbnailY)
+	}
+	if m.FieldCleared(meta.FieldThumbnailWidth) {
+		fields = append(fields, meta.FieldThumbnailWidth)
+	}
+	if m.FieldCleared(meta.FieldThumbnailHeight) {
+		fields = append(fields, meta.FieldThumbnailHeight)
+	}
+	if m.FieldCleared(meta.FieldSeries) {
+		fields = append(fields, meta.FieldSeries)
+	}
+	if m.FieldCleared(meta.FieldVolume) {
+		fields = append(fields, meta.FieldVolume)
+	}
+	if m.FieldCleared(meta.FieldChapter) {
+		fields = append(fields, meta.FieldChapter)
+	}
+	if m.FieldCleared(meta.FieldArtist) {
+		fields = append(fields, meta.FieldArtist)
+	}
+	if m.FieldCleared(meta.FieldYear) {
+		fields = append(fields, meta.FieldYear)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MetaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MetaMutation) ClearField(name string) error {
+	switch name {
+	case meta.FieldSearchKey:
+		m.ClearSearchKey()
+		return nil
+	case meta.FieldSortName:
+		m.ClearSortName()
+		return nil
+	case meta.FieldPagesUpdateTime:
+		m.ClearPagesUpdateTime()
+		return nil
+	case meta.FieldThumbnailIndex:
+		m.ClearThumbnailIndex()
+		return nil
+	case meta.FieldThumbnailX:
+		m.ClearThumbnailX()
+		return nil
+	case meta.FieldThumbnailY:
+		m.ClearThumbnailY()
+		return nil
+	case meta.FieldThumbnailWidth:
+		m.ClearThumbnailWidth()
+		return nil
+	case meta.FieldThumbnailHeight:
+		m.ClearThumbnailHeight()
+		return nil
+	case meta.FieldSeries:
+		m.ClearSeries()
+		return nil
+	case meta.FieldVolume:
+		m.ClearVolume()
+		return nil
+	case meta.FieldChapter:
+		m.ClearChapter()
+		return nil
+	case meta.FieldArtist:
+		m.ClearArtist()
+		return nil
+	case meta.FieldYear:
+		m.ClearYear()
+		return nil
+	}
+	return fmt.Errorf("unknown Meta nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MetaMutation) ResetField(name string) error {
+	switch name {
+	case meta.FieldName:
+		m.ResetName()
+		return nil
+	case meta.FieldSearchKey:
+		m.ResetSearchKey()
+		return nil
+	case meta.FieldSortName:
+		m.ResetSortName()
+		return nil
+	case meta.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case meta.FieldFavorite:
+		m.ResetFavorite()
+		return nil
+	case meta.FieldFileIndices:
+		m.ResetFileIndices()
+		return nil
+	case meta.FieldPagesUpdateTime:
+		m.ResetPagesUpdateTime()
+		return nil
+	case meta.FieldRead:
+		m.ResetRead()
+		return nil
+	case meta.FieldActive:
+		m.ResetActive()
+		return nil
+	case meta.FieldHidden:
+		m.ResetHidden()
+		return nil
+	case meta.FieldContainerType:
+		m.ResetContainerType()
+		return nil
+	case meta.FieldThumbnailIndex:
+		m.ResetThumbnailIndex()
+		return nil
+	case meta.FieldThumbnailX:
+		m.ResetThumbnailX()
+		return nil
+	case meta.FieldThumbnailY:
+		m.ResetThumbnailY()
+		return nil
+	case meta.FieldThumbnailWidth:
+		m.ResetThumbnailWidth()
+		return nil
+	case meta.FieldThumbnailHeight:
+		m.ResetThumbnailHeight()
+		return nil
+	case meta.FieldSeries:
+		m.ResetSeries()
+		return nil
+	case meta.FieldVolume:
+		m.ResetVolume()
+		return nil
+	case meta.FieldChapter:
+		m.ResetChapter()
+		return nil
+	case meta.FieldArtist:
+		m.ResetArtist()
+		return nil
+	case meta.FieldYear:
+		m.ResetYear()
+		return nil
+	case meta.FieldAgeRating:
+		m.ResetAgeRating()
+		return nil
+	}
+	return fmt.Errorf("unknown Meta field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MetaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.tags != nil {
+		edges = append(edges, meta.EdgeTags)
+	}
+	if m.excluded_tags != nil {
+		edges = append(edges, meta.EdgeExcludedTags)
+	}
+	if m.histories != nil {
+		edges = append(edges, meta.EdgeHistories)
+	}
+	if m.favorite_of_user != nil {
+		edges = append(edges, meta.EdgeFavoriteOfUser)
+	}
+	if m.progress != nil {
+		edges = append(edges, meta.EdgeProgress)
+	}
+	if m.blocked_by_user != nil {
+		edges = append(edges, meta.EdgeBlockedByUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MetaMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case meta.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case meta.EdgeExcludedTags:
+		ids := make([]ent.Value, 0, len(m.excluded_tags))
+		for id := range m.excluded_tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case meta.EdgeHistories:
+		ids := make([]ent.Value, 0, len(m.histories))
+		for id := range m.histories {
+			ids = append(ids, id)
+		}
+		return ids
+	case meta.EdgeFavoriteOfUser:
+		ids := make([]ent.Value, 0, len(m.favorite_of_user))
+		for id := range m.favorite_of_user {
+			ids = append(ids, id)
+		}
+		return ids
+	case meta.EdgeProgress:
+		ids := make([]ent.Value, 0, len(m.progress))
+		for id := range m.progress {
+			ids = append(ids, id)
+		}
+		return ids
+	case meta.EdgeBlockedByUser:
+		ids := make([]ent.Value, 0, len(m.blocked_by_user))
+		for id := range m.blocked_by_user {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MetaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedtags != nil {
+		edges = append(edges, meta.EdgeTags)
+	}
+	if m.removedexcluded_tags != nil {
+		edges = append(edges, meta.EdgeExcludedTags)
+	}
+	if m.removedhistories != nil {
+		edges = append(edges, meta.EdgeHistories)
+	}
+	if m.removedfavorite_of_user != nil {
+		edges = append(edges, meta.EdgeFavoriteOfUser)
+	}
+	if m.removedprogress != nil {
+		edges = append(edges, meta.EdgeProgress)
+	}
+	if m.removedblocked_by_user != nil {
+		edges = append(edges, meta.EdgeBlockedByUser)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MetaMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case meta.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case meta.EdgeExcludedTags:
+		ids := make([]ent.Value, 0, len(m.removedexcluded_tags))
+		for id := range m.removedexcluded_tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case meta.EdgeHistories:
+		ids := make([]ent.Value, 0, len(m.removedhistories))
+		for id := range m.removedhistories {
+			ids = append(ids, id)
+		}
+		return ids
+	case meta.EdgeFavoriteOfUser:
+		ids := make([]ent.Value, 0, len(m.removedfavorite_of_user))
+		for id := range m.removedfavorite_of_user {
+			ids = append(ids, id)
+		}
+		return ids
+	case meta.EdgeProgress:
+		ids := make([]ent.Value, 0, len(m.removedprogress))
+		for id := range m.removedprogress {
+			ids = append(ids, id)
+		}
+		return ids
+	case meta.EdgeBlockedByUser:
+		ids := make([]ent.Value, 0, len(m.removedblocked_by_user))
+		for id := range m.removedblocked_by_user {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MetaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedtags {
+		edges = append(edges, meta.EdgeTags)
+	}
+	if m.clearedexcluded_tags {
+		edges = append(edges, meta.EdgeExcludedTags)
+	}
+	if m.clearedhistories {
+		edges = append(edges, meta.EdgeHistories)
+	}
+	if m.clearedfavorite_of_user {
+		edges = append(edges, meta.EdgeFavoriteOfUser)
+	}
+	if m.clearedprogress {
+		edges = append(edges, meta.EdgeProgress)
+	}
+	if m.clearedblocked_by_user {
+		edges = append(edges, meta.EdgeBlockedByUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MetaMutation) EdgeCleared(name string) bool {
+	switch name {
+	case meta.EdgeTags:
+		return m.clearedtags
+	case meta.EdgeExcludedTags:
+		return m.clearedexcluded_tags
+	case meta.EdgeHistories:
+		return m.clearedhistories
+	case meta.EdgeFavoriteOfUser:
+		return m.clearedfavorite_of_user
+	case meta.EdgeProgress:
+		return m.clearedprogress
+	case meta.EdgeBlockedByUser:
+		return m.clearedblocked_by_user
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MetaMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Meta unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MetaMutation) ResetEdge(name string) error {
+	switch name {
+	case meta.EdgeTags:
+		m.ResetTags()
+		return nil
+	case meta.EdgeExcludedTags:
+		m.ResetExcludedTags()
+		return nil
+	case meta.EdgeHistories:
+		m.ResetHistories()
+		return nil
+	case meta.EdgeFavoriteOfUser:
+		m.ResetFavoriteOfUser()
+		return nil
+	case meta.EdgeProgress:
+		m.ResetProgress()
+		return nil
+	case meta.EdgeBlockedByUser:
+		m.ResetBlockedByUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Meta edge %s", name)
+}
+
+// MetaTagMutation represents an operation that mutates the MetaTag nodes in the graph.
+type MetaTagMutation struct {
+	config
+	op            Op
+	typ           string
+	source        *metatag.Source
+	clearedFields map[string]struct{}
+	meta          *int
+	clearedmeta   bool
+	tag           *int
+	clearedtag    bool
+	done          bool
+	oldValue      func(context.Context) (*MetaTag, error)
+	predicates    []predicate.MetaTag
+}
+
+var _ ent.Mutation = (*MetaTagMutation)(nil)
+
+// metatagOption allows management of the mutation configuration using functional options.
+type metatagOption func(*MetaTagMutation)
+
+// newMetaTagMutation creates new mutation for the MetaTag entity.
+func newMetaTagMutation(c config, op Op, opts ...metatagOption) *MetaTagMutation {
+	m := &MetaTagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMetaTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MetaTagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MetaTagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetMetaID sets the "meta_id" field.
+func (m *MetaTagMutation) SetMetaID(i int) {
+	m.meta = &i
+}
+
+// MetaID returns the value of the "meta_id" field in the mutation.
+func (m *MetaTagMutation) MetaID() (r int, exists bool) {
+	v := m.meta
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMetaID resets all changes to the "meta_id" field.
+func (m *MetaTagMutation) ResetMetaID() {
+	m.meta = nil
+}
+
+// SetTagID sets the "tag_id" field.
+func (m *MetaTagMutation) SetTagID(i int) {
+	m.tag = &i
+}
+
+// TagID returns the value of the "tag_id" field in the mutation.
+func (m *MetaTagMutation) TagID() (r int, exists bool) {
+	v := m.tag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTagID resets all changes to the "tag_id" field.
+func (m *MetaTagMutation) ResetTagID() {
+	m.tag = nil
+}
+
+// SetSource sets the "source" field.
+func (m *MetaTagMutation) SetSource(value metatag.Source) {
+	m.source = &value
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *MetaTagMutation) Source() (r metatag.Source, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *MetaTagMutation) ResetSource() {
+	m.source = nil
+}
+
+// ClearMeta clears the "meta" edge to the Meta entity.
+func (m *MetaTagMutation) ClearMeta() {
+	m.clearedmeta = true
+	m.clearedFields[metatag.FieldMetaID] = struct{}{}
+}
+
+// MetaCleared reports if the "meta" edge to the Meta entity was cleared.
+func (m *MetaTagMutation) MetaCleared() bool {
+	return m.clearedmeta
+}
+
+// MetaIDs returns the "meta" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MetaID instead. It exists only for internal usage by the builders.
+func (m *MetaTagMutation) MetaIDs() (ids []int) {
+	if id := m.meta; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMeta resets all changes to the "meta" edge.
+func (m *MetaTagMutation) ResetMeta() {
+	m.meta = nil
+	m.clearedmeta = false
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (m *MetaTagMutation) ClearTag() {
+	m.clearedtag = true
+	m.clearedFields[metatag.FieldTagID] = struct{}{}
+}
+
+// TagCleared reports if the "tag" edge to the Tag entity was cleared.
+func (m *MetaTagMutation) TagCleared() bool {
+	return m.clearedtag
+}
+
+// TagIDs returns the "tag" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TagID instead. It exists only for internal usage by the builders.
+func (m *MetaTagMutation) TagIDs() (ids []int) {
+	if id := m.tag; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTag resets all changes to the "tag" edge.
+func (m *MetaTagMutation) ResetTag() {
+	m.tag = nil
+	m.clearedtag = false
+}
+
+// Where appends a list predicates to the MetaTagMutation builder.
+func (m *MetaTagMutation) Where(ps ...predicate.MetaTag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MetaTagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MetaTagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MetaTag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MetaTagMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MetaTagMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MetaTag).
+func (m *MetaTagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MetaTagMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.meta != nil {
+		fields = append(fields, metatag.FieldMetaID)
+	}
+	if m.tag != nil {
+		fields = append(fields, metatag.FieldTagID)
+	}
+	if m.source != nil {
+		fields = append(fields, metatag.FieldSource)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MetaTagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case metatag.FieldMetaID:
+		return m.MetaID()
+	case metatag.FieldTagID:
+		return m.TagID()
+	case metatag.FieldSource:
+		return m.Source()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MetaTagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	return nil, errors.New("edge schema MetaTag does not support getting old values")
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MetaTagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case metatag.FieldMetaID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetaID(v)
+		return nil
+	case metatag.FieldTagID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagID(v)
+		return nil
+	case metatag.FieldSource:
+		v, ok := value.(metatag.Source)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MetaTag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MetaTagMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MetaTagMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MetaTagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MetaTag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MetaTagMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MetaTagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MetaTagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MetaTag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MetaTagMutation) ResetField(name string) error {
+	switch name {
+	case metatag.FieldMetaID:
+		m.ResetMetaID()
+		return nil
+	case metatag.FieldTagID:
+		m.ResetTagID()
+		return nil
+	case metatag.FieldSource:
+		m.ResetSource()
+		return nil
+	}
+	return fmt.Errorf("unknown MetaTag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MetaTagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.meta != nil {
+		edges = append(edges, metatag.EdgeMeta)
+	}
+	if m.tag != nil {
+		edges = append(edges, metatag.EdgeTag)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MetaTagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case metatag.EdgeMeta:
+		if id := m.meta; id != nil {
+			return []ent.Value{*id}
+		}
+	case metatag.EdgeTag:
+		if id := m.tag; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MetaTagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MetaTagMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MetaTagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmeta {
+		edges = append(edges, metatag.EdgeMeta)
+	}
+	if m.clearedtag {
+		edges = append(edges, metatag.EdgeTag)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MetaTagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case metatag.EdgeMeta:
+		return m.clearedmeta
+	case metatag.EdgeTag:
+		return m.clearedtag
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MetaTagMutation) ClearEdge(name string) error {
+	switch name {
+	case metatag.EdgeMeta:
+		m.ClearMeta()
+		return nil
+	case metatag.EdgeTag:
+		m.ClearTag()
+		return nil
+	}
+	return fmt.Errorf("unknown MetaTag unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MetaTagMutation) ResetEdge(name string) error {
+	switch name {
+	case metatag.EdgeMeta:
+		m.ResetMeta()
+		return nil
+	case metatag.EdgeTag:
+		m.ResetTag()
+		return nil
+	}
+	return fmt.Errorf("unknown MetaTag edge %s", name)
+}
+
+// ProgressMutation represents an operation that mutates the Progress nodes in the graph.
+type ProgressMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	page          *int
+	addpage       *int
+	max           *int
+	addmax        *int
+	clearedFields map[string]struct{}
+	item          *int
+	cleareditem   bool
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*Progress, error)
+	predicates    []predicate.Progress
+}
+
+var _ ent.Mutation = (*ProgressMutation)(nil)
+
+// progressOption allows management of the mutation configuration using functional options.
+type progressOption func(*ProgressMutation)
+
+// newProgressMutation creates new mutation for the Progress entity.
+func newProgressMutation(c config, op Op, opts ...progressOption) *ProgressMutation {
+	m := &ProgressMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProgress,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProgressID sets the ID field of the mutation.
+func withProgressID(id int) progressOption {
+	return func(m *ProgressMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Progress
+		)
+		m.oldValue = func(ctx context.Context) (*Progress, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Progress.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProgress sets the old Progress of the mutation.
+func withProgress(node *Progress) progressOption {
+	return func(m *ProgressMutation) {
+		m.oldValue = func(context.Context) (*Progress, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProgressMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProgressMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProgressMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProgressMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Progress.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPage sets the "page" field.
+func (m *ProgressMutation) SetPage(i int) {
+	m.page = &i
+	m.addpage = nil
+}
+
+// Page returns the value of the "page" field in the mutation.
+func (m *ProgressMutation) Page() (r int, exists bool) {
+	v := m.page
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPage returns the old "page" field's value of the Progress entity.
+// If the Progress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProgressMutation) OldPage(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPage: %w", err)
+	}
+	return oldValue.Page, nil
+}
+
+// AddPage adds i to the "page" field.
+func (m *ProgressMutation) AddPage(i int) {
+	if m.addpage != nil {
+		*m.addpage += i
+	} else {
+		m.addpage = &i
+	}
+}
+
+// AddedPage returns the value that was added to the "page" field in this mutation.
+func (m *ProgressMutation) AddedPage() (r int, exists bool) {
+	v := m.addpage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPage resets all changes to the "page" field.
+func (m *ProgressMutation) ResetPage() {
+	m.page = nil
+	m.addpage = nil
+}
+
+// SetMax sets the "max" field.
+func (m *ProgressMutation) SetMax(i int) {
+	m.max = &i
+	m.addmax = nil
+}
+
+// Max returns the value of the "max" field in the mutation.
+func (m *ProgressMutation) Max() (r int, exists bool) {
+	v := m.max
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMax returns the old "max" field's value of the Progress entity.
+// If the Progress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProgressMutation) OldMax(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMax is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMax requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMax: %w", err)
+	}
+	return oldValue.Max, nil
+}
+
+// AddMax adds i to the "max" field.
+func (m *ProgressMutation) AddMax(i int) {
+	if m.addmax != nil {
+		*m.addmax += i
+	} else {
+		m.addmax = &i
+	}
+}
+
+// AddedMax returns the value that was added to the "max" field in this mutation.
+func (m *ProgressMutation) AddedMax() (r int, exists bool) {
+	v := m.addmax
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMax resets all changes to the "max" field.
+func (m *ProgressMutation) ResetMax() {
+	m.max = nil
+	m.addmax = nil
+}
+
+// SetItemID sets the "item_id" field.
+func (m *ProgressMutation) SetItemID(i int) {
+	m.item = &i
+}
+
+// ItemID returns the value of the "item_id" field in the mutation.
+func (m *ProgressMutation) ItemID() (r int, exists bool) {
+	v := m.item
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldItemID returns the old "item_id" field's value of the Progress entity.
+// If the Progress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProgressMutation) OldItemID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldItemID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
+	}
+	return oldValue.ItemID, nil
+}
+
+// ClearItemID clears the value of the "item_id" field.
+func (m *ProgressMutation) ClearItemID() {
+	m.item = nil
+	m.clearedFields[progress.FieldItemID] = struct{}{}
+}
+
+// ItemIDCleared returns if the "item_id" field was cleared in this mutation.
+func (m *ProgressMutation) ItemIDCleared() bool {
+	_, ok := m.clearedFields[progress.FieldItemID]
+	return ok
+}
+
+// ResetItemID resets all changes to the "item_id" field.
+func (m *ProgressMutation) ResetItemID() {
+	m.item = nil
+	delete(m.clearedFields, progress.FieldItemID)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ProgressMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ProgressMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Progress entity.
+// If the Progress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProgressMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *ProgressMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[progress.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *ProgressMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[progress.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ProgressMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, progress.FieldUserID)
+}
+
+// ClearItem clears the "item" edge to the Meta entity.
+func (m *ProgressMutation) ClearItem() {
+	m.cleareditem = true
+	m.clearedFields[progress.FieldItemID] = struct{}{}
+}
+
+// ItemCleared reports if the "item" edge to the Meta entity was cleared.
+func (m *ProgressMutation) ItemCleared() bool {
+	return m.ItemIDCleared() || m.cleareditem
+}
+
+// ItemIDs returns the "item" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *ProgressMutation) ItemIDs() (ids []int) {
+	if id := m.item; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetItem resets all changes to the "item" edge.
+func (m *ProgressMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ProgressMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[progress.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ProgressMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ProgressMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *ProgressMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the ProgressMutation builder.
+func (m *ProgressMutation) Where(ps ...predicate.Progress) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProgressMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProgressMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Progress, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProgressMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProgressMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Progress).
+func (m *ProgressMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProgressMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.page != nil {
+		fields = append(fields, progress.FieldPage)
+	}
+	if m.max != nil {
+		fields = append(fields, progress.FieldMax)
+	}
+	if m.item != nil {
+		fields = append(fields, progress.FieldItemID)
+	}
+	if m.user != nil {
+		fields = append(fields, progress.FieldUserID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProgressMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case progress.FieldPage:
+		return m.Page()
+	case progress.FieldMax:
+		return m.Max()
+	case progress.FieldItemID:
+		return m.ItemID()
+	case progress.FieldUserID:
+		return m.UserID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProgressMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case progress.FieldPage:
+		return m.OldPage(ctx)
+	case progress.FieldMax:
+		return m.OldMax(ctx)
+	case progress.FieldItemID:
+		return m.OldItemID(ctx)
+	case progress.FieldUserID:
+		return m.OldUserID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Progress field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProgressMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case progress.FieldPage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPage(v)
+		return nil
+	case progress.FieldMax:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMax(v)
+		return nil
+	case progress.FieldItemID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItemID(v)
+		return nil
+	case progress.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Progress field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProgressMutation) AddedFields() []string {
+	var fields []string
+	if m.addpage != nil {
+		fields = append(fields, progress.FieldPage)
+	}
+	if m.addmax != nil {
+		fields = append(fields, progress.FieldMax)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProgressMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case progress.FieldPage:
+		return m.AddedPage()
+	case progress.FieldMax:
+		return m.AddedMax()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProgressMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case progress.FieldPage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPage(v)
+		return nil
+	case progress.FieldMax:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMax(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Progress numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProgressMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(progress.FieldItemID) {
+		fields = append(fields, progress.FieldItemID)
+	}
+	if m.FieldCleared(progress.FieldUserID) {
+		fields = append(fields, progress.FieldUserID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProgressMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProgressMutation) ClearField(name string) error {
+	switch name {
+	case progress.FieldItemID:
+		m.ClearItemID()
+		return nil
+	case progress.FieldUserID:
+		m.ClearUserID()
+		return nil
+	}
+	return fmt.Errorf("unknown Progress nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProgressMutation) ResetField(name string) error {
+	switch name {
+	case progress.FieldPage:
+		m.ResetPage()
+		return nil
+	case progress.FieldMax:
+		m.ResetMax()
+		return nil
+	case progress.FieldItemID:
+		m.ResetItemID()
+		return nil
+	case progress.FieldUserID:
+		m.ResetUserID()
+		return nil
+	}
+	return fmt.Errorf("unknown Progress field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProgressMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.item != nil {
+		edges = append(edges, progress.EdgeItem)
+	}
+	if m.user != nil {
+		edges = append(edges, progress.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProgressMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case progress.EdgeItem:
+		if id := m.item; id != nil {
+			return []ent.Value{*id}
+		}
+	case progress.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProgressMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProgressMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProgressMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareditem {
+		edges = append(edges, progress.EdgeItem)
+	}
+	if m.cleareduser {
+		edges = append(edges, progress.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProgressMutation) EdgeCleared(name string) bool {
+	switch name {
+	case progress.EdgeItem:
+		return m.cleareditem
+	case progress.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProgressMutation) ClearEdge(name string) error {
+	switch name {
+	case progress.EdgeItem:
+		m.ClearItem()
+		return nil
+	case progress.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Progress unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProgressMutation) ResetEdge(name string) error {
+	switch name {
+	case progress.EdgeItem:
+		m.ResetItem()
+		return nil
+	case progress.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Progress edge %s", name)
+}
+
+// SavedSearchMutation represents an operation that mutates the SavedSearch nodes in the graph.
+type SavedSearchMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	name               *string
+	search             *string
+	query              *string
+	filter             *int
+	addfilter          *int
+	sort               *int
+	addsort            *int
+	sort_order         *int
+	addsort_order      *int
+	series             *string
+	include_tags       *[]int
+	appendinclude_tags []int
+	tag_match          *int
+	addtag_match       *int
+	exclude_tags       *[]int
+	appendexclude_tags []int
+	random_seed        *int64
+	addrandom_seed     *int64
+	create_time        *time.Time
+	last_open_time     *time.Time
+	clearedFields      map[string]struct{}
+	user               *int
+	cleareduser        bool
+	done               bool
+	oldValue           func(context.Context) (*SavedSearch, error)
+	predicates         []predicate.SavedSearch
+}
+
+var _ ent.Mutation = (*SavedSearchMutation)(nil)
+
+// savedsearchOption allows management of the mutation configuration using functional options.
+type savedsearchOption func(*SavedSearchMutation)
+
+// newSavedSearchMutation creates new mutation for the SavedSearch entity.
+func newSavedSearchMutation(c config, op Op, opts ...savedsearchOption) *SavedSearchMutation {
+	m := &SavedSearchMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSavedSearch,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSavedSearchID sets the ID field of the mutation.
+func withSavedSearchID(id int) savedsearchOption {
+	return func(m *SavedSearchMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SavedSearch
+		)
+		m.oldValue = func(ctx context.Context) (*SavedSearch, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SavedSearch.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSavedSearch sets the old SavedSearch of the mutation.
+func withSavedSearch(node *SavedSearch) savedsearchOption {
+	return func(m *SavedSearchMutation) {
+		m.oldValue = func(context.Context) (*SavedSearch, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SavedSearchMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SavedSearchMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SavedSearchMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SavedSearchMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SavedSearch.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *SavedSearchMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SavedSearchMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SavedSearchMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSearch sets the "search" field.
+func (m *SavedSearchMutation) SetSearch(s string) {
+	m.search = &s
+}
+
+// Search returns the value of the "search" field in the mutation.
+func (m *SavedSearchMutation) Search() (r string, exists bool) {
+	v := m.search
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSearch returns the old "search" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldSearch(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSearch is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSearch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSearch: %w", err)
+	}
+	return oldValue.Search, nil
+}
+
+// ClearSearch clears the value of the "search" field.
+func (m *SavedSearchMutation) ClearSearch() {
+	m.search = nil
+	m.clearedFields[savedsearch.FieldSearch] = struct{}{}
+}
+
+// SearchCleared returns if the "search" field was cleared in this mutation.
+func (m *SavedSearchMutation) SearchCleared() bool {
+	_, ok := m.clearedFields[savedsearch.FieldSearch]
+	return ok
+}
+
+// ResetSearch resets all changes to the "search" field.
+func (m *SavedSearchMutation) ResetSearch() {
+	m.search = nil
+	delete(m.clearedFields, savedsearch.FieldSearch)
+}
+
+// SetQuery sets the "query" field.
+func (m *SavedSearchMutation) SetQuery(s string) {
+	m.query = &s
+}
+
+// Query returns the value of the "query" field in the mutation.
+func (m *SavedSearchMutation) Query() (r string, exists bool) {
+	v := m.query
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuery returns the old "query" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldQuery(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuery is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuery requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuery: %w", err)
+	}
+	return oldValue.Query, nil
+}
+
+// ClearQuery clears the value of the "query" field.
+func (m *SavedSearchMutation) ClearQuery() {
+	m.query = nil
+	m.clearedFields[savedsearch.FieldQuery] = struct{}{}
+}
+
+// QueryCleared returns if the "query" field was cleared in this mutation.
+func (m *SavedSearchMutation) QueryCleared() bool {
+	_, ok := m.clearedFields[savedsearch.FieldQuery]
+	return ok
+}
+
+// ResetQuery resets all changes to the "query" field.
+func (m *SavedSearchMutation) ResetQuery() {
+	m.query = nil
+	delete(m.clearedFields, savedsearch.FieldQuery)
+}
+
+// SetFilter sets the "filter" field.
+func (m *SavedSearchMutation) SetFilter(i int) {
+	m.filter = &i
+	m.addfilter = nil
+}
+
+// Filter returns the value of the "filter" field in the mutation.
+func (m *SavedSearchMutation) Filter() (r int, exists bool) {
+	v := m.filter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilter returns the old "filter" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldFilter(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilter: %w", err)
+	}
+	return oldValue.Filter, nil
+}
+
+// AddFilter adds i to the "filter" field.
+func (m *SavedSearchMutation) AddFilter(i int) {
+	if m.addfilter != nil {
+		*m.addfilter += i
+	} else {
+		m.addfilter = &i
+	}
+}
+
+// AddedFilter returns the value that was added to the "filter" field in this mutation.
+func (m *SavedSearchMutation) AddedFilter() (r int, exists bool) {
+	v := m.addfilter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFilter resets all changes to the "filter" field.
+func (m *SavedSearchMutation) ResetFilter() {
+	m.filter = nil
+	m.addfilter = nil
+}
+
+// SetSort sets the "sort" field.
+func (m *SavedSearchMutation) SetSort(i int) {
+	m.sort = &i
+	m.addsort = nil
+}
+
+// Sort returns the value of the "sort" field in the mutation.
+func (m *SavedSearchMutation) Sort() (r int, exists bool) {
+	v := m.sort
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSort returns the old "sort" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldSort(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSort is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSort requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSort: %w", err)
+	}
+	return oldValue.Sort, nil
+}
+
+// AddSort adds i to the "sort" field.
+func (m *SavedSearchMutation) AddSort(i int) {
+	if m.addsort != nil {
+		*m.addsort += i
+	} else {
+		m.addsort = &i
+	}
+}
+
+// AddedSort returns the value that was added to the "sort" field in this mutation.
+func (m *SavedSearchMutation) AddedSort() (r int, exists bool) {
+	v := m.addsort
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSort resets all changes to the "sort" field.
+func (m *SavedSearchMutation) ResetSort() {
+	m.sort = nil
+	m.addsort = nil
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (m *SavedSearchMutation) SetSortOrder(i int) {
+	m.sort_order = &i
+	m.addsort_order = nil
+}
+
+// SortOrder returns the value of the "sort_order" field in the mutation.
+func (m *SavedSearchMutation) SortOrder() (r int, exists bool) {
+	v := m.sort_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSortOrder returns the old "sort_order" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldSortOrder(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSortOrder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSortOrder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSortOrder: %w", err)
+	}
+	return oldValue.SortOrder, nil
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (m *SavedSearchMutation) AddSortOrder(i int) {
+	if m.addsort_order != nil {
+		*m.addsort_order += i
+	} else {
+		m.addsort_order = &i
+	}
+}
+
+// AddedSortOrder returns the value that was added to the "sort_order" field in this mutation.
+func (m *SavedSearchMutation) AddedSortOrder() (r int, exists bool) {
+	v := m.addsort_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSortOrder resets all changes to the "sort_order" field.
+func (m *SavedSearchMutation) ResetSortOrder() {
+	m.sort_order = nil
+	m.addsort_order = nil
+}
+
+// SetSeries sets the "series" field.
+func (m *SavedSearchMutation) SetSeries(s string) {
+	m.series = &s
+}
+
+// Series returns the value of the "series" field in the mutation.
+func (m *SavedSearchMutation) Series() (r string, exists bool) {
+	v := m.series
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeries returns the old "series" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldSeries(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeries is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeries requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeries: %w", err)
+	}
+	return oldValue.Series, nil
+}
+
+// ClearSeries clears the value of the "series" field.
+func (m *SavedSearchMutation) ClearSeries() {
+	m.series = nil
+	m.clearedFields[savedsearch.FieldSeries] = struct{}{}
+}
+
+// SeriesCleared returns if the "series" field was cleared in this mutation.
+func (m *SavedSearchMutation) SeriesCleared() bool {
+	_, ok := m.clearedFields[savedsearch.FieldSeries]
+	return ok
+}
+
+// ResetSeries resets all changes to the "series" field.
+func (m *SavedSearchMutation) ResetSeries() {
+	m.series = nil
+	delete(m.clearedFields, savedsearch.FieldSeries)
+}
+
+// SetIncludeTags sets the "include_tags" field.
+func (m *SavedSearchMutation) SetIncludeTags(i []int) {
+	m.include_tags = &i
+	m.appendinclude_tags = nil
+}
+
+// IncludeTags returns the value of the "include_tags" field in the mutation.
+func (m *SavedSearchMutation) IncludeTags() (r []int, exists bool) {
+	v := m.include_tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIncludeTags returns the old "include_tags" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldIncludeTags(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIncludeTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIncludeTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIncludeTags: %w", err)
+	}
+	return oldValue.IncludeTags, nil
+}
+
+// AppendIncludeTags adds i to the "include_tags" field.
+func (m *SavedSearchMutation) AppendIncludeTags(i []int) {
+	m.appendinclude_tags = append(m.appendinclude_tags, i...)
+}
+
+// AppendedIncludeTags returns the list of values that were appended to the "include_tags" field in this mutation.
+func (m *SavedSearchMutation) AppendedIncludeTags() ([]int, bool) {
+	if len(m.appendinclude_tags) == 0 {
+		return nil, false
+	}
+	return m.appendinclude_tags, true
+}
+
+// ClearIncludeTags clears the value of the "include_tags" field.
+func (m *SavedSearchMutation) ClearIncludeTags() {
+	m.include_tags = nil
+	m.appendinclude_tags = nil
+	m.clearedFields[savedsearch.FieldIncludeTags] = struct{}{}
+}
+
+// IncludeTagsCleared returns if the "include_tags" field was cleared in this mutation.
+func (m *SavedSearchMutation) IncludeTagsCleared() bool {
+	_, ok := m.clearedFields[savedsearch.FieldIncludeTags]
+	return ok
+}
+
+// ResetIncludeTags resets all changes to the "include_tags" field.
+func (m *SavedSearchMutation) ResetIncludeTags() {
+	m.include_tags = nil
+	m.appendinclude_tags = nil
+	delete(m.clearedFields, savedsearch.FieldIncludeTags)
+}
+
+// SetTagMatch sets the "tag_match" field.
+func (m *SavedSearchMutation) SetTagMatch(i int) {
+	m.tag_match = &i
+	m.addtag_match = nil
+}
+
+// TagMatch returns the value of the "tag_match" field in the mutation.
+func (m *SavedSearchMutation) TagMatch() (r int, exists bool) {
+	v := m.tag_match
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagMatch returns the old "tag_match" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldTagMatch(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagMatch is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagMatch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagMatch: %w", err)
+	}
+	return oldValue.TagMatch, nil
+}
+
+// AddTagMatch adds i to the "tag_match" field.
+func (m *SavedSearchMutation) AddTagMatch(i int) {
+	if m.addtag_match != nil {
+		*m.addtag_match += i
+	} else {
+		m.addtag_match = &i
+	}
+}
+
+// AddedTagMatch returns the value that was added to the "tag_match" field in this mutation.
+func (m *SavedSearchMutation) AddedTagMatch() (r int, exists bool) {
+	v := m.addtag_match
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTagMatch resets all changes to the "tag_match" field.
+func (m *SavedSearchMutation) ResetTagMatch() {
+	m.tag_match = nil
+	m.addtag_match = nil
+}
+
+// SetExcludeTags sets the "exclude_tags" field.
+func (m *SavedSearchMutation) SetExcludeTags(i []int) {
+	m.exclude_tags = &i
+	m.appendexclude_tags = nil
+}
+
+// ExcludeTags returns the value of the "exclude_tags" field in the mutation.
+func (m *SavedSearchMutation) ExcludeTags() (r []int, exists bool) {
+	v := m.exclude_tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExcludeTags returns the old "exclude_tags" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldExcludeTags(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExcludeTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExcludeTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExcludeTags: %w", err)
+	}
+	return oldValue.ExcludeTags, nil
+}
+
+// AppendExcludeTags adds i to the "exclude_tags" field.
+func (m *SavedSearchMutation) AppendExcludeTags(i []int) {
+	m.appendexclude_tags = append(m.appendexclude_tags, i...)
+}
+
+// AppendedExcludeTags returns the list of values that were appended to the "exclude_tags" field in this mutation.
+func (m *SavedSearchMutation) AppendedExcludeTags() ([]int, bool) {
+	if len(m.appendexclude_tags) == 0 {
+		return nil, false
+	}
+	return m.appendexclude_tags, true
+}
+
+// ClearExcludeTags clears the value of the "exclude_tags" field.
+func (m *SavedSearchMutation) ClearExcludeTags() {
+	m.exclude_tags = nil
+	m.appendexclude_tags = nil
+	m.clearedFields[savedsearch.FieldExcludeTags] = struct{}{}
+}
+
+// ExcludeTagsCleared returns if the "exclude_tags" field was cleared in this mutation.
+func (m *SavedSearchMutation) ExcludeTagsCleared() bool {
+	_, ok := m.clearedFields[savedsearch.FieldExcludeTags]
+	return ok
+}
+
+// ResetExcludeTags resets all changes to the "exclude_tags" field.
+func (m *SavedSearchMutation) ResetExcludeTags() {
+	m.exclude_tags = nil
+	m.appendexclude_tags = nil
+	delete(m.clearedFields, savedsearch.FieldExcludeTags)
+}
+
+// SetRandomSeed sets the "random_seed" field.
+func (m *SavedSearchMutation) SetRandomSeed(i int64) {
+	m.random_seed = &i
+	m.addrandom_seed = nil
+}
+
+// RandomSeed returns the value of the "random_seed" field in the mutation.
+func (m *SavedSearchMutation) RandomSeed() (r int64, exists bool) {
+	v := m.random_seed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRandomSeed returns the old "random_seed" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldRandomSeed(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRandomSeed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRandomSeed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRandomSeed: %w", err)
+	}
+	return oldValue.RandomSeed, nil
+}
+
+// AddRandomSeed adds i to the "random_seed" field.
+func (m *SavedSearchMutation) AddRandomSeed(i int64) {
+	if m.addrandom_seed != nil {
+		*m.addrandom_seed += i
+	} else {
+		m.addrandom_seed = &i
+	}
+}
+
+// AddedRandomSeed returns the value that was added to the "random_seed" field in this mutation.
+func (m *SavedSearchMutation) AddedRandomSeed() (r int64, exists bool) {
+	v := m.addrandom_seed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRandomSeed resets all changes to the "random_seed" field.
+func (m *SavedSearchMutation) ResetRandomSeed() {
+	m.random_seed = nil
+	m.addrandom_seed = nil
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *SavedSearchMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *SavedSearchMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *SavedSearchMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetLastOpenTime sets the "last_open_time" field.
+func (m *SavedSearchMutation) SetLastOpenTime(t time.Time) {
+	m.last_open_time = &t
+}
+
+// LastOpenTime returns the value of the "last_open_time" field in the mutation.
+func (m *SavedSearchMutation) LastOpenTime() (r time.Time, exists bool) {
+	v := m.last_open_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastOpenTime returns the old "last_open_time" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldLastOpenTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastOpenTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastOpenTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastOpenTime: %w", err)
+	}
+	return oldValue.LastOpenTime, nil
+}
+
+// ResetLastOpenTime resets all changes to the "last_open_time" field.
+func (m *SavedSearchMutation) ResetLastOpenTime() {
+	m.last_open_time = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *SavedSearchMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SavedSearchMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SavedSearchMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *SavedSearchMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SavedSearchMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *SavedSearchMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the SavedSearchMutation builder.
+func (m *SavedSearchMutation) Where(ps ...predicate.SavedSearch) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SavedSearchMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SavedSearchMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SavedSearch, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SavedSearchMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SavedSearchMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SavedSearch).
+func (m *SavedSearchMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SavedSearchMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.name != nil {
+		fields = append(fields, savedsearch.FieldName)
+	}
+	if m.search != nil {
+		fields = append(fields, savedsearch.FieldSearch)
+	}
+	if m.query != nil {
+		fields = append(fields, savedsearch.FieldQuery)
+	}
+	if m.filter != nil {
+		fields = append(fields, savedsearch.FieldFilter)
+	}
+	if m.sort != nil {
+		fields = append(fields, savedsearch.FieldSort)
+	}
+	if m.sort_order != nil {
+		fields = append(fields, savedsearch.FieldSortOrder)
+	}
+	if m.series != nil {
+		fields = append(fields, savedsearch.FieldSeries)
+	}
+	if m.include_tags != nil {
+		fields = append(fields, savedsearch.FieldIncludeTags)
+	}
+	if m.tag_match != nil {
+		fields = append(fields, savedsearch.FieldTagMatch)
+	}
+	if m.exclude_tags != nil {
+		fields = append(fields, savedsearch.FieldExcludeTags)
+	}
+	if m.random_seed != nil {
+		fields = append(fields, savedsearch.FieldRandomSeed)
+	}
+	if m.create_time != nil {
+		fields = append(fields, savedsearch.FieldCreateTime)
+	}
+	if m.last_open_time != nil {
+		fields = append(fields, savedsearch.FieldLastOpenTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SavedSearchMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case savedsearch.FieldName:
+		return m.Name()
+	case savedsearch.FieldSearch:
+		return m.Search()
+	case savedsearch.FieldQuery:
+		return m.Query()
+	case savedsearch.FieldFilter:
+		return m.Filter()
+	case savedsearch.FieldSort:
+		return m.Sort()
+	case savedsearch.FieldSortOrder:
+		return m.SortOrder()
+	case savedsearch.FieldSeries:
+		return m.Series()
+	case savedsearch.FieldIncludeTags:
+		return m.IncludeTags()
+	case savedsearch.FieldTagMatch:
+		return m.TagMatch()
+	case savedsearch.FieldExcludeTags:
+		return m.ExcludeTags()
+	case savedsearch.FieldRandomSeed:
+		return m.RandomSeed()
+	case savedsearch.FieldCreateTime:
+		return m.CreateTime()
+	case savedsearch.FieldLastOpenTime:
+		return m.LastOpenTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SavedSearchMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case savedsearch.FieldName:
+		return m.OldName(ctx)
+	case savedsearch.FieldSearch:
+		return m.OldSearch(ctx)
+	case savedsearch.FieldQuery:
+		return m.OldQuery(ctx)
+	case savedsearch.FieldFilter:
+		return m.OldFilter(ctx)
+	case savedsearch.FieldSort:
+		return m.OldSort(ctx)
+	case savedsearch.FieldSortOrder:
+		return m.OldSortOrder(ctx)
+	case savedsearch.FieldSeries:
+		return m.OldSeries(ctx)
+	case savedsearch.FieldIncludeTags:
+		return m.OldIncludeTags(ctx)
+	case savedsearch.FieldTagMatch:
+		return m.OldTagMatch(ctx)
+	case savedsearch.FieldExcludeTags:
+		return m.OldExcludeTags(ctx)
+	case savedsearch.FieldRandomSeed:
+		return m.OldRandomSeed(ctx)
+	case savedsearch.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case savedsearch.FieldLastOpenTime:
+		return m.OldLastOpenTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown SavedSearch field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SavedSearchMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case savedsearch.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case savedsearch.FieldSearch:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSearch(v)
+		return nil
+	case savedsearch.FieldQuery:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuery(v)
+		return nil
+	case savedsearch.FieldFilter:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilter(v)
+		return nil
+	case savedsearch.FieldSort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSort(v)
+		return nil
+	case savedsearch.FieldSortOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSortOrder(v)
+		return nil
+	case savedsearch.FieldSeries:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeries(v)
+		return nil
+	case savedsearch.FieldIncludeTags:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIncludeTags(v)
+		return nil
+	case savedsearch.FieldTagMatch:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagMatch(v)
+		return nil
+	case savedsearch.FieldExcludeTags:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExcludeTags(v)
+		return nil
+	case savedsearch.FieldRandomSeed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRandomSeed(v)
+		return nil
+	case savedsearch.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case savedsearch.FieldLastOpenTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastOpenTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SavedSearch field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SavedSearchMutation) AddedFields() []string {
+	var fields []string
+	if m.addfilter != nil {
+		fields = append(fields, savedsearch.FieldFilter)
+	}
+	if m.addsort != nil {
+		fields = append(fields, savedsearch.FieldSort)
+	}
+	if m.addsort_order != nil {
+		fields = append(fields, savedsearch.FieldSortOrder)
+	}
+	if m.addtag_match != nil {
+		fields = append(fields, savedsearch.FieldTagMatch)
+	}
+	if m.addrandom_seed != nil {
+		fields = append(fields, savedsearch.FieldRandomSeed)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SavedSearchMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case savedsearch.FieldFilter:
+		return m.AddedFilter()
+	case savedsearch.FieldSort:
+		return m.AddedSort()
+	case savedsearch.FieldSortOrder:
+		return m.AddedSortOrder()
+	case savedsearch.FieldTagMatch:
+		return m.AddedTagMatch()
+	case savedsearch.FieldRandomSeed:
+		return m.AddedRandomSeed()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SavedSearchMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case savedsearch.FieldFilter:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFilter(v)
+		return nil
+	case savedsearch.FieldSort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSort(v)
+		return nil
+	case savedsearch.FieldSortOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSortOrder(v)
+		return nil
+	case savedsearch.FieldTagMatch:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTagMatch(v)
+		return nil
+	case savedsearch.FieldRandomSeed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRandomSeed(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SavedSearch numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SavedSearchMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(savedsearch.FieldSearch) {
+		fields = append(fields, savedsearch.FieldSearch)
+	}
+	if m.FieldCleared(savedsearch.FieldQuery) {
+		fields = append(fields, savedsearch.FieldQuery)
+	}
+	if m.FieldCleared(savedsearch.FieldSeries) {
+		fields = append(fields, savedsearch.FieldSeries)
+	}
+	if m.FieldCleared(savedsearch.FieldIncludeTags) {
+		fields = append(fields, savedsearch.FieldIncludeTags)
+	}
+	if m.FieldCleared(savedsearch.FieldExcludeTags) {
+		fields = append(fields, savedsearch.FieldExcludeTags)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SavedSearchMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SavedSearchMutation) ClearField(name string) error {
+	switch name {
+	case savedsearch.FieldSearch:
+		m.ClearSearch()
+		return nil
+	case savedsearch.FieldQuery:
+		m.ClearQuery()
+		return nil
+	case savedsearch.FieldSeries:
+		m.ClearSeries()
+		return nil
+	case savedsearch.FieldIncludeTags:
+		m.ClearIncludeTags()
+		return nil
+	case savedsearch.FieldExcludeTags:
+		m.ClearExcludeTags()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedSearch nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SavedSearchMutation) ResetField(name string) error {
+	switch name {
+	case savedsearch.FieldName:
+		m.ResetName()
+		return nil
+	case savedsearch.FieldSearch:
+		m.ResetSearch()
+		return nil
+	case savedsearch.FieldQuery:
+		m.ResetQuery()
+		return nil
+	case savedsearch.FieldFilter:
+		m.ResetFilter()
+		return nil
+	case savedsearch.FieldSort:
+		m.ResetSort()
+		return nil
+	case savedsearch.FieldSortOrder:
+		m.ResetSortOrder()
+		return nil
+	case savedsearch.FieldSeries:
+		m.ResetSeries()
+		return nil
+	case savedsearch.FieldIncludeTags:
+		m.ResetIncludeTags()
+		return nil
+	case savedsearch.FieldTagMatch:
+		m.ResetTagMatch()
+		return nil
+	case savedsearch.FieldExcludeTags:
+		m.ResetExcludeTags()
+		return nil
+	case savedsearch.FieldRandomSeed:
+		m.ResetRandomSeed()
+		return nil
+	case savedsearch.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case savedsearch.FieldLastOpenTime:
+		m.ResetLastOpenTime()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedSearch field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SavedSearchMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, savedsearch.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SavedSearchMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case savedsearch.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SavedSearchMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SavedSearchMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SavedSearchMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, savedsearch.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SavedSearchMutation) EdgeCleared(name string) bool {
+	switch name {
+	case savedsearch.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SavedSearchMutation) ClearEdge(name string) error {
+	switch name {
+	case savedsearch.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedSearch unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SavedSearchMutation) ResetEdge(name string) error {
+	switch name {
+	case savedsearch.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedSearch edge %s", name)
+}
+
+// SessionMutation represents an operation that mutates the Session nodes in the graph.
+type SessionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	token_hash    *string
+	create_time   *time.Time
+	expire_time   *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*Session, error)
+	predicates    []predicate.Session
+}
+
+var _ ent.Mutation = (*SessionMutation)(nil)
+
+// sessionOption allows management of the mutation configuration using functional options.
+type sessionOption func(*SessionMutation)
+
+// newSessionMutation creates new mutation for the Session entity.
+func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
+	m := &SessionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSession,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSessionID sets the ID field of the mutation.
+func withSessionID(id int) sessionOption {
+	return func(m *SessionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Session
+		)
+		m.oldValue = func(ctx context.Context) (*Session, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Session.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSession sets the old Session of the mutation.
+func withSession(node *Session) sessionOption {
+	return func(m *SessionMutation) {
+		m.oldValue = func(context.Context) (*Session, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SessionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SessionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SessionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SessionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (m *SessionMutation) SetTokenHash(s string) {
+	m.token_hash = &s
+}
+
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *SessionMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenHash returns the old "token_hash" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
+}
+
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *SessionMutation) ResetTokenHash() {
+	m.token_hash = nil
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *SessionMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *SessionMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *SessionMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetExpireTime sets the "expire_time" field.
+func (m *SessionMutation) SetExpireTime(t time.Time) {
+	m.expire_time = &t
+}
+
+// ExpireTime returns the value of the "expire_time" field in the mutation.
+func (m *SessionMutation) ExpireTime() (r time.Time, exists bool) {
+	v := m.expire_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpireTime returns the old "expire_time" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldExpireTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpireTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpireTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpireTime: %w", err)
+	}
+	return oldValue.ExpireTime, nil
+}
+
+// ResetExpireTime resets all changes to the "expire_time" field.
+func (m *SessionMutation) ResetExpireTime() {
+	m.expire_time = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *SessionMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SessionMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SessionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *SessionMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SessionMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *SessionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the SessionMutation builder.
+func (m *SessionMutation) Where(ps ...predicate.Session) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Session, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SessionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SessionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Session).
+func (m *SessionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SessionMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.token_hash != nil {
+		fields = append(fields, session.FieldTokenHash)
+	}
+	if m.create_time != nil {
+		fields = append(fields, session.FieldCreateTime)
+	}
+	if m.expire_time != nil {
+		fields = append(fields, session.FieldExpireTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case session.FieldTokenHash:
+		return m.TokenHash()
+	case session.FieldCreateTime:
+		return m.CreateTime()
+	case session.FieldExpireTime:
+		return m.ExpireTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case session.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case session.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case session.FieldExpireTime:
+		return m.OldExpireTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown Session field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case session.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case session.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case session.FieldExpireTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpireTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Session field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SessionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Session numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SessionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SessionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Session nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SessionMutation) ResetField(name string) error {
+	switch name {
+	case session.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case session.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case session.FieldExpireTime:
+		m.ResetExpireTime()
+		return nil
+	}
+	return fmt.Errorf("unknown Session field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, session.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SessionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case session.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, session.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SessionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case session.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SessionMutation) ClearEdge(name string) error {
+	switch name {
+	case session.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Session unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SessionMutation) ResetEdge(name string) error {
+	switch name {
+	case session.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Session edge %s", name)
+}
+
+// TagMutation represents an operation that mutates the Tag nodes in the graph.
+type TagMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	name                    *string
+	favorite                *bool
+	hidden                  *bool
+	hide_items              *bool
+	active                  *bool
+	last_update             *time.Time
+	normalized_name         *string
+	search_key              *string
+	category                *tag.Category
+	description             *string
+	links                   *[]string
+	appendlinks             []string
+	clearedFields           map[string]struct{}
+	meta                    map[int]struct{}
+	removedmeta             map[int]struct{}
+	clearedmeta             bool
+	excluded_from           map[int]struct{}
+	removedexcluded_from    map[int]struct{}
+	clearedexcluded_from    bool
+	favorite_of_user        map[int]struct{}
+	removedfavorite_of_user map[int]struct{}
+	clearedfavorite_of_user bool
+	blocked_by_user         map[int]struct{}
+	removedblocked_by_user  map[int]struct{}
+	clearedblocked_by_user  bool
+	denied_to_user          map[int]struct{}
+	removeddenied_to_user   map[int]struct{}
+	cleareddenied_to_user   bool
+	aliases                 map[int]struct{}
+	removedaliases          map[int]struct{}
+	clearedaliases          bool
+	done                    bool
+	oldValue                func(context.Context) (*Tag, error)
+	predicates              []predicate.Tag
+}
+
+var _ ent.Mutation = (*TagMutation)(nil)
+
+// tagOption allows management of the mutation configuration using functional options.
+type tagOption func(*TagMutation)
+
+// newTagMutation creates new mutation for the Tag entity.
+func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
+	m := &TagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTagID sets the ID field of the mutation.
+func withTagID(id int) tagOption {
+	return func(m *TagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tag
+		)
+		m.oldValue = func(ctx context.Context) (*Tag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTag sets the old Tag of the mutation.
+func withTag(node *Tag) tagOption {
+	return func(m *TagMutation) {
+		m.oldValue = func(context.Context) (*Tag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TagMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TagMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *TagMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TagMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TagMutation) ResetName() {
+	m.name = nil
+}
+
+// SetFavorite sets the "favorite" field.
+func (m *TagMutation) SetFavorite(b bool) {
+	m.favorite = &b
+}
+
+// Favorite returns the value of the "favorite" field in the mutation.
+func (m *TagMutation) Favorite() (r bool, exists bool) {
+	v := m.favorite
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContainerType returns the old "container_type" field's value of the Meta entity.
-// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// OldFavorite returns the old "favorite" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetaMutation) OldContainerType(ctx context.Context) (v meta.ContainerType, err error) {
+func (m *TagMutation) OldFavorite(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContainerType is only allowed on UpdateOne operations")
+		return v, errors.New("OldFavorite is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContainerType requires an ID field in the mutation")
+		return v, errors.New("OldFavorite requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContainerType: %w", err)
+		return v, fmt.Errorf("querying old value for OldFavorite: %w", err)
 	}
-	return oldValue.ContainerType, nil
+	return oldValue.Favorite, nil
 }
 
-// ResetContainerType resets all changes to the "container_type" field.
-func (m *MetaMutation) ResetContainerType() {
-	m.container_type = nil
+// ResetFavorite resets all changes to the "favorite" field.
+func (m *TagMutation) ResetFavorite() {
+	m.favorite = nil
 }
 
-// SetThumbnailIndex sets the "thumbnail_index" field.
-func (m *MetaMutation) SetThumbnailIndex(i int) {
-	m.thumbnail_index = &i
-	m.addthumbnail_index = nil
+// SetHidden sets the "hidden" field.
+func (m *TagMutation) SetHidden(b bool) {
+	m.hidden = &b
 }
 
-// ThumbnailIndex returns the value of the "thumbnail_index" field in the mutation.
-func (m *MetaMutation) ThumbnailIndex() (r int, exists bool) {
-	v := m.thumbnail_index
+// Hidden returns the value of the "hidden" field in the mutation.
+func (m *TagMutation) Hidden() (r bool, exists bool) {
+	v := m.hidden
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldThumbnailIndex returns the old "thumbnail_index" field's value of the Meta entity.
-// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// OldHidden returns the old "hidden" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetaMutation) OldThumbnailIndex(ctx context.Context) (v int, err error) {
+func (m *TagMutation) OldHidden(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldThumbnailIndex is only allowed on UpdateOne operations")
+		return v, errors.New("OldHidden is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldThumbnailIndex requires an ID field in the mutation")
+		return v, errors.New("OldHidden requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldThumbnailIndex: %w", err)
+		return v, fmt.Errorf("querying old value for OldHidden: %w", err)
 	}
-	return oldValue.ThumbnailIndex, nil
+	return oldValue.Hidden, nil
 }
 
-// AddThumbnailIndex adds i to the "thumbnail_index" field.
-func (m *MetaMutation) AddThumbnailIndex(i int) {
-	if m.addthumbnail_index != nil {
-		*m.addthumbnail_index += i
-	} else {
-		m.addthumbnail_index = &i
-	}
+// ResetHidden resets all changes to the "hidden" field.
+func (m *TagMutation) ResetHidden() {
+	m.hidden = nil
 }
 
-// AddedThumbnailIndex returns the value that was added to the "thumbnail_index" field in this mutation.
-func (m *MetaMutation) AddedThumbnailIndex() (r int, exists bool) {
-	v := m.addthumbnail_index
+// SetHideItems sets the "hide_items" field.
+func (m *TagMutation) SetHideItems(b bool) {
+	m.hide_items = &b
+}
+
+// HideItems returns the value of the "hide_items" field in the mutation.
+func (m *TagMutation) HideItems() (r bool, exists bool) {
+	v := m.hide_items
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearThumbnailIndex clears the value of the "thumbnail_index" field.
-func (m *MetaMutation) ClearThumbnailIndex() {
-	m.thumbnail_index = nil
-	m.addthumbnail_index = nil
-	m.clearedFields[meta.FieldThumbnailIndex] = struct{}{}
-}
-
-// ThumbnailIndexCleared returns if the "thumbnail_index" field was cleared in this mutation.
-func (m *MetaMutation) ThumbnailIndexCleared() bool {
-	_, ok := m.clearedFields[meta.FieldThumbnailIndex]
-	return ok
+// OldHideItems returns the old "hide_items" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldHideItems(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHideItems is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHideItems requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHideItems: %w", err)
+	}
+	return oldValue.HideItems, nil
 }
 
-// ResetThumbnailIndex resets all changes to the "thumbnail_index" field.
-func (m *MetaMutation) ResetThumbnailIndex() {
-	m.thumbnail_index = nil
-	m.addthumbnail_index = nil
-	delete(m.clearedFields, meta.FieldThumbnailIndex)
+// ResetHideItems resets all changes to the "hide_items" field.
+func (m *TagMutation) ResetHideItems() {
+	m.hide_items = nil
 }
 
-// SetThumbnailX sets the "thumbnail_x" field.
-func (m *MetaMutation) SetThumbnailX(i int) {
-	m.thumbnail_x = &i
-	m.addthumbnail_x = nil
+// SetActive sets the "active" field.
+func (m *TagMutation) SetActive(b bool) {
+	m.active = &b
 }
 
-// ThumbnailX returns the value of the "thumbnail_x" field in the mutation.
-func (m *MetaMutation) ThumbnailX() (r int, exists bool) {
-	v := m.thumbnail_x
+// Active returns the value of the "active" field in the mutation.
+func (m *TagMutation) Active() (r bool, exists bool) {
+	v := m.active
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldThumbnailX returns the old "thumbnail_x" field's value of the Meta entity.
-// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// OldActive returns the old "active" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetaMutation) OldThumbnailX(ctx context.Context) (v int, err error) {
+func (m *TagMutation) OldActive(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldThumbnailX is only allowed on UpdateOne operations")
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldThumbnailX requires an ID field in the mutation")
+		return v, errors.New("OldActive requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldThumbnailX: %w", err)
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
 	}
-	return oldValue.ThumbnailX, nil
+	return oldValue.Active, nil
 }
 
-// AddThumbnailX adds i to the "thumbnail_x" field.
-func (m *MetaMutation) AddThumbnailX(i int) {
-	if m.addthumbnail_x != nil {
-		*m.addthumbnail_x += i
-	} else {
-		m.addthumbnail_x = &i
-	}
+// ResetActive resets all changes to the "active" field.
+func (m *TagMutation) ResetActive() {
+	m.active = nil
 }
 
-// AddedThumbnailX returns the value that was added to the "thumbnail_x" field in this mutation.
-func (m *MetaMutation) AddedThumbnailX() (r int, exists bool) {
-	v := m.addthumbnail_x
+// SetLastUpdate sets the "last_update" field.
+func (m *TagMutation) SetLastUpdate(t time.Time) {
+	m.last_update = &t
+}
+
+// LastUpdate returns the value of the "last_update" field in the mutation.
+func (m *TagMutation) LastUpdate() (r time.Time, exists bool) {
+	v := m.last_update
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearThumbnailX clears the value of the "thumbnail_x" field.
-func (m *MetaMutation) ClearThumbnailX() {
-	m.thumbnail_x = nil
-	m.addthumbnail_x = nil
-	m.clearedFields[meta.FieldThumbnailX] = struct{}{}
+// OldLastUpdate returns the old "last_update" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldLastUpdate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUpdate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUpdate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUpdate: %w", err)
+	}
+	return oldValue.LastUpdate, nil
 }
 
-// ThumbnailXCleared returns if the "thumbnail_x" field was cleared in this mutation.
-func (m *MetaMutation) ThumbnailXCleared() bool {
-	_, ok := m.clearedFields[meta.FieldThumbnailX]
+// ClearLastUpdate clears the value of the "last_update" field.
+func (m *TagMutation) ClearLastUpdate() {
+	m.last_update = nil
+	m.clearedFields[tag.FieldLastUpdate] = struct{}{}
+}
+
+// LastUpdateCleared returns if the "last_update" field was cleared in this mutation.
+func (m *TagMutation) LastUpdateCleared() bool {
+	_, ok := m.clearedFields[tag.FieldLastUpdate]
 	return ok
 }
 
-// ResetThumbnailX resets all changes to the "thumbnail_x" field.
-func (m *MetaMutation) ResetThumbnailX() {
-	m.thumbnail_x = nil
-	m.addthumbnail_x = nil
-	delete(m.clearedFields, meta.FieldThumbnailX)
+// ResetLastUpdate resets all changes to the "last_update" field.
+func (m *TagMutation) ResetLastUpdate() {
+	m.last_update = nil
+	delete(m.clearedFields, tag.FieldLastUpdate)
 }
 
-// SetThumbnailY sets the "thumbnail_y" field.
-func (m *MetaMutation) SetThumbnailY(i int) {
-	m.thumbnail_y = &i
-	m.addthumbnail_y = nil
+// SetNormalizedName sets the "normalized_name" field.
+func (m *TagMutation) SetNormalizedName(s string) {
+	m.normalized_name = &s
 }
 
-// ThumbnailY returns the value of the "thumbnail_y" field in the mutation.
-func (m *MetaMutation) ThumbnailY() (r int, exists bool) {
-	v := m.thumbnail_y
+// NormalizedName returns the value of the "normalized_name" field in the mutation.
+func (m *TagMutation) NormalizedName() (r string, exists bool) {
+	v := m.normalized_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldThumbnailY returns the old "thumbnail_y" field's value of the Meta entity.
-// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// OldNormalizedName returns the old "normalized_name" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetaMutation) OldThumbnailY(ctx context.Context) (v int, err error) {
+func (m *TagMutation) OldNormalizedName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldThumbnailY is only allowed on UpdateOne operations")
+		return v, errors.New("OldNormalizedName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldThumbnailY requires an ID field in the mutation")
+		return v, errors.New("OldNormalizedName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldThumbnailY: %w", err)
+		return v, fmt.Errorf("querying old value for OldNormalizedName: %w", err)
 	}
-	return oldValue.ThumbnailY, nil
+	return oldValue.NormalizedName, nil
 }
 
-// AddThumbnailY adds i to the "thumbnail_y" field.
-func (m *MetaMutation) AddThumbnailY(i int) {
-	if m.addthumbnail_y != nil {
-		*m.addthumbnail_y += i
-	} else {
-		m.addthumbnail_y = &i
-	}
+// ClearNormalizedName clears the value of the "normalized_name" field.
+func (m *TagMutation) ClearNormalizedName() {
+	m.normalized_name = nil
+	m.clearedFields[tag.FieldNormalizedName] = struct{}{}
 }
 
-// AddedThumbnailY returns the value that was added to the "thumbnail_y" field in this mutation.
-func (m *MetaMutation) AddedThumbnailY() (r int, exists bool) {
-	v := m.addthumbnail_y
+// NormalizedNameCleared returns if the "normalized_name" field was cleared in this mutation.
+func (m *TagMutation) NormalizedNameCleared() bool {
+	_, ok := m.clearedFields[tag.FieldNormalizedName]
+	return ok
+}
+
+// ResetNormalizedName resets all changes to the "normalized_name" field.
+func (m *TagMutation) ResetNormalizedName() {
+	m.normalized_name = nil
+	delete(m.clearedFields, tag.FieldNormalizedName)
+}
+
+// SetSearchKey sets the "search_key" field.
+func (m *TagMutation) SetSearchKey(s string) {
+	m.search_key = &s
+}
+
+// SearchKey returns the value of the "search_key" field in the mutation.
+func (m *TagMutation) SearchKey() (r string, exists bool) {
+	v := m.search_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearThumbnailY clears the value of the "thumbnail_y" field.
-func (m *MetaMutation) ClearThumbnailY() {
-	m.thumbnail_y = nil
-	m.addthumbnail_y = nil
-	m.clearedFields[meta.FieldThumbnailY] = struct{}{}
+// OldSearchKey returns the old "search_key" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldSearchKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSearchKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSearchKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSearchKey: %w", err)
+	}
+	return oldValue.SearchKey, nil
 }
 
-// ThumbnailYCleared returns if the "thumbnail_y" field was cleared in this mutation.
-func (m *MetaMutation) ThumbnailYCleared() bool {
-	_, ok := m.clearedFields[meta.FieldThumbnailY]
+// ClearSearchKey clears the value of the "search_key" field.
+func (m *TagMutation) ClearSearchKey() {
+	m.search_key = nil
+	m.clearedFields[tag.FieldSearchKey] = struct{}{}
+}
+
+// SearchKeyCleared returns if the "search_key" field was cleared in this mutation.
+func (m *TagMutation) SearchKeyCleared() bool {
+	_, ok := m.clearedFields[tag.FieldSearchKey]
 	return ok
 }
 
-// ResetThumbnailY resets all changes to the "thumbnail_y" field.
-func (m *MetaMutation) ResetThumbnailY() {
-	m.thumbnail_y = nil
-	m.addthumbnail_y = nil
-	delete(m.clearedFields, meta.FieldThumbnailY)
+// ResetSearchKey resets all changes to the "search_key" field.
+func (m *TagMutation) ResetSearchKey() {
+	m.search_key = nil
+	delete(m.clearedFields, tag.FieldSearchKey)
 }
 
-// SetThumbnailWidth sets the "thumbnail_width" field.
-func (m *MetaMutation) SetThumbnailWidth(i int) {
-	m.thumbnail_width = &i
-	m.addthumbnail_width = nil
+// SetCategory sets the "category" field.
+func (m *TagMutation) SetCategory(t tag.Category) {
+	m.category = &t
 }
 
-// ThumbnailWidth returns the value of the "thumbnail_width" field in the mutation.
-func (m *MetaMutation) ThumbnailWidth() (r int, exists bool) {
-	v := m.thumbnail_width
+// Category returns the value of the "category" field in the mutation.
+func (m *TagMutation) Category() (r tag.Category, exists bool) {
+	v := m.category
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldThumbnailWidth returns the old "thumbnail_width" field's value of the Meta entity.
-// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// OldCategory returns the old "category" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetaMutation) OldThumbnailWidth(ctx context.Context) (v int, err error) {
+func (m *TagMutation) OldCategory(ctx context.Context) (v tag.Category, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldThumbnailWidth is only allowed on UpdateOne operations")
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldThumbnailWidth requires an ID field in the mutation")
+		return v, errors.New("OldCategory requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldThumbnailWidth: %w", err)
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
 	}
-	return oldValue.ThumbnailWidth, nil
+	return oldValue.Category, nil
 }
 
-// AddThumbnailWidth adds i to the "thumbnail_width" field.
-func (m *MetaMutation) AddThumbnailWidth(i int) {
-	if m.addthumbnail_width != nil {
-		*m.addthumbnail_width += i
-	} else {
-		m.addthumbnail_width = &i
-	}
+// ClearCategory clears the value of the "category" field.
+func (m *TagMutation) ClearCategory() {
+	m.category = nil
+	m.clearedFields[tag.FieldCategory] = struct{}{}
+}
+
+// CategoryCleared returns if the "category" field was cleared in this mutation.
+func (m *TagMutation) CategoryCleared() bool {
+	_, ok := m.clearedFields[tag.FieldCategory]
+	return ok
 }
 
-// AddedThumbnailWidth returns the value that was added to the "thumbnail_width" field in this mutation.
-func (m *MetaMutation) AddedThumbnailWidth() (r int, exists bool) {
-	v := m.addthumbnail_width
+// ResetCategory resets all changes to the "category" field.
+func (m *TagMutation) ResetCategory() {
+	m.category = nil
+	delete(m.clearedFields, tag.FieldCategory)
+}
+
+// SetDescription sets the "description" field.
+func (m *TagMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *TagMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearThumbnailWidth clears the value of the "thumbnail_width" field.
-func (m *MetaMutation) ClearThumbnailWidth() {
-	m.thumbnail_width = nil
-	m.addthumbnail_width = nil
-	m.clearedFields[meta.FieldThumbnailWidth] = struct{}{}
+// OldDescription returns the old "description" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
 }
 
-// ThumbnailWidthCleared returns if the "thumbnail_width" field was cleared in this mutation.
-func (m *MetaMutation) ThumbnailWidthCleared() bool {
-	_, ok := m.clearedFields[meta.FieldThumbnailWidth]
+// ClearDescription clears the value of the "description" field.
+func (m *TagMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[tag.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *TagMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[tag.FieldDescription]
 	return ok
 }
 
-// ResetThumbnailWidth resets all changes to the "thumbnail_width" field.
-func (m *MetaMutation) ResetThumbnailWidth() {
-	m.thumbnail_width = nil
-	m.addthumbnail_width = nil
-	delete(m.clearedFields, meta.FieldThumbnailWidth)
+// ResetDescription resets all changes to the "description" field.
+func (m *TagMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, tag.FieldDescription)
 }
 
-// SetThumbnailHeight sets the "thumbnail_height" field.
-func (m *MetaMutation) SetThumbnailHeight(i int) {
-	m.thumbnail_height = &i
-	m.addthumbnail_height = nil
+// SetLinks sets the "links" field.
+func (m *TagMutation) SetLinks(s []string) {
+	m.links = &s
+	m.appendlinks = nil
 }
 
-// ThumbnailHeight returns the value of the "thumbnail_height" field in the mutation.
-func (m *MetaMutation) ThumbnailHeight() (r int, exists bool) {
-	v := m.thumbnail_height
+// Links returns the value of the "links" field in the mutation.
+func (m *TagMutation) Links() (r []string, exists bool) {
+	v := m.links
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldThumbnailHeight returns the old "thumbnail_height" field's value of the Meta entity.
-// If the Meta object wasn't provided to the builder, the object is fetched from the database.
+// OldLinks returns the old "links" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetaMutation) OldThumbnailHeight(ctx context.Context) (v int, err error) {
+func (m *TagMutation) OldLinks(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldThumbnailHeight is only allowed on UpdateOne operations")
+		return v, errors.New("OldLinks is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldThumbnailHeight requires an ID field in the mutation")
+		return v, errors.New("OldLinks requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldThumbnailHeight: %w", err)
+		return v, fmt.Errorf("querying old value for OldLinks: %w", err)
 	}
-	return oldValue.ThumbnailHeight, nil
+	return oldValue.Links, nil
 }
 
-// AddThumbnailHeight adds i to the "thumbnail_height" field.
-func (m *MetaMutation) AddThumbnailHeight(i int) {
-	if m.addthumbnail_height != nil {
-		*m.addthumbnail_height += i
-	} else {
-		m.addthumbnail_height = &i
-	}
+// AppendLinks adds s to the "links" field.
+func (m *TagMutation) AppendLinks(s []string) {
+	m.appendlinks = append(m.appendlinks, s...)
 }
 
-// AddedThumbnailHeight returns the value that was added to the "thumbnail_height" field in this mutation.
-func (m *MetaMutation) AddedThumbnailHeight() (r int, exists bool) {
-	v := m.addthumbnail_height
-	if v == nil {
-		return
+// AppendedLinks returns the list of values that were appended to the "links" field in this mutation.
+func (m *TagMutation) AppendedLinks() ([]string, bool) {
+	if len(m.appendlinks) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appendlinks, true
 }
 
-// ClearThumbnailHeight clears the value of the "thumbnail_height" field.
-func (m *MetaMutation) ClearThumbnailHeight() {
-	m.thumbnail_height = nil
-	m.addthumbnail_height = nil
-	m.clearedFields[meta.FieldThumbnailHeight] = struct{}{}
+// ClearLinks clears the value of the "links" field.
+func (m *TagMutation) ClearLinks() {
+	m.links = nil
+	m.appendlinks = nil
+	m.clearedFields[tag.FieldLinks] = struct{}{}
 }
 
-// ThumbnailHeightCleared returns if the "thumbnail_height" field was cleared in this mutation.
-func (m *MetaMutation) ThumbnailHeightCleared() bool {
-	_, ok := m.clearedFields[meta.FieldThumbnailHeight]
+// LinksCleared returns if the "links" field was cleared in this mutation.
+func (m *TagMutation) LinksCleared() bool {
+	_, ok := m.clearedFields[tag.FieldLinks]
 	return ok
 }
 
-// ResetThumbnailHeight resets all changes to the "thumbnail_height" field.
-func (m *MetaMutation) ResetThumbnailHeight() {
-	m.thumbnail_height = nil
-	m.addthumbnail_height = nil
-	delete(m.clearedFields, meta.FieldThumbnailHeight)
+// ResetLinks resets all changes to the "links" field.
+func (m *TagMutation) ResetLinks() {
+	m.links = nil
+	m.appendlinks = nil
+	delete(m.clearedFields, tag.FieldLinks)
 }
 
-// AddTagIDs adds the "tags" edge to the Tag entity by ids.
-func (m *MetaMutation) AddTagIDs(ids ...int) {
-	if m.tags == nil {
-		m.tags = make(map[int]struct{})
+// AddMetumIDs adds the "meta" edge to the Meta entity by ids.
+func (m *TagMutation) AddMetumIDs(ids ...int) {
+	if m.meta == nil {
+		m.meta = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.tags[ids[i]] = struct{}{}
+		m.meta[ids[i]] = struct{}{}
 	}
 }
 
-// ClearTags clears the "tags" edge to the Tag entity.
-func (m *MetaMutation) ClearTags() {
-	m.clearedtags = true
+// ClearMeta clears the "meta" edge to the Meta entity.
+func (m *TagMutation) ClearMeta() {
+	m.clearedmeta = true
 }
 
-// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
-func (m *MetaMutation) TagsCleared() bool {
-	return m.clearedtags
+// MetaCleared reports if the "meta" edge to the Meta entity was cleared.
+func (m *TagMutation) MetaCleared() bool {
+	return m.clearedmeta
 }
 
-// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
-func (m *MetaMutation) RemoveTagIDs(ids ...int) {
-	if m.removedtags == nil {
-		m.removedtags = make(map[int]struct{})
+// RemoveMetumIDs removes the "meta" edge to the Meta entity by IDs.
+func (m *TagMutation) RemoveMetumIDs(ids ...int) {
+	if m.removedmeta == nil {
+		m.removedmeta = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.tags, ids[i])
-		m.removedtags[ids[i]] = struct{}{}
+		delete(m.meta, ids[i])
+		m.removedmeta[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
-func (m *MetaMutation) RemovedTagsIDs() (ids []int) {
-	for id := range m.removedtags {
+// RemovedMeta returns the removed IDs of the "meta" edge to the Meta entity.
+func (m *TagMutation) RemovedMetaIDs() (ids []int) {
+	for id := range m.removedmeta {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// TagsIDs returns the "tags" edge IDs in the mutation.
-func (m *MetaMutation) TagsIDs() (ids []int) {
-	for id := range m.tags {
+// MetaIDs returns the "meta" edge IDs in the mutation.
+func (m *TagMutation) MetaIDs() (ids []int) {
+	for id := range m.meta {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetTags resets all changes to the "tags" edge.
-func (m *MetaMutation) ResetTags() {
-	m.tags = nil
-	m.clearedtags = false
-	m.removedtags = nil
+// ResetMeta resets all changes to the "meta" edge.
+func (m *TagMutation) ResetMeta() {
+	m.meta = nil
+	m.clearedmeta = false
+	m.removedmeta = nil
 }
 
-// AddHistoryIDs adds the "histories" edge to the History entity by ids.
-func (m *MetaMutation) AddHistoryIDs(ids ...int) {
-	if m.histories == nil {
-		m.histories = make(map[int]struct{})
+// AddExcludedFromIDs adds the "excluded_from" edge to the Meta entity by ids.
+func (m *TagMutation) AddExcludedFromIDs(ids ...int) {
+	if m.excluded_from == nil {
+		m.excluded_from = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.histories[ids[i]] = struct{}{}
+		m.excluded_from[ids[i]] = struct{}{}
 	}
 }
 
-// ClearHistories clears the "histories" edge to the History entity.
-func (m *MetaMutation) ClearHistories() {
-	m.clearedhistories = true
+// ClearExcludedFrom clears the "excluded_from" edge to the Meta entity.
+func (m *TagMutation) ClearExcludedFrom() {
+	m.clearedexcluded_from = true
 }
 
-// HistoriesCleared reports if the "histories" edge to the History entity was cleared.
-func (m *MetaMutation) HistoriesCleared() bool {
-	return m.clearedhistories
+// ExcludedFromCleared reports if the "excluded_from" edge to the Meta entity was cleared.
+func (m *TagMutation) ExcludedFromCleared() bool {
+	return m.clearedexcluded_from
 }
 
-// RemoveHistoryIDs removes the "histories" edge to the History entity by IDs.
-func (m *MetaMutation) RemoveHistoryIDs(ids ...int) {
-	if m.removedhistories == nil {
-		m.removedhistories = make(map[int]struct{})
+// RemoveExcludedFromIDs removes the "excluded_from" edge to the Meta entity by IDs.
+func (m *TagMutation) RemoveExcludedFromIDs(ids ...int) {
+	if m.removedexcluded_from == nil {
+		m.removedexcluded_from = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.histories, ids[i])
-		m.removedhistories[ids[i]] = struct{}{}
+		delete(m.excluded_from, ids[i])
+		m.removedexcluded_from[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedHistories returns the removed IDs of the "histories" edge to the History entity.
-func (m *MetaMutation) RemovedHistoriesIDs() (ids []int) {
-	for id := range m.removedhistories {
+// RemovedExcludedFrom returns the removed IDs of the "excluded_from" edge to the Meta entity.
+func (m *TagMutation) RemovedExcludedFromIDs() (ids []int) {
+	for id := range m.removedexcluded_from {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// HistoriesIDs returns the "histories" edge IDs in the mutation.
-func (m *MetaMutation) HistoriesIDs() (ids []int) {
-	for id := range m.histories {
+// ExcludedFromIDs returns the "excluded_from" edge IDs in the mutation.
+func (m *TagMutation) ExcludedFromIDs() (ids []int) {
+	for id := range m.excluded_from {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetHistories resets all changes to the "histories" edge.
-func (m *MetaMutation) ResetHistories() {
-	m.histories = nil
-	m.clearedhistories = false
-	m.removedhistories = nil
+// ResetExcludedFrom resets all changes to the "excluded_from" edge.
+func (m *TagMutation) ResetExcludedFrom() {
+	m.excluded_from = nil
+	m.clearedexcluded_from = false
+	m.removedexcluded_from = nil
 }
 
 // AddFavoriteOfUserIDs adds the "favorite_of_user" edge to the User entity by ids.
-func (m *MetaMutation) AddFavoriteOfUserIDs(ids ...int) {
+func (m *TagMutation) AddFavoriteOfUserIDs(ids ...int) {
 	if m.favorite_of_user == nil {
 		m.favorite_of_user = make(map[int]struct{})
 	}
@@ -1400,17 +7520,17 @@ func (m *MetaMutation) AddFavoriteOfUserIDs(ids ...int) {
 }
 
 // ClearFavoriteOfUser clears the "favorite_of_user" edge to the User entity.
-func (m *MetaMutation) ClearFavoriteOfUser() {
+func (m *TagMutation) ClearFavoriteOfUser() {
 	m.clearedfavorite_of_user = true
 }
 
 // FavoriteOfUserCleared reports if the "favorite_of_user" edge to the User entity was cleared.
-func (m *MetaMutation) FavoriteOfUserCleared() bool {
+func (m *TagMutation) FavoriteOfUserCleared() bool {
 	return m.clearedfavorite_of_user
 }
 
 // RemoveFavoriteOfUserIDs removes the "favorite_of_user" edge to the User entity by IDs.
-func (m *MetaMutation) RemoveFavoriteOfUserIDs(ids ...int) {
+func (m *TagMutation) RemoveFavoriteOfUserIDs(ids ...int) {
 	if m.removedfavorite_of_user == nil {
 		m.removedfavorite_of_user = make(map[int]struct{})
 	}
@@ -1421,7 +7541,7 @@ func (m *MetaMutation) RemoveFavoriteOfUserIDs(ids ...int) {
 }
 
 // RemovedFavoriteOfUser returns the removed IDs of the "favorite_of_user" edge to the User entity.
-func (m *MetaMutation) RemovedFavoriteOfUserIDs() (ids []int) {
+func (m *TagMutation) RemovedFavoriteOfUserIDs() (ids []int) {
 	for id := range m.removedfavorite_of_user {
 		ids = append(ids, id)
 	}
@@ -1429,7 +7549,7 @@ func (m *MetaMutation) RemovedFavoriteOfUserIDs() (ids []int) {
 }
 
 // FavoriteOfUserIDs returns the "favorite_of_user" edge IDs in the mutation.
-func (m *MetaMutation) FavoriteOfUserIDs() (ids []int) {
+func (m *TagMutation) FavoriteOfUserIDs() (ids []int) {
 	for id := range m.favorite_of_user {
 		ids = append(ids, id)
 	}
@@ -1437,75 +7557,183 @@ func (m *MetaMutation) FavoriteOfUserIDs() (ids []int) {
 }
 
 // ResetFavoriteOfUser resets all changes to the "favorite_of_user" edge.
-func (m *MetaMutation) ResetFavoriteOfUser() {
+func (m *TagMutation) ResetFavoriteOfUser() {
 	m.favorite_of_user = nil
 	m.clearedfavorite_of_user = false
 	m.removedfavorite_of_user = nil
 }
 
-// AddProgresIDs adds the "progress" edge to the Progress entity by ids.
-func (m *MetaMutation) AddProgresIDs(ids ...int) {
-	if m.progress == nil {
-		m.progress = make(map[int]struct{})
+// AddBlockedByUserIDs adds the "blocked_by_user" edge to the User entity by ids.
+func (m *TagMutation) AddBlockedByUserIDs(ids ...int) {
+	if m.blocked_by_user == nil {
+		m.blocked_by_user = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.progress[ids[i]] = struct{}{}
+		m.blocked_by_user[ids[i]] = struct{}{}
 	}
 }
 
-// ClearProgress clears the "progress" edge to the Progress entity.
-func (m *MetaMutation) ClearProgress() {
-	m.clearedprogress = true
+// ClearBlockedByUser clears the "blocked_by_user" edge to the User entity.
+func (m *TagMutation) ClearBlockedByUser() {
+	m.clearedblocked_by_user = true
 }
 
-// ProgressCleared reports if the "progress" edge to the Progress entity was cleared.
-func (m *MetaMutation) ProgressCleared() bool {
-	return m.clearedprogress
+// BlockedByUserCleared reports if the "blocked_by_user" edge to the User entity was cleared.
+func (m *TagMutation) BlockedByUserCleared() bool {
+	return m.clearedblocked_by_user
 }
 
-// RemoveProgresIDs removes the "progress" edge to the Progress entity by IDs.
-func (m *MetaMutation) RemoveProgresIDs(ids ...int) {
-	if m.removedprogress == nil {
-		m.removedprogress = make(map[int]struct{})
+// RemoveBlockedByUserIDs removes the "blocked_by_user" edge to the User entity by IDs.
+func (m *TagMutation) RemoveBlockedByUserIDs(ids ...int) {
+	if m.removedblocked_by_user == nil {
+		m.removedblocked_by_user = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.progress, ids[i])
-		m.removedprogress[ids[i]] = struct{}{}
+		delete(m.blocked_by_user, ids[i])
+		m.removedblocked_by_user[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedProgress returns the removed IDs of the "progress" edge to the Progress entity.
-func (m *MetaMutation) RemovedProgressIDs() (ids []int) {
-	for id := range m.removedprogress {
+// RemovedBlockedByUser returns the removed IDs of the "blocked_by_user" edge to the User entity.
+func (m *TagMutation) RemovedBlockedByUserIDs() (ids []int) {
+	for id := range m.removedblocked_by_user {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ProgressIDs returns the "progress" edge IDs in the mutation.
-func (m *MetaMutation) ProgressIDs() (ids []int) {
-	for id := range m.progress {
+// BlockedByUserIDs returns the "blocked_by_user" edge IDs in the mutation.
+func (m *TagMutation) BlockedByUserIDs() (ids []int) {
+	for id := range m.blocked_by_user {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetProgress resets all changes to the "progress" edge.
-func (m *MetaMutation) ResetProgress() {
-	m.progress = nil
-	m.clearedprogress = false
-	m.removedprogress = nil
+// ResetBlockedByUser resets all changes to the "blocked_by_user" edge.
+func (m *TagMutation) ResetBlockedByUser() {
+	m.blocked_by_user = nil
+	m.clearedblocked_by_user = false
+	m.removedblocked_by_user = nil
 }
 
-// Where appends a list predicates to the MetaMutation builder.
-func (m *MetaMutation) Where(ps ...predicate.Meta) {
+// AddDeniedToUserIDs adds the "denied_to_user" edge to the User entity by ids.
+func (m *TagMutation) AddDeniedToUserIDs(ids ...int) {
+	if m.denied_to_user == nil {
+		m.denied_to_user = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.denied_to_user[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDeniedToUser clears the "denied_to_user" edge to the User entity.
+func (m *TagMutation) ClearDeniedToUser() {
+	m.cleareddenied_to_user = true
+}
+
+// DeniedToUserCleared reports if the "denied_to_user" edge to the User entity was cleared.
+func (m *TagMutation) DeniedToUserCleared() bool {
+	return m.cleareddenied_to_user
+}
+
+// RemoveDeniedToUserIDs removes the "denied_to_user" edge to the User entity by IDs.
+func (m *TagMutation) RemoveDeniedToUserIDs(ids ...int) {
+	if m.removeddenied_to_user == nil {
+		m.removeddenied_to_user = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.denied_to_user, ids[i])
+		m.removeddenied_to_user[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDeniedToUser returns the removed IDs of the "denied_to_user" edge to the User entity.
+func (m *TagMutation) RemovedDeniedToUserIDs() (ids []int) {
+	for id := range m.removeddenied_to_user {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DeniedToUserIDs returns the "denied_to_user" edge IDs in the mutation.
+func (m *TagMutation) DeniedToUserIDs() (ids []int) {
+	for id := range m.denied_to_user {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDeniedToUser resets all changes to the "denied_to_user" edge.
+func (m *TagMutation) ResetDeniedToUser() {
+	m.denied_to_user = nil
+	m.cleareddenied_to_user = false
+	m.removeddenied_to_user = nil
+}
+
+// AddAliasIDs adds the "aliases" edge to the TagAlias entity by ids.
+func (m *TagMutation) AddAliasIDs(ids ...int) {
+	if m.aliases == nil {
+		m.aliases = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.aliases[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAliases clears the "aliases" edge to the TagAlias entity.
+func (m *TagMutation) ClearAliases() {
+	m.clearedaliases = true
+}
+
+// AliasesCleared reports if the "aliases" edge to the TagAlias entity was cleared.
+func (m *TagMutation) AliasesCleared() bool {
+	return m.clearedaliases
+}
+
+// RemoveAliasIDs removes the "aliases" edge to the TagAlias entity by IDs.
+func (m *TagMutation) RemoveAliasIDs(ids ...int) {
+	if m.removedaliases == nil {
+		m.removedaliases = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.aliases, ids[i])
+		m.removedaliases[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAliases returns the removed IDs of the "aliases" edge to the TagAlias entity.
+func (m *TagMutation) RemovedAliasesIDs() (ids []int) {
+	for id := range m.removedaliases {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AliasesIDs returns the "aliases" edge IDs in the mutation.
+func (m *TagMutation) AliasesIDs() (ids []int) {
+	for id := range m.aliases {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAliases resets all changes to the "aliases" edge.
+func (m *TagMutation) ResetAliases() {
+	m.aliases = nil
+	m.clearedaliases = false
+	m.removedaliases = nil
+}
+
+// Where appends a list predicates to the TagMutation builder.
+func (m *TagMutation) Where(ps ...predicate.Tag) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MetaMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TagMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MetaMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Meta, len(ps))
+func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tag, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1513,63 +7741,57 @@ func (m *MetaMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MetaMutation) Op() Op {
+func (m *TagMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MetaMutation) SetOp(op Op) {
+func (m *TagMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Meta).
-func (m *MetaMutation) Type() string {
+// Type returns the node type of this mutation (Tag).
+func (m *TagMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MetaMutation) Fields() []string {
-	fields := make([]string, 0, 13)
+func (m *TagMutation) Fields() []string {
+	fields := make([]string, 0, 11)
 	if m.name != nil {
-		fields = append(fields, meta.FieldName)
-	}
-	if m.create_time != nil {
-		fields = append(fields, meta.FieldCreateTime)
+		fields = append(fields, tag.FieldName)
 	}
 	if m.favorite != nil {
-		fields = append(fields, meta.FieldFavorite)
+		fields = append(fields, tag.FieldFavorite)
 	}
-	if m.file_indices != nil {
-		fields = append(fields, meta.FieldFileIndices)
+	if m.hidden != nil {
+		fields = append(fields, tag.FieldHidden)
 	}
-	if m.read != nil {
-		fields = append(fields, meta.FieldRead)
+	if m.hide_items != nil {
+		fields = append(fields, tag.FieldHideItems)
 	}
 	if m.active != nil {
-		fields = append(fields, meta.FieldActive)
+		fields = append(fields, tag.FieldActive)
 	}
-	if m.hidden != nil {
-		fields = append(fields, meta.FieldHidden)
-	}
-	if m.container_type != nil {
-		fields = append(fields, meta.FieldContainerType)
+	if m.last_update != nil {
+		fields = append(fields, tag.FieldLastUpdate)
 	}
-	if m.thumbnail_index != nil {
-		fields = append(fields, meta.FieldThumbnailIndex)
+	if m.normalized_name != nil {
+		fields = append(fields, tag.FieldNormalizedName)
 	}
-	if m.thumbnail_x != nil {
-		fields = append(fields, meta.FieldThumbnailX)
+	if m.search_key != nil {
+		fields = append(fields, tag.FieldSearchKey)
 	}
-	if m.thumbnail_y != nil {
-		fields = append(fields, meta.FieldThumbnailY)
+	if m.category != nil {
+		fields = append(fields, tag.FieldCategory)
 	}
-	if m.thumbnail_width != nil {
-		fields = append(fields, meta.FieldThumbnailWidth)
+	if m.description != nil {
+		fields = append(fields, tag.FieldDescription)
 	}
-	if m.thumbnail_height != nil {
-		fields = append(fields, meta.FieldThumbnailHeight)
+	if m.links != nil {
+		fields = append(fields, tag.FieldLinks)
 	}
 	return fields
 }
@@ -1577,34 +7799,30 @@ func (m *MetaMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MetaMutation) Field(name string) (ent.Value, bool) {
+func (m *TagMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case meta.FieldName:
+	case tag.FieldName:
 		return m.Name()
-	case meta.FieldCreateTime:
-		return m.CreateTime()
-	case meta.FieldFavorite:
+	case tag.FieldFavorite:
 		return m.Favorite()
-	case meta.FieldFileIndices:
-		return m.FileIndices()
-	case meta.FieldRead:
-		return m.Read()
-	case meta.FieldActive:
-		return m.Active()
-	case meta.FieldHidden:
+	case tag.FieldHidden:
 		return m.Hidden()
-	case meta.FieldContainerType:
-		return m.ContainerType()
-	case meta.FieldThumbnailIndex:
-		return m.ThumbnailIndex()
-	case meta.FieldThumbnailX:
-		return m.ThumbnailX()
-	case meta.FieldThumbnailY:
-		return m.ThumbnailY()
-	case meta.FieldThumbnailWidth:
-		return m.ThumbnailWidth()
-	case meta.FieldThumbnailHeight:
-		return m.ThumbnailHeight()
+	case tag.FieldHideItems:
+		return m.HideItems()
+	case tag.FieldActive:
+		return m.Active()
+	case tag.FieldLastUpdate:
+		return m.LastUpdate()
+	case tag.FieldNormalizedName:
+		return m.NormalizedName()
+	case tag.FieldSearchKey:
+		return m.SearchKey()
+	case tag.FieldCategory:
+		return m.Category()
+	case tag.FieldDescription:
+		return m.Description()
+	case tag.FieldLinks:
+		return m.Links()
 	}
 	return nil, false
 }
@@ -1612,365 +7830,302 @@ func (m *MetaMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MetaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case meta.FieldName:
+	case tag.FieldName:
 		return m.OldName(ctx)
-	case meta.FieldCreateTime:
-		return m.OldCreateTime(ctx)
-	case meta.FieldFavorite:
+	case tag.FieldFavorite:
 		return m.OldFavorite(ctx)
-	case meta.FieldFileIndices:
-		return m.OldFileIndices(ctx)
-	case meta.FieldRead:
-		return m.OldRead(ctx)
-	case meta.FieldActive:
-		return m.OldActive(ctx)
-	case meta.FieldHidden:
+	case tag.FieldHidden:
 		return m.OldHidden(ctx)
-	case meta.FieldContainerType:
-		return m.OldContainerType(ctx)
-	case meta.FieldThumbnailIndex:
-		return m.OldThumbnailIndex(ctx)
-	case meta.FieldThumbnailX:
-		return m.OldThumbnailX(ctx)
-	case meta.FieldThumbnailY:
-		return m.OldThumbnailY(ctx)
-	case meta.FieldThumbnailWidth:
-		return m.OldThumbnailWidth(ctx)
-	case meta.FieldThumbnailHeight:
-		return m.OldThumbnailHeight(ctx)
+	case tag.FieldHideItems:
+		return m.OldHideItems(ctx)
+	case tag.FieldActive:
+		return m.OldActive(ctx)
+	case tag.FieldLastUpdate:
+		return m.OldLastUpdate(ctx)
+	case tag.FieldNormalizedName:
+		return m.OldNormalizedName(ctx)
+	case tag.FieldSearchKey:
+		return m.OldSearchKey(ctx)
+	case tag.FieldCategory:
+		return m.OldCategory(ctx)
+	case tag.FieldDescription:
+		return m.OldDescription(ctx)
+	case tag.FieldLinks:
+		return m.OldLinks(ctx)
 	}
-	return nil, fmt.Errorf("unknown Meta field %s", name)
+	return nil, fmt.Errorf("unknown Tag field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MetaMutation) SetField(name string, value ent.Value) error {
+func (m *TagMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case meta.FieldName:
+	case tag.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case meta.FieldCreateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateTime(v)
-		return nil
-	case meta.FieldFavorite:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFavorite(v)
-		return nil
-	case meta.FieldFileIndices:
-		v, ok := value.([]int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFileIndices(v)
-		return nil
-	case meta.FieldRead:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRead(v)
-		return nil
-	case meta.FieldActive:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetActive(v)
-		return nil
-	case meta.FieldHidden:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetHidden(v)
-		return nil
-	case meta.FieldContainerType:
-		v, ok := value.(meta.ContainerType)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetContainerType(v)
-		return nil
-	case meta.FieldThumbnailIndex:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetThumbnailIndex(v)
-		return nil
-	case meta.FieldThumbnailX:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetThumbnailX(v)
-		return nil
-	case meta.FieldThumbnailY:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetThumbnailY(v)
-		return nil
-	case meta.FieldThumbnailWidth:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetThumbnailWidth(v)
-		return nil
-	case meta.FieldThumbnailHeight:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetThumbnailHeight(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Meta field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *MetaMutation) AddedFields() []string {
-	var fields []string
-	if m.addthumbnail_index != nil {
-		fields = append(fields, meta.FieldThumbnailIndex)
-	}
-	if m.addthumbnail_x != nil {
-		fields = append(fields, meta.FieldThumbnailX)
-	}
-	if m.addthumbnail_y != nil {
-		fields = append(fields, meta.FieldThumbnailY)
-	}
-	if m.addthumbnail_width != nil {
-		fields = append(fields, meta.FieldThumbnailWidth)
-	}
-	if m.addthumbnail_height != nil {
-		fields = append(fields, meta.FieldThumbnailHeight)
-	}
-	return fields
-}
-
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *MetaMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case meta.FieldThumbnailIndex:
-		return m.AddedThumbnailIndex()
-	case meta.FieldThumbnailX:
-		return m.AddedThumbnailX()
-	case meta.FieldThumbnailY:
-		return m.AddedThumbnailY()
-	case meta.FieldThumbnailWidth:
-		return m.AddedThumbnailWidth()
-	case meta.FieldThumbnailHeight:
-		return m.AddedThumbnailHeight()
-	}
-	return nil, false
-}
-
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *MetaMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case meta.FieldThumbnailIndex:
-		v, ok := value.(int)
+		}
+		m.SetName(v)
+		return nil
+	case tag.FieldFavorite:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddThumbnailIndex(v)
+		m.SetFavorite(v)
 		return nil
-	case meta.FieldThumbnailX:
-		v, ok := value.(int)
+	case tag.FieldHidden:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddThumbnailX(v)
+		m.SetHidden(v)
 		return nil
-	case meta.FieldThumbnailY:
-		v, ok := value.(int)
+	case tag.FieldHideItems:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddThumbnailY(v)
+		m.SetHideItems(v)
 		return nil
-	case meta.FieldThumbnailWidth:
-		v, ok := value.(int)
+	case tag.FieldActive:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddThumbnailWidth(v)
+		m.SetActive(v)
 		return nil
-	case meta.FieldThumbnailHeight:
-		v, ok := value.(int)
+	case tag.FieldLastUpdate:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddThumbnailHeight(v)
+		m.SetLastUpdate(v)
+		return nil
+	case tag.FieldNormalizedName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNormalizedName(v)
+		return nil
+	case tag.FieldSearchKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSearchKey(v)
+		return nil
+	case tag.FieldCategory:
+		v, ok := value.(tag.Category)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case tag.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case tag.FieldLinks:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLinks(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Meta numeric field %s", name)
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TagMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Tag numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MetaMutation) ClearedFields() []string {
+func (m *TagMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(meta.FieldThumbnailIndex) {
-		fields = append(fields, meta.FieldThumbnailIndex)
+	if m.FieldCleared(tag.FieldLastUpdate) {
+		fields = append(fields, tag.FieldLastUpdate)
 	}
-	if m.FieldCleared(meta.FieldThumbnailX) {
-		fields = append(fields, meta.FieldThumbnailX)
+	if m.FieldCleared(tag.FieldNormalizedName) {
+		fields = append(fields, tag.FieldNormalizedName)
 	}
-	if m.FieldCleared(meta.FieldThumbnailY) {
-		fields = append(fields, meta.FieldThumbnailY)
+	if m.FieldCleared(tag.FieldSearchKey) {
+		fields = append(fields, tag.FieldSearchKey)
 	}
-	if m.FieldCleared(meta.FieldThumbnailWidth) {
-		fields = append(fields, meta.FieldThumbnailWidth)
+	if m.FieldCleared(tag.FieldCategory) {
+		fields = append(fields, tag.FieldCategory)
 	}
-	if m.FieldCleared(meta.FieldThumbnailHeight) {
-		fields = append(fields, meta.FieldThumbnailHeight)
+	if m.FieldCleared(tag.FieldDescription) {
+		fields = append(fields, tag.FieldDescription)
+	}
+	if m.FieldCleared(tag.FieldLinks) {
+		fields = append(fields, tag.FieldLinks)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MetaMutation) FieldCleared(name string) bool {
+func (m *TagMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MetaMutation) ClearField(name string) error {
+func (m *TagMutation) ClearField(name string) error {
 	switch name {
-	case meta.FieldThumbnailIndex:
-		m.ClearThumbnailIndex()
+	case tag.FieldLastUpdate:
+		m.ClearLastUpdate()
 		return nil
-	case meta.FieldThumbnailX:
-		m.ClearThumbnailX()
+	case tag.FieldNormalizedName:
+		m.ClearNormalizedName()
 		return nil
-	case meta.FieldThumbnailY:
-		m.ClearThumbnailY()
+	case tag.FieldSearchKey:
+		m.ClearSearchKey()
 		return nil
-	case meta.FieldThumbnailWidth:
-		m.ClearThumbnailWidth()
+	case tag.FieldCategory:
+		m.ClearCategory()
 		return nil
-	case meta.FieldThumbnailHeight:
-		m.ClearThumbnailHeight()
+	case tag.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case tag.FieldLinks:
+		m.ClearLinks()
 		return nil
 	}
-	return fmt.Errorf("unknown Meta nullable field %s", name)
+	return fmt.Errorf("unknown Tag nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MetaMutation) ResetField(name string) error {
+func (m *TagMutation) ResetField(name string) error {
 	switch name {
-	case meta.FieldName:
+	case tag.FieldName:
 		m.ResetName()
 		return nil
-	case meta.FieldCreateTime:
-		m.ResetCreateTime()
-		return nil
-	case meta.FieldFavorite:
+	case tag.FieldFavorite:
 		m.ResetFavorite()
 		return nil
-	case meta.FieldFileIndices:
-		m.ResetFileIndices()
+	case tag.FieldHidden:
+		m.ResetHidden()
 		return nil
-	case meta.FieldRead:
-		m.ResetRead()
+	case tag.FieldHideItems:
+		m.ResetHideItems()
 		return nil
-	case meta.FieldActive:
+	case tag.FieldActive:
 		m.ResetActive()
 		return nil
-	case meta.FieldHidden:
-		m.ResetHidden()
-		return nil
-	case meta.FieldContainerType:
-		m.ResetContainerType()
+	case tag.FieldLastUpdate:
+		m.ResetLastUpdate()
 		return nil
-	case meta.FieldThumbnailIndex:
-		m.ResetThumbnailIndex()
+	case tag.FieldNormalizedName:
+		m.ResetNormalizedName()
 		return nil
-	case meta.FieldThumbnailX:
-		m.ResetThumbnailX()
+	case tag.FieldSearchKey:
+		m.ResetSearchKey()
 		return nil
-	case meta.FieldThumbnailY:
-		m.ResetThumbnailY()
+	case tag.FieldCategory:
+		m.ResetCategory()
 		return nil
-	case meta.FieldThumbnailWidth:
-		m.ResetThumbnailWidth()
+	case tag.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case meta.FieldThumbnailHeight:
-		m.ResetThumbnailHeight()
+	case tag.FieldLinks:
+		m.ResetLinks()
 		return nil
 	}
-	return fmt.Errorf("unknown Meta field %s", name)
+	return fmt.Errorf("unknown Tag field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MetaMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.tags != nil {
-		edges = append(edges, meta.EdgeTags)
+func (m *TagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.meta != nil {
+		edges = append(edges, tag.EdgeMeta)
 	}
-	if m.histories != nil {
-		edges = append(edges, meta.EdgeHistories)
+	if m.excluded_from != nil {
+		edges = append(edges, tag.EdgeExcludedFrom)
 	}
 	if m.favorite_of_user != nil {
-		edges = append(edges, meta.EdgeFavoriteOfUser)
+		edges = append(edges, tag.EdgeFavoriteOfUser)
 	}
-	if m.progress != nil {
-		edges = append(edges, meta.EdgeProgress)
+	if m.blocked_by_user != nil {
+		edges = append(edges, tag.EdgeBlockedByUser)
+	}
+	if m.denied_to_user != nil {
+		edges = append(edges, tag.EdgeDeniedToUser)
+	}
+	if m.aliases != nil {
+		edges = append(edges, tag.EdgeAliases)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MetaMutation) AddedIDs(name string) []ent.Value {
+func (m *TagMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case meta.EdgeTags:
-		ids := make([]ent.Value, 0, len(m.tags))
-		for id := range m.tags {
+	case tag.EdgeMeta:
+		ids := make([]ent.Value, 0, len(m.meta))
+		for id := range m.meta {
 			ids = append(ids, id)
 		}
 		return ids
-	case meta.EdgeHistories:
-		ids := make([]ent.Value, 0, len(m.histories))
-		for id := range m.histories {
+	case tag.EdgeExcludedFrom:
+		ids := make([]ent.Value, 0, len(m.excluded_from))
+		for id := range m.excluded_from {
 			ids = append(ids, id)
 		}
 		return ids
-	case meta.EdgeFavoriteOfUser:
+	case tag.EdgeFavoriteOfUser:
 		ids := make([]ent.Value, 0, len(m.favorite_of_user))
 		for id := range m.favorite_of_user {
 			ids = append(ids, id)
 		}
 		return ids
-	case meta.EdgeProgress:
-		ids := make([]ent.Value, 0, len(m.progress))
-		for id := range m.progress {
+	case tag.EdgeBlockedByUser:
+		ids := make([]ent.Value, 0, len(m.blocked_by_user))
+		for id := range m.blocked_by_user {
+			ids = append(ids, id)
+		}
+		return ids
+	case tag.EdgeDeniedToUser:
+		ids := make([]ent.Value, 0, len(m.denied_to_user))
+		for id := range m.denied_to_user {
+			ids = append(ids, id)
+		}
+		return ids
+	case tag.EdgeAliases:
+		ids := make([]ent.Value, 0, len(m.aliases))
+		for id := range m.aliases {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1979,48 +8134,66 @@ func (m *MetaMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MetaMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedtags != nil {
-		edges = append(edges, meta.EdgeTags)
+func (m *TagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedmeta != nil {
+		edges = append(edges, tag.EdgeMeta)
 	}
-	if m.removedhistories != nil {
-		edges = append(edges, meta.EdgeHistories)
+	if m.removedexcluded_from != nil {
+		edges = append(edges, tag.EdgeExcludedFrom)
 	}
 	if m.removedfavorite_of_user != nil {
-		edges = append(edges, meta.EdgeFavoriteOfUser)
+		edges = append(edges, tag.EdgeFavoriteOfUser)
 	}
-	if m.removedprogress != nil {
-		edges = append(edges, meta.EdgeProgress)
+	if m.removedblocked_by_user != nil {
+		edges = append(edges, tag.EdgeBlockedByUser)
+	}
+	if m.removeddenied_to_user != nil {
+		edges = append(edges, tag.EdgeDeniedToUser)
+	}
+	if m.removedaliases != nil {
+		edges = append(edges, tag.EdgeAliases)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MetaMutation) RemovedIDs(name string) []ent.Value {
+func (m *TagMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case meta.EdgeTags:
-		ids := make([]ent.Value, 0, len(m.removedtags))
-		for id := range m.removedtags {
+	case tag.EdgeMeta:
+		ids := make([]ent.Value, 0, len(m.removedmeta))
+		for id := range m.removedmeta {
 			ids = append(ids, id)
 		}
 		return ids
-	case meta.EdgeHistories:
-		ids := make([]ent.Value, 0, len(m.removedhistories))
-		for id := range m.removedhistories {
+	case tag.EdgeExcludedFrom:
+		ids := make([]ent.Value, 0, len(m.removedexcluded_from))
+		for id := range m.removedexcluded_from {
 			ids = append(ids, id)
 		}
 		return ids
-	case meta.EdgeFavoriteOfUser:
+	case tag.EdgeFavoriteOfUser:
 		ids := make([]ent.Value, 0, len(m.removedfavorite_of_user))
 		for id := range m.removedfavorite_of_user {
 			ids = append(ids, id)
 		}
 		return ids
-	case meta.EdgeProgress:
-		ids := make([]ent.Value, 0, len(m.removedprogress))
-		for id := range m.removedprogress {
+	case tag.EdgeBlockedByUser:
+		ids := make([]ent.Value, 0, len(m.removedblocked_by_user))
+		for id := range m.removedblocked_by_user {
+			ids = append(ids, id)
+		}
+		return ids
+	case tag.EdgeDeniedToUser:
+		ids := make([]ent.Value, 0, len(m.removeddenied_to_user))
+		for id := range m.removeddenied_to_user {
+			ids = append(ids, id)
+		}
+		return ids
+	case tag.EdgeAliases:
+		ids := make([]ent.Value, 0, len(m.removedaliases))
+		for id := range m.removedaliases {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2029,98 +8202,111 @@ func (m *MetaMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MetaMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedtags {
-		edges = append(edges, meta.EdgeTags)
+func (m *TagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedmeta {
+		edges = append(edges, tag.EdgeMeta)
 	}
-	if m.clearedhistories {
-		edges = append(edges, meta.EdgeHistories)
+	if m.clearedexcluded_from {
+		edges = append(edges, tag.EdgeExcludedFrom)
 	}
 	if m.clearedfavorite_of_user {
-		edges = append(edges, meta.EdgeFavoriteOfUser)
+		edges = append(edges, tag.EdgeFavoriteOfUser)
 	}
-	if m.clearedprogress {
-		edges = append(edges, meta.EdgeProgress)
+	if m.clearedblocked_by_user {
+		edges = append(edges, tag.EdgeBlockedByUser)
+	}
+	if m.cleareddenied_to_user {
+		edges = append(edges, tag.EdgeDeniedToUser)
+	}
+	if m.clearedaliases {
+		edges = append(edges, tag.EdgeAliases)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MetaMutation) EdgeCleared(name string) bool {
+func (m *TagMutation) EdgeCleared(name string) bool {
 	switch name {
-	case meta.EdgeTags:
-		return m.clearedtags
-	case meta.EdgeHistories:
-		return m.clearedhistories
-	case meta.EdgeFavoriteOfUser:
+	case tag.EdgeMeta:
+		return m.clearedmeta
+	case tag.EdgeExcludedFrom:
+		return m.clearedexcluded_from
+	case tag.EdgeFavoriteOfUser:
 		return m.clearedfavorite_of_user
-	case meta.EdgeProgress:
-		return m.clearedprogress
+	case tag.EdgeBlockedByUser:
+		return m.clearedblocked_by_user
+	case tag.EdgeDeniedToUser:
+		return m.cleareddenied_to_user
+	case tag.EdgeAliases:
+		return m.clearedaliases
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MetaMutation) ClearEdge(name string) error {
+func (m *TagMutation) ClearEdge(name string) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Meta unique edge %s", name)
+	return fmt.Errorf("unknown Tag unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MetaMutation) ResetEdge(name string) error {
+func (m *TagMutation) ResetEdge(name string) error {
 	switch name {
-	case meta.EdgeTags:
-		m.ResetTags()
+	case tag.EdgeMeta:
+		m.ResetMeta()
 		return nil
-	case meta.EdgeHistories:
-		m.ResetHistories()
+	case tag.EdgeExcludedFrom:
+		m.ResetExcludedFrom()
 		return nil
-	case meta.EdgeFavoriteOfUser:
+	case tag.EdgeFavoriteOfUser:
 		m.ResetFavoriteOfUser()
 		return nil
-	case meta.EdgeProgress:
-		m.ResetProgress()
+	case tag.EdgeBlockedByUser:
+		m.ResetBlockedByUser()
+		return nil
+	case tag.EdgeDeniedToUser:
+		m.ResetDeniedToUser()
+		return nil
+	case tag.EdgeAliases:
+		m.ResetAliases()
 		return nil
 	}
-	return fmt.Errorf("unknown Meta edge %s", name)
+	return fmt.Errorf("unknown Tag edge %s", name)
 }
 
-// ProgressMutation represents an operation that mutates the Progress nodes in the graph.
-type ProgressMutation struct {
+// TagAliasMutation represents an operation that mutates the TagAlias nodes in the graph.
+type TagAliasMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	page          *int
-	addpage       *int
-	max           *int
-	addmax        *int
-	clearedFields map[string]struct{}
-	item          *int
-	cleareditem   bool
-	user          *int
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*Progress, error)
-	predicates    []predicate.Progress
-}
-
-var _ ent.Mutation = (*ProgressMutation)(nil)
-
-// progressOption allows management of the mutation configuration using functional options.
-type progressOption func(*ProgressMutation)
-
-// newProgressMutation creates new mutation for the Progress entity.
-func newProgressMutation(c config, op Op, opts ...progressOption) *ProgressMutation {
-	m := &ProgressMutation{
+	op              Op
+	typ             string
+	id              *int
+	name            *string
+	normalized_name *string
+	create_time     *time.Time
+	clearedFields   map[string]struct{}
+	tag             *int
+	clearedtag      bool
+	done            bool
+	oldValue        func(context.Context) (*TagAlias, error)
+	predicates      []predicate.TagAlias
+}
+
+var _ ent.Mutation = (*TagAliasMutation)(nil)
+
+// tagaliasOption allows management of the mutation configuration using functional options.
+type tagaliasOption func(*TagAliasMutation)
+
+// newTagAliasMutation creates new mutation for the TagAlias entity.
+func newTagAliasMutation(c config, op Op, opts ...tagaliasOption) *TagAliasMutation {
+	m := &TagAliasMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProgress,
+		typ:           TypeTagAlias,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2129,20 +8315,20 @@ func newProgressMutation(c config, op Op, opts ...progressOption) *ProgressMutat
 	return m
 }
 
-// withProgressID sets the ID field of the mutation.
-func withProgressID(id int) progressOption {
-	return func(m *ProgressMutation) {
+// withTagAliasID sets the ID field of the mutation.
+func withTagAliasID(id int) tagaliasOption {
+	return func(m *TagAliasMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Progress
+			value *TagAlias
 		)
-		m.oldValue = func(ctx context.Context) (*Progress, error) {
+		m.oldValue = func(ctx context.Context) (*TagAlias, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Progress.Get(ctx, id)
+					value, err = m.Client().TagAlias.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2151,10 +8337,10 @@ func withProgressID(id int) progressOption {
 	}
 }
 
-// withProgress sets the old Progress of the mutation.
-func withProgress(node *Progress) progressOption {
-	return func(m *ProgressMutation) {
-		m.oldValue = func(context.Context) (*Progress, error) {
+// withTagAlias sets the old TagAlias of the mutation.
+func withTagAlias(node *TagAlias) tagaliasOption {
+	return func(m *TagAliasMutation) {
+		m.oldValue = func(context.Context) (*TagAlias, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2163,7 +8349,7 @@ func withProgress(node *Progress) progressOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProgressMutation) Client() *Client {
+func (m TagAliasMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2171,7 +8357,7 @@ func (m ProgressMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProgressMutation) Tx() (*Tx, error) {
+func (m TagAliasMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2182,7 +8368,7 @@ func (m ProgressMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProgressMutation) ID() (id int, exists bool) {
+func (m *TagAliasMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2193,7 +8379,7 @@ func (m *ProgressMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProgressMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *TagAliasMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2202,285 +8388,181 @@ func (m *ProgressMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Progress.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TagAlias.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetPage sets the "page" field.
-func (m *ProgressMutation) SetPage(i int) {
-	m.page = &i
-	m.addpage = nil
-}
-
-// Page returns the value of the "page" field in the mutation.
-func (m *ProgressMutation) Page() (r int, exists bool) {
-	v := m.page
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldPage returns the old "page" field's value of the Progress entity.
-// If the Progress object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProgressMutation) OldPage(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPage is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPage requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPage: %w", err)
-	}
-	return oldValue.Page, nil
-}
-
-// AddPage adds i to the "page" field.
-func (m *ProgressMutation) AddPage(i int) {
-	if m.addpage != nil {
-		*m.addpage += i
-	} else {
-		m.addpage = &i
-	}
-}
-
-// AddedPage returns the value that was added to the "page" field in this mutation.
-func (m *ProgressMutation) AddedPage() (r int, exists bool) {
-	v := m.addpage
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetPage resets all changes to the "page" field.
-func (m *ProgressMutation) ResetPage() {
-	m.page = nil
-	m.addpage = nil
-}
-
-// SetMax sets the "max" field.
-func (m *ProgressMutation) SetMax(i int) {
-	m.max = &i
-	m.addmax = nil
+// SetName sets the "name" field.
+func (m *TagAliasMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Max returns the value of the "max" field in the mutation.
-func (m *ProgressMutation) Max() (r int, exists bool) {
-	v := m.max
+// Name returns the value of the "name" field in the mutation.
+func (m *TagAliasMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMax returns the old "max" field's value of the Progress entity.
-// If the Progress object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the TagAlias entity.
+// If the TagAlias object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProgressMutation) OldMax(ctx context.Context) (v int, err error) {
+func (m *TagAliasMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMax is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMax requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMax: %w", err)
-	}
-	return oldValue.Max, nil
-}
-
-// AddMax adds i to the "max" field.
-func (m *ProgressMutation) AddMax(i int) {
-	if m.addmax != nil {
-		*m.addmax += i
-	} else {
-		m.addmax = &i
-	}
-}
-
-// AddedMax returns the value that was added to the "max" field in this mutation.
-func (m *ProgressMutation) AddedMax() (r int, exists bool) {
-	v := m.addmax
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return *v, true
+	return oldValue.Name, nil
 }
 
-// ResetMax resets all changes to the "max" field.
-func (m *ProgressMutation) ResetMax() {
-	m.max = nil
-	m.addmax = nil
+// ResetName resets all changes to the "name" field.
+func (m *TagAliasMutation) ResetName() {
+	m.name = nil
 }
 
-// SetItemID sets the "item_id" field.
-func (m *ProgressMutation) SetItemID(i int) {
-	m.item = &i
+// SetNormalizedName sets the "normalized_name" field.
+func (m *TagAliasMutation) SetNormalizedName(s string) {
+	m.normalized_name = &s
 }
 
-// ItemID returns the value of the "item_id" field in the mutation.
-func (m *ProgressMutation) ItemID() (r int, exists bool) {
-	v := m.item
+// NormalizedName returns the value of the "normalized_name" field in the mutation.
+func (m *TagAliasMutation) NormalizedName() (r string, exists bool) {
+	v := m.normalized_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldItemID returns the old "item_id" field's value of the Progress entity.
-// If the Progress object wasn't provided to the builder, the object is fetched from the database.
+// OldNormalizedName returns the old "normalized_name" field's value of the TagAlias entity.
+// If the TagAlias object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProgressMutation) OldItemID(ctx context.Context) (v int, err error) {
+func (m *TagAliasMutation) OldNormalizedName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
+		return v, errors.New("OldNormalizedName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldItemID requires an ID field in the mutation")
+		return v, errors.New("OldNormalizedName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
+		return v, fmt.Errorf("querying old value for OldNormalizedName: %w", err)
 	}
-	return oldValue.ItemID, nil
+	return oldValue.NormalizedName, nil
 }
 
-// ClearItemID clears the value of the "item_id" field.
-func (m *ProgressMutation) ClearItemID() {
-	m.item = nil
-	m.clearedFields[progress.FieldItemID] = struct{}{}
+// ClearNormalizedName clears the value of the "normalized_name" field.
+func (m *TagAliasMutation) ClearNormalizedName() {
+	m.normalized_name = nil
+	m.clearedFields[tagalias.FieldNormalizedName] = struct{}{}
 }
 
-// ItemIDCleared returns if the "item_id" field was cleared in this mutation.
-func (m *ProgressMutation) ItemIDCleared() bool {
-	_, ok := m.clearedFields[progress.FieldItemID]
+// NormalizedNameCleared returns if the "normalized_name" field was cleared in this mutation.
+func (m *TagAliasMutation) NormalizedNameCleared() bool {
+	_, ok := m.clearedFields[tagalias.FieldNormalizedName]
 	return ok
 }
 
-// ResetItemID resets all changes to the "item_id" field.
-func (m *ProgressMutation) ResetItemID() {
-	m.item = nil
-	delete(m.clearedFields, progress.FieldItemID)
+// ResetNormalizedName resets all changes to the "normalized_name" field.
+func (m *TagAliasMutation) ResetNormalizedName() {
+	m.normalized_name = nil
+	delete(m.clearedFields, tagalias.FieldNormalizedName)
 }
 
-// SetUserID sets the "user_id" field.
-func (m *ProgressMutation) SetUserID(i int) {
-	m.user = &i
+// SetCreateTime sets the "create_time" field.
+func (m *TagAliasMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *ProgressMutation) UserID() (r int, exists bool) {
-	v := m.user
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *TagAliasMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Progress entity.
-// If the Progress object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the TagAlias entity.
+// If the TagAlias object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProgressMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *TagAliasMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return oldValue.UserID, nil
-}
-
-// ClearUserID clears the value of the "user_id" field.
-func (m *ProgressMutation) ClearUserID() {
-	m.user = nil
-	m.clearedFields[progress.FieldUserID] = struct{}{}
+	return oldValue.CreateTime, nil
 }
 
-// UserIDCleared returns if the "user_id" field was cleared in this mutation.
-func (m *ProgressMutation) UserIDCleared() bool {
-	_, ok := m.clearedFields[progress.FieldUserID]
-	return ok
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *TagAliasMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *ProgressMutation) ResetUserID() {
-	m.user = nil
-	delete(m.clearedFields, progress.FieldUserID)
+// SetTagID sets the "tag" edge to the Tag entity by id.
+func (m *TagAliasMutation) SetTagID(id int) {
+	m.tag = &id
 }
 
-// ClearItem clears the "item" edge to the Meta entity.
-func (m *ProgressMutation) ClearItem() {
-	m.cleareditem = true
-	m.clearedFields[progress.FieldItemID] = struct{}{}
+// ClearTag clears the "tag" edge to the Tag entity.
+func (m *TagAliasMutation) ClearTag() {
+	m.clearedtag = true
 }
 
-// ItemCleared reports if the "item" edge to the Meta entity was cleared.
-func (m *ProgressMutation) ItemCleared() bool {
-	return m.ItemIDCleared() || m.cleareditem
+// TagCleared reports if the "tag" edge to the Tag entity was cleared.
+func (m *TagAliasMutation) TagCleared() bool {
+	return m.clearedtag
 }
 
-// ItemIDs returns the "item" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ItemID instead. It exists only for internal usage by the builders.
-func (m *ProgressMutation) ItemIDs() (ids []int) {
-	if id := m.item; id != nil {
-		ids = append(ids, *id)
+// TagID returns the "tag" edge ID in the mutation.
+func (m *TagAliasMutation) TagID() (id int, exists bool) {
+	if m.tag != nil {
+		return *m.tag, true
 	}
 	return
 }
 
-// ResetItem resets all changes to the "item" edge.
-func (m *ProgressMutation) ResetItem() {
-	m.item = nil
-	m.cleareditem = false
-}
-
-// ClearUser clears the "user" edge to the User entity.
-func (m *ProgressMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[progress.FieldUserID] = struct{}{}
-}
-
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *ProgressMutation) UserCleared() bool {
-	return m.UserIDCleared() || m.cleareduser
-}
-
-// UserIDs returns the "user" edge IDs in the mutation.
+// TagIDs returns the "tag" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *ProgressMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
+// TagID instead. It exists only for internal usage by the builders.
+func (m *TagAliasMutation) TagIDs() (ids []int) {
+	if id := m.tag; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *ProgressMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetTag resets all changes to the "tag" edge.
+func (m *TagAliasMutation) ResetTag() {
+	m.tag = nil
+	m.clearedtag = false
 }
 
-// Where appends a list predicates to the ProgressMutation builder.
-func (m *ProgressMutation) Where(ps ...predicate.Progress) {
+// Where appends a list predicates to the TagAliasMutation builder.
+func (m *TagAliasMutation) Where(ps ...predicate.TagAlias) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ProgressMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProgressMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Progress, len(ps))
+// WhereP appends storage-level predicates to the TagAliasMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TagAliasMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TagAlias, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2488,36 +8570,33 @@ func (m *ProgressMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ProgressMutation) Op() Op {
+func (m *TagAliasMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ProgressMutation) SetOp(op Op) {
+func (m *TagAliasMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Progress).
-func (m *ProgressMutation) Type() string {
+// Type returns the node type of this mutation (TagAlias).
+func (m *TagAliasMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ProgressMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.page != nil {
-		fields = append(fields, progress.FieldPage)
-	}
-	if m.max != nil {
-		fields = append(fields, progress.FieldMax)
+func (m *TagAliasMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, tagalias.FieldName)
 	}
-	if m.item != nil {
-		fields = append(fields, progress.FieldItemID)
+	if m.normalized_name != nil {
+		fields = append(fields, tagalias.FieldNormalizedName)
 	}
-	if m.user != nil {
-		fields = append(fields, progress.FieldUserID)
+	if m.create_time != nil {
+		fields = append(fields, tagalias.FieldCreateTime)
 	}
 	return fields
 }
@@ -2525,16 +8604,14 @@ func (m *ProgressMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ProgressMutation) Field(name string) (ent.Value, bool) {
+func (m *TagAliasMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case progress.FieldPage:
-		return m.Page()
-	case progress.FieldMax:
-		return m.Max()
-	case progress.FieldItemID:
-		return m.ItemID()
-	case progress.FieldUserID:
-		return m.UserID()
+	case tagalias.FieldName:
+		return m.Name()
+	case tagalias.FieldNormalizedName:
+		return m.NormalizedName()
+	case tagalias.FieldCreateTime:
+		return m.CreateTime()
 	}
 	return nil, false
 }
@@ -2542,182 +8619,130 @@ func (m *ProgressMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ProgressMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TagAliasMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case progress.FieldPage:
-		return m.OldPage(ctx)
-	case progress.FieldMax:
-		return m.OldMax(ctx)
-	case progress.FieldItemID:
-		return m.OldItemID(ctx)
-	case progress.FieldUserID:
-		return m.OldUserID(ctx)
+	case tagalias.FieldName:
+		return m.OldName(ctx)
+	case tagalias.FieldNormalizedName:
+		return m.OldNormalizedName(ctx)
+	case tagalias.FieldCreateTime:
+		return m.OldCreateTime(ctx)
 	}
-	return nil, fmt.Errorf("unknown Progress field %s", name)
+	return nil, fmt.Errorf("unknown TagAlias field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProgressMutation) SetField(name string, value ent.Value) error {
+func (m *TagAliasMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case progress.FieldPage:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPage(v)
-		return nil
-	case progress.FieldMax:
-		v, ok := value.(int)
+	case tagalias.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMax(v)
+		m.SetName(v)
 		return nil
-	case progress.FieldItemID:
-		v, ok := value.(int)
+	case tagalias.FieldNormalizedName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetItemID(v)
+		m.SetNormalizedName(v)
 		return nil
-	case progress.FieldUserID:
-		v, ok := value.(int)
+	case tagalias.FieldCreateTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetCreateTime(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Progress field %s", name)
+	return fmt.Errorf("unknown TagAlias field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProgressMutation) AddedFields() []string {
-	var fields []string
-	if m.addpage != nil {
-		fields = append(fields, progress.FieldPage)
-	}
-	if m.addmax != nil {
-		fields = append(fields, progress.FieldMax)
-	}
-	return fields
+func (m *TagAliasMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProgressMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case progress.FieldPage:
-		return m.AddedPage()
-	case progress.FieldMax:
-		return m.AddedMax()
-	}
+func (m *TagAliasMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProgressMutation) AddField(name string, value ent.Value) error {
+func (m *TagAliasMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case progress.FieldPage:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPage(v)
-		return nil
-	case progress.FieldMax:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMax(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Progress numeric field %s", name)
+	return fmt.Errorf("unknown TagAlias numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProgressMutation) ClearedFields() []string {
+func (m *TagAliasMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(progress.FieldItemID) {
-		fields = append(fields, progress.FieldItemID)
-	}
-	if m.FieldCleared(progress.FieldUserID) {
-		fields = append(fields, progress.FieldUserID)
+	if m.FieldCleared(tagalias.FieldNormalizedName) {
+		fields = append(fields, tagalias.FieldNormalizedName)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProgressMutation) FieldCleared(name string) bool {
+func (m *TagAliasMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProgressMutation) ClearField(name string) error {
+func (m *TagAliasMutation) ClearField(name string) error {
 	switch name {
-	case progress.FieldItemID:
-		m.ClearItemID()
-		return nil
-	case progress.FieldUserID:
-		m.ClearUserID()
+	case tagalias.FieldNormalizedName:
+		m.ClearNormalizedName()
 		return nil
 	}
-	return fmt.Errorf("unknown Progress nullable field %s", name)
+	return fmt.Errorf("unknown TagAlias nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProgressMutation) ResetField(name string) error {
+func (m *TagAliasMutation) ResetField(name string) error {
 	switch name {
-	case progress.FieldPage:
-		m.ResetPage()
-		return nil
-	case progress.FieldMax:
-		m.ResetMax()
+	case tagalias.FieldName:
+		m.ResetName()
 		return nil
-	case progress.FieldItemID:
-		m.ResetItemID()
+	case tagalias.FieldNormalizedName:
+		m.ResetNormalizedName()
 		return nil
-	case progress.FieldUserID:
-		m.ResetUserID()
+	case tagalias.FieldCreateTime:
+		m.ResetCreateTime()
 		return nil
 	}
-	return fmt.Errorf("unknown Progress field %s", name)
+	return fmt.Errorf("unknown TagAlias field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProgressMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.item != nil {
-		edges = append(edges, progress.EdgeItem)
-	}
-	if m.user != nil {
-		edges = append(edges, progress.EdgeUser)
+func (m *TagAliasMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.tag != nil {
+		edges = append(edges, tagalias.EdgeTag)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProgressMutation) AddedIDs(name string) []ent.Value {
+func (m *TagAliasMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case progress.EdgeItem:
-		if id := m.item; id != nil {
-			return []ent.Value{*id}
-		}
-	case progress.EdgeUser:
-		if id := m.user; id != nil {
+	case tagalias.EdgeTag:
+		if id := m.tag; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -2725,102 +8750,120 @@ func (m *ProgressMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProgressMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *TagAliasMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProgressMutation) RemovedIDs(name string) []ent.Value {
+func (m *TagAliasMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProgressMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareditem {
-		edges = append(edges, progress.EdgeItem)
-	}
-	if m.cleareduser {
-		edges = append(edges, progress.EdgeUser)
+func (m *TagAliasMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtag {
+		edges = append(edges, tagalias.EdgeTag)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProgressMutation) EdgeCleared(name string) bool {
+func (m *TagAliasMutation) EdgeCleared(name string) bool {
 	switch name {
-	case progress.EdgeItem:
-		return m.cleareditem
-	case progress.EdgeUser:
-		return m.cleareduser
+	case tagalias.EdgeTag:
+		return m.clearedtag
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProgressMutation) ClearEdge(name string) error {
+func (m *TagAliasMutation) ClearEdge(name string) error {
 	switch name {
-	case progress.EdgeItem:
-		m.ClearItem()
-		return nil
-	case progress.EdgeUser:
-		m.ClearUser()
+	case tagalias.EdgeTag:
+		m.ClearTag()
 		return nil
 	}
-	return fmt.Errorf("unknown Progress unique edge %s", name)
+	return fmt.Errorf("unknown TagAlias unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProgressMutation) ResetEdge(name string) error {
+func (m *TagAliasMutation) ResetEdge(name string) error {
 	switch name {
-	case progress.EdgeItem:
-		m.ResetItem()
-		return nil
-	case progress.EdgeUser:
-		m.ResetUser()
+	case tagalias.EdgeTag:
+		m.ResetTag()
 		return nil
 	}
-	return fmt.Errorf("unknown Progress edge %s", name)
+	return fmt.Errorf("unknown TagAlias edge %s", name)
 }
 
-// TagMutation represents an operation that mutates the Tag nodes in the graph.
-type TagMutation struct {
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *int
-	name                    *string
-	favorite                *bool
-	hidden                  *bool
-	last_update             *time.Time
-	clearedFields           map[string]struct{}
-	meta                    map[int]struct{}
-	removedmeta             map[int]struct{}
-	clearedmeta             bool
-	favorite_of_user        map[int]struct{}
-	removedfavorite_of_user map[int]struct{}
-	clearedfavorite_of_user bool
-	done                    bool
-	oldValue                func(context.Context) (*Tag, error)
-	predicates              []predicate.Tag
+	op                    Op
+	typ                   string
+	id                    *int
+	email                 *string
+	active                *bool
+	password_hash         *string
+	role                  *user.Role
+	max_age_rating        *int
+	addmax_age_rating     *int
+	allowed_paths         *[]string
+	appendallowed_paths   []string
+	preferences           *preference.Document
+	clearedFields         map[string]struct{}
+	favorite_items        map[int]struct{}
+	removedfavorite_items map[int]struct{}
+	clearedfavorite_items bool
+	favorite_tags         map[int]struct{}
+	removedfavorite_tags  map[int]struct{}
+	clearedfavorite_tags  bool
+	histories             map[int]struct{}
+	removedhistories      map[int]struct{}
+	clearedhistories      bool
+	progress              map[int]struct{}
+	removedprogress       map[int]struct{}
+	clearedprogress       bool
+	blocked_items         map[int]struct{}
+	removedblocked_items  map[int]struct{}
+	clearedblocked_items  bool
+	blocked_tags          map[int]struct{}
+	removedblocked_tags   map[int]struct{}
+	clearedblocked_tags   bool
+	saved_searches        map[int]struct{}
+	removedsaved_searches map[int]struct{}
+	clearedsaved_searches bool
+	sessions              map[int]struct{}
+	removedsessions       map[int]struct{}
+	clearedsessions       bool
+	api_keys              map[int]struct{}
+	removedapi_keys       map[int]struct{}
+	clearedapi_keys       bool
+	denied_tags           map[int]struct{}
+	removeddenied_tags    map[int]struct{}
+	cleareddenied_tags    bool
+	done                  bool
+	oldValue              func(context.Context) (*User, error)
+	predicates            []predicate.User
 }
 
-var _ ent.Mutation = (*TagMutation)(nil)
+var _ ent.Mutation = (*UserMutation)(nil)
 
-// tagOption allows management of the mutation configuration using functional options.
-type tagOption func(*TagMutation)
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
 
-// newTagMutation creates new mutation for the Tag entity.
-func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
-	m := &TagMutation{
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTag,
+		typ:           TypeUser,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2829,20 +8872,20 @@ func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
 	return m
 }
 
-// withTagID sets the ID field of the mutation.
-func withTagID(id int) tagOption {
-	return func(m *TagMutation) {
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Tag
+			value *User
 		)
-		m.oldValue = func(ctx context.Context) (*Tag, error) {
+		m.oldValue = func(ctx context.Context) (*User, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Tag.Get(ctx, id)
+					value, err = m.Client().User.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2851,10 +8894,10 @@ func withTagID(id int) tagOption {
 	}
 }
 
-// withTag sets the old Tag of the mutation.
-func withTag(node *Tag) tagOption {
-	return func(m *TagMutation) {
-		m.oldValue = func(context.Context) (*Tag, error) {
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2863,7 +8906,7 @@ func withTag(node *Tag) tagOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TagMutation) Client() *Client {
+func (m UserMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2871,7 +8914,7 @@ func (m TagMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TagMutation) Tx() (*Tx, error) {
+func (m UserMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2882,7 +8925,7 @@ func (m TagMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TagMutation) ID() (id int, exists bool) {
+func (m *UserMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2893,7 +8936,7 @@ func (m *TagMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TagMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2902,988 +8945,891 @@ func (m *TagMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *TagMutation) SetName(s string) {
-	m.name = &s
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *TagMutation) Name() (r string, exists bool) {
-	v := m.name
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Tag entity.
-// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Email, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *TagMutation) ResetName() {
-	m.name = nil
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
 }
 
-// SetFavorite sets the "favorite" field.
-func (m *TagMutation) SetFavorite(b bool) {
-	m.favorite = &b
+// SetActive sets the "active" field.
+func (m *UserMutation) SetActive(b bool) {
+	m.active = &b
 }
 
-// Favorite returns the value of the "favorite" field in the mutation.
-func (m *TagMutation) Favorite() (r bool, exists bool) {
-	v := m.favorite
+// Active returns the value of the "active" field in the mutation.
+func (m *UserMutation) Active() (r bool, exists bool) {
+	v := m.active
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFavorite returns the old "favorite" field's value of the Tag entity.
-// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// OldActive returns the old "active" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TagMutation) OldFavorite(ctx context.Context) (v bool, err error) {
+func (m *UserMutation) OldActive(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFavorite is only allowed on UpdateOne operations")
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFavorite requires an ID field in the mutation")
+		return v, errors.New("OldActive requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFavorite: %w", err)
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
 	}
-	return oldValue.Favorite, nil
+	return oldValue.Active, nil
 }
 
-// ResetFavorite resets all changes to the "favorite" field.
-func (m *TagMutation) ResetFavorite() {
-	m.favorite = nil
+// ResetActive resets all changes to the "active" field.
+func (m *UserMutation) ResetActive() {
+	m.active = nil
 }
 
-// SetHidden sets the "hidden" field.
-func (m *TagMutation) SetHidden(b bool) {
-	m.hidden = &b
+// SetPasswordHash sets the "password_hash" field.
+func (m *UserMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
 }
 
-// Hidden returns the value of the "hidden" field in the mutation.
-func (m *TagMutation) Hidden() (r bool, exists bool) {
-	v := m.hidden
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *UserMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHidden returns the old "hidden" field's value of the Tag entity.
-// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// OldPasswordHash returns the old "password_hash" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TagMutation) OldHidden(ctx context.Context) (v bool, err error) {
+func (m *UserMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHidden is only allowed on UpdateOne operations")
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHidden requires an ID field in the mutation")
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHidden: %w", err)
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
 	}
-	return oldValue.Hidden, nil
+	return oldValue.PasswordHash, nil
 }
 
-// ResetHidden resets all changes to the "hidden" field.
-func (m *TagMutation) ResetHidden() {
-	m.hidden = nil
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (m *UserMutation) ClearPasswordHash() {
+	m.password_hash = nil
+	m.clearedFields[user.FieldPasswordHash] = struct{}{}
 }
 
-// SetLastUpdate sets the "last_update" field.
-func (m *TagMutation) SetLastUpdate(t time.Time) {
-	m.last_update = &t
+// PasswordHashCleared returns if the "password_hash" field was cleared in this mutation.
+func (m *UserMutation) PasswordHashCleared() bool {
+	_, ok := m.clearedFields[user.FieldPasswordHash]
+	return ok
 }
 
-// LastUpdate returns the value of the "last_update" field in the mutation.
-func (m *TagMutation) LastUpdate() (r time.Time, exists bool) {
-	v := m.last_update
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *UserMutation) ResetPasswordHash() {
+	m.password_hash = nil
+	delete(m.clearedFields, user.FieldPasswordHash)
+}
+
+// SetRole sets the "role" field.
+func (m *UserMutation) SetRole(u user.Role) {
+	m.role = &u
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *UserMutation) Role() (r user.Role, exists bool) {
+	v := m.role
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastUpdate returns the old "last_update" field's value of the Tag entity.
-// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// OldRole returns the old "role" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TagMutation) OldLastUpdate(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldRole(ctx context.Context) (v user.Role, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastUpdate is only allowed on UpdateOne operations")
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastUpdate requires an ID field in the mutation")
+		return v, errors.New("OldRole requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastUpdate: %w", err)
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
 	}
-	return oldValue.LastUpdate, nil
-}
-
-// ClearLastUpdate clears the value of the "last_update" field.
-func (m *TagMutation) ClearLastUpdate() {
-	m.last_update = nil
-	m.clearedFields[tag.FieldLastUpdate] = struct{}{}
+	return oldValue.Role, nil
 }
 
-// LastUpdateCleared returns if the "last_update" field was cleared in this mutation.
-func (m *TagMutation) LastUpdateCleared() bool {
-	_, ok := m.clearedFields[tag.FieldLastUpdate]
-	return ok
+// ResetRole resets all changes to the "role" field.
+func (m *UserMutation) ResetRole() {
+	m.role = nil
 }
 
-// ResetLastUpdate resets all changes to the "last_update" field.
-func (m *TagMutation) ResetLastUpdate() {
-	m.last_update = nil
-	delete(m.clearedFields, tag.FieldLastUpdate)
+// SetMaxAgeRating sets the "max_age_rating" field.
+func (m *UserMutation) SetMaxAgeRating(i int) {
+	m.max_age_rating = &i
+	m.addmax_age_rating = nil
 }
 
-// AddMetumIDs adds the "meta" edge to the Meta entity by ids.
-func (m *TagMutation) AddMetumIDs(ids ...int) {
-	if m.meta == nil {
-		m.meta = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.meta[ids[i]] = struct{}{}
+// MaxAgeRating returns the value of the "max_age_rating" field in the mutation.
+func (m *UserMutation) MaxAgeRating() (r int, exists bool) {
+	v := m.max_age_rating
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearMeta clears the "meta" edge to the Meta entity.
-func (m *TagMutation) ClearMeta() {
-	m.clearedmeta = true
-}
-
-// MetaCleared reports if the "meta" edge to the Meta entity was cleared.
-func (m *TagMutation) MetaCleared() bool {
-	return m.clearedmeta
-}
-
-// RemoveMetumIDs removes the "meta" edge to the Meta entity by IDs.
-func (m *TagMutation) RemoveMetumIDs(ids ...int) {
-	if m.removedmeta == nil {
-		m.removedmeta = make(map[int]struct{})
+// OldMaxAgeRating returns the old "max_age_rating" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldMaxAgeRating(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxAgeRating is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.meta, ids[i])
-		m.removedmeta[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxAgeRating requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxAgeRating: %w", err)
 	}
+	return oldValue.MaxAgeRating, nil
 }
 
-// RemovedMeta returns the removed IDs of the "meta" edge to the Meta entity.
-func (m *TagMutation) RemovedMetaIDs() (ids []int) {
-	for id := range m.removedmeta {
-		ids = append(ids, id)
+// AddMaxAgeRating adds i to the "max_age_rating" field.
+func (m *UserMutation) AddMaxAgeRating(i int) {
+	if m.addmax_age_rating != nil {
+		*m.addmax_age_rating += i
+	} else {
+		m.addmax_age_rating = &i
 	}
-	return
 }
 
-// MetaIDs returns the "meta" edge IDs in the mutation.
-func (m *TagMutation) MetaIDs() (ids []int) {
-	for id := range m.meta {
-		ids = append(ids, id)
+// AddedMaxAgeRating returns the value that was added to the "max_age_rating" field in this mutation.
+func (m *UserMutation) AddedMaxAgeRating() (r int, exists bool) {
+	v := m.addmax_age_rating
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetMeta resets all changes to the "meta" edge.
-func (m *TagMutation) ResetMeta() {
-	m.meta = nil
-	m.clearedmeta = false
-	m.removedmeta = nil
+// ClearMaxAgeRating clears the value of the "max_age_rating" field.
+func (m *UserMutation) ClearMaxAgeRating() {
+	m.max_age_rating = nil
+	m.addmax_age_rating = nil
+	m.clearedFields[user.FieldMaxAgeRating] = struct{}{}
 }
 
-// AddFavoriteOfUserIDs adds the "favorite_of_user" edge to the User entity by ids.
-func (m *TagMutation) AddFavoriteOfUserIDs(ids ...int) {
-	if m.favorite_of_user == nil {
-		m.favorite_of_user = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.favorite_of_user[ids[i]] = struct{}{}
-	}
+// MaxAgeRatingCleared returns if the "max_age_rating" field was cleared in this mutation.
+func (m *UserMutation) MaxAgeRatingCleared() bool {
+	_, ok := m.clearedFields[user.FieldMaxAgeRating]
+	return ok
 }
 
-// ClearFavoriteOfUser clears the "favorite_of_user" edge to the User entity.
-func (m *TagMutation) ClearFavoriteOfUser() {
-	m.clearedfavorite_of_user = true
+// ResetMaxAgeRating resets all changes to the "max_age_rating" field.
+func (m *UserMutation) ResetMaxAgeRating() {
+	m.max_age_rating = nil
+	m.addmax_age_rating = nil
+	delete(m.clearedFields, user.FieldMaxAgeRating)
 }
 
-// FavoriteOfUserCleared reports if the "favorite_of_user" edge to the User entity was cleared.
-func (m *TagMutation) FavoriteOfUserCleared() bool {
-	return m.clearedfavorite_of_user
+// SetAllowedPaths sets the "allowed_paths" field.
+func (m *UserMutation) SetAllowedPaths(s []string) {
+	m.allowed_paths = &s
+	m.appendallowed_paths = nil
 }
 
-// RemoveFavoriteOfUserIDs removes the "favorite_of_user" edge to the User entity by IDs.
-func (m *TagMutation) RemoveFavoriteOfUserIDs(ids ...int) {
-	if m.removedfavorite_of_user == nil {
-		m.removedfavorite_of_user = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.favorite_of_user, ids[i])
-		m.removedfavorite_of_user[ids[i]] = struct{}{}
+// AllowedPaths returns the value of the "allowed_paths" field in the mutation.
+func (m *UserMutation) AllowedPaths() (r []string, exists bool) {
+	v := m.allowed_paths
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedFavoriteOfUser returns the removed IDs of the "favorite_of_user" edge to the User entity.
-func (m *TagMutation) RemovedFavoriteOfUserIDs() (ids []int) {
-	for id := range m.removedfavorite_of_user {
-		ids = append(ids, id)
+// OldAllowedPaths returns the old "allowed_paths" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAllowedPaths(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAllowedPaths is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// FavoriteOfUserIDs returns the "favorite_of_user" edge IDs in the mutation.
-func (m *TagMutation) FavoriteOfUserIDs() (ids []int) {
-	for id := range m.favorite_of_user {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAllowedPaths requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAllowedPaths: %w", err)
+	}
+	return oldValue.AllowedPaths, nil
 }
 
-// ResetFavoriteOfUser resets all changes to the "favorite_of_user" edge.
-func (m *TagMutation) ResetFavoriteOfUser() {
-	m.favorite_of_user = nil
-	m.clearedfavorite_of_user = false
-	m.removedfavorite_of_user = nil
+// AppendAllowedPaths adds s to the "allowed_paths" field.
+func (m *UserMutation) AppendAllowedPaths(s []string) {
+	m.appendallowed_paths = append(m.appendallowed_paths, s...)
 }
 
-// Where appends a list predicates to the TagMutation builder.
-func (m *TagMutation) Where(ps ...predicate.Tag) {
-	m.predicates = append(m.predicates, ps...)
+// AppendedAllowedPaths returns the list of values that were appended to the "allowed_paths" field in this mutation.
+func (m *UserMutation) AppendedAllowedPaths() ([]string, bool) {
+	if len(m.appendallowed_paths) == 0 {
+		return nil, false
+	}
+	return m.appendallowed_paths, true
 }
 
-// WhereP appends storage-level predicates to the TagMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Tag, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ClearAllowedPaths clears the value of the "allowed_paths" field.
+func (m *UserMutation) ClearAllowedPaths() {
+	m.allowed_paths = nil
+	m.appendallowed_paths = nil
+	m.clearedFields[user.FieldAllowedPaths] = struct{}{}
 }
 
-// Op returns the operation name.
-func (m *TagMutation) Op() Op {
-	return m.op
+// AllowedPathsCleared returns if the "allowed_paths" field was cleared in this mutation.
+func (m *UserMutation) AllowedPathsCleared() bool {
+	_, ok := m.clearedFields[user.FieldAllowedPaths]
+	return ok
 }
 
-// SetOp allows setting the mutation operation.
-func (m *TagMutation) SetOp(op Op) {
-	m.op = op
+// ResetAllowedPaths resets all changes to the "allowed_paths" field.
+func (m *UserMutation) ResetAllowedPaths() {
+	m.allowed_paths = nil
+	m.appendallowed_paths = nil
+	delete(m.clearedFields, user.FieldAllowedPaths)
 }
 
-// Type returns the node type of this mutation (Tag).
-func (m *TagMutation) Type() string {
-	return m.typ
+// SetPreferences sets the "preferences" field.
+func (m *UserMutation) SetPreferences(pr preference.Document) {
+	m.preferences = &pr
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *TagMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.name != nil {
-		fields = append(fields, tag.FieldName)
+// Preferences returns the value of the "preferences" field in the mutation.
+func (m *UserMutation) Preferences() (r preference.Document, exists bool) {
+	v := m.preferences
+	if v == nil {
+		return
 	}
-	if m.favorite != nil {
-		fields = append(fields, tag.FieldFavorite)
+	return *v, true
+}
+
+// OldPreferences returns the old "preferences" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPreferences(ctx context.Context) (v preference.Document, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreferences is only allowed on UpdateOne operations")
 	}
-	if m.hidden != nil {
-		fields = append(fields, tag.FieldHidden)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreferences requires an ID field in the mutation")
 	}
-	if m.last_update != nil {
-		fields = append(fields, tag.FieldLastUpdate)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreferences: %w", err)
 	}
-	return fields
+	return oldValue.Preferences, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *TagMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case tag.FieldName:
-		return m.Name()
-	case tag.FieldFavorite:
-		return m.Favorite()
-	case tag.FieldHidden:
-		return m.Hidden()
-	case tag.FieldLastUpdate:
-		return m.LastUpdate()
-	}
-	return nil, false
+// ClearPreferences clears the value of the "preferences" field.
+func (m *UserMutation) ClearPreferences() {
+	m.preferences = nil
+	m.clearedFields[user.FieldPreferences] = struct{}{}
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case tag.FieldName:
-		return m.OldName(ctx)
-	case tag.FieldFavorite:
-		return m.OldFavorite(ctx)
-	case tag.FieldHidden:
-		return m.OldHidden(ctx)
-	case tag.FieldLastUpdate:
-		return m.OldLastUpdate(ctx)
-	}
-	return nil, fmt.Errorf("unknown Tag field %s", name)
+// PreferencesCleared returns if the "preferences" field was cleared in this mutation.
+func (m *UserMutation) PreferencesCleared() bool {
+	_, ok := m.clearedFields[user.FieldPreferences]
+	return ok
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *TagMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case tag.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case tag.FieldFavorite:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFavorite(v)
-		return nil
-	case tag.FieldHidden:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetHidden(v)
-		return nil
-	case tag.FieldLastUpdate:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastUpdate(v)
-		return nil
+// ResetPreferences resets all changes to the "preferences" field.
+func (m *UserMutation) ResetPreferences() {
+	m.preferences = nil
+	delete(m.clearedFields, user.FieldPreferences)
+}
+
+// AddFavoriteItemIDs adds the "favorite_items" edge to the Meta entity by ids.
+func (m *UserMutation) AddFavoriteItemIDs(ids ...int) {
+	if m.favorite_items == nil {
+		m.favorite_items = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.favorite_items[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown Tag field %s", name)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *TagMutation) AddedFields() []string {
-	return nil
+// ClearFavoriteItems clears the "favorite_items" edge to the Meta entity.
+func (m *UserMutation) ClearFavoriteItems() {
+	m.clearedfavorite_items = true
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// FavoriteItemsCleared reports if the "favorite_items" edge to the Meta entity was cleared.
+func (m *UserMutation) FavoriteItemsCleared() bool {
+	return m.clearedfavorite_items
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *TagMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// RemoveFavoriteItemIDs removes the "favorite_items" edge to the Meta entity by IDs.
+func (m *UserMutation) RemoveFavoriteItemIDs(ids ...int) {
+	if m.removedfavorite_items == nil {
+		m.removedfavorite_items = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.favorite_items, ids[i])
+		m.removedfavorite_items[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown Tag numeric field %s", name)
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *TagMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(tag.FieldLastUpdate) {
-		fields = append(fields, tag.FieldLastUpdate)
+// RemovedFavoriteItems returns the removed IDs of the "favorite_items" edge to the Meta entity.
+func (m *UserMutation) RemovedFavoriteItemsIDs() (ids []int) {
+	for id := range m.removedfavorite_items {
+		ids = append(ids, id)
 	}
-	return fields
+	return
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *TagMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// FavoriteItemsIDs returns the "favorite_items" edge IDs in the mutation.
+func (m *UserMutation) FavoriteItemsIDs() (ids []int) {
+	for id := range m.favorite_items {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *TagMutation) ClearField(name string) error {
-	switch name {
-	case tag.FieldLastUpdate:
-		m.ClearLastUpdate()
-		return nil
-	}
-	return fmt.Errorf("unknown Tag nullable field %s", name)
+// ResetFavoriteItems resets all changes to the "favorite_items" edge.
+func (m *UserMutation) ResetFavoriteItems() {
+	m.favorite_items = nil
+	m.clearedfavorite_items = false
+	m.removedfavorite_items = nil
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *TagMutation) ResetField(name string) error {
-	switch name {
-	case tag.FieldName:
-		m.ResetName()
-		return nil
-	case tag.FieldFavorite:
-		m.ResetFavorite()
-		return nil
-	case tag.FieldHidden:
-		m.ResetHidden()
-		return nil
-	case tag.FieldLastUpdate:
-		m.ResetLastUpdate()
-		return nil
+// AddFavoriteTagIDs adds the "favorite_tags" edge to the Tag entity by ids.
+func (m *UserMutation) AddFavoriteTagIDs(ids ...int) {
+	if m.favorite_tags == nil {
+		m.favorite_tags = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.favorite_tags[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown Tag field %s", name)
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TagMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.meta != nil {
-		edges = append(edges, tag.EdgeMeta)
+// ClearFavoriteTags clears the "favorite_tags" edge to the Tag entity.
+func (m *UserMutation) ClearFavoriteTags() {
+	m.clearedfavorite_tags = true
+}
+
+// FavoriteTagsCleared reports if the "favorite_tags" edge to the Tag entity was cleared.
+func (m *UserMutation) FavoriteTagsCleared() bool {
+	return m.clearedfavorite_tags
+}
+
+// RemoveFavoriteTagIDs removes the "favorite_tags" edge to the Tag entity by IDs.
+func (m *UserMutation) RemoveFavoriteTagIDs(ids ...int) {
+	if m.removedfavorite_tags == nil {
+		m.removedfavorite_tags = make(map[int]struct{})
 	}
-	if m.favorite_of_user != nil {
-		edges = append(edges, tag.EdgeFavoriteOfUser)
+	for i := range ids {
+		delete(m.favorite_tags, ids[i])
+		m.removedfavorite_tags[ids[i]] = struct{}{}
 	}
-	return edges
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *TagMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case tag.EdgeMeta:
-		ids := make([]ent.Value, 0, len(m.meta))
-		for id := range m.meta {
-			ids = append(ids, id)
-		}
-		return ids
-	case tag.EdgeFavoriteOfUser:
-		ids := make([]ent.Value, 0, len(m.favorite_of_user))
-		for id := range m.favorite_of_user {
-			ids = append(ids, id)
-		}
-		return ids
+// RemovedFavoriteTags returns the removed IDs of the "favorite_tags" edge to the Tag entity.
+func (m *UserMutation) RemovedFavoriteTagsIDs() (ids []int) {
+	for id := range m.removedfavorite_tags {
+		ids = append(ids, id)
 	}
-	return nil
+	return
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TagMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedmeta != nil {
-		edges = append(edges, tag.EdgeMeta)
-	}
-	if m.removedfavorite_of_user != nil {
-		edges = append(edges, tag.EdgeFavoriteOfUser)
+// FavoriteTagsIDs returns the "favorite_tags" edge IDs in the mutation.
+func (m *UserMutation) FavoriteTagsIDs() (ids []int) {
+	for id := range m.favorite_tags {
+		ids = append(ids, id)
 	}
-	return edges
+	return
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *TagMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case tag.EdgeMeta:
-		ids := make([]ent.Value, 0, len(m.removedmeta))
-		for id := range m.removedmeta {
-			ids = append(ids, id)
-		}
-		return ids
-	case tag.EdgeFavoriteOfUser:
-		ids := make([]ent.Value, 0, len(m.removedfavorite_of_user))
-		for id := range m.removedfavorite_of_user {
-			ids = append(ids, id)
-		}
-		return ids
+// ResetFavoriteTags resets all changes to the "favorite_tags" edge.
+func (m *UserMutation) ResetFavoriteTags() {
+	m.favorite_tags = nil
+	m.clearedfavorite_tags = false
+	m.removedfavorite_tags = nil
+}
+
+// AddHistoryIDs adds the "histories" edge to the History entity by ids.
+func (m *UserMutation) AddHistoryIDs(ids ...int) {
+	if m.histories == nil {
+		m.histories = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.histories[ids[i]] = struct{}{}
 	}
-	return nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TagMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedmeta {
-		edges = append(edges, tag.EdgeMeta)
+// ClearHistories clears the "histories" edge to the History entity.
+func (m *UserMutation) ClearHistories() {
+	m.clearedhistories = true
+}
+
+// HistoriesCleared reports if the "histories" edge to the History entity was cleared.
+func (m *UserMutation) HistoriesCleared() bool {
+	return m.clearedhistories
+}
+
+// RemoveHistoryIDs removes the "histories" edge to the History entity by IDs.
+func (m *UserMutation) RemoveHistoryIDs(ids ...int) {
+	if m.removedhistories == nil {
+		m.removedhistories = make(map[int]struct{})
 	}
-	if m.clearedfavorite_of_user {
-		edges = append(edges, tag.EdgeFavoriteOfUser)
+	for i := range ids {
+		delete(m.histories, ids[i])
+		m.removedhistories[ids[i]] = struct{}{}
 	}
-	return edges
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *TagMutation) EdgeCleared(name string) bool {
-	switch name {
-	case tag.EdgeMeta:
-		return m.clearedmeta
-	case tag.EdgeFavoriteOfUser:
-		return m.clearedfavorite_of_user
+// RemovedHistories returns the removed IDs of the "histories" edge to the History entity.
+func (m *UserMutation) RemovedHistoriesIDs() (ids []int) {
+	for id := range m.removedhistories {
+		ids = append(ids, id)
 	}
-	return false
+	return
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *TagMutation) ClearEdge(name string) error {
-	switch name {
+// HistoriesIDs returns the "histories" edge IDs in the mutation.
+func (m *UserMutation) HistoriesIDs() (ids []int) {
+	for id := range m.histories {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown Tag unique edge %s", name)
+	return
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *TagMutation) ResetEdge(name string) error {
-	switch name {
-	case tag.EdgeMeta:
-		m.ResetMeta()
-		return nil
-	case tag.EdgeFavoriteOfUser:
-		m.ResetFavoriteOfUser()
-		return nil
-	}
-	return fmt.Errorf("unknown Tag edge %s", name)
+// ResetHistories resets all changes to the "histories" edge.
+func (m *UserMutation) ResetHistories() {
+	m.histories = nil
+	m.clearedhistories = false
+	m.removedhistories = nil
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
-	config
-	op                    Op
-	typ                   string
-	id                    *int
-	email                 *string
-	active                *bool
-	clearedFields         map[string]struct{}
-	favorite_items        map[int]struct{}
-	removedfavorite_items map[int]struct{}
-	clearedfavorite_items bool
-	favorite_tags         map[int]struct{}
-	removedfavorite_tags  map[int]struct{}
-	clearedfavorite_tags  bool
-	histories             map[int]struct{}
-	removedhistories      map[int]struct{}
-	clearedhistories      bool
-	progress              map[int]struct{}
-	removedprogress       map[int]struct{}
-	clearedprogress       bool
-	done                  bool
-	oldValue              func(context.Context) (*User, error)
-	predicates            []predicate.User
+// AddProgresIDs adds the "progress" edge to the Progress entity by ids.
+func (m *UserMutation) AddProgresIDs(ids ...int) {
+	if m.progress == nil {
+		m.progress = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.progress[ids[i]] = struct{}{}
+	}
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+// ClearProgress clears the "progress" edge to the Progress entity.
+func (m *UserMutation) ClearProgress() {
+	m.clearedprogress = true
+}
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// ProgressCleared reports if the "progress" edge to the Progress entity was cleared.
+func (m *UserMutation) ProgressCleared() bool {
+	return m.clearedprogress
+}
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeUser,
-		clearedFields: make(map[string]struct{}),
+// RemoveProgresIDs removes the "progress" edge to the Progress entity by IDs.
+func (m *UserMutation) RemoveProgresIDs(ids ...int) {
+	if m.removedprogress == nil {
+		m.removedprogress = make(map[int]struct{})
 	}
-	for _, opt := range opts {
-		opt(m)
+	for i := range ids {
+		delete(m.progress, ids[i])
+		m.removedprogress[ids[i]] = struct{}{}
 	}
-	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id int) userOption {
-	return func(m *UserMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *User
-		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().User.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// RemovedProgress returns the removed IDs of the "progress" edge to the Progress entity.
+func (m *UserMutation) RemovedProgressIDs() (ids []int) {
+	for id := range m.removedprogress {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// ProgressIDs returns the "progress" edge IDs in the mutation.
+func (m *UserMutation) ProgressIDs() (ids []int) {
+	for id := range m.progress {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetProgress resets all changes to the "progress" edge.
+func (m *UserMutation) ResetProgress() {
+	m.progress = nil
+	m.clearedprogress = false
+	m.removedprogress = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AddBlockedItemIDs adds the "blocked_items" edge to the Meta entity by ids.
+func (m *UserMutation) AddBlockedItemIDs(ids ...int) {
+	if m.blocked_items == nil {
+		m.blocked_items = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.blocked_items[ids[i]] = struct{}{}
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// ClearBlockedItems clears the "blocked_items" edge to the Meta entity.
+func (m *UserMutation) ClearBlockedItems() {
+	m.clearedblocked_items = true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// BlockedItemsCleared reports if the "blocked_items" edge to the Meta entity was cleared.
+func (m *UserMutation) BlockedItemsCleared() bool {
+	return m.clearedblocked_items
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// RemoveBlockedItemIDs removes the "blocked_items" edge to the Meta entity by IDs.
+func (m *UserMutation) RemoveBlockedItemIDs(ids ...int) {
+	if m.removedblocked_items == nil {
+		m.removedblocked_items = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.blocked_items, ids[i])
+		m.removedblocked_items[ids[i]] = struct{}{}
+	}
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
-	if v == nil {
-		return
+// RemovedBlockedItems returns the removed IDs of the "blocked_items" edge to the Meta entity.
+func (m *UserMutation) RemovedBlockedItemsIDs() (ids []int) {
+	for id := range m.removedblocked_items {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+// BlockedItemsIDs returns the "blocked_items" edge IDs in the mutation.
+func (m *UserMutation) BlockedItemsIDs() (ids []int) {
+	for id := range m.blocked_items {
+		ids = append(ids, id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+	return
+}
+
+// ResetBlockedItems resets all changes to the "blocked_items" edge.
+func (m *UserMutation) ResetBlockedItems() {
+	m.blocked_items = nil
+	m.clearedblocked_items = false
+	m.removedblocked_items = nil
+}
+
+// AddBlockedTagIDs adds the "blocked_tags" edge to the Tag entity by ids.
+func (m *UserMutation) AddBlockedTagIDs(ids ...int) {
+	if m.blocked_tags == nil {
+		m.blocked_tags = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	for i := range ids {
+		m.blocked_tags[ids[i]] = struct{}{}
 	}
-	return oldValue.Email, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+// ClearBlockedTags clears the "blocked_tags" edge to the Tag entity.
+func (m *UserMutation) ClearBlockedTags() {
+	m.clearedblocked_tags = true
 }
 
-// SetActive sets the "active" field.
-func (m *UserMutation) SetActive(b bool) {
-	m.active = &b
+// BlockedTagsCleared reports if the "blocked_tags" edge to the Tag entity was cleared.
+func (m *UserMutation) BlockedTagsCleared() bool {
+	return m.clearedblocked_tags
+}
+
+// RemoveBlockedTagIDs removes the "blocked_tags" edge to the Tag entity by IDs.
+func (m *UserMutation) RemoveBlockedTagIDs(ids ...int) {
+	if m.removedblocked_tags == nil {
+		m.removedblocked_tags = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.blocked_tags, ids[i])
+		m.removedblocked_tags[ids[i]] = struct{}{}
+	}
 }
 
-// Active returns the value of the "active" field in the mutation.
-func (m *UserMutation) Active() (r bool, exists bool) {
-	v := m.active
-	if v == nil {
-		return
+// RemovedBlockedTags returns the removed IDs of the "blocked_tags" edge to the Tag entity.
+func (m *UserMutation) RemovedBlockedTagsIDs() (ids []int) {
+	for id := range m.removedblocked_tags {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldActive returns the old "active" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldActive(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActive is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActive requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+// BlockedTagsIDs returns the "blocked_tags" edge IDs in the mutation.
+func (m *UserMutation) BlockedTagsIDs() (ids []int) {
+	for id := range m.blocked_tags {
+		ids = append(ids, id)
 	}
-	return oldValue.Active, nil
+	return
 }
 
-// ResetActive resets all changes to the "active" field.
-func (m *UserMutation) ResetActive() {
-	m.active = nil
+// ResetBlockedTags resets all changes to the "blocked_tags" edge.
+func (m *UserMutation) ResetBlockedTags() {
+	m.blocked_tags = nil
+	m.clearedblocked_tags = false
+	m.removedblocked_tags = nil
 }
 
-// AddFavoriteItemIDs adds the "favorite_items" edge to the Meta entity by ids.
-func (m *UserMutation) AddFavoriteItemIDs(ids ...int) {
-	if m.favorite_items == nil {
-		m.favorite_items = make(map[int]struct{})
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by ids.
+func (m *UserMutation) AddSavedSearchIDs(ids ...int) {
+	if m.saved_searches == nil {
+		m.saved_searches = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.favorite_items[ids[i]] = struct{}{}
+		m.saved_searches[ids[i]] = struct{}{}
 	}
 }
 
-// ClearFavoriteItems clears the "favorite_items" edge to the Meta entity.
-func (m *UserMutation) ClearFavoriteItems() {
-	m.clearedfavorite_items = true
+// ClearSavedSearches clears the "saved_searches" edge to the SavedSearch entity.
+func (m *UserMutation) ClearSavedSearches() {
+	m.clearedsaved_searches = true
 }
 
-// FavoriteItemsCleared reports if the "favorite_items" edge to the Meta entity was cleared.
-func (m *UserMutation) FavoriteItemsCleared() bool {
-	return m.clearedfavorite_items
+// SavedSearchesCleared reports if the "saved_searches" edge to the SavedSearch entity was cleared.
+func (m *UserMutation) SavedSearchesCleared() bool {
+	return m.clearedsaved_searches
 }
 
-// RemoveFavoriteItemIDs removes the "favorite_items" edge to the Meta entity by IDs.
-func (m *UserMutation) RemoveFavoriteItemIDs(ids ...int) {
-	if m.removedfavorite_items == nil {
-		m.removedfavorite_items = make(map[int]struct{})
+// RemoveSavedSearchIDs removes the "saved_searches" edge to the SavedSearch entity by IDs.
+func (m *UserMutation) RemoveSavedSearchIDs(ids ...int) {
+	if m.removedsaved_searches == nil {
+		m.removedsaved_searches = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.favorite_items, ids[i])
-		m.removedfavorite_items[ids[i]] = struct{}{}
+		delete(m.saved_searches, ids[i])
+		m.removedsaved_searches[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedFavoriteItems returns the removed IDs of the "favorite_items" edge to the Meta entity.
-func (m *UserMutation) RemovedFavoriteItemsIDs() (ids []int) {
-	for id := range m.removedfavorite_items {
+// RemovedSavedSearches returns the removed IDs of the "saved_searches" edge to the SavedSearch entity.
+func (m *UserMutation) RemovedSavedSearchesIDs() (ids []int) {
+	for id := range m.removedsaved_searches {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// FavoriteItemsIDs returns the "favorite_items" edge IDs in the mutation.
-func (m *UserMutation) FavoriteItemsIDs() (ids []int) {
-	for id := range m.favorite_items {
+// SavedSearchesIDs returns the "saved_searches" edge IDs in the mutation.
+func (m *UserMutation) SavedSearchesIDs() (ids []int) {
+	for id := range m.saved_searches {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetFavoriteItems resets all changes to the "favorite_items" edge.
-func (m *UserMutation) ResetFavoriteItems() {
-	m.favorite_items = nil
-	m.clearedfavorite_items = false
-	m.removedfavorite_items = nil
+// ResetSavedSearches resets all changes to the "saved_searches" edge.
+func (m *UserMutation) ResetSavedSearches() {
+	m.saved_searches = nil
+	m.clearedsaved_searches = false
+	m.removedsaved_searches = nil
 }
 
-// AddFavoriteTagIDs adds the "favorite_tags" edge to the Tag entity by ids.
-func (m *UserMutation) AddFavoriteTagIDs(ids ...int) {
-	if m.favorite_tags == nil {
-		m.favorite_tags = make(map[int]struct{})
+// AddSessionIDs adds the "sessions" edge to the Session entity by ids.
+func (m *UserMutation) AddSessionIDs(ids ...int) {
+	if m.sessions == nil {
+		m.sessions = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.favorite_tags[ids[i]] = struct{}{}
+		m.sessions[ids[i]] = struct{}{}
 	}
 }
 
-// ClearFavoriteTags clears the "favorite_tags" edge to the Tag entity.
-func (m *UserMutation) ClearFavoriteTags() {
-	m.clearedfavorite_tags = true
+// ClearSessions clears the "sessions" edge to the Session entity.
+func (m *UserMutation) ClearSessions() {
+	m.clearedsessions = true
 }
 
-// FavoriteTagsCleared reports if the "favorite_tags" edge to the Tag entity was cleared.
-func (m *UserMutation) FavoriteTagsCleared() bool {
-	return m.clearedfavorite_tags
+// SessionsCleared reports if the "sessions" edge to the Session entity was cleared.
+func (m *UserMutation) SessionsCleared() bool {
+	return m.clearedsessions
 }
 
-// RemoveFavoriteTagIDs removes the "favorite_tags" edge to the Tag entity by IDs.
-func (m *UserMutation) RemoveFavoriteTagIDs(ids ...int) {
-	if m.removedfavorite_tags == nil {
-		m.removedfavorite_tags = make(map[int]struct{})
+// RemoveSessionIDs removes the "sessions" edge to the Session entity by IDs.
+func (m *UserMutation) RemoveSessionIDs(ids ...int) {
+	if m.removedsessions == nil {
+		m.removedsessions = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.favorite_tags, ids[i])
-		m.removedfavorite_tags[ids[i]] = struct{}{}
+		delete(m.sessions, ids[i])
+		m.removedsessions[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedFavoriteTags returns the removed IDs of the "favorite_tags" edge to the Tag entity.
-func (m *UserMutation) RemovedFavoriteTagsIDs() (ids []int) {
-	for id := range m.removedfavorite_tags {
+// RemovedSessions returns the removed IDs of the "sessions" edge to the Session entity.
+func (m *UserMutation) RemovedSessionsIDs() (ids []int) {
+	for id := range m.removedsessions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// FavoriteTagsIDs returns the "favorite_tags" edge IDs in the mutation.
-func (m *UserMutation) FavoriteTagsIDs() (ids []int) {
-	for id := range m.favorite_tags {
+// SessionsIDs returns the "sessions" edge IDs in the mutation.
+func (m *UserMutation) SessionsIDs() (ids []int) {
+	for id := range m.sessions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetFavoriteTags resets all changes to the "favorite_tags" edge.
-func (m *UserMutation) ResetFavoriteTags() {
-	m.favorite_tags = nil
-	m.clearedfavorite_tags = false
-	m.removedfavorite_tags = nil
+// ResetSessions resets all changes to the "sessions" edge.
+func (m *UserMutation) ResetSessions() {
+	m.sessions = nil
+	m.clearedsessions = false
+	m.removedsessions = nil
 }
 
-// AddHistoryIDs adds the "histories" edge to the History entity by ids.
-func (m *UserMutation) AddHistoryIDs(ids ...int) {
-	if m.histories == nil {
-		m.histories = make(map[int]struct{})
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by ids.
+func (m *UserMutation) AddAPIKeyIDs(ids ...int) {
+	if m.api_keys == nil {
+		m.api_keys = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.histories[ids[i]] = struct{}{}
+		m.api_keys[ids[i]] = struct{}{}
 	}
 }
 
-// ClearHistories clears the "histories" edge to the History entity.
-func (m *UserMutation) ClearHistories() {
-	m.clearedhistories = true
+// ClearAPIKeys clears the "api_keys" edge to the APIKey entity.
+func (m *UserMutation) ClearAPIKeys() {
+	m.clearedapi_keys = true
 }
 
-// HistoriesCleared reports if the "histories" edge to the History entity was cleared.
-func (m *UserMutation) HistoriesCleared() bool {
-	return m.clearedhistories
+// APIKeysCleared reports if the "api_keys" edge to the APIKey entity was cleared.
+func (m *UserMutation) APIKeysCleared() bool {
+	return m.clearedapi_keys
 }
 
-// RemoveHistoryIDs removes the "histories" edge to the History entity by IDs.
-func (m *UserMutation) RemoveHistoryIDs(ids ...int) {
-	if m.removedhistories == nil {
-		m.removedhistories = make(map[int]struct{})
+// RemoveAPIKeyIDs removes the "api_keys" edge to the APIKey entity by IDs.
+func (m *UserMutation) RemoveAPIKeyIDs(ids ...int) {
+	if m.removedapi_keys == nil {
+		m.removedapi_keys = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.histories, ids[i])
-		m.removedhistories[ids[i]] = struct{}{}
+		delete(m.api_keys, ids[i])
+		m.removedapi_keys[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedHistories returns the removed IDs of the "histories" edge to the History entity.
-func (m *UserMutation) RemovedHistoriesIDs() (ids []int) {
-	for id := range m.removedhistories {
+// RemovedAPIKeys returns the removed IDs of the "api_keys" edge to the APIKey entity.
+func (m *UserMutation) RemovedAPIKeysIDs() (ids []int) {
+	for id := range m.removedapi_keys {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// HistoriesIDs returns the "histories" edge IDs in the mutation.
-func (m *UserMutation) HistoriesIDs() (ids []int) {
-	for id := range m.histories {
+// APIKeysIDs returns the "api_keys" edge IDs in the mutation.
+func (m *UserMutation) APIKeysIDs() (ids []int) {
+	for id := range m.api_keys {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetHistories resets all changes to the "histories" edge.
-func (m *UserMutation) ResetHistories() {
-	m.histories = nil
-	m.clearedhistories = false
-	m.removedhistories = nil
+// ResetAPIKeys resets all changes to the "api_keys" edge.
+func (m *UserMutation) ResetAPIKeys() {
+	m.api_keys = nil
+	m.clearedapi_keys = false
+	m.removedapi_keys = nil
 }
 
-// AddProgresIDs adds the "progress" edge to the Progress entity by ids.
-func (m *UserMutation) AddProgresIDs(ids ...int) {
-	if m.progress == nil {
-		m.progress = make(map[int]struct{})
+// AddDeniedTagIDs adds the "denied_tags" edge to the Tag entity by ids.
+func (m *UserMutation) AddDeniedTagIDs(ids ...int) {
+	if m.denied_tags == nil {
+		m.denied_tags = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.progress[ids[i]] = struct{}{}
+		m.denied_tags[ids[i]] = struct{}{}
 	}
 }
 
-// ClearProgress clears the "progress" edge to the Progress entity.
-func (m *UserMutation) ClearProgress() {
-	m.clearedprogress = true
+// ClearDeniedTags clears the "denied_tags" edge to the Tag entity.
+func (m *UserMutation) ClearDeniedTags() {
+	m.cleareddenied_tags = true
 }
 
-// ProgressCleared reports if the "progress" edge to the Progress entity was cleared.
-func (m *UserMutation) ProgressCleared() bool {
-	return m.clearedprogress
+// DeniedTagsCleared reports if the "denied_tags" edge to the Tag entity was cleared.
+func (m *UserMutation) DeniedTagsCleared() bool {
+	return m.cleareddenied_tags
 }
 
-// RemoveProgresIDs removes the "progress" edge to the Progress entity by IDs.
-func (m *UserMutation) RemoveProgresIDs(ids ...int) {
-	if m.removedprogress == nil {
-		m.removedprogress = make(map[int]struct{})
+// RemoveDeniedTagIDs removes the "denied_tags" edge to the Tag entity by IDs.
+func (m *UserMutation) RemoveDeniedTagIDs(ids ...int) {
+	if m.removeddenied_tags == nil {
+		m.removeddenied_tags = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.progress, ids[i])
-		m.removedprogress[ids[i]] = struct{}{}
+		delete(m.denied_tags, ids[i])
+		m.removeddenied_tags[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedProgress returns the removed IDs of the "progress" edge to the Progress entity.
-func (m *UserMutation) RemovedProgressIDs() (ids []int) {
-	for id := range m.removedprogress {
+// RemovedDeniedTags returns the removed IDs of the "denied_tags" edge to the Tag entity.
+func (m *UserMutation) RemovedDeniedTagsIDs() (ids []int) {
+	for id := range m.removeddenied_tags {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ProgressIDs returns the "progress" edge IDs in the mutation.
-func (m *UserMutation) ProgressIDs() (ids []int) {
-	for id := range m.progress {
+// DeniedTagsIDs returns the "denied_tags" edge IDs in the mutation.
+func (m *UserMutation) DeniedTagsIDs() (ids []int) {
+	for id := range m.denied_tags {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetProgress resets all changes to the "progress" edge.
-func (m *UserMutation) ResetProgress() {
-	m.progress = nil
-	m.clearedprogress = false
-	m.removedprogress = nil
+// ResetDeniedTags resets all changes to the "denied_tags" edge.
+func (m *UserMutation) ResetDeniedTags() {
+	m.denied_tags = nil
+	m.cleareddenied_tags = false
+	m.removeddenied_tags = nil
 }
 
 // Where appends a list predicates to the UserMutation builder.
@@ -3920,13 +9866,28 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 2)
+	fields := make([]string, 0, 7)
 	if m.email != nil {
 		fields = append(fields, user.FieldEmail)
 	}
 	if m.active != nil {
 		fields = append(fields, user.FieldActive)
 	}
+	if m.password_hash != nil {
+		fields = append(fields, user.FieldPasswordHash)
+	}
+	if m.role != nil {
+		fields = append(fields, user.FieldRole)
+	}
+	if m.max_age_rating != nil {
+		fields = append(fields, user.FieldMaxAgeRating)
+	}
+	if m.allowed_paths != nil {
+		fields = append(fields, user.FieldAllowedPaths)
+	}
+	if m.preferences != nil {
+		fields = append(fields, user.FieldPreferences)
+	}
 	return fields
 }
 
@@ -3939,6 +9900,16 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.Email()
 	case user.FieldActive:
 		return m.Active()
+	case user.FieldPasswordHash:
+		return m.PasswordHash()
+	case user.FieldRole:
+		return m.Role()
+	case user.FieldMaxAgeRating:
+		return m.MaxAgeRating()
+	case user.FieldAllowedPaths:
+		return m.AllowedPaths()
+	case user.FieldPreferences:
+		return m.Preferences()
 	}
 	return nil, false
 }
@@ -3952,6 +9923,16 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldEmail(ctx)
 	case user.FieldActive:
 		return m.OldActive(ctx)
+	case user.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case user.FieldRole:
+		return m.OldRole(ctx)
+	case user.FieldMaxAgeRating:
+		return m.OldMaxAgeRating(ctx)
+	case user.FieldAllowedPaths:
+		return m.OldAllowedPaths(ctx)
+	case user.FieldPreferences:
+		return m.OldPreferences(ctx)
 	}
 	return nil, fmt.Errorf("unknown User field %s", name)
 }
@@ -3975,6 +9956,41 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetActive(v)
 		return nil
+	case user.FieldPasswordHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordHash(v)
+		return nil
+	case user.FieldRole:
+		v, ok := value.(user.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case user.FieldMaxAgeRating:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxAgeRating(v)
+		return nil
+	case user.FieldAllowedPaths:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAllowedPaths(v)
+		return nil
+	case user.FieldPreferences:
+		v, ok := value.(preference.Document)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreferences(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -3982,13 +9998,21 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *UserMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addmax_age_rating != nil {
+		fields = append(fields, user.FieldMaxAgeRating)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldMaxAgeRating:
+		return m.AddedMaxAgeRating()
+	}
 	return nil, false
 }
 
@@ -3997,6 +10021,13 @@ func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case user.FieldMaxAgeRating:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxAgeRating(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User numeric field %s", name)
 }
@@ -4004,7 +10035,20 @@ func (m *UserMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *UserMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(user.FieldPasswordHash) {
+		fields = append(fields, user.FieldPasswordHash)
+	}
+	if m.FieldCleared(user.FieldMaxAgeRating) {
+		fields = append(fields, user.FieldMaxAgeRating)
+	}
+	if m.FieldCleared(user.FieldAllowedPaths) {
+		fields = append(fields, user.FieldAllowedPaths)
+	}
+	if m.FieldCleared(user.FieldPreferences) {
+		fields = append(fields, user.FieldPreferences)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -4017,6 +10061,20 @@ func (m *UserMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldPasswordHash:
+		m.ClearPasswordHash()
+		return nil
+	case user.FieldMaxAgeRating:
+		m.ClearMaxAgeRating()
+		return nil
+	case user.FieldAllowedPaths:
+		m.ClearAllowedPaths()
+		return nil
+	case user.FieldPreferences:
+		m.ClearPreferences()
+		return nil
+	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
@@ -4030,13 +10088,28 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldActive:
 		m.ResetActive()
 		return nil
+	case user.FieldPasswordHash:
+		m.ResetPasswordHash()
+		return nil
+	case user.FieldRole:
+		m.ResetRole()
+		return nil
+	case user.FieldMaxAgeRating:
+		m.ResetMaxAgeRating()
+		return nil
+	case user.FieldAllowedPaths:
+		m.ResetAllowedPaths()
+		return nil
+	case user.FieldPreferences:
+		m.ResetPreferences()
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
+	edges := make([]string, 0, 10)
 	if m.favorite_items != nil {
 		edges = append(edges, user.EdgeFavoriteItems)
 	}
@@ -4049,6 +10122,24 @@ func (m *UserMutation) AddedEdges() []string {
 	if m.progress != nil {
 		edges = append(edges, user.EdgeProgress)
 	}
+	if m.blocked_items != nil {
+		edges = append(edges, user.EdgeBlockedItems)
+	}
+	if m.blocked_tags != nil {
+		edges = append(edges, user.EdgeBlockedTags)
+	}
+	if m.saved_searches != nil {
+		edges = append(edges, user.EdgeSavedSearches)
+	}
+	if m.sessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.api_keys != nil {
+		edges = append(edges, user.EdgeAPIKeys)
+	}
+	if m.denied_tags != nil {
+		edges = append(edges, user.EdgeDeniedTags)
+	}
 	return edges
 }
 
@@ -4080,13 +10171,49 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeBlockedItems:
+		ids := make([]ent.Value, 0, len(m.blocked_items))
+		for id := range m.blocked_items {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBlockedTags:
+		ids := make([]ent.Value, 0, len(m.blocked_tags))
+		for id := range m.blocked_tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSavedSearches:
+		ids := make([]ent.Value, 0, len(m.saved_searches))
+		for id := range m.saved_searches {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.sessions))
+		for id := range m.sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAPIKeys:
+		ids := make([]ent.Value, 0, len(m.api_keys))
+		for id := range m.api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeDeniedTags:
+		ids := make([]ent.Value, 0, len(m.denied_tags))
+		for id := range m.denied_tags {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
+	edges := make([]string, 0, 10)
 	if m.removedfavorite_items != nil {
 		edges = append(edges, user.EdgeFavoriteItems)
 	}
@@ -4099,6 +10226,24 @@ func (m *UserMutation) RemovedEdges() []string {
 	if m.removedprogress != nil {
 		edges = append(edges, user.EdgeProgress)
 	}
+	if m.removedblocked_items != nil {
+		edges = append(edges, user.EdgeBlockedItems)
+	}
+	if m.removedblocked_tags != nil {
+		edges = append(edges, user.EdgeBlockedTags)
+	}
+	if m.removedsaved_searches != nil {
+		edges = append(edges, user.EdgeSavedSearches)
+	}
+	if m.removedsessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.removedapi_keys != nil {
+		edges = append(edges, user.EdgeAPIKeys)
+	}
+	if m.removeddenied_tags != nil {
+		edges = append(edges, user.EdgeDeniedTags)
+	}
 	return edges
 }
 
@@ -4130,13 +10275,49 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeBlockedItems:
+		ids := make([]ent.Value, 0, len(m.removedblocked_items))
+		for id := range m.removedblocked_items {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBlockedTags:
+		ids := make([]ent.Value, 0, len(m.removedblocked_tags))
+		for id := range m.removedblocked_tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSavedSearches:
+		ids := make([]ent.Value, 0, len(m.removedsaved_searches))
+		for id := range m.removedsaved_searches {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.removedsessions))
+		for id := range m.removedsessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAPIKeys:
+		ids := make([]ent.Value, 0, len(m.removedapi_keys))
+		for id := range m.removedapi_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeDeniedTags:
+		ids := make([]ent.Value, 0, len(m.removeddenied_tags))
+		for id := range m.removeddenied_tags {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
+	edges := make([]string, 0, 10)
 	if m.clearedfavorite_items {
 		edges = append(edges, user.EdgeFavoriteItems)
 	}
@@ -4149,6 +10330,24 @@ func (m *UserMutation) ClearedEdges() []string {
 	if m.clearedprogress {
 		edges = append(edges, user.EdgeProgress)
 	}
+	if m.clearedblocked_items {
+		edges = append(edges, user.EdgeBlockedItems)
+	}
+	if m.clearedblocked_tags {
+		edges = append(edges, user.EdgeBlockedTags)
+	}
+	if m.clearedsaved_searches {
+		edges = append(edges, user.EdgeSavedSearches)
+	}
+	if m.clearedsessions {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.clearedapi_keys {
+		edges = append(edges, user.EdgeAPIKeys)
+	}
+	if m.cleareddenied_tags {
+		edges = append(edges, user.EdgeDeniedTags)
+	}
 	return edges
 }
 
@@ -4164,6 +10363,18 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 		return m.clearedhistories
 	case user.EdgeProgress:
 		return m.clearedprogress
+	case user.EdgeBlockedItems:
+		return m.clearedblocked_items
+	case user.EdgeBlockedTags:
+		return m.clearedblocked_tags
+	case user.EdgeSavedSearches:
+		return m.clearedsaved_searches
+	case user.EdgeSessions:
+		return m.clearedsessions
+	case user.EdgeAPIKeys:
+		return m.clearedapi_keys
+	case user.EdgeDeniedTags:
+		return m.cleareddenied_tags
 	}
 	return false
 }
@@ -4192,6 +10403,24 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeProgress:
 		m.ResetProgress()
 		return nil
+	case user.EdgeBlockedItems:
+		m.ResetBlockedItems()
+		return nil
+	case user.EdgeBlockedTags:
+		m.ResetBlockedTags()
+		return nil
+	case user.EdgeSavedSearches:
+		m.ResetSavedSearches()
+		return nil
+	case user.EdgeSessions:
+		m.ResetSessions()
+		return nil
+	case user.EdgeAPIKeys:
+		m.ResetAPIKeys()
+		return nil
+	case user.EdgeDeniedTags:
+		m.ResetDeniedTags()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
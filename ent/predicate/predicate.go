@@ -6,17 +6,32 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIKey is the predicate function for apikey builders.
+type APIKey func(*sql.Selector)
+
 // History is the predicate function for history builders.
 type History func(*sql.Selector)
 
 // Meta is the predicate function for meta builders.
 type Meta func(*sql.Selector)
 
+// MetaTag is the predicate function for metatag builders.
+type MetaTag func(*sql.Selector)
+
 // Progress is the predicate function for progress builders.
 type Progress func(*sql.Selector)
 
+// SavedSearch is the predicate function for savedsearch builders.
+type SavedSearch func(*sql.Selector)
+
+// Session is the predicate function for session builders.
+type Session func(*sql.Selector)
+
 // Tag is the predicate function for tag builders.
 type Tag func(*sql.Selector)
 
+// TagAlias is the predicate function for tagalias builders.
+type TagAlias func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
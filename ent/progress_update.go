@@ -19,8 +19,9 @@ import (
 // ProgressUpdate is the builder for updating Progress entities.
 type ProgressUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ProgressMutation
+	hooks     []Hook
+	mutation  *ProgressMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ProgressUpdate builder.
@@ -165,6 +166,12 @@ func (_u *ProgressUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ProgressUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ProgressUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ProgressUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	_spec := sqlgraph.NewUpdateSpec(progress.Table, progress.Columns, sqlgraph.NewFieldSpec(progress.FieldID, field.TypeInt))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
@@ -244,6 +251,7 @@ func (_u *ProgressUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{progress.Label}
@@ -259,9 +267,10 @@ func (_u *ProgressUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // ProgressUpdateOne is the builder for updating a single Progress entity.
 type ProgressUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ProgressMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *ProgressMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetPage sets the "page" field.
@@ -413,6 +422,12 @@ func (_u *ProgressUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ProgressUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ProgressUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ProgressUpdateOne) sqlSave(ctx context.Context) (_node *Progress, err error) {
 	_spec := sqlgraph.NewUpdateSpec(progress.Table, progress.Columns, sqlgraph.NewFieldSpec(progress.FieldID, field.TypeInt))
 	id, ok := _u.mutation.ID()
@@ -509,6 +524,7 @@ func (_u *ProgressUpdateOne) sqlSave(ctx context.Context) (_node *Progress, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Progress{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -2,7 +2,279 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/mangaweb4/mangaweb4-backend/ent/runtime.go
+import (
+	"context"
+	"time"
+
+	"github.com/mangaweb4/mangaweb4-backend/ent/apikey"
+	"github.com/mangaweb4/mangaweb4-backend/ent/history"
+	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/mangaweb4/mangaweb4-backend/ent/metatag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/progress"
+	"github.com/mangaweb4/mangaweb4-backend/ent/savedsearch"
+	"github.com/mangaweb4/mangaweb4-backend/ent/schema"
+	"github.com/mangaweb4/mangaweb4-backend/ent/session"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tagalias"
+	"github.com/mangaweb4/mangaweb4-backend/ent/user"
+
+	"entgo.io/ent"
+	"entgo.io/ent/privacy"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[0].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = apikeyDescName.Validators[0].(func(string) error)
+	// apikeyDescKeyHash is the schema descriptor for key_hash field.
+	apikeyDescKeyHash := apikeyFields[1].Descriptor()
+	// apikey.KeyHashValidator is a validator for the "key_hash" field. It is called by the builders before save.
+	apikey.KeyHashValidator = apikeyDescKeyHash.Validators[0].(func(string) error)
+	// apikeyDescScopes is the schema descriptor for scopes field.
+	apikeyDescScopes := apikeyFields[3].Descriptor()
+	// apikey.DefaultScopes holds the default value on creation for the scopes field.
+	apikey.DefaultScopes = apikeyDescScopes.Default.([]string)
+	// apikeyDescCreateTime is the schema descriptor for create_time field.
+	apikeyDescCreateTime := apikeyFields[4].Descriptor()
+	// apikey.DefaultCreateTime holds the default value on creation for the create_time field.
+	apikey.DefaultCreateTime = apikeyDescCreateTime.Default.(func() time.Time)
+	historyFields := schema.History{}.Fields()
+	_ = historyFields
+	// historyDescCreateTime is the schema descriptor for create_time field.
+	historyDescCreateTime := historyFields[0].Descriptor()
+	// history.DefaultCreateTime holds the default value on creation for the create_time field.
+	history.DefaultCreateTime = historyDescCreateTime.Default.(func() time.Time)
+	meta.Policy = privacy.NewPolicies(schema.Meta{})
+	meta.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := meta.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	metaHooks := schema.Meta{}.Hooks()
+
+	meta.Hooks[1] = metaHooks[0]
+
+	meta.Hooks[2] = metaHooks[1]
+	metaFields := schema.Meta{}.Fields()
+	_ = metaFields
+	// metaDescName is the schema descriptor for name field.
+	metaDescName := metaFields[0].Descriptor()
+	// meta.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	meta.NameValidator = metaDescName.Validators[0].(func(string) error)
+	// metaDescCreateTime is the schema descriptor for create_time field.
+	metaDescCreateTime := metaFields[3].Descriptor()
+	// meta.DefaultCreateTime holds the default value on creation for the create_time field.
+	meta.DefaultCreateTime = metaDescCreateTime.Default.(func() time.Time)
+	// metaDescFavorite is the schema descriptor for favorite field.
+	metaDescFavorite := metaFields[4].Descriptor()
+	// meta.DefaultFavorite holds the default value on creation for the favorite field.
+	meta.DefaultFavorite = metaDescFavorite.Default.(bool)
+	// metaDescFileIndices is the schema descriptor for file_indices field.
+	metaDescFileIndices := metaFields[5].Descriptor()
+	// meta.DefaultFileIndices holds the default value on creation for the file_indices field.
+	meta.DefaultFileIndices = metaDescFileIndices.Default.([]int)
+	// metaDescRead is the schema descriptor for read field.
+	metaDescRead := metaFields[7].Descriptor()
+	// meta.DefaultRead holds the default value on creation for the read field.
+	meta.DefaultRead = metaDescRead.Default.(bool)
+	// metaDescActive is the schema descriptor for active field.
+	metaDescActive := metaFields[8].Descriptor()
+	// meta.DefaultActive holds the default value on creation for the active field.
+	meta.DefaultActive = metaDescActive.Default.(bool)
+	// metaDescHidden is the schema descriptor for hidden field.
+	metaDescHidden := metaFields[9].Descriptor()
+	// meta.DefaultHidden holds the default value on creation for the hidden field.
+	meta.DefaultHidden = metaDescHidden.Default.(bool)
+	// metaDescThumbnailIndex is the schema descriptor for thumbnail_index field.
+	metaDescThumbnailIndex := metaFields[11].Descriptor()
+	// meta.DefaultThumbnailIndex holds the default value on creation for the thumbnail_index field.
+	meta.DefaultThumbnailIndex = metaDescThumbnailIndex.Default.(int)
+	// metaDescThumbnailX is the schema descriptor for thumbnail_x field.
+	metaDescThumbnailX := metaFields[12].Descriptor()
+	// meta.DefaultThumbnailX holds the default value on creation for the thumbnail_x field.
+	meta.DefaultThumbnailX = metaDescThumbnailX.Default.(int)
+	// metaDescThumbnailY is the schema descriptor for thumbnail_y field.
+	metaDescThumbnailY := metaFields[13].Descriptor()
+	// meta.DefaultThumbnailY holds the default value on creation for the thumbnail_y field.
+	meta.DefaultThumbnailY = metaDescThumbnailY.Default.(int)
+	// metaDescThumbnailWidth is the schema descriptor for thumbnail_width field.
+	metaDescThumbnailWidth := metaFields[14].Descriptor()
+	// meta.DefaultThumbnailWidth holds the default value on creation for the thumbnail_width field.
+	meta.DefaultThumbnailWidth = metaDescThumbnailWidth.Default.(int)
+	// metaDescThumbnailHeight is the schema descriptor for thumbnail_height field.
+	metaDescThumbnailHeight := metaFields[15].Descriptor()
+	// meta.DefaultThumbnailHeight holds the default value on creation for the thumbnail_height field.
+	meta.DefaultThumbnailHeight = metaDescThumbnailHeight.Default.(int)
+	// metaDescAgeRating is the schema descriptor for age_rating field.
+	metaDescAgeRating := metaFields[21].Descriptor()
+	// meta.DefaultAgeRating holds the default value on creation for the age_rating field.
+	meta.DefaultAgeRating = metaDescAgeRating.Default.(int)
+	// meta.AgeRatingValidator is a validator for the "age_rating" field. It is called by the builders before save.
+	meta.AgeRatingValidator = metaDescAgeRating.Validators[0].(func(int) error)
+	metatag.Policy = privacy.NewPolicies(schema.MetaTag{})
+	metatag.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := metatag.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	metatagFields := schema.MetaTag{}.Fields()
+	_ = metatagFields
+	progressFields := schema.Progress{}.Fields()
+	_ = progressFields
+	// progressDescPage is the schema descriptor for page field.
+	progressDescPage := progressFields[0].Descriptor()
+	// progress.DefaultPage holds the default value on creation for the page field.
+	progress.DefaultPage = progressDescPage.Default.(int)
+	// progressDescMax is the schema descriptor for max field.
+	progressDescMax := progressFields[1].Descriptor()
+	// progress.DefaultMax holds the default value on creation for the max field.
+	progress.DefaultMax = progressDescMax.Default.(int)
+	savedsearch.Policy = privacy.NewPolicies(schema.SavedSearch{})
+	savedsearch.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := savedsearch.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	savedsearchFields := schema.SavedSearch{}.Fields()
+	_ = savedsearchFields
+	// savedsearchDescName is the schema descriptor for name field.
+	savedsearchDescName := savedsearchFields[0].Descriptor()
+	// savedsearch.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	savedsearch.NameValidator = savedsearchDescName.Validators[0].(func(string) error)
+	// savedsearchDescFilter is the schema descriptor for filter field.
+	savedsearchDescFilter := savedsearchFields[3].Descriptor()
+	// savedsearch.DefaultFilter holds the default value on creation for the filter field.
+	savedsearch.DefaultFilter = savedsearchDescFilter.Default.(int)
+	// savedsearchDescSort is the schema descriptor for sort field.
+	savedsearchDescSort := savedsearchFields[4].Descriptor()
+	// savedsearch.DefaultSort holds the default value on creation for the sort field.
+	savedsearch.DefaultSort = savedsearchDescSort.Default.(int)
+	// savedsearchDescSortOrder is the schema descriptor for sort_order field.
+	savedsearchDescSortOrder := savedsearchFields[5].Descriptor()
+	// savedsearch.DefaultSortOrder holds the default value on creation for the sort_order field.
+	savedsearch.DefaultSortOrder = savedsearchDescSortOrder.Default.(int)
+	// savedsearchDescTagMatch is the schema descriptor for tag_match field.
+	savedsearchDescTagMatch := savedsearchFields[8].Descriptor()
+	// savedsearch.DefaultTagMatch holds the default value on creation for the tag_match field.
+	savedsearch.DefaultTagMatch = savedsearchDescTagMatch.Default.(int)
+	// savedsearchDescRandomSeed is the schema descriptor for random_seed field.
+	savedsearchDescRandomSeed := savedsearchFields[10].Descriptor()
+	// savedsearch.DefaultRandomSeed holds the default value on creation for the random_seed field.
+	savedsearch.DefaultRandomSeed = savedsearchDescRandomSeed.Default.(int64)
+	// savedsearchDescCreateTime is the schema descriptor for create_time field.
+	savedsearchDescCreateTime := savedsearchFields[11].Descriptor()
+	// savedsearch.DefaultCreateTime holds the default value on creation for the create_time field.
+	savedsearch.DefaultCreateTime = savedsearchDescCreateTime.Default.(func() time.Time)
+	// savedsearchDescLastOpenTime is the schema descriptor for last_open_time field.
+	savedsearchDescLastOpenTime := savedsearchFields[12].Descriptor()
+	// savedsearch.DefaultLastOpenTime holds the default value on creation for the last_open_time field.
+	savedsearch.DefaultLastOpenTime = savedsearchDescLastOpenTime.Default.(func() time.Time)
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescTokenHash is the schema descriptor for token_hash field.
+	sessionDescTokenHash := sessionFields[0].Descriptor()
+	// session.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	session.TokenHashValidator = sessionDescTokenHash.Validators[0].(func(string) error)
+	// sessionDescCreateTime is the schema descriptor for create_time field.
+	sessionDescCreateTime := sessionFields[1].Descriptor()
+	// session.DefaultCreateTime holds the default value on creation for the create_time field.
+	session.DefaultCreateTime = sessionDescCreateTime.Default.(func() time.Time)
+	tag.Policy = privacy.NewPolicies(schema.Tag{})
+	tag.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := tag.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	tagHooks := schema.Tag{}.Hooks()
+
+	tag.Hooks[1] = tagHooks[0]
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[0].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = tagDescName.Validators[0].(func(string) error)
+	// tagDescFavorite is the schema descriptor for favorite field.
+	tagDescFavorite := tagFields[1].Descriptor()
+	// tag.DefaultFavorite holds the default value on creation for the favorite field.
+	tag.DefaultFavorite = tagDescFavorite.Default.(bool)
+	// tagDescHidden is the schema descriptor for hidden field.
+	tagDescHidden := tagFields[2].Descriptor()
+	// tag.DefaultHidden holds the default value on creation for the hidden field.
+	tag.DefaultHidden = tagDescHidden.Default.(bool)
+	// tagDescHideItems is the schema descriptor for hide_items field.
+	tagDescHideItems := tagFields[3].Descriptor()
+	// tag.DefaultHideItems holds the default value on creation for the hide_items field.
+	tag.DefaultHideItems = tagDescHideItems.Default.(bool)
+	// tagDescActive is the schema descriptor for active field.
+	tagDescActive := tagFields[4].Descriptor()
+	// tag.DefaultActive holds the default value on creation for the active field.
+	tag.DefaultActive = tagDescActive.Default.(bool)
+	// tagDescLastUpdate is the schema descriptor for last_update field.
+	tagDescLastUpdate := tagFields[5].Descriptor()
+	// tag.DefaultLastUpdate holds the default value on creation for the last_update field.
+	tag.DefaultLastUpdate = tagDescLastUpdate.Default.(time.Time)
+	tagalias.Policy = privacy.NewPolicies(schema.TagAlias{})
+	tagalias.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := tagalias.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	tagaliasFields := schema.TagAlias{}.Fields()
+	_ = tagaliasFields
+	// tagaliasDescName is the schema descriptor for name field.
+	tagaliasDescName := tagaliasFields[0].Descriptor()
+	// tagalias.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tagalias.NameValidator = tagaliasDescName.Validators[0].(func(string) error)
+	// tagaliasDescCreateTime is the schema descriptor for create_time field.
+	tagaliasDescCreateTime := tagaliasFields[2].Descriptor()
+	// tagalias.DefaultCreateTime holds the default value on creation for the create_time field.
+	tagalias.DefaultCreateTime = tagaliasDescCreateTime.Default.(func() time.Time)
+	user.Policy = privacy.NewPolicies(schema.User{})
+	user.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := user.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[0].Descriptor()
+	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescActive is the schema descriptor for active field.
+	userDescActive := userFields[1].Descriptor()
+	// user.DefaultActive holds the default value on creation for the active field.
+	user.DefaultActive = userDescActive.Default.(bool)
+	// userDescMaxAgeRating is the schema descriptor for max_age_rating field.
+	userDescMaxAgeRating := userFields[4].Descriptor()
+	// user.MaxAgeRatingValidator is a validator for the "max_age_rating" field. It is called by the builders before save.
+	user.MaxAgeRatingValidator = userDescMaxAgeRating.Validators[0].(func(int) error)
+}
 
 const (
 	Version = "v0.14.6"                                         // Version of ent codegen.
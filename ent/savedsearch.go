@@ -0,0 +1,284 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mangaweb4/mangaweb4-backend/ent/savedsearch"
+	"github.com/mangaweb4/mangaweb4-backend/ent/user"
+)
+
+// SavedSearch is the model entity for the SavedSearch schema.
+type SavedSearch struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Search holds the value of the "search" field.
+	Search string `json:"search,omitempty"`
+	// Query holds the value of the "query" field.
+	Query string `json:"query,omitempty"`
+	// Filter holds the value of the "filter" field.
+	Filter int `json:"filter,omitempty"`
+	// Sort holds the value of the "sort" field.
+	Sort int `json:"sort,omitempty"`
+	// SortOrder holds the value of the "sort_order" field.
+	SortOrder int `json:"sort_order,omitempty"`
+	// Series holds the value of the "series" field.
+	Series string `json:"series,omitempty"`
+	// IncludeTags holds the value of the "include_tags" field.
+	IncludeTags []int `json:"include_tags,omitempty"`
+	// TagMatch holds the value of the "tag_match" field.
+	TagMatch int `json:"tag_match,omitempty"`
+	// ExcludeTags holds the value of the "exclude_tags" field.
+	ExcludeTags []int `json:"exclude_tags,omitempty"`
+	// RandomSeed holds the value of the "random_seed" field.
+	RandomSeed int64 `json:"random_seed,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// LastOpenTime holds the value of the "last_open_time" field.
+	LastOpenTime time.Time `json:"last_open_time,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SavedSearchQuery when eager-loading is set.
+	Edges               SavedSearchEdges `json:"edges"`
+	user_saved_searches *int
+	selectValues        sql.SelectValues
+}
+
+// SavedSearchEdges holds the relations/edges for other nodes in the graph.
+type SavedSearchEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SavedSearchEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SavedSearch) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case savedsearch.FieldIncludeTags, savedsearch.FieldExcludeTags:
+			values[i] = new([]byte)
+		case savedsearch.FieldID, savedsearch.FieldFilter, savedsearch.FieldSort, savedsearch.FieldSortOrder, savedsearch.FieldTagMatch, savedsearch.FieldRandomSeed:
+			values[i] = new(sql.NullInt64)
+		case savedsearch.FieldName, savedsearch.FieldSearch, savedsearch.FieldQuery, savedsearch.FieldSeries:
+			values[i] = new(sql.NullString)
+		case savedsearch.FieldCreateTime, savedsearch.FieldLastOpenTime:
+			values[i] = new(sql.NullTime)
+		case savedsearch.ForeignKeys[0]: // user_saved_searches
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SavedSearch fields.
+func (_m *SavedSearch) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case savedsearch.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case savedsearch.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case savedsearch.FieldSearch:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field search", values[i])
+			} else if value.Valid {
+				_m.Search = value.String
+			}
+		case savedsearch.FieldQuery:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field query", values[i])
+			} else if value.Valid {
+				_m.Query = value.String
+			}
+		case savedsearch.FieldFilter:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field filter", values[i])
+			} else if value.Valid {
+				_m.Filter = int(value.Int64)
+			}
+		case savedsearch.FieldSort:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sort", values[i])
+			} else if value.Valid {
+				_m.Sort = int(value.Int64)
+			}
+		case savedsearch.FieldSortOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sort_order", values[i])
+			} else if value.Valid {
+				_m.SortOrder = int(value.Int64)
+			}
+		case savedsearch.FieldSeries:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field series", values[i])
+			} else if value.Valid {
+				_m.Series = value.String
+			}
+		case savedsearch.FieldIncludeTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field include_tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.IncludeTags); err != nil {
+					return fmt.Errorf("unmarshal field include_tags: %w", err)
+				}
+			}
+		case savedsearch.FieldTagMatch:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tag_match", values[i])
+			} else if value.Valid {
+				_m.TagMatch = int(value.Int64)
+			}
+		case savedsearch.FieldExcludeTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field exclude_tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ExcludeTags); err != nil {
+					return fmt.Errorf("unmarshal field exclude_tags: %w", err)
+				}
+			}
+		case savedsearch.FieldRandomSeed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field random_seed", values[i])
+			} else if value.Valid {
+				_m.RandomSeed = value.Int64
+			}
+		case savedsearch.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case savedsearch.FieldLastOpenTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_open_time", values[i])
+			} else if value.Valid {
+				_m.LastOpenTime = value.Time
+			}
+		case savedsearch.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_saved_searches", value)
+			} else if value.Valid {
+				_m.user_saved_searches = new(int)
+				*_m.user_saved_searches = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SavedSearch.
+// This includes values selected through modifiers, order, etc.
+func (_m *SavedSearch) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the SavedSearch entity.
+func (_m *SavedSearch) QueryUser() *UserQuery {
+	return NewSavedSearchClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this SavedSearch.
+// Note that you need to call SavedSearch.Unwrap() before calling this method if this SavedSearch
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SavedSearch) Update() *SavedSearchUpdateOne {
+	return NewSavedSearchClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SavedSearch entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SavedSearch) Unwrap() *SavedSearch {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SavedSearch is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SavedSearch) String() string {
+	var builder strings.Builder
+	builder.WriteString("SavedSearch(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("search=")
+	builder.WriteString(_m.Search)
+	builder.WriteString(", ")
+	builder.WriteString("query=")
+	builder.WriteString(_m.Query)
+	builder.WriteString(", ")
+	builder.WriteString("filter=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Filter))
+	builder.WriteString(", ")
+	builder.WriteString("sort=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Sort))
+	builder.WriteString(", ")
+	builder.WriteString("sort_order=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SortOrder))
+	builder.WriteString(", ")
+	builder.WriteString("series=")
+	builder.WriteString(_m.Series)
+	builder.WriteString(", ")
+	builder.WriteString("include_tags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IncludeTags))
+	builder.WriteString(", ")
+	builder.WriteString("tag_match=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagMatch))
+	builder.WriteString(", ")
+	builder.WriteString("exclude_tags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExcludeTags))
+	builder.WriteString(", ")
+	builder.WriteString("random_seed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RandomSeed))
+	builder.WriteString(", ")
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_open_time=")
+	builder.WriteString(_m.LastOpenTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SavedSearches is a parsable slice of SavedSearch.
+type SavedSearches []*SavedSearch
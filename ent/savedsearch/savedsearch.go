@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package savedsearch
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the savedsearch type in the database.
+	Label = "saved_search"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldSearch holds the string denoting the search field in the database.
+	FieldSearch = "search"
+	// FieldQuery holds the string denoting the query field in the database.
+	FieldQuery = "query"
+	// FieldFilter holds the string denoting the filter field in the database.
+	FieldFilter = "filter"
+	// FieldSort holds the string denoting the sort field in the database.
+	FieldSort = "sort"
+	// FieldSortOrder holds the string denoting the sort_order field in the database.
+	FieldSortOrder = "sort_order"
+	// FieldSeries holds the string denoting the series field in the database.
+	FieldSeries = "series"
+	// FieldIncludeTags holds the string denoting the include_tags field in the database.
+	FieldIncludeTags = "include_tags"
+	// FieldTagMatch holds the string denoting the tag_match field in the database.
+	FieldTagMatch = "tag_match"
+	// FieldExcludeTags holds the string denoting the exclude_tags field in the database.
+	FieldExcludeTags = "exclude_tags"
+	// FieldRandomSeed holds the string denoting the random_seed field in the database.
+	FieldRandomSeed = "random_seed"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldLastOpenTime holds the string denoting the last_open_time field in the database.
+	FieldLastOpenTime = "last_open_time"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the savedsearch in the database.
+	Table = "saved_searches"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "saved_searches"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_saved_searches"
+)
+
+// Columns holds all SQL columns for savedsearch fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldSearch,
+	FieldQuery,
+	FieldFilter,
+	FieldSort,
+	FieldSortOrder,
+	FieldSeries,
+	FieldIncludeTags,
+	FieldTagMatch,
+	FieldExcludeTags,
+	FieldRandomSeed,
+	FieldCreateTime,
+	FieldLastOpenTime,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "saved_searches"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_saved_searches",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/mangaweb4/mangaweb4-backend/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultFilter holds the default value on creation for the "filter" field.
+	DefaultFilter int
+	// DefaultSort holds the default value on creation for the "sort" field.
+	DefaultSort int
+	// DefaultSortOrder holds the default value on creation for the "sort_order" field.
+	DefaultSortOrder int
+	// DefaultTagMatch holds the default value on creation for the "tag_match" field.
+	DefaultTagMatch int
+	// DefaultRandomSeed holds the default value on creation for the "random_seed" field.
+	DefaultRandomSeed int64
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultLastOpenTime holds the default value on creation for the "last_open_time" field.
+	DefaultLastOpenTime func() time.Time
+)
+
+// OrderOption defines the ordering options for the SavedSearch queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// BySearch orders the results by the search field.
+func BySearch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSearch, opts...).ToFunc()
+}
+
+// ByQuery orders the results by the query field.
+func ByQuery(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuery, opts...).ToFunc()
+}
+
+// ByFilter orders the results by the filter field.
+func ByFilter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFilter, opts...).ToFunc()
+}
+
+// BySort orders the results by the sort field.
+func BySort(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSort, opts...).ToFunc()
+}
+
+// BySortOrder orders the results by the sort_order field.
+func BySortOrder(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSortOrder, opts...).ToFunc()
+}
+
+// BySeries orders the results by the series field.
+func BySeries(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeries, opts...).ToFunc()
+}
+
+// ByTagMatch orders the results by the tag_match field.
+func ByTagMatch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTagMatch, opts...).ToFunc()
+}
+
+// ByRandomSeed orders the results by the random_seed field.
+func ByRandomSeed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRandomSeed, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByLastOpenTime orders the results by the last_open_time field.
+func ByLastOpenTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastOpenTime, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
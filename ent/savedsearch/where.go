@@ -0,0 +1,739 @@
+// Code generated by ent, DO NOT EDIT.
+
+package savedsearch
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/mangaweb4/mangaweb4-backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldName, v))
+}
+
+// Search applies equality check predicate on the "search" field. It's identical to SearchEQ.
+func Search(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldSearch, v))
+}
+
+// Query applies equality check predicate on the "query" field. It's identical to QueryEQ.
+func Query(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldQuery, v))
+}
+
+// Filter applies equality check predicate on the "filter" field. It's identical to FilterEQ.
+func Filter(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldFilter, v))
+}
+
+// Sort applies equality check predicate on the "sort" field. It's identical to SortEQ.
+func Sort(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldSort, v))
+}
+
+// SortOrder applies equality check predicate on the "sort_order" field. It's identical to SortOrderEQ.
+func SortOrder(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// Series applies equality check predicate on the "series" field. It's identical to SeriesEQ.
+func Series(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldSeries, v))
+}
+
+// TagMatch applies equality check predicate on the "tag_match" field. It's identical to TagMatchEQ.
+func TagMatch(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldTagMatch, v))
+}
+
+// RandomSeed applies equality check predicate on the "random_seed" field. It's identical to RandomSeedEQ.
+func RandomSeed(v int64) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldRandomSeed, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// LastOpenTime applies equality check predicate on the "last_open_time" field. It's identical to LastOpenTimeEQ.
+func LastOpenTime(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldLastOpenTime, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldContainsFold(FieldName, v))
+}
+
+// SearchEQ applies the EQ predicate on the "search" field.
+func SearchEQ(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldSearch, v))
+}
+
+// SearchNEQ applies the NEQ predicate on the "search" field.
+func SearchNEQ(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNEQ(FieldSearch, v))
+}
+
+// SearchIn applies the In predicate on the "search" field.
+func SearchIn(vs ...string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIn(FieldSearch, vs...))
+}
+
+// SearchNotIn applies the NotIn predicate on the "search" field.
+func SearchNotIn(vs ...string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotIn(FieldSearch, vs...))
+}
+
+// SearchGT applies the GT predicate on the "search" field.
+func SearchGT(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGT(FieldSearch, v))
+}
+
+// SearchGTE applies the GTE predicate on the "search" field.
+func SearchGTE(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGTE(FieldSearch, v))
+}
+
+// SearchLT applies the LT predicate on the "search" field.
+func SearchLT(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLT(FieldSearch, v))
+}
+
+// SearchLTE applies the LTE predicate on the "search" field.
+func SearchLTE(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLTE(FieldSearch, v))
+}
+
+// SearchContains applies the Contains predicate on the "search" field.
+func SearchContains(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldContains(FieldSearch, v))
+}
+
+// SearchHasPrefix applies the HasPrefix predicate on the "search" field.
+func SearchHasPrefix(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldHasPrefix(FieldSearch, v))
+}
+
+// SearchHasSuffix applies the HasSuffix predicate on the "search" field.
+func SearchHasSuffix(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldHasSuffix(FieldSearch, v))
+}
+
+// SearchIsNil applies the IsNil predicate on the "search" field.
+func SearchIsNil() predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIsNull(FieldSearch))
+}
+
+// SearchNotNil applies the NotNil predicate on the "search" field.
+func SearchNotNil() predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotNull(FieldSearch))
+}
+
+// SearchEqualFold applies the EqualFold predicate on the "search" field.
+func SearchEqualFold(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEqualFold(FieldSearch, v))
+}
+
+// SearchContainsFold applies the ContainsFold predicate on the "search" field.
+func SearchContainsFold(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldContainsFold(FieldSearch, v))
+}
+
+// QueryEQ applies the EQ predicate on the "query" field.
+func QueryEQ(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldQuery, v))
+}
+
+// QueryNEQ applies the NEQ predicate on the "query" field.
+func QueryNEQ(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNEQ(FieldQuery, v))
+}
+
+// QueryIn applies the In predicate on the "query" field.
+func QueryIn(vs ...string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIn(FieldQuery, vs...))
+}
+
+// QueryNotIn applies the NotIn predicate on the "query" field.
+func QueryNotIn(vs ...string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotIn(FieldQuery, vs...))
+}
+
+// QueryGT applies the GT predicate on the "query" field.
+func QueryGT(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGT(FieldQuery, v))
+}
+
+// QueryGTE applies the GTE predicate on the "query" field.
+func QueryGTE(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGTE(FieldQuery, v))
+}
+
+// QueryLT applies the LT predicate on the "query" field.
+func QueryLT(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLT(FieldQuery, v))
+}
+
+// QueryLTE applies the LTE predicate on the "query" field.
+func QueryLTE(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLTE(FieldQuery, v))
+}
+
+// QueryContains applies the Contains predicate on the "query" field.
+func QueryContains(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldContains(FieldQuery, v))
+}
+
+// QueryHasPrefix applies the HasPrefix predicate on the "query" field.
+func QueryHasPrefix(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldHasPrefix(FieldQuery, v))
+}
+
+// QueryHasSuffix applies the HasSuffix predicate on the "query" field.
+func QueryHasSuffix(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldHasSuffix(FieldQuery, v))
+}
+
+// QueryIsNil applies the IsNil predicate on the "query" field.
+func QueryIsNil() predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIsNull(FieldQuery))
+}
+
+// QueryNotNil applies the NotNil predicate on the "query" field.
+func QueryNotNil() predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotNull(FieldQuery))
+}
+
+// QueryEqualFold applies the EqualFold predicate on the "query" field.
+func QueryEqualFold(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEqualFold(FieldQuery, v))
+}
+
+// QueryContainsFold applies the ContainsFold predicate on the "query" field.
+func QueryContainsFold(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldContainsFold(FieldQuery, v))
+}
+
+// FilterEQ applies the EQ predicate on the "filter" field.
+func FilterEQ(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldFilter, v))
+}
+
+// FilterNEQ applies the NEQ predicate on the "filter" field.
+func FilterNEQ(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNEQ(FieldFilter, v))
+}
+
+// FilterIn applies the In predicate on the "filter" field.
+func FilterIn(vs ...int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIn(FieldFilter, vs...))
+}
+
+// FilterNotIn applies the NotIn predicate on the "filter" field.
+func FilterNotIn(vs ...int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotIn(FieldFilter, vs...))
+}
+
+// FilterGT applies the GT predicate on the "filter" field.
+func FilterGT(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGT(FieldFilter, v))
+}
+
+// FilterGTE applies the GTE predicate on the "filter" field.
+func FilterGTE(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGTE(FieldFilter, v))
+}
+
+// FilterLT applies the LT predicate on the "filter" field.
+func FilterLT(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLT(FieldFilter, v))
+}
+
+// FilterLTE applies the LTE predicate on the "filter" field.
+func FilterLTE(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLTE(FieldFilter, v))
+}
+
+// SortEQ applies the EQ predicate on the "sort" field.
+func SortEQ(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldSort, v))
+}
+
+// SortNEQ applies the NEQ predicate on the "sort" field.
+func SortNEQ(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNEQ(FieldSort, v))
+}
+
+// SortIn applies the In predicate on the "sort" field.
+func SortIn(vs ...int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIn(FieldSort, vs...))
+}
+
+// SortNotIn applies the NotIn predicate on the "sort" field.
+func SortNotIn(vs ...int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotIn(FieldSort, vs...))
+}
+
+// SortGT applies the GT predicate on the "sort" field.
+func SortGT(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGT(FieldSort, v))
+}
+
+// SortGTE applies the GTE predicate on the "sort" field.
+func SortGTE(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGTE(FieldSort, v))
+}
+
+// SortLT applies the LT predicate on the "sort" field.
+func SortLT(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLT(FieldSort, v))
+}
+
+// SortLTE applies the LTE predicate on the "sort" field.
+func SortLTE(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLTE(FieldSort, v))
+}
+
+// SortOrderEQ applies the EQ predicate on the "sort_order" field.
+func SortOrderEQ(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// SortOrderNEQ applies the NEQ predicate on the "sort_order" field.
+func SortOrderNEQ(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNEQ(FieldSortOrder, v))
+}
+
+// SortOrderIn applies the In predicate on the "sort_order" field.
+func SortOrderIn(vs ...int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIn(FieldSortOrder, vs...))
+}
+
+// SortOrderNotIn applies the NotIn predicate on the "sort_order" field.
+func SortOrderNotIn(vs ...int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotIn(FieldSortOrder, vs...))
+}
+
+// SortOrderGT applies the GT predicate on the "sort_order" field.
+func SortOrderGT(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGT(FieldSortOrder, v))
+}
+
+// SortOrderGTE applies the GTE predicate on the "sort_order" field.
+func SortOrderGTE(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGTE(FieldSortOrder, v))
+}
+
+// SortOrderLT applies the LT predicate on the "sort_order" field.
+func SortOrderLT(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLT(FieldSortOrder, v))
+}
+
+// SortOrderLTE applies the LTE predicate on the "sort_order" field.
+func SortOrderLTE(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLTE(FieldSortOrder, v))
+}
+
+// SeriesEQ applies the EQ predicate on the "series" field.
+func SeriesEQ(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldSeries, v))
+}
+
+// SeriesNEQ applies the NEQ predicate on the "series" field.
+func SeriesNEQ(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNEQ(FieldSeries, v))
+}
+
+// SeriesIn applies the In predicate on the "series" field.
+func SeriesIn(vs ...string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIn(FieldSeries, vs...))
+}
+
+// SeriesNotIn applies the NotIn predicate on the "series" field.
+func SeriesNotIn(vs ...string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotIn(FieldSeries, vs...))
+}
+
+// SeriesGT applies the GT predicate on the "series" field.
+func SeriesGT(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGT(FieldSeries, v))
+}
+
+// SeriesGTE applies the GTE predicate on the "series" field.
+func SeriesGTE(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGTE(FieldSeries, v))
+}
+
+// SeriesLT applies the LT predicate on the "series" field.
+func SeriesLT(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLT(FieldSeries, v))
+}
+
+// SeriesLTE applies the LTE predicate on the "series" field.
+func SeriesLTE(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLTE(FieldSeries, v))
+}
+
+// SeriesContains applies the Contains predicate on the "series" field.
+func SeriesContains(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldContains(FieldSeries, v))
+}
+
+// SeriesHasPrefix applies the HasPrefix predicate on the "series" field.
+func SeriesHasPrefix(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldHasPrefix(FieldSeries, v))
+}
+
+// SeriesHasSuffix applies the HasSuffix predicate on the "series" field.
+func SeriesHasSuffix(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldHasSuffix(FieldSeries, v))
+}
+
+// SeriesIsNil applies the IsNil predicate on the "series" field.
+func SeriesIsNil() predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIsNull(FieldSeries))
+}
+
+// SeriesNotNil applies the NotNil predicate on the "series" field.
+func SeriesNotNil() predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotNull(FieldSeries))
+}
+
+// SeriesEqualFold applies the EqualFold predicate on the "series" field.
+func SeriesEqualFold(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEqualFold(FieldSeries, v))
+}
+
+// SeriesContainsFold applies the ContainsFold predicate on the "series" field.
+func SeriesContainsFold(v string) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldContainsFold(FieldSeries, v))
+}
+
+// IncludeTagsIsNil applies the IsNil predicate on the "include_tags" field.
+func IncludeTagsIsNil() predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIsNull(FieldIncludeTags))
+}
+
+// IncludeTagsNotNil applies the NotNil predicate on the "include_tags" field.
+func IncludeTagsNotNil() predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotNull(FieldIncludeTags))
+}
+
+// TagMatchEQ applies the EQ predicate on the "tag_match" field.
+func TagMatchEQ(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldTagMatch, v))
+}
+
+// TagMatchNEQ applies the NEQ predicate on the "tag_match" field.
+func TagMatchNEQ(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNEQ(FieldTagMatch, v))
+}
+
+// TagMatchIn applies the In predicate on the "tag_match" field.
+func TagMatchIn(vs ...int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIn(FieldTagMatch, vs...))
+}
+
+// TagMatchNotIn applies the NotIn predicate on the "tag_match" field.
+func TagMatchNotIn(vs ...int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotIn(FieldTagMatch, vs...))
+}
+
+// TagMatchGT applies the GT predicate on the "tag_match" field.
+func TagMatchGT(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGT(FieldTagMatch, v))
+}
+
+// TagMatchGTE applies the GTE predicate on the "tag_match" field.
+func TagMatchGTE(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGTE(FieldTagMatch, v))
+}
+
+// TagMatchLT applies the LT predicate on the "tag_match" field.
+func TagMatchLT(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLT(FieldTagMatch, v))
+}
+
+// TagMatchLTE applies the LTE predicate on the "tag_match" field.
+func TagMatchLTE(v int) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLTE(FieldTagMatch, v))
+}
+
+// ExcludeTagsIsNil applies the IsNil predicate on the "exclude_tags" field.
+func ExcludeTagsIsNil() predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIsNull(FieldExcludeTags))
+}
+
+// ExcludeTagsNotNil applies the NotNil predicate on the "exclude_tags" field.
+func ExcludeTagsNotNil() predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotNull(FieldExcludeTags))
+}
+
+// RandomSeedEQ applies the EQ predicate on the "random_seed" field.
+func RandomSeedEQ(v int64) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldRandomSeed, v))
+}
+
+// RandomSeedNEQ applies the NEQ predicate on the "random_seed" field.
+func RandomSeedNEQ(v int64) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNEQ(FieldRandomSeed, v))
+}
+
+// RandomSeedIn applies the In predicate on the "random_seed" field.
+func RandomSeedIn(vs ...int64) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIn(FieldRandomSeed, vs...))
+}
+
+// RandomSeedNotIn applies the NotIn predicate on the "random_seed" field.
+func RandomSeedNotIn(vs ...int64) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotIn(FieldRandomSeed, vs...))
+}
+
+// RandomSeedGT applies the GT predicate on the "random_seed" field.
+func RandomSeedGT(v int64) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGT(FieldRandomSeed, v))
+}
+
+// RandomSeedGTE applies the GTE predicate on the "random_seed" field.
+func RandomSeedGTE(v int64) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGTE(FieldRandomSeed, v))
+}
+
+// RandomSeedLT applies the LT predicate on the "random_seed" field.
+func RandomSeedLT(v int64) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLT(FieldRandomSeed, v))
+}
+
+// RandomSeedLTE applies the LTE predicate on the "random_seed" field.
+func RandomSeedLTE(v int64) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLTE(FieldRandomSeed, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// LastOpenTimeEQ applies the EQ predicate on the "last_open_time" field.
+func LastOpenTimeEQ(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldEQ(FieldLastOpenTime, v))
+}
+
+// LastOpenTimeNEQ applies the NEQ predicate on the "last_open_time" field.
+func LastOpenTimeNEQ(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNEQ(FieldLastOpenTime, v))
+}
+
+// LastOpenTimeIn applies the In predicate on the "last_open_time" field.
+func LastOpenTimeIn(vs ...time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldIn(FieldLastOpenTime, vs...))
+}
+
+// LastOpenTimeNotIn applies the NotIn predicate on the "last_open_time" field.
+func LastOpenTimeNotIn(vs ...time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldNotIn(FieldLastOpenTime, vs...))
+}
+
+// LastOpenTimeGT applies the GT predicate on the "last_open_time" field.
+func LastOpenTimeGT(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGT(FieldLastOpenTime, v))
+}
+
+// LastOpenTimeGTE applies the GTE predicate on the "last_open_time" field.
+func LastOpenTimeGTE(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldGTE(FieldLastOpenTime, v))
+}
+
+// LastOpenTimeLT applies the LT predicate on the "last_open_time" field.
+func LastOpenTimeLT(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLT(FieldLastOpenTime, v))
+}
+
+// LastOpenTimeLTE applies the LTE predicate on the "last_open_time" field.
+func LastOpenTimeLTE(v time.Time) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.FieldLTE(FieldLastOpenTime, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.SavedSearch {
+	return predicate.SavedSearch(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.SavedSearch {
+	return predicate.SavedSearch(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SavedSearch) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SavedSearch) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SavedSearch) predicate.SavedSearch {
+	return predicate.SavedSearch(sql.NotPredicates(p))
+}
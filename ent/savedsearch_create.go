@@ -0,0 +1,1436 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mangaweb4/mangaweb4-backend/ent/savedsearch"
+	"github.com/mangaweb4/mangaweb4-backend/ent/user"
+)
+
+// SavedSearchCreate is the builder for creating a SavedSearch entity.
+type SavedSearchCreate struct {
+	config
+	mutation *SavedSearchMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (_c *SavedSearchCreate) SetName(v string) *SavedSearchCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetSearch sets the "search" field.
+func (_c *SavedSearchCreate) SetSearch(v string) *SavedSearchCreate {
+	_c.mutation.SetSearch(v)
+	return _c
+}
+
+// SetNillableSearch sets the "search" field if the given value is not nil.
+func (_c *SavedSearchCreate) SetNillableSearch(v *string) *SavedSearchCreate {
+	if v != nil {
+		_c.SetSearch(*v)
+	}
+	return _c
+}
+
+// SetQuery sets the "query" field.
+func (_c *SavedSearchCreate) SetQuery(v string) *SavedSearchCreate {
+	_c.mutation.SetQuery(v)
+	return _c
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (_c *SavedSearchCreate) SetNillableQuery(v *string) *SavedSearchCreate {
+	if v != nil {
+		_c.SetQuery(*v)
+	}
+	return _c
+}
+
+// SetFilter sets the "filter" field.
+func (_c *SavedSearchCreate) SetFilter(v int) *SavedSearchCreate {
+	_c.mutation.SetFilter(v)
+	return _c
+}
+
+// SetNillableFilter sets the "filter" field if the given value is not nil.
+func (_c *SavedSearchCreate) SetNillableFilter(v *int) *SavedSearchCreate {
+	if v != nil {
+		_c.SetFilter(*v)
+	}
+	return _c
+}
+
+// SetSort sets the "sort" field.
+func (_c *SavedSearchCreate) SetSort(v int) *SavedSearchCreate {
+	_c.mutation.SetSort(v)
+	return _c
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_c *SavedSearchCreate) SetNillableSort(v *int) *SavedSearchCreate {
+	if v != nil {
+		_c.SetSort(*v)
+	}
+	return _c
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_c *SavedSearchCreate) SetSortOrder(v int) *SavedSearchCreate {
+	_c.mutation.SetSortOrder(v)
+	return _c
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_c *SavedSearchCreate) SetNillableSortOrder(v *int) *SavedSearchCreate {
+	if v != nil {
+		_c.SetSortOrder(*v)
+	}
+	return _c
+}
+
+// SetSeries sets the "series" field.
+func (_c *SavedSearchCreate) SetSeries(v string) *SavedSearchCreate {
+	_c.mutation.SetSeries(v)
+	return _c
+}
+
+// SetNillableSeries sets the "series" field if the given value is not nil.
+func (_c *SavedSearchCreate) SetNillableSeries(v *string) *SavedSearchCreate {
+	if v != nil {
+		_c.SetSeries(*v)
+	}
+	return _c
+}
+
+// SetIncludeTags sets the "include_tags" field.
+func (_c *SavedSearchCreate) SetIncludeTags(v []int) *SavedSearchCreate {
+	_c.mutation.SetIncludeTags(v)
+	return _c
+}
+
+// SetTagMatch sets the "tag_match" field.
+func (_c *SavedSearchCreate) SetTagMatch(v int) *SavedSearchCreate {
+	_c.mutation.SetTagMatch(v)
+	return _c
+}
+
+// SetNillableTagMatch sets the "tag_match" field if the given value is not nil.
+func (_c *SavedSearchCreate) SetNillableTagMatch(v *int) *SavedSearchCreate {
+	if v != nil {
+		_c.SetTagMatch(*v)
+	}
+	return _c
+}
+
+// SetExcludeTags sets the "exclude_tags" field.
+func (_c *SavedSearchCreate) SetExcludeTags(v []int) *SavedSearchCreate {
+	_c.mutation.SetExcludeTags(v)
+	return _c
+}
+
+// SetRandomSeed sets the "random_seed" field.
+func (_c *SavedSearchCreate) SetRandomSeed(v int64) *SavedSearchCreate {
+	_c.mutation.SetRandomSeed(v)
+	return _c
+}
+
+// SetNillableRandomSeed sets the "random_seed" field if the given value is not nil.
+func (_c *SavedSearchCreate) SetNillableRandomSeed(v *int64) *SavedSearchCreate {
+	if v != nil {
+		_c.SetRandomSeed(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *SavedSearchCreate) SetCreateTime(v time.Time) *SavedSearchCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *SavedSearchCreate) SetNillableCreateTime(v *time.Time) *SavedSearchCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetLastOpenTime sets the "last_open_time" field.
+func (_c *SavedSearchCreate) SetLastOpenTime(v time.Time) *SavedSearchCreate {
+	_c.mutation.SetLastOpenTime(v)
+	return _c
+}
+
+// SetNillableLastOpenTime sets the "last_open_time" field if the given value is not nil.
+func (_c *SavedSearchCreate) SetNillableLastOpenTime(v *time.Time) *SavedSearchCreate {
+	if v != nil {
+		_c.SetLastOpenTime(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *SavedSearchCreate) SetUserID(id int) *SavedSearchCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *SavedSearchCreate) SetUser(v *User) *SavedSearchCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the SavedSearchMutation object of the builder.
+func (_c *SavedSearchCreate) Mutation() *SavedSearchMutation {
+	return _c.mutation
+}
+
+// Save creates the SavedSearch in the database.
+func (_c *SavedSearchCreate) Save(ctx context.Context) (*SavedSearch, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SavedSearchCreate) SaveX(ctx context.Context) *SavedSearch {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SavedSearchCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SavedSearchCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SavedSearchCreate) defaults() error {
+	if _, ok := _c.mutation.Filter(); !ok {
+		v := savedsearch.DefaultFilter
+		_c.mutation.SetFilter(v)
+	}
+	if _, ok := _c.mutation.Sort(); !ok {
+		v := savedsearch.DefaultSort
+		_c.mutation.SetSort(v)
+	}
+	if _, ok := _c.mutation.SortOrder(); !ok {
+		v := savedsearch.DefaultSortOrder
+		_c.mutation.SetSortOrder(v)
+	}
+	if _, ok := _c.mutation.TagMatch(); !ok {
+		v := savedsearch.DefaultTagMatch
+		_c.mutation.SetTagMatch(v)
+	}
+	if _, ok := _c.mutation.RandomSeed(); !ok {
+		v := savedsearch.DefaultRandomSeed
+		_c.mutation.SetRandomSeed(v)
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if savedsearch.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized savedsearch.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := savedsearch.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.LastOpenTime(); !ok {
+		if savedsearch.DefaultLastOpenTime == nil {
+			return fmt.Errorf("ent: uninitialized savedsearch.DefaultLastOpenTime (forgotten import ent/runtime?)")
+		}
+		v := savedsearch.DefaultLastOpenTime()
+		_c.mutation.SetLastOpenTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SavedSearchCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SavedSearch.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := savedsearch.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SavedSearch.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Filter(); !ok {
+		return &ValidationError{Name: "filter", err: errors.New(`ent: missing required field "SavedSearch.filter"`)}
+	}
+	if _, ok := _c.mutation.Sort(); !ok {
+		return &ValidationError{Name: "sort", err: errors.New(`ent: missing required field "SavedSearch.sort"`)}
+	}
+	if _, ok := _c.mutation.SortOrder(); !ok {
+		return &ValidationError{Name: "sort_order", err: errors.New(`ent: missing required field "SavedSearch.sort_order"`)}
+	}
+	if _, ok := _c.mutation.TagMatch(); !ok {
+		return &ValidationError{Name: "tag_match", err: errors.New(`ent: missing required field "SavedSearch.tag_match"`)}
+	}
+	if _, ok := _c.mutation.RandomSeed(); !ok {
+		return &ValidationError{Name: "random_seed", err: errors.New(`ent: missing required field "SavedSearch.random_seed"`)}
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "SavedSearch.create_time"`)}
+	}
+	if _, ok := _c.mutation.LastOpenTime(); !ok {
+		return &ValidationError{Name: "last_open_time", err: errors.New(`ent: missing required field "SavedSearch.last_open_time"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "SavedSearch.user"`)}
+	}
+	return nil
+}
+
+func (_c *SavedSearchCreate) sqlSave(ctx context.Context) (*SavedSearch, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SavedSearchCreate) createSpec() (*SavedSearch, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SavedSearch{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(savedsearch.Table, sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(savedsearch.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Search(); ok {
+		_spec.SetField(savedsearch.FieldSearch, field.TypeString, value)
+		_node.Search = value
+	}
+	if value, ok := _c.mutation.Query(); ok {
+		_spec.SetField(savedsearch.FieldQuery, field.TypeString, value)
+		_node.Query = value
+	}
+	if value, ok := _c.mutation.Filter(); ok {
+		_spec.SetField(savedsearch.FieldFilter, field.TypeInt, value)
+		_node.Filter = value
+	}
+	if value, ok := _c.mutation.Sort(); ok {
+		_spec.SetField(savedsearch.FieldSort, field.TypeInt, value)
+		_node.Sort = value
+	}
+	if value, ok := _c.mutation.SortOrder(); ok {
+		_spec.SetField(savedsearch.FieldSortOrder, field.TypeInt, value)
+		_node.SortOrder = value
+	}
+	if value, ok := _c.mutation.Series(); ok {
+		_spec.SetField(savedsearch.FieldSeries, field.TypeString, value)
+		_node.Series = value
+	}
+	if value, ok := _c.mutation.IncludeTags(); ok {
+		_spec.SetField(savedsearch.FieldIncludeTags, field.TypeJSON, value)
+		_node.IncludeTags = value
+	}
+	if value, ok := _c.mutation.TagMatch(); ok {
+		_spec.SetField(savedsearch.FieldTagMatch, field.TypeInt, value)
+		_node.TagMatch = value
+	}
+	if value, ok := _c.mutation.ExcludeTags(); ok {
+		_spec.SetField(savedsearch.FieldExcludeTags, field.TypeJSON, value)
+		_node.ExcludeTags = value
+	}
+	if value, ok := _c.mutation.RandomSeed(); ok {
+		_spec.SetField(savedsearch.FieldRandomSeed, field.TypeInt64, value)
+		_node.RandomSeed = value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(savedsearch.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.LastOpenTime(); ok {
+		_spec.SetField(savedsearch.FieldLastOpenTime, field.TypeTime, value)
+		_node.LastOpenTime = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedsearch.UserTable,
+			Columns: []string{savedsearch.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_saved_searches = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SavedSearch.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SavedSearchUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SavedSearchCreate) OnConflict(opts ...sql.ConflictOption) *SavedSearchUpsertOne {
+	_c.conflict = opts
+	return &SavedSearchUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SavedSearch.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SavedSearchCreate) OnConflictColumns(columns ...string) *SavedSearchUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SavedSearchUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SavedSearchUpsertOne is the builder for "upsert"-ing
+	//  one SavedSearch node.
+	SavedSearchUpsertOne struct {
+		create *SavedSearchCreate
+	}
+
+	// SavedSearchUpsert is the "OnConflict" setter.
+	SavedSearchUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *SavedSearchUpsert) SetName(v string) *SavedSearchUpsert {
+	u.Set(savedsearch.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SavedSearchUpsert) UpdateName() *SavedSearchUpsert {
+	u.SetExcluded(savedsearch.FieldName)
+	return u
+}
+
+// SetSearch sets the "search" field.
+func (u *SavedSearchUpsert) SetSearch(v string) *SavedSearchUpsert {
+	u.Set(savedsearch.FieldSearch, v)
+	return u
+}
+
+// UpdateSearch sets the "search" field to the value that was provided on create.
+func (u *SavedSearchUpsert) UpdateSearch() *SavedSearchUpsert {
+	u.SetExcluded(savedsearch.FieldSearch)
+	return u
+}
+
+// ClearSearch clears the value of the "search" field.
+func (u *SavedSearchUpsert) ClearSearch() *SavedSearchUpsert {
+	u.SetNull(savedsearch.FieldSearch)
+	return u
+}
+
+// SetQuery sets the "query" field.
+func (u *SavedSearchUpsert) SetQuery(v string) *SavedSearchUpsert {
+	u.Set(savedsearch.FieldQuery, v)
+	return u
+}
+
+// UpdateQuery sets the "query" field to the value that was provided on create.
+func (u *SavedSearchUpsert) UpdateQuery() *SavedSearchUpsert {
+	u.SetExcluded(savedsearch.FieldQuery)
+	return u
+}
+
+// ClearQuery clears the value of the "query" field.
+func (u *SavedSearchUpsert) ClearQuery() *SavedSearchUpsert {
+	u.SetNull(savedsearch.FieldQuery)
+	return u
+}
+
+// SetFilter sets the "filter" field.
+func (u *SavedSearchUpsert) SetFilter(v int) *SavedSearchUpsert {
+	u.Set(savedsearch.FieldFilter, v)
+	return u
+}
+
+// UpdateFilter sets the "filter" field to the value that was provided on create.
+func (u *SavedSearchUpsert) UpdateFilter() *SavedSearchUpsert {
+	u.SetExcluded(savedsearch.FieldFilter)
+	return u
+}
+
+// AddFilter adds v to the "filter" field.
+func (u *SavedSearchUpsert) AddFilter(v int) *SavedSearchUpsert {
+	u.Add(savedsearch.FieldFilter, v)
+	return u
+}
+
+// SetSort sets the "sort" field.
+func (u *SavedSearchUpsert) SetSort(v int) *SavedSearchUpsert {
+	u.Set(savedsearch.FieldSort, v)
+	return u
+}
+
+// UpdateSort sets the "sort" field to the value that was provided on create.
+func (u *SavedSearchUpsert) UpdateSort() *SavedSearchUpsert {
+	u.SetExcluded(savedsearch.FieldSort)
+	return u
+}
+
+// AddSort adds v to the "sort" field.
+func (u *SavedSearchUpsert) AddSort(v int) *SavedSearchUpsert {
+	u.Add(savedsearch.FieldSort, v)
+	return u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *SavedSearchUpsert) SetSortOrder(v int) *SavedSearchUpsert {
+	u.Set(savedsearch.FieldSortOrder, v)
+	return u
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *SavedSearchUpsert) UpdateSortOrder() *SavedSearchUpsert {
+	u.SetExcluded(savedsearch.FieldSortOrder)
+	return u
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *SavedSearchUpsert) AddSortOrder(v int) *SavedSearchUpsert {
+	u.Add(savedsearch.FieldSortOrder, v)
+	return u
+}
+
+// SetSeries sets the "series" field.
+func (u *SavedSearchUpsert) SetSeries(v string) *SavedSearchUpsert {
+	u.Set(savedsearch.FieldSeries, v)
+	return u
+}
+
+// UpdateSeries sets the "series" field to the value that was provided on create.
+func (u *SavedSearchUpsert) UpdateSeries() *SavedSearchUpsert {
+	u.SetExcluded(savedsearch.FieldSeries)
+	return u
+}
+
+// ClearSeries clears the value of the "series" field.
+func (u *SavedSearchUpsert) ClearSeries() *SavedSearchUpsert {
+	u.SetNull(savedsearch.FieldSeries)
+	return u
+}
+
+// SetIncludeTags sets the "include_tags" field.
+func (u *SavedSearchUpsert) SetIncludeTags(v []int) *SavedSearchUpsert {
+	u.Set(savedsearch.FieldIncludeTags, v)
+	return u
+}
+
+// UpdateIncludeTags sets the "include_tags" field to the value that was provided on create.
+func (u *SavedSearchUpsert) UpdateIncludeTags() *SavedSearchUpsert {
+	u.SetExcluded(savedsearch.FieldIncludeTags)
+	return u
+}
+
+// ClearIncludeTags clears the value of the "include_tags" field.
+func (u *SavedSearchUpsert) ClearIncludeTags() *SavedSearchUpsert {
+	u.SetNull(savedsearch.FieldIncludeTags)
+	return u
+}
+
+// SetTagMatch sets the "tag_match" field.
+func (u *SavedSearchUpsert) SetTagMatch(v int) *SavedSearchUpsert {
+	u.Set(savedsearch.FieldTagMatch, v)
+	return u
+}
+
+// UpdateTagMatch sets the "tag_match" field to the value that was provided on create.
+func (u *SavedSearchUpsert) UpdateTagMatch() *SavedSearchUpsert {
+	u.SetExcluded(savedsearch.FieldTagMatch)
+	return u
+}
+
+// AddTagMatch adds v to the "tag_match" field.
+func (u *SavedSearchUpsert) AddTagMatch(v int) *SavedSearchUpsert {
+	u.Add(savedsearch.FieldTagMatch, v)
+	return u
+}
+
+// SetExcludeTags sets the "exclude_tags" field.
+func (u *SavedSearchUpsert) SetExcludeTags(v []int) *SavedSearchUpsert {
+	u.Set(savedsearch.FieldExcludeTags, v)
+	return u
+}
+
+// UpdateExcludeTags sets the "exclude_tags" field to the value that was provided on create.
+func (u *SavedSearchUpsert) UpdateExcludeTags() *SavedSearchUpsert {
+	u.SetExcluded(savedsearch.FieldExcludeTags)
+	return u
+}
+
+// ClearExcludeTags clears the value of the "exclude_tags" field.
+func (u *SavedSearchUpsert) ClearExcludeTags() *SavedSearchUpsert {
+	u.SetNull(savedsearch.FieldExcludeTags)
+	return u
+}
+
+// SetRandomSeed sets the "random_seed" field.
+func (u *SavedSearchUpsert) SetRandomSeed(v int64) *SavedSearchUpsert {
+	u.Set(savedsearch.FieldRandomSeed, v)
+	return u
+}
+
+// UpdateRandomSeed sets the "random_seed" field to the value that was provided on create.
+func (u *SavedSearchUpsert) UpdateRandomSeed() *SavedSearchUpsert {
+	u.SetExcluded(savedsearch.FieldRandomSeed)
+	return u
+}
+
+// AddRandomSeed adds v to the "random_seed" field.
+func (u *SavedSearchUpsert) AddRandomSeed(v int64) *SavedSearchUpsert {
+	u.Add(savedsearch.FieldRandomSeed, v)
+	return u
+}
+
+// SetCreateTime sets the "create_time" field.
+func (u *SavedSearchUpsert) SetCreateTime(v time.Time) *SavedSearchUpsert {
+	u.Set(savedsearch.FieldCreateTime, v)
+	return u
+}
+
+// UpdateCreateTime sets the "create_time" field to the value that was provided on create.
+func (u *SavedSearchUpsert) UpdateCreateTime() *SavedSearchUpsert {
+	u.SetExcluded(savedsearch.FieldCreateTime)
+	return u
+}
+
+// SetLastOpenTime sets the "last_open_time" field.
+func (u *SavedSearchUpsert) SetLastOpenTime(v time.Time) *SavedSearchUpsert {
+	u.Set(savedsearch.FieldLastOpenTime, v)
+	return u
+}
+
+// UpdateLastOpenTime sets the "last_open_time" field to the value that was provided on create.
+func (u *SavedSearchUpsert) UpdateLastOpenTime() *SavedSearchUpsert {
+	u.SetExcluded(savedsearch.FieldLastOpenTime)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.SavedSearch.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SavedSearchUpsertOne) UpdateNewValues() *SavedSearchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SavedSearch.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SavedSearchUpsertOne) Ignore() *SavedSearchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SavedSearchUpsertOne) DoNothing() *SavedSearchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SavedSearchCreate.OnConflict
+// documentation for more info.
+func (u *SavedSearchUpsertOne) Update(set func(*SavedSearchUpsert)) *SavedSearchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SavedSearchUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *SavedSearchUpsertOne) SetName(v string) *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SavedSearchUpsertOne) UpdateName() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetSearch sets the "search" field.
+func (u *SavedSearchUpsertOne) SetSearch(v string) *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetSearch(v)
+	})
+}
+
+// UpdateSearch sets the "search" field to the value that was provided on create.
+func (u *SavedSearchUpsertOne) UpdateSearch() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateSearch()
+	})
+}
+
+// ClearSearch clears the value of the "search" field.
+func (u *SavedSearchUpsertOne) ClearSearch() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.ClearSearch()
+	})
+}
+
+// SetQuery sets the "query" field.
+func (u *SavedSearchUpsertOne) SetQuery(v string) *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetQuery(v)
+	})
+}
+
+// UpdateQuery sets the "query" field to the value that was provided on create.
+func (u *SavedSearchUpsertOne) UpdateQuery() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateQuery()
+	})
+}
+
+// ClearQuery clears the value of the "query" field.
+func (u *SavedSearchUpsertOne) ClearQuery() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.ClearQuery()
+	})
+}
+
+// SetFilter sets the "filter" field.
+func (u *SavedSearchUpsertOne) SetFilter(v int) *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetFilter(v)
+	})
+}
+
+// AddFilter adds v to the "filter" field.
+func (u *SavedSearchUpsertOne) AddFilter(v int) *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.AddFilter(v)
+	})
+}
+
+// UpdateFilter sets the "filter" field to the value that was provided on create.
+func (u *SavedSearchUpsertOne) UpdateFilter() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateFilter()
+	})
+}
+
+// SetSort sets the "sort" field.
+func (u *SavedSearchUpsertOne) SetSort(v int) *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetSort(v)
+	})
+}
+
+// AddSort adds v to the "sort" field.
+func (u *SavedSearchUpsertOne) AddSort(v int) *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.AddSort(v)
+	})
+}
+
+// UpdateSort sets the "sort" field to the value that was provided on create.
+func (u *SavedSearchUpsertOne) UpdateSort() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateSort()
+	})
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *SavedSearchUpsertOne) SetSortOrder(v int) *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetSortOrder(v)
+	})
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *SavedSearchUpsertOne) AddSortOrder(v int) *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.AddSortOrder(v)
+	})
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *SavedSearchUpsertOne) UpdateSortOrder() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateSortOrder()
+	})
+}
+
+// SetSeries sets the "series" field.
+func (u *SavedSearchUpsertOne) SetSeries(v string) *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetSeries(v)
+	})
+}
+
+// UpdateSeries sets the "series" field to the value that was provided on create.
+func (u *SavedSearchUpsertOne) UpdateSeries() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateSeries()
+	})
+}
+
+// ClearSeries clears the value of the "series" field.
+func (u *SavedSearchUpsertOne) ClearSeries() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.ClearSeries()
+	})
+}
+
+// SetIncludeTags sets the "include_tags" field.
+func (u *SavedSearchUpsertOne) SetIncludeTags(v []int) *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetIncludeTags(v)
+	})
+}
+
+// UpdateIncludeTags sets the "include_tags" field to the value that was provided on create.
+func (u *SavedSearchUpsertOne) UpdateIncludeTags() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateIncludeTags()
+	})
+}
+
+// ClearIncludeTags clears the value of the "include_tags" field.
+func (u *SavedSearchUpsertOne) ClearIncludeTags() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.ClearIncludeTags()
+	})
+}
+
+// SetTagMatch sets the "tag_match" field.
+func (u *SavedSearchUpsertOne) SetTagMatch(v int) *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetTagMatch(v)
+	})
+}
+
+// AddTagMatch adds v to the "tag_match" field.
+func (u *SavedSearchUpsertOne) AddTagMatch(v int) *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.AddTagMatch(v)
+	})
+}
+
+// UpdateTagMatch sets the "tag_match" field to the value that was provided on create.
+func (u *SavedSearchUpsertOne) UpdateTagMatch() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateTagMatch()
+	})
+}
+
+// SetExcludeTags sets the "exclude_tags" field.
+func (u *SavedSearchUpsertOne) SetExcludeTags(v []int) *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetExcludeTags(v)
+	})
+}
+
+// UpdateExcludeTags sets the "exclude_tags" field to the value that was provided on create.
+func (u *SavedSearchUpsertOne) UpdateExcludeTags() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateExcludeTags()
+	})
+}
+
+// ClearExcludeTags clears the value of the "exclude_tags" field.
+func (u *SavedSearchUpsertOne) ClearExcludeTags() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.ClearExcludeTags()
+	})
+}
+
+// SetRandomSeed sets the "random_seed" field.
+func (u *SavedSearchUpsertOne) SetRandomSeed(v int64) *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetRandomSeed(v)
+	})
+}
+
+// AddRandomSeed adds v to the "random_seed" field.
+func (u *SavedSearchUpsertOne) AddRandomSeed(v int64) *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.AddRandomSeed(v)
+	})
+}
+
+// UpdateRandomSeed sets the "random_seed" field to the value that was provided on create.
+func (u *SavedSearchUpsertOne) UpdateRandomSeed() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateRandomSeed()
+	})
+}
+
+// SetCreateTime sets the "create_time" field.
+func (u *SavedSearchUpsertOne) SetCreateTime(v time.Time) *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetCreateTime(v)
+	})
+}
+
+// UpdateCreateTime sets the "create_time" field to the value that was provided on create.
+func (u *SavedSearchUpsertOne) UpdateCreateTime() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateCreateTime()
+	})
+}
+
+// SetLastOpenTime sets the "last_open_time" field.
+func (u *SavedSearchUpsertOne) SetLastOpenTime(v time.Time) *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetLastOpenTime(v)
+	})
+}
+
+// UpdateLastOpenTime sets the "last_open_time" field to the value that was provided on create.
+func (u *SavedSearchUpsertOne) UpdateLastOpenTime() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateLastOpenTime()
+	})
+}
+
+// Exec executes the query.
+func (u *SavedSearchUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SavedSearchCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SavedSearchUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SavedSearchUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SavedSearchUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SavedSearchCreateBulk is the builder for creating many SavedSearch entities in bulk.
+type SavedSearchCreateBulk struct {
+	config
+	err      error
+	builders []*SavedSearchCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SavedSearch entities in the database.
+func (_c *SavedSearchCreateBulk) Save(ctx context.Context) ([]*SavedSearch, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SavedSearch, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SavedSearchMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SavedSearchCreateBulk) SaveX(ctx context.Context) []*SavedSearch {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SavedSearchCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SavedSearchCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SavedSearch.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SavedSearchUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SavedSearchCreateBulk) OnConflict(opts ...sql.ConflictOption) *SavedSearchUpsertBulk {
+	_c.conflict = opts
+	return &SavedSearchUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SavedSearch.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SavedSearchCreateBulk) OnConflictColumns(columns ...string) *SavedSearchUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SavedSearchUpsertBulk{
+		create: _c,
+	}
+}
+
+// SavedSearchUpsertBulk is the builder for "upsert"-ing
+// a bulk of SavedSearch nodes.
+type SavedSearchUpsertBulk struct {
+	create *SavedSearchCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SavedSearch.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SavedSearchUpsertBulk) UpdateNewValues() *SavedSearchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SavedSearch.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SavedSearchUpsertBulk) Ignore() *SavedSearchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SavedSearchUpsertBulk) DoNothing() *SavedSearchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SavedSearchCreateBulk.OnConflict
+// documentation for more info.
+func (u *SavedSearchUpsertBulk) Update(set func(*SavedSearchUpsert)) *SavedSearchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SavedSearchUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *SavedSearchUpsertBulk) SetName(v string) *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SavedSearchUpsertBulk) UpdateName() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetSearch sets the "search" field.
+func (u *SavedSearchUpsertBulk) SetSearch(v string) *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetSearch(v)
+	})
+}
+
+// UpdateSearch sets the "search" field to the value that was provided on create.
+func (u *SavedSearchUpsertBulk) UpdateSearch() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateSearch()
+	})
+}
+
+// ClearSearch clears the value of the "search" field.
+func (u *SavedSearchUpsertBulk) ClearSearch() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.ClearSearch()
+	})
+}
+
+// SetQuery sets the "query" field.
+func (u *SavedSearchUpsertBulk) SetQuery(v string) *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetQuery(v)
+	})
+}
+
+// UpdateQuery sets the "query" field to the value that was provided on create.
+func (u *SavedSearchUpsertBulk) UpdateQuery() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateQuery()
+	})
+}
+
+// ClearQuery clears the value of the "query" field.
+func (u *SavedSearchUpsertBulk) ClearQuery() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.ClearQuery()
+	})
+}
+
+// SetFilter sets the "filter" field.
+func (u *SavedSearchUpsertBulk) SetFilter(v int) *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetFilter(v)
+	})
+}
+
+// AddFilter adds v to the "filter" field.
+func (u *SavedSearchUpsertBulk) AddFilter(v int) *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.AddFilter(v)
+	})
+}
+
+// UpdateFilter sets the "filter" field to the value that was provided on create.
+func (u *SavedSearchUpsertBulk) UpdateFilter() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateFilter()
+	})
+}
+
+// SetSort sets the "sort" field.
+func (u *SavedSearchUpsertBulk) SetSort(v int) *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetSort(v)
+	})
+}
+
+// AddSort adds v to the "sort" field.
+func (u *SavedSearchUpsertBulk) AddSort(v int) *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.AddSort(v)
+	})
+}
+
+// UpdateSort sets the "sort" field to the value that was provided on create.
+func (u *SavedSearchUpsertBulk) UpdateSort() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateSort()
+	})
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *SavedSearchUpsertBulk) SetSortOrder(v int) *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetSortOrder(v)
+	})
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *SavedSearchUpsertBulk) AddSortOrder(v int) *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.AddSortOrder(v)
+	})
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *SavedSearchUpsertBulk) UpdateSortOrder() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateSortOrder()
+	})
+}
+
+// SetSeries sets the "series" field.
+func (u *SavedSearchUpsertBulk) SetSeries(v string) *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetSeries(v)
+	})
+}
+
+// UpdateSeries sets the "series" field to the value that was provided on create.
+func (u *SavedSearchUpsertBulk) UpdateSeries() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateSeries()
+	})
+}
+
+// ClearSeries clears the value of the "series" field.
+func (u *SavedSearchUpsertBulk) ClearSeries() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.ClearSeries()
+	})
+}
+
+// SetIncludeTags sets the "include_tags" field.
+func (u *SavedSearchUpsertBulk) SetIncludeTags(v []int) *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetIncludeTags(v)
+	})
+}
+
+// UpdateIncludeTags sets the "include_tags" field to the value that was provided on create.
+func (u *SavedSearchUpsertBulk) UpdateIncludeTags() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateIncludeTags()
+	})
+}
+
+// ClearIncludeTags clears the value of the "include_tags" field.
+func (u *SavedSearchUpsertBulk) ClearIncludeTags() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.ClearIncludeTags()
+	})
+}
+
+// SetTagMatch sets the "tag_match" field.
+func (u *SavedSearchUpsertBulk) SetTagMatch(v int) *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetTagMatch(v)
+	})
+}
+
+// AddTagMatch adds v to the "tag_match" field.
+func (u *SavedSearchUpsertBulk) AddTagMatch(v int) *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.AddTagMatch(v)
+	})
+}
+
+// UpdateTagMatch sets the "tag_match" field to the value that was provided on create.
+func (u *SavedSearchUpsertBulk) UpdateTagMatch() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateTagMatch()
+	})
+}
+
+// SetExcludeTags sets the "exclude_tags" field.
+func (u *SavedSearchUpsertBulk) SetExcludeTags(v []int) *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetExcludeTags(v)
+	})
+}
+
+// UpdateExcludeTags sets the "exclude_tags" field to the value that was provided on create.
+func (u *SavedSearchUpsertBulk) UpdateExcludeTags() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateExcludeTags()
+	})
+}
+
+// ClearExcludeTags clears the value of the "exclude_tags" field.
+func (u *SavedSearchUpsertBulk) ClearExcludeTags() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.ClearExcludeTags()
+	})
+}
+
+// SetRandomSeed sets the "random_seed" field.
+func (u *SavedSearchUpsertBulk) SetRandomSeed(v int64) *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetRandomSeed(v)
+	})
+}
+
+// AddRandomSeed adds v to the "random_seed" field.
+func (u *SavedSearchUpsertBulk) AddRandomSeed(v int64) *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.AddRandomSeed(v)
+	})
+}
+
+// UpdateRandomSeed sets the "random_seed" field to the value that was provided on create.
+func (u *SavedSearchUpsertBulk) UpdateRandomSeed() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateRandomSeed()
+	})
+}
+
+// SetCreateTime sets the "create_time" field.
+func (u *SavedSearchUpsertBulk) SetCreateTime(v time.Time) *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetCreateTime(v)
+	})
+}
+
+// UpdateCreateTime sets the "create_time" field to the value that was provided on create.
+func (u *SavedSearchUpsertBulk) UpdateCreateTime() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateCreateTime()
+	})
+}
+
+// SetLastOpenTime sets the "last_open_time" field.
+func (u *SavedSearchUpsertBulk) SetLastOpenTime(v time.Time) *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetLastOpenTime(v)
+	})
+}
+
+// UpdateLastOpenTime sets the "last_open_time" field to the value that was provided on create.
+func (u *SavedSearchUpsertBulk) UpdateLastOpenTime() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateLastOpenTime()
+	})
+}
+
+// Exec executes the query.
+func (u *SavedSearchUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SavedSearchCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SavedSearchCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SavedSearchUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,962 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/mangaweb4/mangaweb4-backend/ent/predicate"
+	"github.com/mangaweb4/mangaweb4-backend/ent/savedsearch"
+	"github.com/mangaweb4/mangaweb4-backend/ent/user"
+)
+
+// SavedSearchUpdate is the builder for updating SavedSearch entities.
+type SavedSearchUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SavedSearchMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SavedSearchUpdate builder.
+func (_u *SavedSearchUpdate) Where(ps ...predicate.SavedSearch) *SavedSearchUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SavedSearchUpdate) SetName(v string) *SavedSearchUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SavedSearchUpdate) SetNillableName(v *string) *SavedSearchUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSearch sets the "search" field.
+func (_u *SavedSearchUpdate) SetSearch(v string) *SavedSearchUpdate {
+	_u.mutation.SetSearch(v)
+	return _u
+}
+
+// SetNillableSearch sets the "search" field if the given value is not nil.
+func (_u *SavedSearchUpdate) SetNillableSearch(v *string) *SavedSearchUpdate {
+	if v != nil {
+		_u.SetSearch(*v)
+	}
+	return _u
+}
+
+// ClearSearch clears the value of the "search" field.
+func (_u *SavedSearchUpdate) ClearSearch() *SavedSearchUpdate {
+	_u.mutation.ClearSearch()
+	return _u
+}
+
+// SetQuery sets the "query" field.
+func (_u *SavedSearchUpdate) SetQuery(v string) *SavedSearchUpdate {
+	_u.mutation.SetQuery(v)
+	return _u
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (_u *SavedSearchUpdate) SetNillableQuery(v *string) *SavedSearchUpdate {
+	if v != nil {
+		_u.SetQuery(*v)
+	}
+	return _u
+}
+
+// ClearQuery clears the value of the "query" field.
+func (_u *SavedSearchUpdate) ClearQuery() *SavedSearchUpdate {
+	_u.mutation.ClearQuery()
+	return _u
+}
+
+// SetFilter sets the "filter" field.
+func (_u *SavedSearchUpdate) SetFilter(v int) *SavedSearchUpdate {
+	_u.mutation.ResetFilter()
+	_u.mutation.SetFilter(v)
+	return _u
+}
+
+// SetNillableFilter sets the "filter" field if the given value is not nil.
+func (_u *SavedSearchUpdate) SetNillableFilter(v *int) *SavedSearchUpdate {
+	if v != nil {
+		_u.SetFilter(*v)
+	}
+	return _u
+}
+
+// AddFilter adds value to the "filter" field.
+func (_u *SavedSearchUpdate) AddFilter(v int) *SavedSearchUpdate {
+	_u.mutation.AddFilter(v)
+	return _u
+}
+
+// SetSort sets the "sort" field.
+func (_u *SavedSearchUpdate) SetSort(v int) *SavedSearchUpdate {
+	_u.mutation.ResetSort()
+	_u.mutation.SetSort(v)
+	return _u
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_u *SavedSearchUpdate) SetNillableSort(v *int) *SavedSearchUpdate {
+	if v != nil {
+		_u.SetSort(*v)
+	}
+	return _u
+}
+
+// AddSort adds value to the "sort" field.
+func (_u *SavedSearchUpdate) AddSort(v int) *SavedSearchUpdate {
+	_u.mutation.AddSort(v)
+	return _u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_u *SavedSearchUpdate) SetSortOrder(v int) *SavedSearchUpdate {
+	_u.mutation.ResetSortOrder()
+	_u.mutation.SetSortOrder(v)
+	return _u
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_u *SavedSearchUpdate) SetNillableSortOrder(v *int) *SavedSearchUpdate {
+	if v != nil {
+		_u.SetSortOrder(*v)
+	}
+	return _u
+}
+
+// AddSortOrder adds value to the "sort_order" field.
+func (_u *SavedSearchUpdate) AddSortOrder(v int) *SavedSearchUpdate {
+	_u.mutation.AddSortOrder(v)
+	return _u
+}
+
+// SetSeries sets the "series" field.
+func (_u *SavedSearchUpdate) SetSeries(v string) *SavedSearchUpdate {
+	_u.mutation.SetSeries(v)
+	return _u
+}
+
+// SetNillableSeries sets the "series" field if the given value is not nil.
+func (_u *SavedSearchUpdate) SetNillableSeries(v *string) *SavedSearchUpdate {
+	if v != nil {
+		_u.SetSeries(*v)
+	}
+	return _u
+}
+
+// ClearSeries clears the value of the "series" field.
+func (_u *SavedSearchUpdate) ClearSeries() *SavedSearchUpdate {
+	_u.mutation.ClearSeries()
+	return _u
+}
+
+// SetIncludeTags sets the "include_tags" field.
+func (_u *SavedSearchUpdate) SetIncludeTags(v []int) *SavedSearchUpdate {
+	_u.mutation.SetIncludeTags(v)
+	return _u
+}
+
+// AppendIncludeTags appends value to the "include_tags" field.
+func (_u *SavedSearchUpdate) AppendIncludeTags(v []int) *SavedSearchUpdate {
+	_u.mutation.AppendIncludeTags(v)
+	return _u
+}
+
+// ClearIncludeTags clears the value of the "include_tags" field.
+func (_u *SavedSearchUpdate) ClearIncludeTags() *SavedSearchUpdate {
+	_u.mutation.ClearIncludeTags()
+	return _u
+}
+
+// SetTagMatch sets the "tag_match" field.
+func (_u *SavedSearchUpdate) SetTagMatch(v int) *SavedSearchUpdate {
+	_u.mutation.ResetTagMatch()
+	_u.mutation.SetTagMatch(v)
+	return _u
+}
+
+// SetNillableTagMatch sets the "tag_match" field if the given value is not nil.
+func (_u *SavedSearchUpdate) SetNillableTagMatch(v *int) *SavedSearchUpdate {
+	if v != nil {
+		_u.SetTagMatch(*v)
+	}
+	return _u
+}
+
+// AddTagMatch adds value to the "tag_match" field.
+func (_u *SavedSearchUpdate) AddTagMatch(v int) *SavedSearchUpdate {
+	_u.mutation.AddTagMatch(v)
+	return _u
+}
+
+// SetExcludeTags sets the "exclude_tags" field.
+func (_u *SavedSearchUpdate) SetExcludeTags(v []int) *SavedSearchUpdate {
+	_u.mutation.SetExcludeTags(v)
+	return _u
+}
+
+// AppendExcludeTags appends value to the "exclude_tags" field.
+func (_u *SavedSearchUpdate) AppendExcludeTags(v []int) *SavedSearchUpdate {
+	_u.mutation.AppendExcludeTags(v)
+	return _u
+}
+
+// ClearExcludeTags clears the value of the "exclude_tags" field.
+func (_u *SavedSearchUpdate) ClearExcludeTags() *SavedSearchUpdate {
+	_u.mutation.ClearExcludeTags()
+	return _u
+}
+
+// SetRandomSeed sets the "random_seed" field.
+func (_u *SavedSearchUpdate) SetRandomSeed(v int64) *SavedSearchUpdate {
+	_u.mutation.ResetRandomSeed()
+	_u.mutation.SetRandomSeed(v)
+	return _u
+}
+
+// SetNillableRandomSeed sets the "random_seed" field if the given value is not nil.
+func (_u *SavedSearchUpdate) SetNillableRandomSeed(v *int64) *SavedSearchUpdate {
+	if v != nil {
+		_u.SetRandomSeed(*v)
+	}
+	return _u
+}
+
+// AddRandomSeed adds value to the "random_seed" field.
+func (_u *SavedSearchUpdate) AddRandomSeed(v int64) *SavedSearchUpdate {
+	_u.mutation.AddRandomSeed(v)
+	return _u
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_u *SavedSearchUpdate) SetCreateTime(v time.Time) *SavedSearchUpdate {
+	_u.mutation.SetCreateTime(v)
+	return _u
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_u *SavedSearchUpdate) SetNillableCreateTime(v *time.Time) *SavedSearchUpdate {
+	if v != nil {
+		_u.SetCreateTime(*v)
+	}
+	return _u
+}
+
+// SetLastOpenTime sets the "last_open_time" field.
+func (_u *SavedSearchUpdate) SetLastOpenTime(v time.Time) *SavedSearchUpdate {
+	_u.mutation.SetLastOpenTime(v)
+	return _u
+}
+
+// SetNillableLastOpenTime sets the "last_open_time" field if the given value is not nil.
+func (_u *SavedSearchUpdate) SetNillableLastOpenTime(v *time.Time) *SavedSearchUpdate {
+	if v != nil {
+		_u.SetLastOpenTime(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *SavedSearchUpdate) SetUserID(id int) *SavedSearchUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *SavedSearchUpdate) SetUser(v *User) *SavedSearchUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the SavedSearchMutation object of the builder.
+func (_u *SavedSearchUpdate) Mutation() *SavedSearchMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *SavedSearchUpdate) ClearUser() *SavedSearchUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SavedSearchUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SavedSearchUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SavedSearchUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SavedSearchUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SavedSearchUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := savedsearch.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SavedSearch.name": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedSearch.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SavedSearchUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SavedSearchUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SavedSearchUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedsearch.Table, savedsearch.Columns, sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(savedsearch.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Search(); ok {
+		_spec.SetField(savedsearch.FieldSearch, field.TypeString, value)
+	}
+	if _u.mutation.SearchCleared() {
+		_spec.ClearField(savedsearch.FieldSearch, field.TypeString)
+	}
+	if value, ok := _u.mutation.Query(); ok {
+		_spec.SetField(savedsearch.FieldQuery, field.TypeString, value)
+	}
+	if _u.mutation.QueryCleared() {
+		_spec.ClearField(savedsearch.FieldQuery, field.TypeString)
+	}
+	if value, ok := _u.mutation.Filter(); ok {
+		_spec.SetField(savedsearch.FieldFilter, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFilter(); ok {
+		_spec.AddField(savedsearch.FieldFilter, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Sort(); ok {
+		_spec.SetField(savedsearch.FieldSort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSort(); ok {
+		_spec.AddField(savedsearch.FieldSort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SortOrder(); ok {
+		_spec.SetField(savedsearch.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSortOrder(); ok {
+		_spec.AddField(savedsearch.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Series(); ok {
+		_spec.SetField(savedsearch.FieldSeries, field.TypeString, value)
+	}
+	if _u.mutation.SeriesCleared() {
+		_spec.ClearField(savedsearch.FieldSeries, field.TypeString)
+	}
+	if value, ok := _u.mutation.IncludeTags(); ok {
+		_spec.SetField(savedsearch.FieldIncludeTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIncludeTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, savedsearch.FieldIncludeTags, value)
+		})
+	}
+	if _u.mutation.IncludeTagsCleared() {
+		_spec.ClearField(savedsearch.FieldIncludeTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagMatch(); ok {
+		_spec.SetField(savedsearch.FieldTagMatch, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTagMatch(); ok {
+		_spec.AddField(savedsearch.FieldTagMatch, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExcludeTags(); ok {
+		_spec.SetField(savedsearch.FieldExcludeTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedExcludeTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, savedsearch.FieldExcludeTags, value)
+		})
+	}
+	if _u.mutation.ExcludeTagsCleared() {
+		_spec.ClearField(savedsearch.FieldExcludeTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RandomSeed(); ok {
+		_spec.SetField(savedsearch.FieldRandomSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRandomSeed(); ok {
+		_spec.AddField(savedsearch.FieldRandomSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreateTime(); ok {
+		_spec.SetField(savedsearch.FieldCreateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastOpenTime(); ok {
+		_spec.SetField(savedsearch.FieldLastOpenTime, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedsearch.UserTable,
+			Columns: []string{savedsearch.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedsearch.UserTable,
+			Columns: []string{savedsearch.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedsearch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SavedSearchUpdateOne is the builder for updating a single SavedSearch entity.
+type SavedSearchUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SavedSearchMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (_u *SavedSearchUpdateOne) SetName(v string) *SavedSearchUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SavedSearchUpdateOne) SetNillableName(v *string) *SavedSearchUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSearch sets the "search" field.
+func (_u *SavedSearchUpdateOne) SetSearch(v string) *SavedSearchUpdateOne {
+	_u.mutation.SetSearch(v)
+	return _u
+}
+
+// SetNillableSearch sets the "search" field if the given value is not nil.
+func (_u *SavedSearchUpdateOne) SetNillableSearch(v *string) *SavedSearchUpdateOne {
+	if v != nil {
+		_u.SetSearch(*v)
+	}
+	return _u
+}
+
+// ClearSearch clears the value of the "search" field.
+func (_u *SavedSearchUpdateOne) ClearSearch() *SavedSearchUpdateOne {
+	_u.mutation.ClearSearch()
+	return _u
+}
+
+// SetQuery sets the "query" field.
+func (_u *SavedSearchUpdateOne) SetQuery(v string) *SavedSearchUpdateOne {
+	_u.mutation.SetQuery(v)
+	return _u
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (_u *SavedSearchUpdateOne) SetNillableQuery(v *string) *SavedSearchUpdateOne {
+	if v != nil {
+		_u.SetQuery(*v)
+	}
+	return _u
+}
+
+// ClearQuery clears the value of the "query" field.
+func (_u *SavedSearchUpdateOne) ClearQuery() *SavedSearchUpdateOne {
+	_u.mutation.ClearQuery()
+	return _u
+}
+
+// SetFilter sets the "filter" field.
+func (_u *SavedSearchUpdateOne) SetFilter(v int) *SavedSearchUpdateOne {
+	_u.mutation.ResetFilter()
+	_u.mutation.SetFilter(v)
+	return _u
+}
+
+// SetNillableFilter sets the "filter" field if the given value is not nil.
+func (_u *SavedSearchUpdateOne) SetNillableFilter(v *int) *SavedSearchUpdateOne {
+	if v != nil {
+		_u.SetFilter(*v)
+	}
+	return _u
+}
+
+// AddFilter adds value to the "filter" field.
+func (_u *SavedSearchUpdateOne) AddFilter(v int) *SavedSearchUpdateOne {
+	_u.mutation.AddFilter(v)
+	return _u
+}
+
+// SetSort sets the "sort" field.
+func (_u *SavedSearchUpdateOne) SetSort(v int) *SavedSearchUpdateOne {
+	_u.mutation.ResetSort()
+	_u.mutation.SetSort(v)
+	return _u
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_u *SavedSearchUpdateOne) SetNillableSort(v *int) *SavedSearchUpdateOne {
+	if v != nil {
+		_u.SetSort(*v)
+	}
+	return _u
+}
+
+// AddSort adds value to the "sort" field.
+func (_u *SavedSearchUpdateOne) AddSort(v int) *SavedSearchUpdateOne {
+	_u.mutation.AddSort(v)
+	return _u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_u *SavedSearchUpdateOne) SetSortOrder(v int) *SavedSearchUpdateOne {
+	_u.mutation.ResetSortOrder()
+	_u.mutation.SetSortOrder(v)
+	return _u
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_u *SavedSearchUpdateOne) SetNillableSortOrder(v *int) *SavedSearchUpdateOne {
+	if v != nil {
+		_u.SetSortOrder(*v)
+	}
+	return _u
+}
+
+// AddSortOrder adds value to the "sort_order" field.
+func (_u *SavedSearchUpdateOne) AddSortOrder(v int) *SavedSearchUpdateOne {
+	_u.mutation.AddSortOrder(v)
+	return _u
+}
+
+// SetSeries sets the "series" field.
+func (_u *SavedSearchUpdateOne) SetSeries(v string) *SavedSearchUpdateOne {
+	_u.mutation.SetSeries(v)
+	return _u
+}
+
+// SetNillableSeries sets the "series" field if the given value is not nil.
+func (_u *SavedSearchUpdateOne) SetNillableSeries(v *string) *SavedSearchUpdateOne {
+	if v != nil {
+		_u.SetSeries(*v)
+	}
+	return _u
+}
+
+// ClearSeries clears the value of the "series" field.
+func (_u *SavedSearchUpdateOne) ClearSeries() *SavedSearchUpdateOne {
+	_u.mutation.ClearSeries()
+	return _u
+}
+
+// SetIncludeTags sets the "include_tags" field.
+func (_u *SavedSearchUpdateOne) SetIncludeTags(v []int) *SavedSearchUpdateOne {
+	_u.mutation.SetIncludeTags(v)
+	return _u
+}
+
+// AppendIncludeTags appends value to the "include_tags" field.
+func (_u *SavedSearchUpdateOne) AppendIncludeTags(v []int) *SavedSearchUpdateOne {
+	_u.mutation.AppendIncludeTags(v)
+	return _u
+}
+
+// ClearIncludeTags clears the value of the "include_tags" field.
+func (_u *SavedSearchUpdateOne) ClearIncludeTags() *SavedSearchUpdateOne {
+	_u.mutation.ClearIncludeTags()
+	return _u
+}
+
+// SetTagMatch sets the "tag_match" field.
+func (_u *SavedSearchUpdateOne) SetTagMatch(v int) *SavedSearchUpdateOne {
+	_u.mutation.ResetTagMatch()
+	_u.mutation.SetTagMatch(v)
+	return _u
+}
+
+// SetNillableTagMatch sets the "tag_match" field if the given value is not nil.
+func (_u *SavedSearchUpdateOne) SetNillableTagMatch(v *int) *SavedSearchUpdateOne {
+	if v != nil {
+		_u.SetTagMatch(*v)
+	}
+	return _u
+}
+
+// AddTagMatch adds value to the "tag_match" field.
+func (_u *SavedSearchUpdateOne) AddTagMatch(v int) *SavedSearchUpdateOne {
+	_u.mutation.AddTagMatch(v)
+	return _u
+}
+
+// SetExcludeTags sets the "exclude_tags" field.
+func (_u *SavedSearchUpdateOne) SetExcludeTags(v []int) *SavedSearchUpdateOne {
+	_u.mutation.SetExcludeTags(v)
+	return _u
+}
+
+// AppendExcludeTags appends value to the "exclude_tags" field.
+func (_u *SavedSearchUpdateOne) AppendExcludeTags(v []int) *SavedSearchUpdateOne {
+	_u.mutation.AppendExcludeTags(v)
+	return _u
+}
+
+// ClearExcludeTags clears the value of the "exclude_tags" field.
+func (_u *SavedSearchUpdateOne) ClearExcludeTags() *SavedSearchUpdateOne {
+	_u.mutation.ClearExcludeTags()
+	return _u
+}
+
+// SetRandomSeed sets the "random_seed" field.
+func (_u *SavedSearchUpdateOne) SetRandomSeed(v int64) *SavedSearchUpdateOne {
+	_u.mutation.ResetRandomSeed()
+	_u.mutation.SetRandomSeed(v)
+	return _u
+}
+
+// SetNillableRandomSeed sets the "random_seed" field if the given value is not nil.
+func (_u *SavedSearchUpdateOne) SetNillableRandomSeed(v *int64) *SavedSearchUpdateOne {
+	if v != nil {
+		_u.SetRandomSeed(*v)
+	}
+	return _u
+}
+
+// AddRandomSeed adds value to the "random_seed" field.
+func (_u *SavedSearchUpdateOne) AddRandomSeed(v int64) *SavedSearchUpdateOne {
+	_u.mutation.AddRandomSeed(v)
+	return _u
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_u *SavedSearchUpdateOne) SetCreateTime(v time.Time) *SavedSearchUpdateOne {
+	_u.mutation.SetCreateTime(v)
+	return _u
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_u *SavedSearchUpdateOne) SetNillableCreateTime(v *time.Time) *SavedSearchUpdateOne {
+	if v != nil {
+		_u.SetCreateTime(*v)
+	}
+	return _u
+}
+
+// SetLastOpenTime sets the "last_open_time" field.
+func (_u *SavedSearchUpdateOne) SetLastOpenTime(v time.Time) *SavedSearchUpdateOne {
+	_u.mutation.SetLastOpenTime(v)
+	return _u
+}
+
+// SetNillableLastOpenTime sets the "last_open_time" field if the given value is not nil.
+func (_u *SavedSearchUpdateOne) SetNillableLastOpenTime(v *time.Time) *SavedSearchUpdateOne {
+	if v != nil {
+		_u.SetLastOpenTime(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *SavedSearchUpdateOne) SetUserID(id int) *SavedSearchUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *SavedSearchUpdateOne) SetUser(v *User) *SavedSearchUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the SavedSearchMutation object of the builder.
+func (_u *SavedSearchUpdateOne) Mutation() *SavedSearchMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *SavedSearchUpdateOne) ClearUser() *SavedSearchUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the SavedSearchUpdate builder.
+func (_u *SavedSearchUpdateOne) Where(ps ...predicate.SavedSearch) *SavedSearchUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SavedSearchUpdateOne) Select(field string, fields ...string) *SavedSearchUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SavedSearch entity.
+func (_u *SavedSearchUpdateOne) Save(ctx context.Context) (*SavedSearch, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SavedSearchUpdateOne) SaveX(ctx context.Context) *SavedSearch {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SavedSearchUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SavedSearchUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SavedSearchUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := savedsearch.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SavedSearch.name": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedSearch.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SavedSearchUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SavedSearchUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SavedSearchUpdateOne) sqlSave(ctx context.Context) (_node *SavedSearch, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedsearch.Table, savedsearch.Columns, sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SavedSearch.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, savedsearch.FieldID)
+		for _, f := range fields {
+			if !savedsearch.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != savedsearch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(savedsearch.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Search(); ok {
+		_spec.SetField(savedsearch.FieldSearch, field.TypeString, value)
+	}
+	if _u.mutation.SearchCleared() {
+		_spec.ClearField(savedsearch.FieldSearch, field.TypeString)
+	}
+	if value, ok := _u.mutation.Query(); ok {
+		_spec.SetField(savedsearch.FieldQuery, field.TypeString, value)
+	}
+	if _u.mutation.QueryCleared() {
+		_spec.ClearField(savedsearch.FieldQuery, field.TypeString)
+	}
+	if value, ok := _u.mutation.Filter(); ok {
+		_spec.SetField(savedsearch.FieldFilter, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFilter(); ok {
+		_spec.AddField(savedsearch.FieldFilter, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Sort(); ok {
+		_spec.SetField(savedsearch.FieldSort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSort(); ok {
+		_spec.AddField(savedsearch.FieldSort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SortOrder(); ok {
+		_spec.SetField(savedsearch.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSortOrder(); ok {
+		_spec.AddField(savedsearch.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Series(); ok {
+		_spec.SetField(savedsearch.FieldSeries, field.TypeString, value)
+	}
+	if _u.mutation.SeriesCleared() {
+		_spec.ClearField(savedsearch.FieldSeries, field.TypeString)
+	}
+	if value, ok := _u.mutation.IncludeTags(); ok {
+		_spec.SetField(savedsearch.FieldIncludeTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIncludeTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, savedsearch.FieldIncludeTags, value)
+		})
+	}
+	if _u.mutation.IncludeTagsCleared() {
+		_spec.ClearField(savedsearch.FieldIncludeTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TagMatch(); ok {
+		_spec.SetField(savedsearch.FieldTagMatch, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTagMatch(); ok {
+		_spec.AddField(savedsearch.FieldTagMatch, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExcludeTags(); ok {
+		_spec.SetField(savedsearch.FieldExcludeTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedExcludeTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, savedsearch.FieldExcludeTags, value)
+		})
+	}
+	if _u.mutation.ExcludeTagsCleared() {
+		_spec.ClearField(savedsearch.FieldExcludeTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RandomSeed(); ok {
+		_spec.SetField(savedsearch.FieldRandomSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRandomSeed(); ok {
+		_spec.AddField(savedsearch.FieldRandomSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreateTime(); ok {
+		_spec.SetField(savedsearch.FieldCreateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastOpenTime(); ok {
+		_spec.SetField(savedsearch.FieldLastOpenTime, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedsearch.UserTable,
+			Columns: []string{savedsearch.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedsearch.UserTable,
+			Columns: []string{savedsearch.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &SavedSearch{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedsearch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// APIKey holds the schema definition for the APIKey entity, a key that lets
+// scripts and apps call the server as a user with limited scopes. Only the
+// SHA-256 hash of the key is stored.
+type APIKey struct {
+	ent.Schema
+}
+
+// Fields of the APIKey.
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty(),
+		field.String("key_hash").Unique().NotEmpty().Sensitive(),
+		// Prefix is the start of the key, to tell keys apart in listings.
+		field.String("prefix"),
+		field.Strings("scopes").Default([]string{}),
+		field.Time("create_time").Default(time.Now),
+		field.Time("expire_time").Optional().Nillable(),
+		field.Time("last_used_time").Optional().Nillable(),
+	}
+}
+
+// Edges of the APIKey.
+func (APIKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("api_keys").Unique().Required(),
+	}
+}
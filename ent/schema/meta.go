@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Meta holds the schema definition for the Meta entity.
@@ -17,9 +18,12 @@ type Meta struct {
 func (Meta) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").NotEmpty().Unique(),
+		field.String("search_key").Optional(),
+		field.String("sort_name").Optional(),
 		field.Time("create_time").Default(time.Now),
 		field.Bool("favorite").Default(false).Deprecated("use 'favorite_of_user' instead."),
 		field.Ints("file_indices").Default([]int{}),
+		field.Time("pages_update_time").Optional().Nillable(),
 		field.Bool("read").Default(false).Deprecated("use 'progress' or 'histories' edge instead."),
 		field.Bool("active").Default(true),
 		field.Bool("hidden").Default(false),
@@ -29,15 +33,46 @@ func (Meta) Fields() []ent.Field {
 		field.Int("thumbnail_y").Default(0).Optional(),
 		field.Int("thumbnail_width").Default(0).Optional(),
 		field.Int("thumbnail_height").Default(0).Optional(),
+		field.String("series").Optional(),
+		field.Float("volume").Optional(),
+		field.Float("chapter").Optional(),
+		field.String("artist").Optional(),
+		field.Int("year").Optional(),
+		// AgeRating is the minimum age of the readers the item is meant for,
+		// 0 when it is suitable for everyone or not rated.
+		field.Int("age_rating").Default(0).NonNegative(),
+	}
+}
+
+// Indexes of the Meta.
+func (Meta) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for grouping and sorting items by series
+		index.Fields("series", "volume", "chapter"),
 	}
 }
 
 // Edges of the Meta.
 func (Meta) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("tags", Tag.Type),
+		edge.To("tags", Tag.Type).Through("meta_tags", MetaTag.Type),
+		edge.To("excluded_tags", Tag.Type),
 		edge.To("histories", History.Type),
 		edge.From("favorite_of_user", User.Type).Ref("favorite_items"),
 		edge.To("progress", Progress.Type),
+		edge.From("blocked_by_user", User.Type).Ref("blocked_items"),
+	}
+}
+
+// Hooks of the Meta.
+func (Meta) Hooks() []ent.Hook {
+	return []ent.Hook{
+		searchKeyHook,
+		sortNameHook,
 	}
 }
+
+// Policy of the Meta.
+func (Meta) Policy() ent.Policy {
+	return libraryPolicy()
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MetaTag holds the schema definition for the association between an item and
+// a tag.
+type MetaTag struct {
+	ent.Schema
+}
+
+func (MetaTag) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		field.ID("meta_id", "tag_id"),
+	}
+}
+
+// Fields of the MetaTag.
+func (MetaTag) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("meta_id"),
+		field.Int("tag_id"),
+		field.Enum("source").Values("parsed", "comic_info", "manual").Default("parsed"),
+	}
+}
+
+// Edges of the MetaTag.
+func (MetaTag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("meta", Meta.Type).Unique().Required().Field("meta_id").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("tag", Tag.Type).Unique().Required().Field("tag_id").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (MetaTag) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for listing the items of a tag and counting tag co-occurrence
+		index.Fields("tag_id", "meta_id"),
+	}
+}
+
+// Policy of the MetaTag.
+func (MetaTag) Policy() ent.Policy {
+	return libraryPolicy()
+}
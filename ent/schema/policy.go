@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"context"
+	"slices"
+
+	"entgo.io/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/privacy"
+	ent_user "github.com/mangaweb4/mangaweb4-backend/ent/user"
+	"github.com/mangaweb4/mangaweb4-backend/user"
+)
+
+// allowIfServer allows the mutations the server makes by itself, such as
+// library scans, which have no user in their context.
+func allowIfServer() privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, m ent.Mutation) error {
+		if _, ok := user.RoleFromContext(ctx); !ok {
+			return privacy.Allow
+		}
+
+		return privacy.Skip
+	})
+}
+
+// allowIfRole allows the mutations of users with the role or a more
+// privileged one.
+func allowIfRole(minimum ent_user.Role) privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, m ent.Mutation) error {
+		if role, ok := user.RoleFromContext(ctx); ok && user.HasRole(role, minimum) {
+			return privacy.Allow
+		}
+
+		return privacy.Skip
+	})
+}
+
+// allowIfSelf allows users with the role or a more privileged one to update
+// their own favorites, blocks and password, but not their role, status or
+// restrictions.
+func allowIfSelf(minimum ent_user.Role) privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, m ent.Mutation) error {
+		role, ok := user.RoleFromContext(ctx)
+		if !ok || !user.HasRole(role, minimum) || !m.Op().Is(ent.OpUpdateOne) {
+			return privacy.Skip
+		}
+
+		id, _ := user.IDFromContext(ctx)
+		mutation, ok := m.(interface{ ID() (int, bool) })
+		if !ok {
+			return privacy.Skip
+		}
+		if target, exists := mutation.ID(); !exists || target != id {
+			return privacy.Skip
+		}
+
+		for _, field := range []string{
+			ent_user.FieldEmail, ent_user.FieldActive, ent_user.FieldRole,
+			ent_user.FieldMaxAgeRating, ent_user.FieldAllowedPaths,
+		} {
+			if _, exists := m.Field(field); exists || m.FieldCleared(field) {
+				return privacy.Skip
+			}
+		}
+
+		for _, edges := range [][]string{m.AddedEdges(), m.RemovedEdges(), m.ClearedEdges()} {
+			if slices.Contains(edges, ent_user.EdgeDeniedTags) {
+				return privacy.Skip
+			}
+		}
+
+		return privacy.Allow
+	})
+}
+
+// libraryPolicy restricts changes to the shared library to editors.
+func libraryPolicy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			allowIfServer(),
+			allowIfRole(ent_user.RoleEditor),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/mangaweb4/mangaweb4-backend/ent/privacy"
+	ent_user "github.com/mangaweb4/mangaweb4-backend/ent/user"
+)
+
+// SavedSearch holds the schema definition for the SavedSearch entity, a
+// listing of items a user keeps to run again. Filter, sort, sort order and
+// tag match hold the values of the gRPC enums.
+type SavedSearch struct {
+	ent.Schema
+}
+
+// Fields of the SavedSearch.
+func (SavedSearch) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty(),
+		field.String("search").Optional(),
+		field.String("query").Optional(),
+		field.Int("filter").Default(0),
+		field.Int("sort").Default(0),
+		field.Int("sort_order").Default(0),
+		field.String("series").Optional(),
+		field.Ints("include_tags").Optional(),
+		field.Int("tag_match").Default(0),
+		field.Ints("exclude_tags").Optional(),
+		field.Int64("random_seed").Default(0),
+		field.Time("create_time").Default(time.Now),
+		field.Time("last_open_time").Default(time.Now),
+	}
+}
+
+// Edges of the SavedSearch.
+func (SavedSearch) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("saved_searches").Unique().Required(),
+	}
+}
+
+// Policy of the SavedSearch. Guests cannot save searches.
+func (SavedSearch) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			allowIfServer(),
+			allowIfRole(ent_user.RoleReader),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -0,0 +1,22 @@
+package schema
+
+import (
+	"context"
+
+	"entgo.io/ent"
+	"github.com/mangaweb4/mangaweb4-backend/fold"
+)
+
+// sortNameHook keeps the sort_name field of an entity in sync with its name
+// field, whenever the name is written.
+func sortNameHook(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		if name, ok := m.Field("name"); ok {
+			if err := m.SetField("sort_name", fold.NaturalKey(name.(string))); err != nil {
+				return nil, err
+			}
+		}
+
+		return next.Mutate(ctx, m)
+	})
+}
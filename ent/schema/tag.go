@@ -4,8 +4,10 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Tag holds the schema definition for the Tag entity.
@@ -19,14 +21,45 @@ func (Tag) Fields() []ent.Field {
 		field.String("name").Unique().NotEmpty(),
 		field.Bool("favorite").Default(false).Deprecated("use 'favorite_of_user' edge instead."),
 		field.Bool("hidden").Default(false),
+		field.Bool("hide_items").Default(false),
+		field.Bool("active").Default(true),
 		field.Time("last_update").Default(time.Time{}).Optional(),
+		field.String("normalized_name").Optional(),
+		field.String("search_key").Optional(),
+		field.Enum("category").Values("artist", "circle", "event", "parody", "language", "group").Optional(),
+		field.Text("description").Optional(),
+		field.Strings("links").Optional(),
 	}
 }
 
 // Edges of the Tag.
 func (Tag) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.From("meta", Meta.Type).Ref("tags"),
+		edge.From("meta", Meta.Type).Ref("tags").Through("meta_tags", MetaTag.Type),
+		edge.From("excluded_from", Meta.Type).Ref("excluded_tags"),
 		edge.From("favorite_of_user", User.Type).Ref("favorite_tags"),
+		edge.From("blocked_by_user", User.Type).Ref("blocked_tags"),
+		edge.From("denied_to_user", User.Type).Ref("denied_tags"),
+		edge.To("aliases", TagAlias.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
+
+func (Tag) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for resolving tag names
+		index.Fields("normalized_name"),
+	}
+}
+
+// Hooks of the Tag.
+func (Tag) Hooks() []ent.Hook {
+	return []ent.Hook{
+		searchKeyHook,
+	}
+}
+
+// Policy of the Tag.
+func (Tag) Policy() ent.Policy {
+	return libraryPolicy()
+}
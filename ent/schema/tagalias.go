@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TagAlias holds the schema definition for the TagAlias entity.
+type TagAlias struct {
+	ent.Schema
+}
+
+// Fields of the TagAlias.
+func (TagAlias) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Unique().NotEmpty(),
+		field.String("normalized_name").Optional(),
+		field.Time("create_time").Default(time.Now),
+	}
+}
+
+// Edges of the TagAlias.
+func (TagAlias) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tag", Tag.Type).Ref("aliases").Unique().Required(),
+	}
+}
+
+func (TagAlias) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for resolving tag names
+		index.Fields("normalized_name"),
+	}
+}
+
+// Policy of the TagAlias.
+func (TagAlias) Policy() ent.Policy {
+	return libraryPolicy()
+}
@@ -4,6 +4,9 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/mangaweb4/mangaweb4-backend/ent/privacy"
+	ent_user "github.com/mangaweb4/mangaweb4-backend/ent/user"
+	"github.com/mangaweb4/mangaweb4-backend/preference"
 )
 
 // User holds the schema definition for the Tag entity.
@@ -16,6 +19,21 @@ func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("email").Unique().NotEmpty(),
 		field.Bool("active").Default(true),
+		// PasswordHash is the argon2id hash of the password, empty until one
+		// is set.
+		field.String("password_hash").Optional().Sensitive(),
+		// Role decides which calls the user may make, from guest, who can only
+		// browse, to admin, who can also maintain the library.
+		field.Enum("role").Values("guest", "reader", "editor", "admin").Default("reader"),
+		// MaxAgeRating is the highest age rating of the items the user can
+		// see, nil when it is not restricted.
+		field.Int("max_age_rating").Optional().Nillable().NonNegative(),
+		// AllowedPaths are the directories of the library the user can see,
+		// empty when every directory is allowed.
+		field.Strings("allowed_paths").Optional(),
+		// Preferences are the reading and browsing settings of the user,
+		// shared by their clients.
+		field.JSON("preferences", preference.Document{}).Optional(),
 	}
 }
 
@@ -26,5 +44,26 @@ func (User) Edges() []ent.Edge {
 		edge.To("favorite_tags", Tag.Type),
 		edge.To("histories", History.Type),
 		edge.To("progress", Progress.Type),
+		edge.To("blocked_items", Meta.Type),
+		edge.To("blocked_tags", Tag.Type),
+		edge.To("saved_searches", SavedSearch.Type),
+		edge.To("sessions", Session.Type),
+		edge.To("api_keys", APIKey.Type),
+		// DeniedTags are set by admins. Unlike blocked tags, the user cannot
+		// remove them, and their items are never shown.
+		edge.To("denied_tags", Tag.Type),
+	}
+}
+
+// Policy of the User. Users can change their own settings, and admins can
+// change every user.
+func (User) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			allowIfServer(),
+			allowIfRole(ent_user.RoleAdmin),
+			allowIfSelf(ent_user.RoleReader),
+			privacy.AlwaysDenyRule(),
+		},
 	}
 }
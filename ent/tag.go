@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -25,8 +26,22 @@ type Tag struct {
 	Favorite bool `json:"favorite,omitempty"`
 	// Hidden holds the value of the "hidden" field.
 	Hidden bool `json:"hidden,omitempty"`
+	// HideItems holds the value of the "hide_items" field.
+	HideItems bool `json:"hide_items,omitempty"`
+	// Active holds the value of the "active" field.
+	Active bool `json:"active,omitempty"`
 	// LastUpdate holds the value of the "last_update" field.
 	LastUpdate time.Time `json:"last_update,omitempty"`
+	// NormalizedName holds the value of the "normalized_name" field.
+	NormalizedName string `json:"normalized_name,omitempty"`
+	// SearchKey holds the value of the "search_key" field.
+	SearchKey string `json:"search_key,omitempty"`
+	// Category holds the value of the "category" field.
+	Category tag.Category `json:"category,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Links holds the value of the "links" field.
+	Links []string `json:"links,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TagQuery when eager-loading is set.
 	Edges        TagEdges `json:"edges"`
@@ -37,11 +52,21 @@ type Tag struct {
 type TagEdges struct {
 	// Meta holds the value of the meta edge.
 	Meta []*Meta `json:"meta,omitempty"`
+	// ExcludedFrom holds the value of the excluded_from edge.
+	ExcludedFrom []*Meta `json:"excluded_from,omitempty"`
 	// FavoriteOfUser holds the value of the favorite_of_user edge.
 	FavoriteOfUser []*User `json:"favorite_of_user,omitempty"`
+	// BlockedByUser holds the value of the blocked_by_user edge.
+	BlockedByUser []*User `json:"blocked_by_user,omitempty"`
+	// DeniedToUser holds the value of the denied_to_user edge.
+	DeniedToUser []*User `json:"denied_to_user,omitempty"`
+	// Aliases holds the value of the aliases edge.
+	Aliases []*TagAlias `json:"aliases,omitempty"`
+	// MetaTags holds the value of the meta_tags edge.
+	MetaTags []*MetaTag `json:"meta_tags,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [7]bool
 }
 
 // MetaOrErr returns the Meta value or an error if the edge
@@ -53,25 +78,72 @@ func (e TagEdges) MetaOrErr() ([]*Meta, error) {
 	return nil, &NotLoadedError{edge: "meta"}
 }
 
+// ExcludedFromOrErr returns the ExcludedFrom value or an error if the edge
+// was not loaded in eager-loading.
+func (e TagEdges) ExcludedFromOrErr() ([]*Meta, error) {
+	if e.loadedTypes[1] {
+		return e.ExcludedFrom, nil
+	}
+	return nil, &NotLoadedError{edge: "excluded_from"}
+}
+
 // FavoriteOfUserOrErr returns the FavoriteOfUser value or an error if the edge
 // was not loaded in eager-loading.
 func (e TagEdges) FavoriteOfUserOrErr() ([]*User, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.FavoriteOfUser, nil
 	}
 	return nil, &NotLoadedError{edge: "favorite_of_user"}
 }
 
+// BlockedByUserOrErr returns the BlockedByUser value or an error if the edge
+// was not loaded in eager-loading.
+func (e TagEdges) BlockedByUserOrErr() ([]*User, error) {
+	if e.loadedTypes[3] {
+		return e.BlockedByUser, nil
+	}
+	return nil, &NotLoadedError{edge: "blocked_by_user"}
+}
+
+// DeniedToUserOrErr returns the DeniedToUser value or an error if the edge
+// was not loaded in eager-loading.
+func (e TagEdges) DeniedToUserOrErr() ([]*User, error) {
+	if e.loadedTypes[4] {
+		return e.DeniedToUser, nil
+	}
+	return nil, &NotLoadedError{edge: "denied_to_user"}
+}
+
+// AliasesOrErr returns the Aliases value or an error if the edge
+// was not loaded in eager-loading.
+func (e TagEdges) AliasesOrErr() ([]*TagAlias, error) {
+	if e.loadedTypes[5] {
+		return e.Aliases, nil
+	}
+	return nil, &NotLoadedError{edge: "aliases"}
+}
+
+// MetaTagsOrErr returns the MetaTags value or an error if the edge
+// was not loaded in eager-loading.
+func (e TagEdges) MetaTagsOrErr() ([]*MetaTag, error) {
+	if e.loadedTypes[6] {
+		return e.MetaTags, nil
+	}
+	return nil, &NotLoadedError{edge: "meta_tags"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Tag) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case tag.FieldFavorite, tag.FieldHidden:
+		case tag.FieldLinks:
+			values[i] = new([]byte)
+		case tag.FieldFavorite, tag.FieldHidden, tag.FieldHideItems, tag.FieldActive:
 			values[i] = new(sql.NullBool)
 		case tag.FieldID:
 			values[i] = new(sql.NullInt64)
-		case tag.FieldName:
+		case tag.FieldName, tag.FieldNormalizedName, tag.FieldSearchKey, tag.FieldCategory, tag.FieldDescription:
 			values[i] = new(sql.NullString)
 		case tag.FieldLastUpdate:
 			values[i] = new(sql.NullTime)
@@ -114,12 +186,56 @@ func (_m *Tag) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Hidden = value.Bool
 			}
+		case tag.FieldHideItems:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field hide_items", values[i])
+			} else if value.Valid {
+				_m.HideItems = value.Bool
+			}
+		case tag.FieldActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field active", values[i])
+			} else if value.Valid {
+				_m.Active = value.Bool
+			}
 		case tag.FieldLastUpdate:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field last_update", values[i])
 			} else if value.Valid {
 				_m.LastUpdate = value.Time
 			}
+		case tag.FieldNormalizedName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field normalized_name", values[i])
+			} else if value.Valid {
+				_m.NormalizedName = value.String
+			}
+		case tag.FieldSearchKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field search_key", values[i])
+			} else if value.Valid {
+				_m.SearchKey = value.String
+			}
+		case tag.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				_m.Category = tag.Category(value.String)
+			}
+		case tag.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case tag.FieldLinks:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field links", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Links); err != nil {
+					return fmt.Errorf("unmarshal field links: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -138,11 +254,36 @@ func (_m *Tag) QueryMeta() *MetaQuery {
 	return NewTagClient(_m.config).QueryMeta(_m)
 }
 
+// QueryExcludedFrom queries the "excluded_from" edge of the Tag entity.
+func (_m *Tag) QueryExcludedFrom() *MetaQuery {
+	return NewTagClient(_m.config).QueryExcludedFrom(_m)
+}
+
 // QueryFavoriteOfUser queries the "favorite_of_user" edge of the Tag entity.
 func (_m *Tag) QueryFavoriteOfUser() *UserQuery {
 	return NewTagClient(_m.config).QueryFavoriteOfUser(_m)
 }
 
+// QueryBlockedByUser queries the "blocked_by_user" edge of the Tag entity.
+func (_m *Tag) QueryBlockedByUser() *UserQuery {
+	return NewTagClient(_m.config).QueryBlockedByUser(_m)
+}
+
+// QueryDeniedToUser queries the "denied_to_user" edge of the Tag entity.
+func (_m *Tag) QueryDeniedToUser() *UserQuery {
+	return NewTagClient(_m.config).QueryDeniedToUser(_m)
+}
+
+// QueryAliases queries the "aliases" edge of the Tag entity.
+func (_m *Tag) QueryAliases() *TagAliasQuery {
+	return NewTagClient(_m.config).QueryAliases(_m)
+}
+
+// QueryMetaTags queries the "meta_tags" edge of the Tag entity.
+func (_m *Tag) QueryMetaTags() *MetaTagQuery {
+	return NewTagClient(_m.config).QueryMetaTags(_m)
+}
+
 // Update returns a builder for updating this Tag.
 // Note that you need to call Tag.Unwrap() before calling this method if this Tag
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -175,8 +316,29 @@ func (_m *Tag) String() string {
 	builder.WriteString("hidden=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Hidden))
 	builder.WriteString(", ")
+	builder.WriteString("hide_items=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HideItems))
+	builder.WriteString(", ")
+	builder.WriteString("active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Active))
+	builder.WriteString(", ")
 	builder.WriteString("last_update=")
 	builder.WriteString(_m.LastUpdate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("normalized_name=")
+	builder.WriteString(_m.NormalizedName)
+	builder.WriteString(", ")
+	builder.WriteString("search_key=")
+	builder.WriteString(_m.SearchKey)
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Category))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("links=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Links))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,8 +3,10 @@
 package tag
 
 import (
+	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -20,12 +22,36 @@ const (
 	FieldFavorite = "favorite"
 	// FieldHidden holds the string denoting the hidden field in the database.
 	FieldHidden = "hidden"
+	// FieldHideItems holds the string denoting the hide_items field in the database.
+	FieldHideItems = "hide_items"
+	// FieldActive holds the string denoting the active field in the database.
+	FieldActive = "active"
 	// FieldLastUpdate holds the string denoting the last_update field in the database.
 	FieldLastUpdate = "last_update"
+	// FieldNormalizedName holds the string denoting the normalized_name field in the database.
+	FieldNormalizedName = "normalized_name"
+	// FieldSearchKey holds the string denoting the search_key field in the database.
+	FieldSearchKey = "search_key"
+	// FieldCategory holds the string denoting the category field in the database.
+	FieldCategory = "category"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldLinks holds the string denoting the links field in the database.
+	FieldLinks = "links"
 	// EdgeMeta holds the string denoting the meta edge name in mutations.
 	EdgeMeta = "meta"
+	// EdgeExcludedFrom holds the string denoting the excluded_from edge name in mutations.
+	EdgeExcludedFrom = "excluded_from"
 	// EdgeFavoriteOfUser holds the string denoting the favorite_of_user edge name in mutations.
 	EdgeFavoriteOfUser = "favorite_of_user"
+	// EdgeBlockedByUser holds the string denoting the blocked_by_user edge name in mutations.
+	EdgeBlockedByUser = "blocked_by_user"
+	// EdgeDeniedToUser holds the string denoting the denied_to_user edge name in mutations.
+	EdgeDeniedToUser = "denied_to_user"
+	// EdgeAliases holds the string denoting the aliases edge name in mutations.
+	EdgeAliases = "aliases"
+	// EdgeMetaTags holds the string denoting the meta_tags edge name in mutations.
+	EdgeMetaTags = "meta_tags"
 	// Table holds the table name of the tag in the database.
 	Table = "tags"
 	// MetaTable is the table that holds the meta relation/edge. The primary key declared below.
@@ -33,11 +59,40 @@ const (
 	// MetaInverseTable is the table name for the Meta entity.
 	// It exists in this package in order to avoid circular dependency with the "meta" package.
 	MetaInverseTable = "meta"
+	// ExcludedFromTable is the table that holds the excluded_from relation/edge. The primary key declared below.
+	ExcludedFromTable = "meta_excluded_tags"
+	// ExcludedFromInverseTable is the table name for the Meta entity.
+	// It exists in this package in order to avoid circular dependency with the "meta" package.
+	ExcludedFromInverseTable = "meta"
 	// FavoriteOfUserTable is the table that holds the favorite_of_user relation/edge. The primary key declared below.
 	FavoriteOfUserTable = "user_favorite_tags"
 	// FavoriteOfUserInverseTable is the table name for the User entity.
 	// It exists in this package in order to avoid circular dependency with the "user" package.
 	FavoriteOfUserInverseTable = "users"
+	// BlockedByUserTable is the table that holds the blocked_by_user relation/edge. The primary key declared below.
+	BlockedByUserTable = "user_blocked_tags"
+	// BlockedByUserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	BlockedByUserInverseTable = "users"
+	// DeniedToUserTable is the table that holds the denied_to_user relation/edge. The primary key declared below.
+	DeniedToUserTable = "user_denied_tags"
+	// DeniedToUserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	DeniedToUserInverseTable = "users"
+	// AliasesTable is the table that holds the aliases relation/edge.
+	AliasesTable = "tag_alias"
+	// AliasesInverseTable is the table name for the TagAlias entity.
+	// It exists in this package in order to avoid circular dependency with the "tagalias" package.
+	AliasesInverseTable = "tag_alias"
+	// AliasesColumn is the table column denoting the aliases relation/edge.
+	AliasesColumn = "tag_aliases"
+	// MetaTagsTable is the table that holds the meta_tags relation/edge.
+	MetaTagsTable = "meta_tags"
+	// MetaTagsInverseTable is the table name for the MetaTag entity.
+	// It exists in this package in order to avoid circular dependency with the "metatag" package.
+	MetaTagsInverseTable = "meta_tags"
+	// MetaTagsColumn is the table column denoting the meta_tags relation/edge.
+	MetaTagsColumn = "tag_id"
 )
 
 // Columns holds all SQL columns for tag fields.
@@ -45,16 +100,32 @@ var Columns = []string{
 	FieldID,
 	FieldName,
 	FieldHidden,
+	FieldHideItems,
+	FieldActive,
 	FieldLastUpdate,
+	FieldNormalizedName,
+	FieldSearchKey,
+	FieldCategory,
+	FieldDescription,
+	FieldLinks,
 }
 
 var (
 	// MetaPrimaryKey and MetaColumn2 are the table columns denoting the
 	// primary key for the meta relation (M2M).
 	MetaPrimaryKey = []string{"meta_id", "tag_id"}
+	// ExcludedFromPrimaryKey and ExcludedFromColumn2 are the table columns denoting the
+	// primary key for the excluded_from relation (M2M).
+	ExcludedFromPrimaryKey = []string{"meta_id", "tag_id"}
 	// FavoriteOfUserPrimaryKey and FavoriteOfUserColumn2 are the table columns denoting the
 	// primary key for the favorite_of_user relation (M2M).
 	FavoriteOfUserPrimaryKey = []string{"user_id", "tag_id"}
+	// BlockedByUserPrimaryKey and BlockedByUserColumn2 are the table columns denoting the
+	// primary key for the blocked_by_user relation (M2M).
+	BlockedByUserPrimaryKey = []string{"user_id", "tag_id"}
+	// DeniedToUserPrimaryKey and DeniedToUserColumn2 are the table columns denoting the
+	// primary key for the denied_to_user relation (M2M).
+	DeniedToUserPrimaryKey = []string{"user_id", "tag_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -72,17 +143,55 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/mangaweb4/mangaweb4-backend/ent/runtime"
 var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
 	// DefaultFavorite holds the default value on creation for the "favorite" field.
 	DefaultFavorite bool
 	// DefaultHidden holds the default value on creation for the "hidden" field.
 	DefaultHidden bool
+	// DefaultHideItems holds the default value on creation for the "hide_items" field.
+	DefaultHideItems bool
+	// DefaultActive holds the default value on creation for the "active" field.
+	DefaultActive bool
 	// DefaultLastUpdate holds the default value on creation for the "last_update" field.
 	DefaultLastUpdate time.Time
 )
 
+// Category defines the type for the "category" enum field.
+type Category string
+
+// Category values.
+const (
+	CategoryArtist   Category = "artist"
+	CategoryCircle   Category = "circle"
+	CategoryEvent    Category = "event"
+	CategoryParody   Category = "parody"
+	CategoryLanguage Category = "language"
+	CategoryGroup    Category = "group"
+)
+
+func (c Category) String() string {
+	return string(c)
+}
+
+// CategoryValidator is a validator for the "category" field enum values. It is called by the builders before save.
+func CategoryValidator(c Category) error {
+	switch c {
+	case CategoryArtist, CategoryCircle, CategoryEvent, CategoryParody, CategoryLanguage, CategoryGroup:
+		return nil
+	default:
+		return fmt.Errorf("tag: invalid enum value for category field: %q", c)
+	}
+}
+
 // OrderOption defines the ordering options for the Tag queries.
 type OrderOption func(*sql.Selector)
 
@@ -106,11 +215,41 @@ func ByHidden(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldHidden, opts...).ToFunc()
 }
 
+// ByHideItems orders the results by the hide_items field.
+func ByHideItems(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHideItems, opts...).ToFunc()
+}
+
+// ByActive orders the results by the active field.
+func ByActive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActive, opts...).ToFunc()
+}
+
 // ByLastUpdate orders the results by the last_update field.
 func ByLastUpdate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastUpdate, opts...).ToFunc()
 }
 
+// ByNormalizedName orders the results by the normalized_name field.
+func ByNormalizedName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNormalizedName, opts...).ToFunc()
+}
+
+// BySearchKey orders the results by the search_key field.
+func BySearchKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSearchKey, opts...).ToFunc()
+}
+
+// ByCategory orders the results by the category field.
+func ByCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategory, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
 // ByMetaCount orders the results by meta count.
 func ByMetaCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -125,6 +264,20 @@ func ByMeta(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByExcludedFromCount orders the results by excluded_from count.
+func ByExcludedFromCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newExcludedFromStep(), opts...)
+	}
+}
+
+// ByExcludedFrom orders the results by excluded_from terms.
+func ByExcludedFrom(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newExcludedFromStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByFavoriteOfUserCount orders the results by favorite_of_user count.
 func ByFavoriteOfUserCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -138,6 +291,62 @@ func ByFavoriteOfUser(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newFavoriteOfUserStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByBlockedByUserCount orders the results by blocked_by_user count.
+func ByBlockedByUserCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlockedByUserStep(), opts...)
+	}
+}
+
+// ByBlockedByUser orders the results by blocked_by_user terms.
+func ByBlockedByUser(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlockedByUserStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDeniedToUserCount orders the results by denied_to_user count.
+func ByDeniedToUserCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDeniedToUserStep(), opts...)
+	}
+}
+
+// ByDeniedToUser orders the results by denied_to_user terms.
+func ByDeniedToUser(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeniedToUserStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAliasesCount orders the results by aliases count.
+func ByAliasesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAliasesStep(), opts...)
+	}
+}
+
+// ByAliases orders the results by aliases terms.
+func ByAliases(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAliasesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMetaTagsCount orders the results by meta_tags count.
+func ByMetaTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMetaTagsStep(), opts...)
+	}
+}
+
+// ByMetaTags orders the results by meta_tags terms.
+func ByMetaTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMetaTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newMetaStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -145,6 +354,13 @@ func newMetaStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, true, MetaTable, MetaPrimaryKey...),
 	)
 }
+func newExcludedFromStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ExcludedFromInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, ExcludedFromTable, ExcludedFromPrimaryKey...),
+	)
+}
 func newFavoriteOfUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -152,3 +368,31 @@ func newFavoriteOfUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, true, FavoriteOfUserTable, FavoriteOfUserPrimaryKey...),
 	)
 }
+func newBlockedByUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BlockedByUserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, BlockedByUserTable, BlockedByUserPrimaryKey...),
+	)
+}
+func newDeniedToUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeniedToUserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, DeniedToUserTable, DeniedToUserPrimaryKey...),
+	)
+}
+func newAliasesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AliasesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AliasesTable, AliasesColumn),
+	)
+}
+func newMetaTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MetaTagsInverseTable, MetaTagsColumn),
+		sqlgraph.Edge(sqlgraph.O2M, true, MetaTagsTable, MetaTagsColumn),
+	)
+}
@@ -70,11 +70,36 @@ func Hidden(v bool) predicate.Tag {
 	return predicate.Tag(sql.FieldEQ(FieldHidden, v))
 }
 
+// HideItems applies equality check predicate on the "hide_items" field. It's identical to HideItemsEQ.
+func HideItems(v bool) predicate.Tag {
+	return predicate.Tag(sql.FieldEQ(FieldHideItems, v))
+}
+
+// Active applies equality check predicate on the "active" field. It's identical to ActiveEQ.
+func Active(v bool) predicate.Tag {
+	return predicate.Tag(sql.FieldEQ(FieldActive, v))
+}
+
 // LastUpdate applies equality check predicate on the "last_update" field. It's identical to LastUpdateEQ.
 func LastUpdate(v time.Time) predicate.Tag {
 	return predicate.Tag(sql.FieldEQ(FieldLastUpdate, v))
 }
 
+// NormalizedName applies equality check predicate on the "normalized_name" field. It's identical to NormalizedNameEQ.
+func NormalizedName(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldEQ(FieldNormalizedName, v))
+}
+
+// SearchKey applies equality check predicate on the "search_key" field. It's identical to SearchKeyEQ.
+func SearchKey(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldEQ(FieldSearchKey, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldEQ(FieldDescription, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Tag {
 	return predicate.Tag(sql.FieldEQ(FieldName, v))
@@ -160,6 +185,26 @@ func HiddenNEQ(v bool) predicate.Tag {
 	return predicate.Tag(sql.FieldNEQ(FieldHidden, v))
 }
 
+// HideItemsEQ applies the EQ predicate on the "hide_items" field.
+func HideItemsEQ(v bool) predicate.Tag {
+	return predicate.Tag(sql.FieldEQ(FieldHideItems, v))
+}
+
+// HideItemsNEQ applies the NEQ predicate on the "hide_items" field.
+func HideItemsNEQ(v bool) predicate.Tag {
+	return predicate.Tag(sql.FieldNEQ(FieldHideItems, v))
+}
+
+// ActiveEQ applies the EQ predicate on the "active" field.
+func ActiveEQ(v bool) predicate.Tag {
+	return predicate.Tag(sql.FieldEQ(FieldActive, v))
+}
+
+// ActiveNEQ applies the NEQ predicate on the "active" field.
+func ActiveNEQ(v bool) predicate.Tag {
+	return predicate.Tag(sql.FieldNEQ(FieldActive, v))
+}
+
 // LastUpdateEQ applies the EQ predicate on the "last_update" field.
 func LastUpdateEQ(v time.Time) predicate.Tag {
 	return predicate.Tag(sql.FieldEQ(FieldLastUpdate, v))
@@ -210,6 +255,271 @@ func LastUpdateNotNil() predicate.Tag {
 	return predicate.Tag(sql.FieldNotNull(FieldLastUpdate))
 }
 
+// NormalizedNameEQ applies the EQ predicate on the "normalized_name" field.
+func NormalizedNameEQ(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldEQ(FieldNormalizedName, v))
+}
+
+// NormalizedNameNEQ applies the NEQ predicate on the "normalized_name" field.
+func NormalizedNameNEQ(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldNEQ(FieldNormalizedName, v))
+}
+
+// NormalizedNameIn applies the In predicate on the "normalized_name" field.
+func NormalizedNameIn(vs ...string) predicate.Tag {
+	return predicate.Tag(sql.FieldIn(FieldNormalizedName, vs...))
+}
+
+// NormalizedNameNotIn applies the NotIn predicate on the "normalized_name" field.
+func NormalizedNameNotIn(vs ...string) predicate.Tag {
+	return predicate.Tag(sql.FieldNotIn(FieldNormalizedName, vs...))
+}
+
+// NormalizedNameGT applies the GT predicate on the "normalized_name" field.
+func NormalizedNameGT(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldGT(FieldNormalizedName, v))
+}
+
+// NormalizedNameGTE applies the GTE predicate on the "normalized_name" field.
+func NormalizedNameGTE(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldGTE(FieldNormalizedName, v))
+}
+
+// NormalizedNameLT applies the LT predicate on the "normalized_name" field.
+func NormalizedNameLT(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldLT(FieldNormalizedName, v))
+}
+
+// NormalizedNameLTE applies the LTE predicate on the "normalized_name" field.
+func NormalizedNameLTE(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldLTE(FieldNormalizedName, v))
+}
+
+// NormalizedNameContains applies the Contains predicate on the "normalized_name" field.
+func NormalizedNameContains(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldContains(FieldNormalizedName, v))
+}
+
+// NormalizedNameHasPrefix applies the HasPrefix predicate on the "normalized_name" field.
+func NormalizedNameHasPrefix(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldHasPrefix(FieldNormalizedName, v))
+}
+
+// NormalizedNameHasSuffix applies the HasSuffix predicate on the "normalized_name" field.
+func NormalizedNameHasSuffix(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldHasSuffix(FieldNormalizedName, v))
+}
+
+// NormalizedNameIsNil applies the IsNil predicate on the "normalized_name" field.
+func NormalizedNameIsNil() predicate.Tag {
+	return predicate.Tag(sql.FieldIsNull(FieldNormalizedName))
+}
+
+// NormalizedNameNotNil applies the NotNil predicate on the "normalized_name" field.
+func NormalizedNameNotNil() predicate.Tag {
+	return predicate.Tag(sql.FieldNotNull(FieldNormalizedName))
+}
+
+// NormalizedNameEqualFold applies the EqualFold predicate on the "normalized_name" field.
+func NormalizedNameEqualFold(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldEqualFold(FieldNormalizedName, v))
+}
+
+// NormalizedNameContainsFold applies the ContainsFold predicate on the "normalized_name" field.
+func NormalizedNameContainsFold(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldContainsFold(FieldNormalizedName, v))
+}
+
+// SearchKeyEQ applies the EQ predicate on the "search_key" field.
+func SearchKeyEQ(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldEQ(FieldSearchKey, v))
+}
+
+// SearchKeyNEQ applies the NEQ predicate on the "search_key" field.
+func SearchKeyNEQ(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldNEQ(FieldSearchKey, v))
+}
+
+// SearchKeyIn applies the In predicate on the "search_key" field.
+func SearchKeyIn(vs ...string) predicate.Tag {
+	return predicate.Tag(sql.FieldIn(FieldSearchKey, vs...))
+}
+
+// SearchKeyNotIn applies the NotIn predicate on the "search_key" field.
+func SearchKeyNotIn(vs ...string) predicate.Tag {
+	return predicate.Tag(sql.FieldNotIn(FieldSearchKey, vs...))
+}
+
+// SearchKeyGT applies the GT predicate on the "search_key" field.
+func SearchKeyGT(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldGT(FieldSearchKey, v))
+}
+
+// SearchKeyGTE applies the GTE predicate on the "search_key" field.
+func SearchKeyGTE(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldGTE(FieldSearchKey, v))
+}
+
+// SearchKeyLT applies the LT predicate on the "search_key" field.
+func SearchKeyLT(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldLT(FieldSearchKey, v))
+}
+
+// SearchKeyLTE applies the LTE predicate on the "search_key" field.
+func SearchKeyLTE(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldLTE(FieldSearchKey, v))
+}
+
+// SearchKeyContains applies the Contains predicate on the "search_key" field.
+func SearchKeyContains(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldContains(FieldSearchKey, v))
+}
+
+// SearchKeyHasPrefix applies the HasPrefix predicate on the "search_key" field.
+func SearchKeyHasPrefix(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldHasPrefix(FieldSearchKey, v))
+}
+
+// SearchKeyHasSuffix applies the HasSuffix predicate on the "search_key" field.
+func SearchKeyHasSuffix(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldHasSuffix(FieldSearchKey, v))
+}
+
+// SearchKeyIsNil applies the IsNil predicate on the "search_key" field.
+func SearchKeyIsNil() predicate.Tag {
+	return predicate.Tag(sql.FieldIsNull(FieldSearchKey))
+}
+
+// SearchKeyNotNil applies the NotNil predicate on the "search_key" field.
+func SearchKeyNotNil() predicate.Tag {
+	return predicate.Tag(sql.FieldNotNull(FieldSearchKey))
+}
+
+// SearchKeyEqualFold applies the EqualFold predicate on the "search_key" field.
+func SearchKeyEqualFold(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldEqualFold(FieldSearchKey, v))
+}
+
+// SearchKeyContainsFold applies the ContainsFold predicate on the "search_key" field.
+func SearchKeyContainsFold(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldContainsFold(FieldSearchKey, v))
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v Category) predicate.Tag {
+	return predicate.Tag(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v Category) predicate.Tag {
+	return predicate.Tag(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...Category) predicate.Tag {
+	return predicate.Tag(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...Category) predicate.Tag {
+	return predicate.Tag(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// CategoryIsNil applies the IsNil predicate on the "category" field.
+func CategoryIsNil() predicate.Tag {
+	return predicate.Tag(sql.FieldIsNull(FieldCategory))
+}
+
+// CategoryNotNil applies the NotNil predicate on the "category" field.
+func CategoryNotNil() predicate.Tag {
+	return predicate.Tag(sql.FieldNotNull(FieldCategory))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Tag {
+	return predicate.Tag(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Tag {
+	return predicate.Tag(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.Tag {
+	return predicate.Tag(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.Tag {
+	return predicate.Tag(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Tag {
+	return predicate.Tag(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// LinksIsNil applies the IsNil predicate on the "links" field.
+func LinksIsNil() predicate.Tag {
+	return predicate.Tag(sql.FieldIsNull(FieldLinks))
+}
+
+// LinksNotNil applies the NotNil predicate on the "links" field.
+func LinksNotNil() predicate.Tag {
+	return predicate.Tag(sql.FieldNotNull(FieldLinks))
+}
+
 // HasMeta applies the HasEdge predicate on the "meta" edge.
 func HasMeta() predicate.Tag {
 	return predicate.Tag(func(s *sql.Selector) {
@@ -233,6 +543,29 @@ func HasMetaWith(preds ...predicate.Meta) predicate.Tag {
 	})
 }
 
+// HasExcludedFrom applies the HasEdge predicate on the "excluded_from" edge.
+func HasExcludedFrom() predicate.Tag {
+	return predicate.Tag(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, ExcludedFromTable, ExcludedFromPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExcludedFromWith applies the HasEdge predicate on the "excluded_from" edge with a given conditions (other predicates).
+func HasExcludedFromWith(preds ...predicate.Meta) predicate.Tag {
+	return predicate.Tag(func(s *sql.Selector) {
+		step := newExcludedFromStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasFavoriteOfUser applies the HasEdge predicate on the "favorite_of_user" edge.
 func HasFavoriteOfUser() predicate.Tag {
 	return predicate.Tag(func(s *sql.Selector) {
@@ -256,6 +589,98 @@ func HasFavoriteOfUserWith(preds ...predicate.User) predicate.Tag {
 	})
 }
 
+// HasBlockedByUser applies the HasEdge predicate on the "blocked_by_user" edge.
+func HasBlockedByUser() predicate.Tag {
+	return predicate.Tag(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, BlockedByUserTable, BlockedByUserPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlockedByUserWith applies the HasEdge predicate on the "blocked_by_user" edge with a given conditions (other predicates).
+func HasBlockedByUserWith(preds ...predicate.User) predicate.Tag {
+	return predicate.Tag(func(s *sql.Selector) {
+		step := newBlockedByUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDeniedToUser applies the HasEdge predicate on the "denied_to_user" edge.
+func HasDeniedToUser() predicate.Tag {
+	return predicate.Tag(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, DeniedToUserTable, DeniedToUserPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeniedToUserWith applies the HasEdge predicate on the "denied_to_user" edge with a given conditions (other predicates).
+func HasDeniedToUserWith(preds ...predicate.User) predicate.Tag {
+	return predicate.Tag(func(s *sql.Selector) {
+		step := newDeniedToUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAliases applies the HasEdge predicate on the "aliases" edge.
+func HasAliases() predicate.Tag {
+	return predicate.Tag(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AliasesTable, AliasesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAliasesWith applies the HasEdge predicate on the "aliases" edge with a given conditions (other predicates).
+func HasAliasesWith(preds ...predicate.TagAlias) predicate.Tag {
+	return predicate.Tag(func(s *sql.Selector) {
+		step := newAliasesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMetaTags applies the HasEdge predicate on the "meta_tags" edge.
+func HasMetaTags() predicate.Tag {
+	return predicate.Tag(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, MetaTagsTable, MetaTagsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMetaTagsWith applies the HasEdge predicate on the "meta_tags" edge with a given conditions (other predicates).
+func HasMetaTagsWith(preds ...predicate.MetaTag) predicate.Tag {
+	return predicate.Tag(func(s *sql.Selector) {
+		step := newMetaTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Tag) predicate.Tag {
 	return predicate.Tag(sql.AndPredicates(predicates...))
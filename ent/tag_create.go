@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
 	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tagalias"
 	"github.com/mangaweb4/mangaweb4-backend/ent/user"
 )
 
@@ -58,6 +59,34 @@ func (_c *TagCreate) SetNillableHidden(v *bool) *TagCreate {
 	return _c
 }
 
+// SetHideItems sets the "hide_items" field.
+func (_c *TagCreate) SetHideItems(v bool) *TagCreate {
+	_c.mutation.SetHideItems(v)
+	return _c
+}
+
+// SetNillableHideItems sets the "hide_items" field if the given value is not nil.
+func (_c *TagCreate) SetNillableHideItems(v *bool) *TagCreate {
+	if v != nil {
+		_c.SetHideItems(*v)
+	}
+	return _c
+}
+
+// SetActive sets the "active" field.
+func (_c *TagCreate) SetActive(v bool) *TagCreate {
+	_c.mutation.SetActive(v)
+	return _c
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_c *TagCreate) SetNillableActive(v *bool) *TagCreate {
+	if v != nil {
+		_c.SetActive(*v)
+	}
+	return _c
+}
+
 // SetLastUpdate sets the "last_update" field.
 func (_c *TagCreate) SetLastUpdate(v time.Time) *TagCreate {
 	_c.mutation.SetLastUpdate(v)
@@ -72,6 +101,68 @@ func (_c *TagCreate) SetNillableLastUpdate(v *time.Time) *TagCreate {
 	return _c
 }
 
+// SetNormalizedName sets the "normalized_name" field.
+func (_c *TagCreate) SetNormalizedName(v string) *TagCreate {
+	_c.mutation.SetNormalizedName(v)
+	return _c
+}
+
+// SetNillableNormalizedName sets the "normalized_name" field if the given value is not nil.
+func (_c *TagCreate) SetNillableNormalizedName(v *string) *TagCreate {
+	if v != nil {
+		_c.SetNormalizedName(*v)
+	}
+	return _c
+}
+
+// SetSearchKey sets the "search_key" field.
+func (_c *TagCreate) SetSearchKey(v string) *TagCreate {
+	_c.mutation.SetSearchKey(v)
+	return _c
+}
+
+// SetNillableSearchKey sets the "search_key" field if the given value is not nil.
+func (_c *TagCreate) SetNillableSearchKey(v *string) *TagCreate {
+	if v != nil {
+		_c.SetSearchKey(*v)
+	}
+	return _c
+}
+
+// SetCategory sets the "category" field.
+func (_c *TagCreate) SetCategory(v tag.Category) *TagCreate {
+	_c.mutation.SetCategory(v)
+	return _c
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_c *TagCreate) SetNillableCategory(v *tag.Category) *TagCreate {
+	if v != nil {
+		_c.SetCategory(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *TagCreate) SetDescription(v string) *TagCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *TagCreate) SetNillableDescription(v *string) *TagCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetLinks sets the "links" field.
+func (_c *TagCreate) SetLinks(v []string) *TagCreate {
+	_c.mutation.SetLinks(v)
+	return _c
+}
+
 // AddMetumIDs adds the "meta" edge to the Meta entity by IDs.
 func (_c *TagCreate) AddMetumIDs(ids ...int) *TagCreate {
 	_c.mutation.AddMetumIDs(ids...)
@@ -87,6 +178,21 @@ func (_c *TagCreate) AddMeta(v ...*Meta) *TagCreate {
 	return _c.AddMetumIDs(ids...)
 }
 
+// AddExcludedFromIDs adds the "excluded_from" edge to the Meta entity by IDs.
+func (_c *TagCreate) AddExcludedFromIDs(ids ...int) *TagCreate {
+	_c.mutation.AddExcludedFromIDs(ids...)
+	return _c
+}
+
+// AddExcludedFrom adds the "excluded_from" edges to the Meta entity.
+func (_c *TagCreate) AddExcludedFrom(v ...*Meta) *TagCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddExcludedFromIDs(ids...)
+}
+
 // AddFavoriteOfUserIDs adds the "favorite_of_user" edge to the User entity by IDs.
 func (_c *TagCreate) AddFavoriteOfUserIDs(ids ...int) *TagCreate {
 	_c.mutation.AddFavoriteOfUserIDs(ids...)
@@ -102,6 +208,51 @@ func (_c *TagCreate) AddFavoriteOfUser(v ...*User) *TagCreate {
 	return _c.AddFavoriteOfUserIDs(ids...)
 }
 
+// AddBlockedByUserIDs adds the "blocked_by_user" edge to the User entity by IDs.
+func (_c *TagCreate) AddBlockedByUserIDs(ids ...int) *TagCreate {
+	_c.mutation.AddBlockedByUserIDs(ids...)
+	return _c
+}
+
+// AddBlockedByUser adds the "blocked_by_user" edges to the User entity.
+func (_c *TagCreate) AddBlockedByUser(v ...*User) *TagCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBlockedByUserIDs(ids...)
+}
+
+// AddDeniedToUserIDs adds the "denied_to_user" edge to the User entity by IDs.
+func (_c *TagCreate) AddDeniedToUserIDs(ids ...int) *TagCreate {
+	_c.mutation.AddDeniedToUserIDs(ids...)
+	return _c
+}
+
+// AddDeniedToUser adds the "denied_to_user" edges to the User entity.
+func (_c *TagCreate) AddDeniedToUser(v ...*User) *TagCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDeniedToUserIDs(ids...)
+}
+
+// AddAliasIDs adds the "aliases" edge to the TagAlias entity by IDs.
+func (_c *TagCreate) AddAliasIDs(ids ...int) *TagCreate {
+	_c.mutation.AddAliasIDs(ids...)
+	return _c
+}
+
+// AddAliases adds the "aliases" edges to the TagAlias entity.
+func (_c *TagCreate) AddAliases(v ...*TagAlias) *TagCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAliasIDs(ids...)
+}
+
 // Mutation returns the TagMutation object of the builder.
 func (_c *TagCreate) Mutation() *TagMutation {
 	return _c.mutation
@@ -109,7 +260,9 @@ func (_c *TagCreate) Mutation() *TagMutation {
 
 // Save creates the Tag in the database.
 func (_c *TagCreate) Save(ctx context.Context) (*Tag, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -136,7 +289,7 @@ func (_c *TagCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *TagCreate) defaults() {
+func (_c *TagCreate) defaults() error {
 	if _, ok := _c.mutation.Favorite(); !ok {
 		v := tag.DefaultFavorite
 		_c.mutation.SetFavorite(v)
@@ -145,10 +298,19 @@ func (_c *TagCreate) defaults() {
 		v := tag.DefaultHidden
 		_c.mutation.SetHidden(v)
 	}
+	if _, ok := _c.mutation.HideItems(); !ok {
+		v := tag.DefaultHideItems
+		_c.mutation.SetHideItems(v)
+	}
+	if _, ok := _c.mutation.Active(); !ok {
+		v := tag.DefaultActive
+		_c.mutation.SetActive(v)
+	}
 	if _, ok := _c.mutation.LastUpdate(); !ok {
 		v := tag.DefaultLastUpdate
 		_c.mutation.SetLastUpdate(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -167,6 +329,17 @@ func (_c *TagCreate) check() error {
 	if _, ok := _c.mutation.Hidden(); !ok {
 		return &ValidationError{Name: "hidden", err: errors.New(`ent: missing required field "Tag.hidden"`)}
 	}
+	if _, ok := _c.mutation.HideItems(); !ok {
+		return &ValidationError{Name: "hide_items", err: errors.New(`ent: missing required field "Tag.hide_items"`)}
+	}
+	if _, ok := _c.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "Tag.active"`)}
+	}
+	if v, ok := _c.mutation.Category(); ok {
+		if err := tag.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "Tag.category": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -206,10 +379,38 @@ func (_c *TagCreate) createSpec() (*Tag, *sqlgraph.CreateSpec) {
 		_spec.SetField(tag.FieldHidden, field.TypeBool, value)
 		_node.Hidden = value
 	}
+	if value, ok := _c.mutation.HideItems(); ok {
+		_spec.SetField(tag.FieldHideItems, field.TypeBool, value)
+		_node.HideItems = value
+	}
+	if value, ok := _c.mutation.Active(); ok {
+		_spec.SetField(tag.FieldActive, field.TypeBool, value)
+		_node.Active = value
+	}
 	if value, ok := _c.mutation.LastUpdate(); ok {
 		_spec.SetField(tag.FieldLastUpdate, field.TypeTime, value)
 		_node.LastUpdate = value
 	}
+	if value, ok := _c.mutation.NormalizedName(); ok {
+		_spec.SetField(tag.FieldNormalizedName, field.TypeString, value)
+		_node.NormalizedName = value
+	}
+	if value, ok := _c.mutation.SearchKey(); ok {
+		_spec.SetField(tag.FieldSearchKey, field.TypeString, value)
+		_node.SearchKey = value
+	}
+	if value, ok := _c.mutation.Category(); ok {
+		_spec.SetField(tag.FieldCategory, field.TypeEnum, value)
+		_node.Category = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(tag.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Links(); ok {
+		_spec.SetField(tag.FieldLinks, field.TypeJSON, value)
+		_node.Links = value
+	}
 	if nodes := _c.mutation.MetaIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -224,6 +425,26 @@ func (_c *TagCreate) createSpec() (*Tag, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &MetaTagCreate{config: _c.config, mutation: newMetaTagMutation(_c.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ExcludedFromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.ExcludedFromTable,
+			Columns: tag.ExcludedFromPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(meta.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := _c.mutation.FavoriteOfUserIDs(); len(nodes) > 0 {
@@ -242,6 +463,54 @@ func (_c *TagCreate) createSpec() (*Tag, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.BlockedByUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.BlockedByUserTable,
+			Columns: tag.BlockedByUserPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DeniedToUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.DeniedToUserTable,
+			Columns: tag.DeniedToUserPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AliasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.AliasesTable,
+			Columns: []string{tag.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -330,6 +599,30 @@ func (u *TagUpsert) UpdateHidden() *TagUpsert {
 	return u
 }
 
+// SetHideItems sets the "hide_items" field.
+func (u *TagUpsert) SetHideItems(v bool) *TagUpsert {
+	u.Set(tag.FieldHideItems, v)
+	return u
+}
+
+// UpdateHideItems sets the "hide_items" field to the value that was provided on create.
+func (u *TagUpsert) UpdateHideItems() *TagUpsert {
+	u.SetExcluded(tag.FieldHideItems)
+	return u
+}
+
+// SetActive sets the "active" field.
+func (u *TagUpsert) SetActive(v bool) *TagUpsert {
+	u.Set(tag.FieldActive, v)
+	return u
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *TagUpsert) UpdateActive() *TagUpsert {
+	u.SetExcluded(tag.FieldActive)
+	return u
+}
+
 // SetLastUpdate sets the "last_update" field.
 func (u *TagUpsert) SetLastUpdate(v time.Time) *TagUpsert {
 	u.Set(tag.FieldLastUpdate, v)
@@ -348,6 +641,96 @@ func (u *TagUpsert) ClearLastUpdate() *TagUpsert {
 	return u
 }
 
+// SetNormalizedName sets the "normalized_name" field.
+func (u *TagUpsert) SetNormalizedName(v string) *TagUpsert {
+	u.Set(tag.FieldNormalizedName, v)
+	return u
+}
+
+// UpdateNormalizedName sets the "normalized_name" field to the value that was provided on create.
+func (u *TagUpsert) UpdateNormalizedName() *TagUpsert {
+	u.SetExcluded(tag.FieldNormalizedName)
+	return u
+}
+
+// ClearNormalizedName clears the value of the "normalized_name" field.
+func (u *TagUpsert) ClearNormalizedName() *TagUpsert {
+	u.SetNull(tag.FieldNormalizedName)
+	return u
+}
+
+// SetSearchKey sets the "search_key" field.
+func (u *TagUpsert) SetSearchKey(v string) *TagUpsert {
+	u.Set(tag.FieldSearchKey, v)
+	return u
+}
+
+// UpdateSearchKey sets the "search_key" field to the value that was provided on create.
+func (u *TagUpsert) UpdateSearchKey() *TagUpsert {
+	u.SetExcluded(tag.FieldSearchKey)
+	return u
+}
+
+// ClearSearchKey clears the value of the "search_key" field.
+func (u *TagUpsert) ClearSearchKey() *TagUpsert {
+	u.SetNull(tag.FieldSearchKey)
+	return u
+}
+
+// SetCategory sets the "category" field.
+func (u *TagUpsert) SetCategory(v tag.Category) *TagUpsert {
+	u.Set(tag.FieldCategory, v)
+	return u
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *TagUpsert) UpdateCategory() *TagUpsert {
+	u.SetExcluded(tag.FieldCategory)
+	return u
+}
+
+// ClearCategory clears the value of the "category" field.
+func (u *TagUpsert) ClearCategory() *TagUpsert {
+	u.SetNull(tag.FieldCategory)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *TagUpsert) SetDescription(v string) *TagUpsert {
+	u.Set(tag.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TagUpsert) UpdateDescription() *TagUpsert {
+	u.SetExcluded(tag.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *TagUpsert) ClearDescription() *TagUpsert {
+	u.SetNull(tag.FieldDescription)
+	return u
+}
+
+// SetLinks sets the "links" field.
+func (u *TagUpsert) SetLinks(v []string) *TagUpsert {
+	u.Set(tag.FieldLinks, v)
+	return u
+}
+
+// UpdateLinks sets the "links" field to the value that was provided on create.
+func (u *TagUpsert) UpdateLinks() *TagUpsert {
+	u.SetExcluded(tag.FieldLinks)
+	return u
+}
+
+// ClearLinks clears the value of the "links" field.
+func (u *TagUpsert) ClearLinks() *TagUpsert {
+	u.SetNull(tag.FieldLinks)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -430,6 +813,34 @@ func (u *TagUpsertOne) UpdateHidden() *TagUpsertOne {
 	})
 }
 
+// SetHideItems sets the "hide_items" field.
+func (u *TagUpsertOne) SetHideItems(v bool) *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.SetHideItems(v)
+	})
+}
+
+// UpdateHideItems sets the "hide_items" field to the value that was provided on create.
+func (u *TagUpsertOne) UpdateHideItems() *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateHideItems()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *TagUpsertOne) SetActive(v bool) *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *TagUpsertOne) UpdateActive() *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateActive()
+	})
+}
+
 // SetLastUpdate sets the "last_update" field.
 func (u *TagUpsertOne) SetLastUpdate(v time.Time) *TagUpsertOne {
 	return u.Update(func(s *TagUpsert) {
@@ -451,6 +862,111 @@ func (u *TagUpsertOne) ClearLastUpdate() *TagUpsertOne {
 	})
 }
 
+// SetNormalizedName sets the "normalized_name" field.
+func (u *TagUpsertOne) SetNormalizedName(v string) *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.SetNormalizedName(v)
+	})
+}
+
+// UpdateNormalizedName sets the "normalized_name" field to the value that was provided on create.
+func (u *TagUpsertOne) UpdateNormalizedName() *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateNormalizedName()
+	})
+}
+
+// ClearNormalizedName clears the value of the "normalized_name" field.
+func (u *TagUpsertOne) ClearNormalizedName() *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.ClearNormalizedName()
+	})
+}
+
+// SetSearchKey sets the "search_key" field.
+func (u *TagUpsertOne) SetSearchKey(v string) *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.SetSearchKey(v)
+	})
+}
+
+// UpdateSearchKey sets the "search_key" field to the value that was provided on create.
+func (u *TagUpsertOne) UpdateSearchKey() *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateSearchKey()
+	})
+}
+
+// ClearSearchKey clears the value of the "search_key" field.
+func (u *TagUpsertOne) ClearSearchKey() *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.ClearSearchKey()
+	})
+}
+
+// SetCategory sets the "category" field.
+func (u *TagUpsertOne) SetCategory(v tag.Category) *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *TagUpsertOne) UpdateCategory() *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// ClearCategory clears the value of the "category" field.
+func (u *TagUpsertOne) ClearCategory() *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.ClearCategory()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *TagUpsertOne) SetDescription(v string) *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TagUpsertOne) UpdateDescription() *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *TagUpsertOne) ClearDescription() *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetLinks sets the "links" field.
+func (u *TagUpsertOne) SetLinks(v []string) *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.SetLinks(v)
+	})
+}
+
+// UpdateLinks sets the "links" field to the value that was provided on create.
+func (u *TagUpsertOne) UpdateLinks() *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateLinks()
+	})
+}
+
+// ClearLinks clears the value of the "links" field.
+func (u *TagUpsertOne) ClearLinks() *TagUpsertOne {
+	return u.Update(func(s *TagUpsert) {
+		s.ClearLinks()
+	})
+}
+
 // Exec executes the query.
 func (u *TagUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -697,6 +1213,34 @@ func (u *TagUpsertBulk) UpdateHidden() *TagUpsertBulk {
 	})
 }
 
+// SetHideItems sets the "hide_items" field.
+func (u *TagUpsertBulk) SetHideItems(v bool) *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.SetHideItems(v)
+	})
+}
+
+// UpdateHideItems sets the "hide_items" field to the value that was provided on create.
+func (u *TagUpsertBulk) UpdateHideItems() *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateHideItems()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *TagUpsertBulk) SetActive(v bool) *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *TagUpsertBulk) UpdateActive() *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateActive()
+	})
+}
+
 // SetLastUpdate sets the "last_update" field.
 func (u *TagUpsertBulk) SetLastUpdate(v time.Time) *TagUpsertBulk {
 	return u.Update(func(s *TagUpsert) {
@@ -718,6 +1262,111 @@ func (u *TagUpsertBulk) ClearLastUpdate() *TagUpsertBulk {
 	})
 }
 
+// SetNormalizedName sets the "normalized_name" field.
+func (u *TagUpsertBulk) SetNormalizedName(v string) *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.SetNormalizedName(v)
+	})
+}
+
+// UpdateNormalizedName sets the "normalized_name" field to the value that was provided on create.
+func (u *TagUpsertBulk) UpdateNormalizedName() *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateNormalizedName()
+	})
+}
+
+// ClearNormalizedName clears the value of the "normalized_name" field.
+func (u *TagUpsertBulk) ClearNormalizedName() *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.ClearNormalizedName()
+	})
+}
+
+// SetSearchKey sets the "search_key" field.
+func (u *TagUpsertBulk) SetSearchKey(v string) *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.SetSearchKey(v)
+	})
+}
+
+// UpdateSearchKey sets the "search_key" field to the value that was provided on create.
+func (u *TagUpsertBulk) UpdateSearchKey() *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateSearchKey()
+	})
+}
+
+// ClearSearchKey clears the value of the "search_key" field.
+func (u *TagUpsertBulk) ClearSearchKey() *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.ClearSearchKey()
+	})
+}
+
+// SetCategory sets the "category" field.
+func (u *TagUpsertBulk) SetCategory(v tag.Category) *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *TagUpsertBulk) UpdateCategory() *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// ClearCategory clears the value of the "category" field.
+func (u *TagUpsertBulk) ClearCategory() *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.ClearCategory()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *TagUpsertBulk) SetDescription(v string) *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TagUpsertBulk) UpdateDescription() *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *TagUpsertBulk) ClearDescription() *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetLinks sets the "links" field.
+func (u *TagUpsertBulk) SetLinks(v []string) *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.SetLinks(v)
+	})
+}
+
+// UpdateLinks sets the "links" field to the value that was provided on create.
+func (u *TagUpsertBulk) UpdateLinks() *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.UpdateLinks()
+	})
+}
+
+// ClearLinks clears the value of the "links" field.
+func (u *TagUpsertBulk) ClearLinks() *TagUpsertBulk {
+	return u.Update(func(s *TagUpsert) {
+		s.ClearLinks()
+	})
+}
+
 // Exec executes the query.
 func (u *TagUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
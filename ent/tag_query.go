@@ -5,6 +5,7 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 
@@ -13,8 +14,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/mangaweb4/mangaweb4-backend/ent/metatag"
 	"github.com/mangaweb4/mangaweb4-backend/ent/predicate"
 	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tagalias"
 	"github.com/mangaweb4/mangaweb4-backend/ent/user"
 )
 
@@ -26,7 +29,13 @@ type TagQuery struct {
 	inters             []Interceptor
 	predicates         []predicate.Tag
 	withMeta           *MetaQuery
+	withExcludedFrom   *MetaQuery
 	withFavoriteOfUser *UserQuery
+	withBlockedByUser  *UserQuery
+	withDeniedToUser   *UserQuery
+	withAliases        *TagAliasQuery
+	withMetaTags       *MetaTagQuery
+	modifiers          []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -85,6 +94,28 @@ func (_q *TagQuery) QueryMeta() *MetaQuery {
 	return query
 }
 
+// QueryExcludedFrom chains the current query on the "excluded_from" edge.
+func (_q *TagQuery) QueryExcludedFrom() *MetaQuery {
+	query := (&MetaClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, selector),
+			sqlgraph.To(meta.Table, meta.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, tag.ExcludedFromTable, tag.ExcludedFromPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryFavoriteOfUser chains the current query on the "favorite_of_user" edge.
 func (_q *TagQuery) QueryFavoriteOfUser() *UserQuery {
 	query := (&UserClient{config: _q.config}).Query()
@@ -107,6 +138,94 @@ func (_q *TagQuery) QueryFavoriteOfUser() *UserQuery {
 	return query
 }
 
+// QueryBlockedByUser chains the current query on the "blocked_by_user" edge.
+func (_q *TagQuery) QueryBlockedByUser() *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, tag.BlockedByUserTable, tag.BlockedByUserPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDeniedToUser chains the current query on the "denied_to_user" edge.
+func (_q *TagQuery) QueryDeniedToUser() *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, tag.DeniedToUserTable, tag.DeniedToUserPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAliases chains the current query on the "aliases" edge.
+func (_q *TagQuery) QueryAliases() *TagAliasQuery {
+	query := (&TagAliasClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, selector),
+			sqlgraph.To(tagalias.Table, tagalias.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tag.AliasesTable, tag.AliasesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMetaTags chains the current query on the "meta_tags" edge.
+func (_q *TagQuery) QueryMetaTags() *MetaTagQuery {
+	query := (&MetaTagClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, selector),
+			sqlgraph.To(metatag.Table, metatag.TagColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, tag.MetaTagsTable, tag.MetaTagsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Tag entity from the query.
 // Returns a *NotFoundError when no Tag was found.
 func (_q *TagQuery) First(ctx context.Context) (*Tag, error) {
@@ -300,10 +419,16 @@ func (_q *TagQuery) Clone() *TagQuery {
 		inters:             append([]Interceptor{}, _q.inters...),
 		predicates:         append([]predicate.Tag{}, _q.predicates...),
 		withMeta:           _q.withMeta.Clone(),
+		withExcludedFrom:   _q.withExcludedFrom.Clone(),
 		withFavoriteOfUser: _q.withFavoriteOfUser.Clone(),
+		withBlockedByUser:  _q.withBlockedByUser.Clone(),
+		withDeniedToUser:   _q.withDeniedToUser.Clone(),
+		withAliases:        _q.withAliases.Clone(),
+		withMetaTags:       _q.withMetaTags.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -318,6 +443,17 @@ func (_q *TagQuery) WithMeta(opts ...func(*MetaQuery)) *TagQuery {
 	return _q
 }
 
+// WithExcludedFrom tells the query-builder to eager-load the nodes that are connected to
+// the "excluded_from" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TagQuery) WithExcludedFrom(opts ...func(*MetaQuery)) *TagQuery {
+	query := (&MetaClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withExcludedFrom = query
+	return _q
+}
+
 // WithFavoriteOfUser tells the query-builder to eager-load the nodes that are connected to
 // the "favorite_of_user" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *TagQuery) WithFavoriteOfUser(opts ...func(*UserQuery)) *TagQuery {
@@ -329,6 +465,50 @@ func (_q *TagQuery) WithFavoriteOfUser(opts ...func(*UserQuery)) *TagQuery {
 	return _q
 }
 
+// WithBlockedByUser tells the query-builder to eager-load the nodes that are connected to
+// the "blocked_by_user" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TagQuery) WithBlockedByUser(opts ...func(*UserQuery)) *TagQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBlockedByUser = query
+	return _q
+}
+
+// WithDeniedToUser tells the query-builder to eager-load the nodes that are connected to
+// the "denied_to_user" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TagQuery) WithDeniedToUser(opts ...func(*UserQuery)) *TagQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDeniedToUser = query
+	return _q
+}
+
+// WithAliases tells the query-builder to eager-load the nodes that are connected to
+// the "aliases" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TagQuery) WithAliases(opts ...func(*TagAliasQuery)) *TagQuery {
+	query := (&TagAliasClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAliases = query
+	return _q
+}
+
+// WithMetaTags tells the query-builder to eager-load the nodes that are connected to
+// the "meta_tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TagQuery) WithMetaTags(opts ...func(*MetaTagQuery)) *TagQuery {
+	query := (&MetaTagClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMetaTags = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -400,6 +580,12 @@ func (_q *TagQuery) prepareQuery(ctx context.Context) error {
 		}
 		_q.sql = prev
 	}
+	if tag.Policy == nil {
+		return errors.New("ent: uninitialized tag.Policy (forgotten import ent/runtime?)")
+	}
+	if err := tag.Policy.EvalQuery(ctx, _q); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -407,9 +593,14 @@ func (_q *TagQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tag, err
 	var (
 		nodes       = []*Tag{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [7]bool{
 			_q.withMeta != nil,
+			_q.withExcludedFrom != nil,
 			_q.withFavoriteOfUser != nil,
+			_q.withBlockedByUser != nil,
+			_q.withDeniedToUser != nil,
+			_q.withAliases != nil,
+			_q.withMetaTags != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -421,6 +612,9 @@ func (_q *TagQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tag, err
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -437,6 +631,13 @@ func (_q *TagQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tag, err
 			return nil, err
 		}
 	}
+	if query := _q.withExcludedFrom; query != nil {
+		if err := _q.loadExcludedFrom(ctx, query, nodes,
+			func(n *Tag) { n.Edges.ExcludedFrom = []*Meta{} },
+			func(n *Tag, e *Meta) { n.Edges.ExcludedFrom = append(n.Edges.ExcludedFrom, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withFavoriteOfUser; query != nil {
 		if err := _q.loadFavoriteOfUser(ctx, query, nodes,
 			func(n *Tag) { n.Edges.FavoriteOfUser = []*User{} },
@@ -444,6 +645,34 @@ func (_q *TagQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tag, err
 			return nil, err
 		}
 	}
+	if query := _q.withBlockedByUser; query != nil {
+		if err := _q.loadBlockedByUser(ctx, query, nodes,
+			func(n *Tag) { n.Edges.BlockedByUser = []*User{} },
+			func(n *Tag, e *User) { n.Edges.BlockedByUser = append(n.Edges.BlockedByUser, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withDeniedToUser; query != nil {
+		if err := _q.loadDeniedToUser(ctx, query, nodes,
+			func(n *Tag) { n.Edges.DeniedToUser = []*User{} },
+			func(n *Tag, e *User) { n.Edges.DeniedToUser = append(n.Edges.DeniedToUser, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAliases; query != nil {
+		if err := _q.loadAliases(ctx, query, nodes,
+			func(n *Tag) { n.Edges.Aliases = []*TagAlias{} },
+			func(n *Tag, e *TagAlias) { n.Edges.Aliases = append(n.Edges.Aliases, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMetaTags; query != nil {
+		if err := _q.loadMetaTags(ctx, query, nodes,
+			func(n *Tag) { n.Edges.MetaTags = []*MetaTag{} },
+			func(n *Tag, e *MetaTag) { n.Edges.MetaTags = append(n.Edges.MetaTags, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -508,6 +737,67 @@ func (_q *TagQuery) loadMeta(ctx context.Context, query *MetaQuery, nodes []*Tag
 	}
 	return nil
 }
+func (_q *TagQuery) loadExcludedFrom(ctx context.Context, query *MetaQuery, nodes []*Tag, init func(*Tag), assign func(*Tag, *Meta)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Tag)
+	nids := make(map[int]map[*Tag]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(tag.ExcludedFromTable)
+		s.Join(joinT).On(s.C(meta.FieldID), joinT.C(tag.ExcludedFromPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(tag.ExcludedFromPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(tag.ExcludedFromPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Tag]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Meta](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "excluded_from" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 func (_q *TagQuery) loadFavoriteOfUser(ctx context.Context, query *UserQuery, nodes []*Tag, init func(*Tag), assign func(*Tag, *User)) error {
 	edgeIDs := make([]driver.Value, len(nodes))
 	byID := make(map[int]*Tag)
@@ -569,9 +859,195 @@ func (_q *TagQuery) loadFavoriteOfUser(ctx context.Context, query *UserQuery, no
 	}
 	return nil
 }
+func (_q *TagQuery) loadBlockedByUser(ctx context.Context, query *UserQuery, nodes []*Tag, init func(*Tag), assign func(*Tag, *User)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Tag)
+	nids := make(map[int]map[*Tag]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(tag.BlockedByUserTable)
+		s.Join(joinT).On(s.C(user.FieldID), joinT.C(tag.BlockedByUserPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(tag.BlockedByUserPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(tag.BlockedByUserPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Tag]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*User](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "blocked_by_user" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *TagQuery) loadDeniedToUser(ctx context.Context, query *UserQuery, nodes []*Tag, init func(*Tag), assign func(*Tag, *User)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Tag)
+	nids := make(map[int]map[*Tag]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(tag.DeniedToUserTable)
+		s.Join(joinT).On(s.C(user.FieldID), joinT.C(tag.DeniedToUserPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(tag.DeniedToUserPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(tag.DeniedToUserPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Tag]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*User](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "denied_to_user" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *TagQuery) loadAliases(ctx context.Context, query *TagAliasQuery, nodes []*Tag, init func(*Tag), assign func(*Tag, *TagAlias)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tag)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.TagAlias(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tag.AliasesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.tag_aliases
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "tag_aliases" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tag_aliases" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TagQuery) loadMetaTags(ctx context.Context, query *MetaTagQuery, nodes []*Tag, init func(*Tag), assign func(*Tag, *MetaTag)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Tag)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(metatag.FieldTagID)
+	}
+	query.Where(predicate.MetaTag(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tag.MetaTagsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TagID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tag_id" returned %v for node %v`, fk, n)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *TagQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -634,6 +1110,9 @@ func (_q *TagQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -651,6 +1130,12 @@ func (_q *TagQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *TagQuery) Modify(modifiers ...func(s *sql.Selector)) *TagSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // TagGroupBy is the group-by builder for Tag entities.
 type TagGroupBy struct {
 	selector
@@ -740,3 +1225,9 @@ func (_s *TagSelect) sqlScan(ctx context.Context, root *TagQuery, v any) error {
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *TagSelect) Modify(modifiers ...func(s *sql.Selector)) *TagSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -10,18 +10,21 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
 	"github.com/mangaweb4/mangaweb4-backend/ent/predicate"
 	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tagalias"
 	"github.com/mangaweb4/mangaweb4-backend/ent/user"
 )
 
 // TagUpdate is the builder for updating Tag entities.
 type TagUpdate struct {
 	config
-	hooks    []Hook
-	mutation *TagMutation
+	hooks     []Hook
+	mutation  *TagMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the TagUpdate builder.
@@ -72,6 +75,34 @@ func (_u *TagUpdate) SetNillableHidden(v *bool) *TagUpdate {
 	return _u
 }
 
+// SetHideItems sets the "hide_items" field.
+func (_u *TagUpdate) SetHideItems(v bool) *TagUpdate {
+	_u.mutation.SetHideItems(v)
+	return _u
+}
+
+// SetNillableHideItems sets the "hide_items" field if the given value is not nil.
+func (_u *TagUpdate) SetNillableHideItems(v *bool) *TagUpdate {
+	if v != nil {
+		_u.SetHideItems(*v)
+	}
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *TagUpdate) SetActive(v bool) *TagUpdate {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *TagUpdate) SetNillableActive(v *bool) *TagUpdate {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
 // SetLastUpdate sets the "last_update" field.
 func (_u *TagUpdate) SetLastUpdate(v time.Time) *TagUpdate {
 	_u.mutation.SetLastUpdate(v)
@@ -92,6 +123,104 @@ func (_u *TagUpdate) ClearLastUpdate() *TagUpdate {
 	return _u
 }
 
+// SetNormalizedName sets the "normalized_name" field.
+func (_u *TagUpdate) SetNormalizedName(v string) *TagUpdate {
+	_u.mutation.SetNormalizedName(v)
+	return _u
+}
+
+// SetNillableNormalizedName sets the "normalized_name" field if the given value is not nil.
+func (_u *TagUpdate) SetNillableNormalizedName(v *string) *TagUpdate {
+	if v != nil {
+		_u.SetNormalizedName(*v)
+	}
+	return _u
+}
+
+// ClearNormalizedName clears the value of the "normalized_name" field.
+func (_u *TagUpdate) ClearNormalizedName() *TagUpdate {
+	_u.mutation.ClearNormalizedName()
+	return _u
+}
+
+// SetSearchKey sets the "search_key" field.
+func (_u *TagUpdate) SetSearchKey(v string) *TagUpdate {
+	_u.mutation.SetSearchKey(v)
+	return _u
+}
+
+// SetNillableSearchKey sets the "search_key" field if the given value is not nil.
+func (_u *TagUpdate) SetNillableSearchKey(v *string) *TagUpdate {
+	if v != nil {
+		_u.SetSearchKey(*v)
+	}
+	return _u
+}
+
+// ClearSearchKey clears the value of the "search_key" field.
+func (_u *TagUpdate) ClearSearchKey() *TagUpdate {
+	_u.mutation.ClearSearchKey()
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *TagUpdate) SetCategory(v tag.Category) *TagUpdate {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *TagUpdate) SetNillableCategory(v *tag.Category) *TagUpdate {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// ClearCategory clears the value of the "category" field.
+func (_u *TagUpdate) ClearCategory() *TagUpdate {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *TagUpdate) SetDescription(v string) *TagUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *TagUpdate) SetNillableDescription(v *string) *TagUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *TagUpdate) ClearDescription() *TagUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetLinks sets the "links" field.
+func (_u *TagUpdate) SetLinks(v []string) *TagUpdate {
+	_u.mutation.SetLinks(v)
+	return _u
+}
+
+// AppendLinks appends value to the "links" field.
+func (_u *TagUpdate) AppendLinks(v []string) *TagUpdate {
+	_u.mutation.AppendLinks(v)
+	return _u
+}
+
+// ClearLinks clears the value of the "links" field.
+func (_u *TagUpdate) ClearLinks() *TagUpdate {
+	_u.mutation.ClearLinks()
+	return _u
+}
+
 // AddMetumIDs adds the "meta" edge to the Meta entity by IDs.
 func (_u *TagUpdate) AddMetumIDs(ids ...int) *TagUpdate {
 	_u.mutation.AddMetumIDs(ids...)
@@ -107,6 +236,21 @@ func (_u *TagUpdate) AddMeta(v ...*Meta) *TagUpdate {
 	return _u.AddMetumIDs(ids...)
 }
 
+// AddExcludedFromIDs adds the "excluded_from" edge to the Meta entity by IDs.
+func (_u *TagUpdate) AddExcludedFromIDs(ids ...int) *TagUpdate {
+	_u.mutation.AddExcludedFromIDs(ids...)
+	return _u
+}
+
+// AddExcludedFrom adds the "excluded_from" edges to the Meta entity.
+func (_u *TagUpdate) AddExcludedFrom(v ...*Meta) *TagUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddExcludedFromIDs(ids...)
+}
+
 // AddFavoriteOfUserIDs adds the "favorite_of_user" edge to the User entity by IDs.
 func (_u *TagUpdate) AddFavoriteOfUserIDs(ids ...int) *TagUpdate {
 	_u.mutation.AddFavoriteOfUserIDs(ids...)
@@ -122,6 +266,51 @@ func (_u *TagUpdate) AddFavoriteOfUser(v ...*User) *TagUpdate {
 	return _u.AddFavoriteOfUserIDs(ids...)
 }
 
+// AddBlockedByUserIDs adds the "blocked_by_user" edge to the User entity by IDs.
+func (_u *TagUpdate) AddBlockedByUserIDs(ids ...int) *TagUpdate {
+	_u.mutation.AddBlockedByUserIDs(ids...)
+	return _u
+}
+
+// AddBlockedByUser adds the "blocked_by_user" edges to the User entity.
+func (_u *TagUpdate) AddBlockedByUser(v ...*User) *TagUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBlockedByUserIDs(ids...)
+}
+
+// AddDeniedToUserIDs adds the "denied_to_user" edge to the User entity by IDs.
+func (_u *TagUpdate) AddDeniedToUserIDs(ids ...int) *TagUpdate {
+	_u.mutation.AddDeniedToUserIDs(ids...)
+	return _u
+}
+
+// AddDeniedToUser adds the "denied_to_user" edges to the User entity.
+func (_u *TagUpdate) AddDeniedToUser(v ...*User) *TagUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeniedToUserIDs(ids...)
+}
+
+// AddAliasIDs adds the "aliases" edge to the TagAlias entity by IDs.
+func (_u *TagUpdate) AddAliasIDs(ids ...int) *TagUpdate {
+	_u.mutation.AddAliasIDs(ids...)
+	return _u
+}
+
+// AddAliases adds the "aliases" edges to the TagAlias entity.
+func (_u *TagUpdate) AddAliases(v ...*TagAlias) *TagUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAliasIDs(ids...)
+}
+
 // Mutation returns the TagMutation object of the builder.
 func (_u *TagUpdate) Mutation() *TagMutation {
 	return _u.mutation
@@ -148,6 +337,27 @@ func (_u *TagUpdate) RemoveMeta(v ...*Meta) *TagUpdate {
 	return _u.RemoveMetumIDs(ids...)
 }
 
+// ClearExcludedFrom clears all "excluded_from" edges to the Meta entity.
+func (_u *TagUpdate) ClearExcludedFrom() *TagUpdate {
+	_u.mutation.ClearExcludedFrom()
+	return _u
+}
+
+// RemoveExcludedFromIDs removes the "excluded_from" edge to Meta entities by IDs.
+func (_u *TagUpdate) RemoveExcludedFromIDs(ids ...int) *TagUpdate {
+	_u.mutation.RemoveExcludedFromIDs(ids...)
+	return _u
+}
+
+// RemoveExcludedFrom removes "excluded_from" edges to Meta entities.
+func (_u *TagUpdate) RemoveExcludedFrom(v ...*Meta) *TagUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExcludedFromIDs(ids...)
+}
+
 // ClearFavoriteOfUser clears all "favorite_of_user" edges to the User entity.
 func (_u *TagUpdate) ClearFavoriteOfUser() *TagUpdate {
 	_u.mutation.ClearFavoriteOfUser()
@@ -169,6 +379,69 @@ func (_u *TagUpdate) RemoveFavoriteOfUser(v ...*User) *TagUpdate {
 	return _u.RemoveFavoriteOfUserIDs(ids...)
 }
 
+// ClearBlockedByUser clears all "blocked_by_user" edges to the User entity.
+func (_u *TagUpdate) ClearBlockedByUser() *TagUpdate {
+	_u.mutation.ClearBlockedByUser()
+	return _u
+}
+
+// RemoveBlockedByUserIDs removes the "blocked_by_user" edge to User entities by IDs.
+func (_u *TagUpdate) RemoveBlockedByUserIDs(ids ...int) *TagUpdate {
+	_u.mutation.RemoveBlockedByUserIDs(ids...)
+	return _u
+}
+
+// RemoveBlockedByUser removes "blocked_by_user" edges to User entities.
+func (_u *TagUpdate) RemoveBlockedByUser(v ...*User) *TagUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBlockedByUserIDs(ids...)
+}
+
+// ClearDeniedToUser clears all "denied_to_user" edges to the User entity.
+func (_u *TagUpdate) ClearDeniedToUser() *TagUpdate {
+	_u.mutation.ClearDeniedToUser()
+	return _u
+}
+
+// RemoveDeniedToUserIDs removes the "denied_to_user" edge to User entities by IDs.
+func (_u *TagUpdate) RemoveDeniedToUserIDs(ids ...int) *TagUpdate {
+	_u.mutation.RemoveDeniedToUserIDs(ids...)
+	return _u
+}
+
+// RemoveDeniedToUser removes "denied_to_user" edges to User entities.
+func (_u *TagUpdate) RemoveDeniedToUser(v ...*User) *TagUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeniedToUserIDs(ids...)
+}
+
+// ClearAliases clears all "aliases" edges to the TagAlias entity.
+func (_u *TagUpdate) ClearAliases() *TagUpdate {
+	_u.mutation.ClearAliases()
+	return _u
+}
+
+// RemoveAliasIDs removes the "aliases" edge to TagAlias entities by IDs.
+func (_u *TagUpdate) RemoveAliasIDs(ids ...int) *TagUpdate {
+	_u.mutation.RemoveAliasIDs(ids...)
+	return _u
+}
+
+// RemoveAliases removes "aliases" edges to TagAlias entities.
+func (_u *TagUpdate) RemoveAliases(v ...*TagAlias) *TagUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAliasIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *TagUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -203,9 +476,20 @@ func (_u *TagUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Tag.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Category(); ok {
+		if err := tag.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "Tag.category": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TagUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TagUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *TagUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -227,12 +511,53 @@ func (_u *TagUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Hidden(); ok {
 		_spec.SetField(tag.FieldHidden, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.HideItems(); ok {
+		_spec.SetField(tag.FieldHideItems, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(tag.FieldActive, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.LastUpdate(); ok {
 		_spec.SetField(tag.FieldLastUpdate, field.TypeTime, value)
 	}
 	if _u.mutation.LastUpdateCleared() {
 		_spec.ClearField(tag.FieldLastUpdate, field.TypeTime)
 	}
+	if value, ok := _u.mutation.NormalizedName(); ok {
+		_spec.SetField(tag.FieldNormalizedName, field.TypeString, value)
+	}
+	if _u.mutation.NormalizedNameCleared() {
+		_spec.ClearField(tag.FieldNormalizedName, field.TypeString)
+	}
+	if value, ok := _u.mutation.SearchKey(); ok {
+		_spec.SetField(tag.FieldSearchKey, field.TypeString, value)
+	}
+	if _u.mutation.SearchKeyCleared() {
+		_spec.ClearField(tag.FieldSearchKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(tag.FieldCategory, field.TypeEnum, value)
+	}
+	if _u.mutation.CategoryCleared() {
+		_spec.ClearField(tag.FieldCategory, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(tag.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(tag.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Links(); ok {
+		_spec.SetField(tag.FieldLinks, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLinks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tag.FieldLinks, value)
+		})
+	}
+	if _u.mutation.LinksCleared() {
+		_spec.ClearField(tag.FieldLinks, field.TypeJSON)
+	}
 	if _u.mutation.MetaCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -244,6 +569,10 @@ func (_u *TagUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 				IDSpec: sqlgraph.NewFieldSpec(meta.FieldID, field.TypeInt),
 			},
 		}
+		createE := &MetaTagCreate{config: _u.config, mutation: newMetaTagMutation(_u.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.RemovedMetaIDs(); len(nodes) > 0 && !_u.mutation.MetaCleared() {
@@ -260,6 +589,10 @@ func (_u *TagUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &MetaTagCreate{config: _u.config, mutation: newMetaTagMutation(_u.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.MetaIDs(); len(nodes) > 0 {
@@ -276,6 +609,55 @@ func (_u *TagUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &MetaTagCreate{config: _u.config, mutation: newMetaTagMutation(_u.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ExcludedFromCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.ExcludedFromTable,
+			Columns: tag.ExcludedFromPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(meta.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedExcludedFromIDs(); len(nodes) > 0 && !_u.mutation.ExcludedFromCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.ExcludedFromTable,
+			Columns: tag.ExcludedFromPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(meta.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExcludedFromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.ExcludedFromTable,
+			Columns: tag.ExcludedFromPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(meta.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	if _u.mutation.FavoriteOfUserCleared() {
@@ -323,6 +705,142 @@ func (_u *TagUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.BlockedByUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.BlockedByUserTable,
+			Columns: tag.BlockedByUserPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBlockedByUserIDs(); len(nodes) > 0 && !_u.mutation.BlockedByUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.BlockedByUserTable,
+			Columns: tag.BlockedByUserPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BlockedByUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.BlockedByUserTable,
+			Columns: tag.BlockedByUserPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DeniedToUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.DeniedToUserTable,
+			Columns: tag.DeniedToUserPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDeniedToUserIDs(); len(nodes) > 0 && !_u.mutation.DeniedToUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.DeniedToUserTable,
+			Columns: tag.DeniedToUserPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeniedToUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.DeniedToUserTable,
+			Columns: tag.DeniedToUserPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AliasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.AliasesTable,
+			Columns: []string{tag.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAliasesIDs(); len(nodes) > 0 && !_u.mutation.AliasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.AliasesTable,
+			Columns: []string{tag.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AliasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.AliasesTable,
+			Columns: []string{tag.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{tag.Label}
@@ -338,9 +856,10 @@ func (_u *TagUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // TagUpdateOne is the builder for updating a single Tag entity.
 type TagUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *TagMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *TagMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -377,31 +896,157 @@ func (_u *TagUpdateOne) SetHidden(v bool) *TagUpdateOne {
 	return _u
 }
 
-// SetNillableHidden sets the "hidden" field if the given value is not nil.
-func (_u *TagUpdateOne) SetNillableHidden(v *bool) *TagUpdateOne {
+// SetNillableHidden sets the "hidden" field if the given value is not nil.
+func (_u *TagUpdateOne) SetNillableHidden(v *bool) *TagUpdateOne {
+	if v != nil {
+		_u.SetHidden(*v)
+	}
+	return _u
+}
+
+// SetHideItems sets the "hide_items" field.
+func (_u *TagUpdateOne) SetHideItems(v bool) *TagUpdateOne {
+	_u.mutation.SetHideItems(v)
+	return _u
+}
+
+// SetNillableHideItems sets the "hide_items" field if the given value is not nil.
+func (_u *TagUpdateOne) SetNillableHideItems(v *bool) *TagUpdateOne {
+	if v != nil {
+		_u.SetHideItems(*v)
+	}
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *TagUpdateOne) SetActive(v bool) *TagUpdateOne {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *TagUpdateOne) SetNillableActive(v *bool) *TagUpdateOne {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
+// SetLastUpdate sets the "last_update" field.
+func (_u *TagUpdateOne) SetLastUpdate(v time.Time) *TagUpdateOne {
+	_u.mutation.SetLastUpdate(v)
+	return _u
+}
+
+// SetNillableLastUpdate sets the "last_update" field if the given value is not nil.
+func (_u *TagUpdateOne) SetNillableLastUpdate(v *time.Time) *TagUpdateOne {
+	if v != nil {
+		_u.SetLastUpdate(*v)
+	}
+	return _u
+}
+
+// ClearLastUpdate clears the value of the "last_update" field.
+func (_u *TagUpdateOne) ClearLastUpdate() *TagUpdateOne {
+	_u.mutation.ClearLastUpdate()
+	return _u
+}
+
+// SetNormalizedName sets the "normalized_name" field.
+func (_u *TagUpdateOne) SetNormalizedName(v string) *TagUpdateOne {
+	_u.mutation.SetNormalizedName(v)
+	return _u
+}
+
+// SetNillableNormalizedName sets the "normalized_name" field if the given value is not nil.
+func (_u *TagUpdateOne) SetNillableNormalizedName(v *string) *TagUpdateOne {
+	if v != nil {
+		_u.SetNormalizedName(*v)
+	}
+	return _u
+}
+
+// ClearNormalizedName clears the value of the "normalized_name" field.
+func (_u *TagUpdateOne) ClearNormalizedName() *TagUpdateOne {
+	_u.mutation.ClearNormalizedName()
+	return _u
+}
+
+// SetSearchKey sets the "search_key" field.
+func (_u *TagUpdateOne) SetSearchKey(v string) *TagUpdateOne {
+	_u.mutation.SetSearchKey(v)
+	return _u
+}
+
+// SetNillableSearchKey sets the "search_key" field if the given value is not nil.
+func (_u *TagUpdateOne) SetNillableSearchKey(v *string) *TagUpdateOne {
+	if v != nil {
+		_u.SetSearchKey(*v)
+	}
+	return _u
+}
+
+// ClearSearchKey clears the value of the "search_key" field.
+func (_u *TagUpdateOne) ClearSearchKey() *TagUpdateOne {
+	_u.mutation.ClearSearchKey()
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *TagUpdateOne) SetCategory(v tag.Category) *TagUpdateOne {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *TagUpdateOne) SetNillableCategory(v *tag.Category) *TagUpdateOne {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// ClearCategory clears the value of the "category" field.
+func (_u *TagUpdateOne) ClearCategory() *TagUpdateOne {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *TagUpdateOne) SetDescription(v string) *TagUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *TagUpdateOne) SetNillableDescription(v *string) *TagUpdateOne {
 	if v != nil {
-		_u.SetHidden(*v)
+		_u.SetDescription(*v)
 	}
 	return _u
 }
 
-// SetLastUpdate sets the "last_update" field.
-func (_u *TagUpdateOne) SetLastUpdate(v time.Time) *TagUpdateOne {
-	_u.mutation.SetLastUpdate(v)
+// ClearDescription clears the value of the "description" field.
+func (_u *TagUpdateOne) ClearDescription() *TagUpdateOne {
+	_u.mutation.ClearDescription()
 	return _u
 }
 
-// SetNillableLastUpdate sets the "last_update" field if the given value is not nil.
-func (_u *TagUpdateOne) SetNillableLastUpdate(v *time.Time) *TagUpdateOne {
-	if v != nil {
-		_u.SetLastUpdate(*v)
-	}
+// SetLinks sets the "links" field.
+func (_u *TagUpdateOne) SetLinks(v []string) *TagUpdateOne {
+	_u.mutation.SetLinks(v)
 	return _u
 }
 
-// ClearLastUpdate clears the value of the "last_update" field.
-func (_u *TagUpdateOne) ClearLastUpdate() *TagUpdateOne {
-	_u.mutation.ClearLastUpdate()
+// AppendLinks appends value to the "links" field.
+func (_u *TagUpdateOne) AppendLinks(v []string) *TagUpdateOne {
+	_u.mutation.AppendLinks(v)
+	return _u
+}
+
+// ClearLinks clears the value of the "links" field.
+func (_u *TagUpdateOne) ClearLinks() *TagUpdateOne {
+	_u.mutation.ClearLinks()
 	return _u
 }
 
@@ -420,6 +1065,21 @@ func (_u *TagUpdateOne) AddMeta(v ...*Meta) *TagUpdateOne {
 	return _u.AddMetumIDs(ids...)
 }
 
+// AddExcludedFromIDs adds the "excluded_from" edge to the Meta entity by IDs.
+func (_u *TagUpdateOne) AddExcludedFromIDs(ids ...int) *TagUpdateOne {
+	_u.mutation.AddExcludedFromIDs(ids...)
+	return _u
+}
+
+// AddExcludedFrom adds the "excluded_from" edges to the Meta entity.
+func (_u *TagUpdateOne) AddExcludedFrom(v ...*Meta) *TagUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddExcludedFromIDs(ids...)
+}
+
 // AddFavoriteOfUserIDs adds the "favorite_of_user" edge to the User entity by IDs.
 func (_u *TagUpdateOne) AddFavoriteOfUserIDs(ids ...int) *TagUpdateOne {
 	_u.mutation.AddFavoriteOfUserIDs(ids...)
@@ -435,6 +1095,51 @@ func (_u *TagUpdateOne) AddFavoriteOfUser(v ...*User) *TagUpdateOne {
 	return _u.AddFavoriteOfUserIDs(ids...)
 }
 
+// AddBlockedByUserIDs adds the "blocked_by_user" edge to the User entity by IDs.
+func (_u *TagUpdateOne) AddBlockedByUserIDs(ids ...int) *TagUpdateOne {
+	_u.mutation.AddBlockedByUserIDs(ids...)
+	return _u
+}
+
+// AddBlockedByUser adds the "blocked_by_user" edges to the User entity.
+func (_u *TagUpdateOne) AddBlockedByUser(v ...*User) *TagUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBlockedByUserIDs(ids...)
+}
+
+// AddDeniedToUserIDs adds the "denied_to_user" edge to the User entity by IDs.
+func (_u *TagUpdateOne) AddDeniedToUserIDs(ids ...int) *TagUpdateOne {
+	_u.mutation.AddDeniedToUserIDs(ids...)
+	return _u
+}
+
+// AddDeniedToUser adds the "denied_to_user" edges to the User entity.
+func (_u *TagUpdateOne) AddDeniedToUser(v ...*User) *TagUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeniedToUserIDs(ids...)
+}
+
+// AddAliasIDs adds the "aliases" edge to the TagAlias entity by IDs.
+func (_u *TagUpdateOne) AddAliasIDs(ids ...int) *TagUpdateOne {
+	_u.mutation.AddAliasIDs(ids...)
+	return _u
+}
+
+// AddAliases adds the "aliases" edges to the TagAlias entity.
+func (_u *TagUpdateOne) AddAliases(v ...*TagAlias) *TagUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAliasIDs(ids...)
+}
+
 // Mutation returns the TagMutation object of the builder.
 func (_u *TagUpdateOne) Mutation() *TagMutation {
 	return _u.mutation
@@ -461,6 +1166,27 @@ func (_u *TagUpdateOne) RemoveMeta(v ...*Meta) *TagUpdateOne {
 	return _u.RemoveMetumIDs(ids...)
 }
 
+// ClearExcludedFrom clears all "excluded_from" edges to the Meta entity.
+func (_u *TagUpdateOne) ClearExcludedFrom() *TagUpdateOne {
+	_u.mutation.ClearExcludedFrom()
+	return _u
+}
+
+// RemoveExcludedFromIDs removes the "excluded_from" edge to Meta entities by IDs.
+func (_u *TagUpdateOne) RemoveExcludedFromIDs(ids ...int) *TagUpdateOne {
+	_u.mutation.RemoveExcludedFromIDs(ids...)
+	return _u
+}
+
+// RemoveExcludedFrom removes "excluded_from" edges to Meta entities.
+func (_u *TagUpdateOne) RemoveExcludedFrom(v ...*Meta) *TagUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExcludedFromIDs(ids...)
+}
+
 // ClearFavoriteOfUser clears all "favorite_of_user" edges to the User entity.
 func (_u *TagUpdateOne) ClearFavoriteOfUser() *TagUpdateOne {
 	_u.mutation.ClearFavoriteOfUser()
@@ -482,6 +1208,69 @@ func (_u *TagUpdateOne) RemoveFavoriteOfUser(v ...*User) *TagUpdateOne {
 	return _u.RemoveFavoriteOfUserIDs(ids...)
 }
 
+// ClearBlockedByUser clears all "blocked_by_user" edges to the User entity.
+func (_u *TagUpdateOne) ClearBlockedByUser() *TagUpdateOne {
+	_u.mutation.ClearBlockedByUser()
+	return _u
+}
+
+// RemoveBlockedByUserIDs removes the "blocked_by_user" edge to User entities by IDs.
+func (_u *TagUpdateOne) RemoveBlockedByUserIDs(ids ...int) *TagUpdateOne {
+	_u.mutation.RemoveBlockedByUserIDs(ids...)
+	return _u
+}
+
+// RemoveBlockedByUser removes "blocked_by_user" edges to User entities.
+func (_u *TagUpdateOne) RemoveBlockedByUser(v ...*User) *TagUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBlockedByUserIDs(ids...)
+}
+
+// ClearDeniedToUser clears all "denied_to_user" edges to the User entity.
+func (_u *TagUpdateOne) ClearDeniedToUser() *TagUpdateOne {
+	_u.mutation.ClearDeniedToUser()
+	return _u
+}
+
+// RemoveDeniedToUserIDs removes the "denied_to_user" edge to User entities by IDs.
+func (_u *TagUpdateOne) RemoveDeniedToUserIDs(ids ...int) *TagUpdateOne {
+	_u.mutation.RemoveDeniedToUserIDs(ids...)
+	return _u
+}
+
+// RemoveDeniedToUser removes "denied_to_user" edges to User entities.
+func (_u *TagUpdateOne) RemoveDeniedToUser(v ...*User) *TagUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeniedToUserIDs(ids...)
+}
+
+// ClearAliases clears all "aliases" edges to the TagAlias entity.
+func (_u *TagUpdateOne) ClearAliases() *TagUpdateOne {
+	_u.mutation.ClearAliases()
+	return _u
+}
+
+// RemoveAliasIDs removes the "aliases" edge to TagAlias entities by IDs.
+func (_u *TagUpdateOne) RemoveAliasIDs(ids ...int) *TagUpdateOne {
+	_u.mutation.RemoveAliasIDs(ids...)
+	return _u
+}
+
+// RemoveAliases removes "aliases" edges to TagAlias entities.
+func (_u *TagUpdateOne) RemoveAliases(v ...*TagAlias) *TagUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAliasIDs(ids...)
+}
+
 // Where appends a list predicates to the TagUpdate builder.
 func (_u *TagUpdateOne) Where(ps ...predicate.Tag) *TagUpdateOne {
 	_u.mutation.Where(ps...)
@@ -529,9 +1318,20 @@ func (_u *TagUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Tag.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Category(); ok {
+		if err := tag.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "Tag.category": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TagUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TagUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *TagUpdateOne) sqlSave(ctx context.Context) (_node *Tag, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -570,12 +1370,53 @@ func (_u *TagUpdateOne) sqlSave(ctx context.Context) (_node *Tag, err error) {
 	if value, ok := _u.mutation.Hidden(); ok {
 		_spec.SetField(tag.FieldHidden, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.HideItems(); ok {
+		_spec.SetField(tag.FieldHideItems, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(tag.FieldActive, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.LastUpdate(); ok {
 		_spec.SetField(tag.FieldLastUpdate, field.TypeTime, value)
 	}
 	if _u.mutation.LastUpdateCleared() {
 		_spec.ClearField(tag.FieldLastUpdate, field.TypeTime)
 	}
+	if value, ok := _u.mutation.NormalizedName(); ok {
+		_spec.SetField(tag.FieldNormalizedName, field.TypeString, value)
+	}
+	if _u.mutation.NormalizedNameCleared() {
+		_spec.ClearField(tag.FieldNormalizedName, field.TypeString)
+	}
+	if value, ok := _u.mutation.SearchKey(); ok {
+		_spec.SetField(tag.FieldSearchKey, field.TypeString, value)
+	}
+	if _u.mutation.SearchKeyCleared() {
+		_spec.ClearField(tag.FieldSearchKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(tag.FieldCategory, field.TypeEnum, value)
+	}
+	if _u.mutation.CategoryCleared() {
+		_spec.ClearField(tag.FieldCategory, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(tag.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(tag.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Links(); ok {
+		_spec.SetField(tag.FieldLinks, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLinks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tag.FieldLinks, value)
+		})
+	}
+	if _u.mutation.LinksCleared() {
+		_spec.ClearField(tag.FieldLinks, field.TypeJSON)
+	}
 	if _u.mutation.MetaCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -587,6 +1428,10 @@ func (_u *TagUpdateOne) sqlSave(ctx context.Context) (_node *Tag, err error) {
 				IDSpec: sqlgraph.NewFieldSpec(meta.FieldID, field.TypeInt),
 			},
 		}
+		createE := &MetaTagCreate{config: _u.config, mutation: newMetaTagMutation(_u.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.RemovedMetaIDs(); len(nodes) > 0 && !_u.mutation.MetaCleared() {
@@ -603,6 +1448,10 @@ func (_u *TagUpdateOne) sqlSave(ctx context.Context) (_node *Tag, err error) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &MetaTagCreate{config: _u.config, mutation: newMetaTagMutation(_u.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.MetaIDs(); len(nodes) > 0 {
@@ -619,6 +1468,55 @@ func (_u *TagUpdateOne) sqlSave(ctx context.Context) (_node *Tag, err error) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		createE := &MetaTagCreate{config: _u.config, mutation: newMetaTagMutation(_u.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ExcludedFromCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.ExcludedFromTable,
+			Columns: tag.ExcludedFromPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(meta.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedExcludedFromIDs(); len(nodes) > 0 && !_u.mutation.ExcludedFromCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.ExcludedFromTable,
+			Columns: tag.ExcludedFromPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(meta.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExcludedFromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.ExcludedFromTable,
+			Columns: tag.ExcludedFromPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(meta.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	if _u.mutation.FavoriteOfUserCleared() {
@@ -666,6 +1564,142 @@ func (_u *TagUpdateOne) sqlSave(ctx context.Context) (_node *Tag, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.BlockedByUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.BlockedByUserTable,
+			Columns: tag.BlockedByUserPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBlockedByUserIDs(); len(nodes) > 0 && !_u.mutation.BlockedByUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.BlockedByUserTable,
+			Columns: tag.BlockedByUserPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BlockedByUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.BlockedByUserTable,
+			Columns: tag.BlockedByUserPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DeniedToUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.DeniedToUserTable,
+			Columns: tag.DeniedToUserPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDeniedToUserIDs(); len(nodes) > 0 && !_u.mutation.DeniedToUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.DeniedToUserTable,
+			Columns: tag.DeniedToUserPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeniedToUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   tag.DeniedToUserTable,
+			Columns: tag.DeniedToUserPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AliasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.AliasesTable,
+			Columns: []string{tag.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAliasesIDs(); len(nodes) > 0 && !_u.mutation.AliasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.AliasesTable,
+			Columns: []string{tag.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AliasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tag.AliasesTable,
+			Columns: []string{tag.AliasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Tag{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
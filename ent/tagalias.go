@@ -0,0 +1,167 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tagalias"
+)
+
+// TagAlias is the model entity for the TagAlias schema.
+type TagAlias struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// NormalizedName holds the value of the "normalized_name" field.
+	NormalizedName string `json:"normalized_name,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TagAliasQuery when eager-loading is set.
+	Edges        TagAliasEdges `json:"edges"`
+	tag_aliases  *int
+	selectValues sql.SelectValues
+}
+
+// TagAliasEdges holds the relations/edges for other nodes in the graph.
+type TagAliasEdges struct {
+	// Tag holds the value of the tag edge.
+	Tag *Tag `json:"tag,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TagOrErr returns the Tag value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TagAliasEdges) TagOrErr() (*Tag, error) {
+	if e.Tag != nil {
+		return e.Tag, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tag.Label}
+	}
+	return nil, &NotLoadedError{edge: "tag"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TagAlias) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tagalias.FieldID:
+			values[i] = new(sql.NullInt64)
+		case tagalias.FieldName, tagalias.FieldNormalizedName:
+			values[i] = new(sql.NullString)
+		case tagalias.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		case tagalias.ForeignKeys[0]: // tag_aliases
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TagAlias fields.
+func (_m *TagAlias) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tagalias.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case tagalias.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case tagalias.FieldNormalizedName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field normalized_name", values[i])
+			} else if value.Valid {
+				_m.NormalizedName = value.String
+			}
+		case tagalias.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case tagalias.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tag_aliases", value)
+			} else if value.Valid {
+				_m.tag_aliases = new(int)
+				*_m.tag_aliases = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TagAlias.
+// This includes values selected through modifiers, order, etc.
+func (_m *TagAlias) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTag queries the "tag" edge of the TagAlias entity.
+func (_m *TagAlias) QueryTag() *TagQuery {
+	return NewTagAliasClient(_m.config).QueryTag(_m)
+}
+
+// Update returns a builder for updating this TagAlias.
+// Note that you need to call TagAlias.Unwrap() before calling this method if this TagAlias
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TagAlias) Update() *TagAliasUpdateOne {
+	return NewTagAliasClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TagAlias entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TagAlias) Unwrap() *TagAlias {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TagAlias is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TagAlias) String() string {
+	var builder strings.Builder
+	builder.WriteString("TagAlias(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("normalized_name=")
+	builder.WriteString(_m.NormalizedName)
+	builder.WriteString(", ")
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TagAliasSlice is a parsable slice of TagAlias.
+type TagAliasSlice []*TagAlias
@@ -0,0 +1,289 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tagalias
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/mangaweb4/mangaweb4-backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldEQ(FieldName, v))
+}
+
+// NormalizedName applies equality check predicate on the "normalized_name" field. It's identical to NormalizedNameEQ.
+func NormalizedName(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldEQ(FieldNormalizedName, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldContainsFold(FieldName, v))
+}
+
+// NormalizedNameEQ applies the EQ predicate on the "normalized_name" field.
+func NormalizedNameEQ(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldEQ(FieldNormalizedName, v))
+}
+
+// NormalizedNameNEQ applies the NEQ predicate on the "normalized_name" field.
+func NormalizedNameNEQ(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldNEQ(FieldNormalizedName, v))
+}
+
+// NormalizedNameIn applies the In predicate on the "normalized_name" field.
+func NormalizedNameIn(vs ...string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldIn(FieldNormalizedName, vs...))
+}
+
+// NormalizedNameNotIn applies the NotIn predicate on the "normalized_name" field.
+func NormalizedNameNotIn(vs ...string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldNotIn(FieldNormalizedName, vs...))
+}
+
+// NormalizedNameGT applies the GT predicate on the "normalized_name" field.
+func NormalizedNameGT(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldGT(FieldNormalizedName, v))
+}
+
+// NormalizedNameGTE applies the GTE predicate on the "normalized_name" field.
+func NormalizedNameGTE(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldGTE(FieldNormalizedName, v))
+}
+
+// NormalizedNameLT applies the LT predicate on the "normalized_name" field.
+func NormalizedNameLT(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldLT(FieldNormalizedName, v))
+}
+
+// NormalizedNameLTE applies the LTE predicate on the "normalized_name" field.
+func NormalizedNameLTE(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldLTE(FieldNormalizedName, v))
+}
+
+// NormalizedNameContains applies the Contains predicate on the "normalized_name" field.
+func NormalizedNameContains(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldContains(FieldNormalizedName, v))
+}
+
+// NormalizedNameHasPrefix applies the HasPrefix predicate on the "normalized_name" field.
+func NormalizedNameHasPrefix(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldHasPrefix(FieldNormalizedName, v))
+}
+
+// NormalizedNameHasSuffix applies the HasSuffix predicate on the "normalized_name" field.
+func NormalizedNameHasSuffix(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldHasSuffix(FieldNormalizedName, v))
+}
+
+// NormalizedNameIsNil applies the IsNil predicate on the "normalized_name" field.
+func NormalizedNameIsNil() predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldIsNull(FieldNormalizedName))
+}
+
+// NormalizedNameNotNil applies the NotNil predicate on the "normalized_name" field.
+func NormalizedNameNotNil() predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldNotNull(FieldNormalizedName))
+}
+
+// NormalizedNameEqualFold applies the EqualFold predicate on the "normalized_name" field.
+func NormalizedNameEqualFold(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldEqualFold(FieldNormalizedName, v))
+}
+
+// NormalizedNameContainsFold applies the ContainsFold predicate on the "normalized_name" field.
+func NormalizedNameContainsFold(v string) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldContainsFold(FieldNormalizedName, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.TagAlias {
+	return predicate.TagAlias(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// HasTag applies the HasEdge predicate on the "tag" edge.
+func HasTag() predicate.TagAlias {
+	return predicate.TagAlias(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TagTable, TagColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagWith applies the HasEdge predicate on the "tag" edge with a given conditions (other predicates).
+func HasTagWith(preds ...predicate.Tag) predicate.TagAlias {
+	return predicate.TagAlias(func(s *sql.Selector) {
+		step := newTagStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TagAlias) predicate.TagAlias {
+	return predicate.TagAlias(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TagAlias) predicate.TagAlias {
+	return predicate.TagAlias(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TagAlias) predicate.TagAlias {
+	return predicate.TagAlias(sql.NotPredicates(p))
+}
@@ -0,0 +1,647 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tagalias"
+)
+
+// TagAliasCreate is the builder for creating a TagAlias entity.
+type TagAliasCreate struct {
+	config
+	mutation *TagAliasMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (_c *TagAliasCreate) SetName(v string) *TagAliasCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNormalizedName sets the "normalized_name" field.
+func (_c *TagAliasCreate) SetNormalizedName(v string) *TagAliasCreate {
+	_c.mutation.SetNormalizedName(v)
+	return _c
+}
+
+// SetNillableNormalizedName sets the "normalized_name" field if the given value is not nil.
+func (_c *TagAliasCreate) SetNillableNormalizedName(v *string) *TagAliasCreate {
+	if v != nil {
+		_c.SetNormalizedName(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *TagAliasCreate) SetCreateTime(v time.Time) *TagAliasCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *TagAliasCreate) SetNillableCreateTime(v *time.Time) *TagAliasCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetTagID sets the "tag" edge to the Tag entity by ID.
+func (_c *TagAliasCreate) SetTagID(id int) *TagAliasCreate {
+	_c.mutation.SetTagID(id)
+	return _c
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (_c *TagAliasCreate) SetTag(v *Tag) *TagAliasCreate {
+	return _c.SetTagID(v.ID)
+}
+
+// Mutation returns the TagAliasMutation object of the builder.
+func (_c *TagAliasCreate) Mutation() *TagAliasMutation {
+	return _c.mutation
+}
+
+// Save creates the TagAlias in the database.
+func (_c *TagAliasCreate) Save(ctx context.Context) (*TagAlias, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TagAliasCreate) SaveX(ctx context.Context) *TagAlias {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TagAliasCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TagAliasCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TagAliasCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if tagalias.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized tagalias.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := tagalias.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TagAliasCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "TagAlias.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := tagalias.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TagAlias.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "TagAlias.create_time"`)}
+	}
+	if len(_c.mutation.TagIDs()) == 0 {
+		return &ValidationError{Name: "tag", err: errors.New(`ent: missing required edge "TagAlias.tag"`)}
+	}
+	return nil
+}
+
+func (_c *TagAliasCreate) sqlSave(ctx context.Context) (*TagAlias, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TagAliasCreate) createSpec() (*TagAlias, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TagAlias{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tagalias.Table, sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(tagalias.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.NormalizedName(); ok {
+		_spec.SetField(tagalias.FieldNormalizedName, field.TypeString, value)
+		_node.NormalizedName = value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(tagalias.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if nodes := _c.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagalias.TagTable,
+			Columns: []string{tagalias.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tag_aliases = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TagAlias.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TagAliasUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TagAliasCreate) OnConflict(opts ...sql.ConflictOption) *TagAliasUpsertOne {
+	_c.conflict = opts
+	return &TagAliasUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TagAlias.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TagAliasCreate) OnConflictColumns(columns ...string) *TagAliasUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TagAliasUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TagAliasUpsertOne is the builder for "upsert"-ing
+	//  one TagAlias node.
+	TagAliasUpsertOne struct {
+		create *TagAliasCreate
+	}
+
+	// TagAliasUpsert is the "OnConflict" setter.
+	TagAliasUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *TagAliasUpsert) SetName(v string) *TagAliasUpsert {
+	u.Set(tagalias.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TagAliasUpsert) UpdateName() *TagAliasUpsert {
+	u.SetExcluded(tagalias.FieldName)
+	return u
+}
+
+// SetNormalizedName sets the "normalized_name" field.
+func (u *TagAliasUpsert) SetNormalizedName(v string) *TagAliasUpsert {
+	u.Set(tagalias.FieldNormalizedName, v)
+	return u
+}
+
+// UpdateNormalizedName sets the "normalized_name" field to the value that was provided on create.
+func (u *TagAliasUpsert) UpdateNormalizedName() *TagAliasUpsert {
+	u.SetExcluded(tagalias.FieldNormalizedName)
+	return u
+}
+
+// ClearNormalizedName clears the value of the "normalized_name" field.
+func (u *TagAliasUpsert) ClearNormalizedName() *TagAliasUpsert {
+	u.SetNull(tagalias.FieldNormalizedName)
+	return u
+}
+
+// SetCreateTime sets the "create_time" field.
+func (u *TagAliasUpsert) SetCreateTime(v time.Time) *TagAliasUpsert {
+	u.Set(tagalias.FieldCreateTime, v)
+	return u
+}
+
+// UpdateCreateTime sets the "create_time" field to the value that was provided on create.
+func (u *TagAliasUpsert) UpdateCreateTime() *TagAliasUpsert {
+	u.SetExcluded(tagalias.FieldCreateTime)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TagAlias.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TagAliasUpsertOne) UpdateNewValues() *TagAliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TagAlias.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TagAliasUpsertOne) Ignore() *TagAliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TagAliasUpsertOne) DoNothing() *TagAliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TagAliasCreate.OnConflict
+// documentation for more info.
+func (u *TagAliasUpsertOne) Update(set func(*TagAliasUpsert)) *TagAliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TagAliasUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TagAliasUpsertOne) SetName(v string) *TagAliasUpsertOne {
+	return u.Update(func(s *TagAliasUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TagAliasUpsertOne) UpdateName() *TagAliasUpsertOne {
+	return u.Update(func(s *TagAliasUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetNormalizedName sets the "normalized_name" field.
+func (u *TagAliasUpsertOne) SetNormalizedName(v string) *TagAliasUpsertOne {
+	return u.Update(func(s *TagAliasUpsert) {
+		s.SetNormalizedName(v)
+	})
+}
+
+// UpdateNormalizedName sets the "normalized_name" field to the value that was provided on create.
+func (u *TagAliasUpsertOne) UpdateNormalizedName() *TagAliasUpsertOne {
+	return u.Update(func(s *TagAliasUpsert) {
+		s.UpdateNormalizedName()
+	})
+}
+
+// ClearNormalizedName clears the value of the "normalized_name" field.
+func (u *TagAliasUpsertOne) ClearNormalizedName() *TagAliasUpsertOne {
+	return u.Update(func(s *TagAliasUpsert) {
+		s.ClearNormalizedName()
+	})
+}
+
+// SetCreateTime sets the "create_time" field.
+func (u *TagAliasUpsertOne) SetCreateTime(v time.Time) *TagAliasUpsertOne {
+	return u.Update(func(s *TagAliasUpsert) {
+		s.SetCreateTime(v)
+	})
+}
+
+// UpdateCreateTime sets the "create_time" field to the value that was provided on create.
+func (u *TagAliasUpsertOne) UpdateCreateTime() *TagAliasUpsertOne {
+	return u.Update(func(s *TagAliasUpsert) {
+		s.UpdateCreateTime()
+	})
+}
+
+// Exec executes the query.
+func (u *TagAliasUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TagAliasCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TagAliasUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TagAliasUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TagAliasUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TagAliasCreateBulk is the builder for creating many TagAlias entities in bulk.
+type TagAliasCreateBulk struct {
+	config
+	err      error
+	builders []*TagAliasCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TagAlias entities in the database.
+func (_c *TagAliasCreateBulk) Save(ctx context.Context) ([]*TagAlias, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TagAlias, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TagAliasMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TagAliasCreateBulk) SaveX(ctx context.Context) []*TagAlias {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TagAliasCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TagAliasCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TagAlias.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TagAliasUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TagAliasCreateBulk) OnConflict(opts ...sql.ConflictOption) *TagAliasUpsertBulk {
+	_c.conflict = opts
+	return &TagAliasUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TagAlias.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TagAliasCreateBulk) OnConflictColumns(columns ...string) *TagAliasUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TagAliasUpsertBulk{
+		create: _c,
+	}
+}
+
+// TagAliasUpsertBulk is the builder for "upsert"-ing
+// a bulk of TagAlias nodes.
+type TagAliasUpsertBulk struct {
+	create *TagAliasCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TagAlias.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TagAliasUpsertBulk) UpdateNewValues() *TagAliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TagAlias.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TagAliasUpsertBulk) Ignore() *TagAliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TagAliasUpsertBulk) DoNothing() *TagAliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TagAliasCreateBulk.OnConflict
+// documentation for more info.
+func (u *TagAliasUpsertBulk) Update(set func(*TagAliasUpsert)) *TagAliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TagAliasUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TagAliasUpsertBulk) SetName(v string) *TagAliasUpsertBulk {
+	return u.Update(func(s *TagAliasUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TagAliasUpsertBulk) UpdateName() *TagAliasUpsertBulk {
+	return u.Update(func(s *TagAliasUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetNormalizedName sets the "normalized_name" field.
+func (u *TagAliasUpsertBulk) SetNormalizedName(v string) *TagAliasUpsertBulk {
+	return u.Update(func(s *TagAliasUpsert) {
+		s.SetNormalizedName(v)
+	})
+}
+
+// UpdateNormalizedName sets the "normalized_name" field to the value that was provided on create.
+func (u *TagAliasUpsertBulk) UpdateNormalizedName() *TagAliasUpsertBulk {
+	return u.Update(func(s *TagAliasUpsert) {
+		s.UpdateNormalizedName()
+	})
+}
+
+// ClearNormalizedName clears the value of the "normalized_name" field.
+func (u *TagAliasUpsertBulk) ClearNormalizedName() *TagAliasUpsertBulk {
+	return u.Update(func(s *TagAliasUpsert) {
+		s.ClearNormalizedName()
+	})
+}
+
+// SetCreateTime sets the "create_time" field.
+func (u *TagAliasUpsertBulk) SetCreateTime(v time.Time) *TagAliasUpsertBulk {
+	return u.Update(func(s *TagAliasUpsert) {
+		s.SetCreateTime(v)
+	})
+}
+
+// UpdateCreateTime sets the "create_time" field to the value that was provided on create.
+func (u *TagAliasUpsertBulk) UpdateCreateTime() *TagAliasUpsertBulk {
+	return u.Update(func(s *TagAliasUpsert) {
+		s.UpdateCreateTime()
+	})
+}
+
+// Exec executes the query.
+func (u *TagAliasUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TagAliasCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TagAliasCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TagAliasUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,437 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mangaweb4/mangaweb4-backend/ent/predicate"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tagalias"
+)
+
+// TagAliasUpdate is the builder for updating TagAlias entities.
+type TagAliasUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TagAliasMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TagAliasUpdate builder.
+func (_u *TagAliasUpdate) Where(ps ...predicate.TagAlias) *TagAliasUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TagAliasUpdate) SetName(v string) *TagAliasUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TagAliasUpdate) SetNillableName(v *string) *TagAliasUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetNormalizedName sets the "normalized_name" field.
+func (_u *TagAliasUpdate) SetNormalizedName(v string) *TagAliasUpdate {
+	_u.mutation.SetNormalizedName(v)
+	return _u
+}
+
+// SetNillableNormalizedName sets the "normalized_name" field if the given value is not nil.
+func (_u *TagAliasUpdate) SetNillableNormalizedName(v *string) *TagAliasUpdate {
+	if v != nil {
+		_u.SetNormalizedName(*v)
+	}
+	return _u
+}
+
+// ClearNormalizedName clears the value of the "normalized_name" field.
+func (_u *TagAliasUpdate) ClearNormalizedName() *TagAliasUpdate {
+	_u.mutation.ClearNormalizedName()
+	return _u
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_u *TagAliasUpdate) SetCreateTime(v time.Time) *TagAliasUpdate {
+	_u.mutation.SetCreateTime(v)
+	return _u
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_u *TagAliasUpdate) SetNillableCreateTime(v *time.Time) *TagAliasUpdate {
+	if v != nil {
+		_u.SetCreateTime(*v)
+	}
+	return _u
+}
+
+// SetTagID sets the "tag" edge to the Tag entity by ID.
+func (_u *TagAliasUpdate) SetTagID(id int) *TagAliasUpdate {
+	_u.mutation.SetTagID(id)
+	return _u
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (_u *TagAliasUpdate) SetTag(v *Tag) *TagAliasUpdate {
+	return _u.SetTagID(v.ID)
+}
+
+// Mutation returns the TagAliasMutation object of the builder.
+func (_u *TagAliasUpdate) Mutation() *TagAliasMutation {
+	return _u.mutation
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (_u *TagAliasUpdate) ClearTag() *TagAliasUpdate {
+	_u.mutation.ClearTag()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TagAliasUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TagAliasUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TagAliasUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TagAliasUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TagAliasUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := tagalias.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TagAlias.name": %w`, err)}
+		}
+	}
+	if _u.mutation.TagCleared() && len(_u.mutation.TagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TagAlias.tag"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TagAliasUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TagAliasUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TagAliasUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tagalias.Table, tagalias.Columns, sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tagalias.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NormalizedName(); ok {
+		_spec.SetField(tagalias.FieldNormalizedName, field.TypeString, value)
+	}
+	if _u.mutation.NormalizedNameCleared() {
+		_spec.ClearField(tagalias.FieldNormalizedName, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreateTime(); ok {
+		_spec.SetField(tagalias.FieldCreateTime, field.TypeTime, value)
+	}
+	if _u.mutation.TagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagalias.TagTable,
+			Columns: []string{tagalias.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagalias.TagTable,
+			Columns: []string{tagalias.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tagalias.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TagAliasUpdateOne is the builder for updating a single TagAlias entity.
+type TagAliasUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TagAliasMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (_u *TagAliasUpdateOne) SetName(v string) *TagAliasUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TagAliasUpdateOne) SetNillableName(v *string) *TagAliasUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetNormalizedName sets the "normalized_name" field.
+func (_u *TagAliasUpdateOne) SetNormalizedName(v string) *TagAliasUpdateOne {
+	_u.mutation.SetNormalizedName(v)
+	return _u
+}
+
+// SetNillableNormalizedName sets the "normalized_name" field if the given value is not nil.
+func (_u *TagAliasUpdateOne) SetNillableNormalizedName(v *string) *TagAliasUpdateOne {
+	if v != nil {
+		_u.SetNormalizedName(*v)
+	}
+	return _u
+}
+
+// ClearNormalizedName clears the value of the "normalized_name" field.
+func (_u *TagAliasUpdateOne) ClearNormalizedName() *TagAliasUpdateOne {
+	_u.mutation.ClearNormalizedName()
+	return _u
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_u *TagAliasUpdateOne) SetCreateTime(v time.Time) *TagAliasUpdateOne {
+	_u.mutation.SetCreateTime(v)
+	return _u
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_u *TagAliasUpdateOne) SetNillableCreateTime(v *time.Time) *TagAliasUpdateOne {
+	if v != nil {
+		_u.SetCreateTime(*v)
+	}
+	return _u
+}
+
+// SetTagID sets the "tag" edge to the Tag entity by ID.
+func (_u *TagAliasUpdateOne) SetTagID(id int) *TagAliasUpdateOne {
+	_u.mutation.SetTagID(id)
+	return _u
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (_u *TagAliasUpdateOne) SetTag(v *Tag) *TagAliasUpdateOne {
+	return _u.SetTagID(v.ID)
+}
+
+// Mutation returns the TagAliasMutation object of the builder.
+func (_u *TagAliasUpdateOne) Mutation() *TagAliasMutation {
+	return _u.mutation
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (_u *TagAliasUpdateOne) ClearTag() *TagAliasUpdateOne {
+	_u.mutation.ClearTag()
+	return _u
+}
+
+// Where appends a list predicates to the TagAliasUpdate builder.
+func (_u *TagAliasUpdateOne) Where(ps ...predicate.TagAlias) *TagAliasUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TagAliasUpdateOne) Select(field string, fields ...string) *TagAliasUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TagAlias entity.
+func (_u *TagAliasUpdateOne) Save(ctx context.Context) (*TagAlias, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TagAliasUpdateOne) SaveX(ctx context.Context) *TagAlias {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TagAliasUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TagAliasUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TagAliasUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := tagalias.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TagAlias.name": %w`, err)}
+		}
+	}
+	if _u.mutation.TagCleared() && len(_u.mutation.TagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TagAlias.tag"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TagAliasUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TagAliasUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TagAliasUpdateOne) sqlSave(ctx context.Context) (_node *TagAlias, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tagalias.Table, tagalias.Columns, sqlgraph.NewFieldSpec(tagalias.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TagAlias.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tagalias.FieldID)
+		for _, f := range fields {
+			if !tagalias.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tagalias.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tagalias.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NormalizedName(); ok {
+		_spec.SetField(tagalias.FieldNormalizedName, field.TypeString, value)
+	}
+	if _u.mutation.NormalizedNameCleared() {
+		_spec.ClearField(tagalias.FieldNormalizedName, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreateTime(); ok {
+		_spec.SetField(tagalias.FieldCreateTime, field.TypeTime, value)
+	}
+	if _u.mutation.TagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagalias.TagTable,
+			Columns: []string{tagalias.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagalias.TagTable,
+			Columns: []string{tagalias.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TagAlias{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tagalias.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,12 +3,14 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/mangaweb4/mangaweb4-backend/ent/user"
+	"github.com/mangaweb4/mangaweb4-backend/preference"
 )
 
 // User is the model entity for the User schema.
@@ -20,6 +22,16 @@ type User struct {
 	Email string `json:"email,omitempty"`
 	// Active holds the value of the "active" field.
 	Active bool `json:"active,omitempty"`
+	// PasswordHash holds the value of the "password_hash" field.
+	PasswordHash string `json:"-"`
+	// Role holds the value of the "role" field.
+	Role user.Role `json:"role,omitempty"`
+	// MaxAgeRating holds the value of the "max_age_rating" field.
+	MaxAgeRating *int `json:"max_age_rating,omitempty"`
+	// AllowedPaths holds the value of the "allowed_paths" field.
+	AllowedPaths []string `json:"allowed_paths,omitempty"`
+	// Preferences holds the value of the "preferences" field.
+	Preferences preference.Document `json:"preferences,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -36,9 +48,21 @@ type UserEdges struct {
 	Histories []*History `json:"histories,omitempty"`
 	// Progress holds the value of the progress edge.
 	Progress []*Progress `json:"progress,omitempty"`
+	// BlockedItems holds the value of the blocked_items edge.
+	BlockedItems []*Meta `json:"blocked_items,omitempty"`
+	// BlockedTags holds the value of the blocked_tags edge.
+	BlockedTags []*Tag `json:"blocked_tags,omitempty"`
+	// SavedSearches holds the value of the saved_searches edge.
+	SavedSearches []*SavedSearch `json:"saved_searches,omitempty"`
+	// Sessions holds the value of the sessions edge.
+	Sessions []*Session `json:"sessions,omitempty"`
+	// APIKeys holds the value of the api_keys edge.
+	APIKeys []*APIKey `json:"api_keys,omitempty"`
+	// DeniedTags holds the value of the denied_tags edge.
+	DeniedTags []*Tag `json:"denied_tags,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [10]bool
 }
 
 // FavoriteItemsOrErr returns the FavoriteItems value or an error if the edge
@@ -77,16 +101,72 @@ func (e UserEdges) ProgressOrErr() ([]*Progress, error) {
 	return nil, &NotLoadedError{edge: "progress"}
 }
 
+// BlockedItemsOrErr returns the BlockedItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BlockedItemsOrErr() ([]*Meta, error) {
+	if e.loadedTypes[4] {
+		return e.BlockedItems, nil
+	}
+	return nil, &NotLoadedError{edge: "blocked_items"}
+}
+
+// BlockedTagsOrErr returns the BlockedTags value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BlockedTagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[5] {
+		return e.BlockedTags, nil
+	}
+	return nil, &NotLoadedError{edge: "blocked_tags"}
+}
+
+// SavedSearchesOrErr returns the SavedSearches value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SavedSearchesOrErr() ([]*SavedSearch, error) {
+	if e.loadedTypes[6] {
+		return e.SavedSearches, nil
+	}
+	return nil, &NotLoadedError{edge: "saved_searches"}
+}
+
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SessionsOrErr() ([]*Session, error) {
+	if e.loadedTypes[7] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
+// APIKeysOrErr returns the APIKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) APIKeysOrErr() ([]*APIKey, error) {
+	if e.loadedTypes[8] {
+		return e.APIKeys, nil
+	}
+	return nil, &NotLoadedError{edge: "api_keys"}
+}
+
+// DeniedTagsOrErr returns the DeniedTags value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) DeniedTagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[9] {
+		return e.DeniedTags, nil
+	}
+	return nil, &NotLoadedError{edge: "denied_tags"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case user.FieldAllowedPaths, user.FieldPreferences:
+			values[i] = new([]byte)
 		case user.FieldActive:
 			values[i] = new(sql.NullBool)
-		case user.FieldID:
+		case user.FieldID, user.FieldMaxAgeRating:
 			values[i] = new(sql.NullInt64)
-		case user.FieldEmail:
+		case user.FieldEmail, user.FieldPasswordHash, user.FieldRole:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -121,6 +201,41 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Active = value.Bool
 			}
+		case user.FieldPasswordHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password_hash", values[i])
+			} else if value.Valid {
+				_m.PasswordHash = value.String
+			}
+		case user.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = user.Role(value.String)
+			}
+		case user.FieldMaxAgeRating:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_age_rating", values[i])
+			} else if value.Valid {
+				_m.MaxAgeRating = new(int)
+				*_m.MaxAgeRating = int(value.Int64)
+			}
+		case user.FieldAllowedPaths:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_paths", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AllowedPaths); err != nil {
+					return fmt.Errorf("unmarshal field allowed_paths: %w", err)
+				}
+			}
+		case user.FieldPreferences:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field preferences", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Preferences); err != nil {
+					return fmt.Errorf("unmarshal field preferences: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -154,6 +269,36 @@ func (_m *User) QueryProgress() *ProgressQuery {
 	return NewUserClient(_m.config).QueryProgress(_m)
 }
 
+// QueryBlockedItems queries the "blocked_items" edge of the User entity.
+func (_m *User) QueryBlockedItems() *MetaQuery {
+	return NewUserClient(_m.config).QueryBlockedItems(_m)
+}
+
+// QueryBlockedTags queries the "blocked_tags" edge of the User entity.
+func (_m *User) QueryBlockedTags() *TagQuery {
+	return NewUserClient(_m.config).QueryBlockedTags(_m)
+}
+
+// QuerySavedSearches queries the "saved_searches" edge of the User entity.
+func (_m *User) QuerySavedSearches() *SavedSearchQuery {
+	return NewUserClient(_m.config).QuerySavedSearches(_m)
+}
+
+// QuerySessions queries the "sessions" edge of the User entity.
+func (_m *User) QuerySessions() *SessionQuery {
+	return NewUserClient(_m.config).QuerySessions(_m)
+}
+
+// QueryAPIKeys queries the "api_keys" edge of the User entity.
+func (_m *User) QueryAPIKeys() *APIKeyQuery {
+	return NewUserClient(_m.config).QueryAPIKeys(_m)
+}
+
+// QueryDeniedTags queries the "denied_tags" edge of the User entity.
+func (_m *User) QueryDeniedTags() *TagQuery {
+	return NewUserClient(_m.config).QueryDeniedTags(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -182,6 +327,22 @@ func (_m *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("active=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Active))
+	builder.WriteString(", ")
+	builder.WriteString("password_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Role))
+	builder.WriteString(", ")
+	if v := _m.MaxAgeRating; v != nil {
+		builder.WriteString("max_age_rating=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("allowed_paths=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AllowedPaths))
+	builder.WriteString(", ")
+	builder.WriteString("preferences=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Preferences))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,9 @@
 package user
 
 import (
+	"fmt"
+
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -16,6 +19,16 @@ const (
 	FieldEmail = "email"
 	// FieldActive holds the string denoting the active field in the database.
 	FieldActive = "active"
+	// FieldPasswordHash holds the string denoting the password_hash field in the database.
+	FieldPasswordHash = "password_hash"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
+	// FieldMaxAgeRating holds the string denoting the max_age_rating field in the database.
+	FieldMaxAgeRating = "max_age_rating"
+	// FieldAllowedPaths holds the string denoting the allowed_paths field in the database.
+	FieldAllowedPaths = "allowed_paths"
+	// FieldPreferences holds the string denoting the preferences field in the database.
+	FieldPreferences = "preferences"
 	// EdgeFavoriteItems holds the string denoting the favorite_items edge name in mutations.
 	EdgeFavoriteItems = "favorite_items"
 	// EdgeFavoriteTags holds the string denoting the favorite_tags edge name in mutations.
@@ -24,6 +37,18 @@ const (
 	EdgeHistories = "histories"
 	// EdgeProgress holds the string denoting the progress edge name in mutations.
 	EdgeProgress = "progress"
+	// EdgeBlockedItems holds the string denoting the blocked_items edge name in mutations.
+	EdgeBlockedItems = "blocked_items"
+	// EdgeBlockedTags holds the string denoting the blocked_tags edge name in mutations.
+	EdgeBlockedTags = "blocked_tags"
+	// EdgeSavedSearches holds the string denoting the saved_searches edge name in mutations.
+	EdgeSavedSearches = "saved_searches"
+	// EdgeSessions holds the string denoting the sessions edge name in mutations.
+	EdgeSessions = "sessions"
+	// EdgeAPIKeys holds the string denoting the api_keys edge name in mutations.
+	EdgeAPIKeys = "api_keys"
+	// EdgeDeniedTags holds the string denoting the denied_tags edge name in mutations.
+	EdgeDeniedTags = "denied_tags"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// FavoriteItemsTable is the table that holds the favorite_items relation/edge. The primary key declared below.
@@ -50,6 +75,42 @@ const (
 	ProgressInverseTable = "progresses"
 	// ProgressColumn is the table column denoting the progress relation/edge.
 	ProgressColumn = "user_id"
+	// BlockedItemsTable is the table that holds the blocked_items relation/edge. The primary key declared below.
+	BlockedItemsTable = "user_blocked_items"
+	// BlockedItemsInverseTable is the table name for the Meta entity.
+	// It exists in this package in order to avoid circular dependency with the "meta" package.
+	BlockedItemsInverseTable = "meta"
+	// BlockedTagsTable is the table that holds the blocked_tags relation/edge. The primary key declared below.
+	BlockedTagsTable = "user_blocked_tags"
+	// BlockedTagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	BlockedTagsInverseTable = "tags"
+	// SavedSearchesTable is the table that holds the saved_searches relation/edge.
+	SavedSearchesTable = "saved_searches"
+	// SavedSearchesInverseTable is the table name for the SavedSearch entity.
+	// It exists in this package in order to avoid circular dependency with the "savedsearch" package.
+	SavedSearchesInverseTable = "saved_searches"
+	// SavedSearchesColumn is the table column denoting the saved_searches relation/edge.
+	SavedSearchesColumn = "user_saved_searches"
+	// SessionsTable is the table that holds the sessions relation/edge.
+	SessionsTable = "sessions"
+	// SessionsInverseTable is the table name for the Session entity.
+	// It exists in this package in order to avoid circular dependency with the "session" package.
+	SessionsInverseTable = "sessions"
+	// SessionsColumn is the table column denoting the sessions relation/edge.
+	SessionsColumn = "user_sessions"
+	// APIKeysTable is the table that holds the api_keys relation/edge.
+	APIKeysTable = "api_keys"
+	// APIKeysInverseTable is the table name for the APIKey entity.
+	// It exists in this package in order to avoid circular dependency with the "apikey" package.
+	APIKeysInverseTable = "api_keys"
+	// APIKeysColumn is the table column denoting the api_keys relation/edge.
+	APIKeysColumn = "user_api_keys"
+	// DeniedTagsTable is the table that holds the denied_tags relation/edge. The primary key declared below.
+	DeniedTagsTable = "user_denied_tags"
+	// DeniedTagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	DeniedTagsInverseTable = "tags"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -57,6 +118,11 @@ var Columns = []string{
 	FieldID,
 	FieldEmail,
 	FieldActive,
+	FieldPasswordHash,
+	FieldRole,
+	FieldMaxAgeRating,
+	FieldAllowedPaths,
+	FieldPreferences,
 }
 
 var (
@@ -66,6 +132,15 @@ var (
 	// FavoriteTagsPrimaryKey and FavoriteTagsColumn2 are the table columns denoting the
 	// primary key for the favorite_tags relation (M2M).
 	FavoriteTagsPrimaryKey = []string{"user_id", "tag_id"}
+	// BlockedItemsPrimaryKey and BlockedItemsColumn2 are the table columns denoting the
+	// primary key for the blocked_items relation (M2M).
+	BlockedItemsPrimaryKey = []string{"user_id", "meta_id"}
+	// BlockedTagsPrimaryKey and BlockedTagsColumn2 are the table columns denoting the
+	// primary key for the blocked_tags relation (M2M).
+	BlockedTagsPrimaryKey = []string{"user_id", "tag_id"}
+	// DeniedTagsPrimaryKey and DeniedTagsColumn2 are the table columns denoting the
+	// primary key for the denied_tags relation (M2M).
+	DeniedTagsPrimaryKey = []string{"user_id", "tag_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -78,13 +153,50 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/mangaweb4/mangaweb4-backend/ent/runtime"
 var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
 	// EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	EmailValidator func(string) error
 	// DefaultActive holds the default value on creation for the "active" field.
 	DefaultActive bool
+	// MaxAgeRatingValidator is a validator for the "max_age_rating" field. It is called by the builders before save.
+	MaxAgeRatingValidator func(int) error
+)
+
+// Role defines the type for the "role" enum field.
+type Role string
+
+// RoleReader is the default value of the Role enum.
+const DefaultRole = RoleReader
+
+// Role values.
+const (
+	RoleGuest  Role = "guest"
+	RoleReader Role = "reader"
+	RoleEditor Role = "editor"
+	RoleAdmin  Role = "admin"
 )
 
+func (r Role) String() string {
+	return string(r)
+}
+
+// RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
+func RoleValidator(r Role) error {
+	switch r {
+	case RoleGuest, RoleReader, RoleEditor, RoleAdmin:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for role field: %q", r)
+	}
+}
+
 // OrderOption defines the ordering options for the User queries.
 type OrderOption func(*sql.Selector)
 
@@ -103,6 +215,21 @@ func ByActive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldActive, opts...).ToFunc()
 }
 
+// ByPasswordHash orders the results by the password_hash field.
+func ByPasswordHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPasswordHash, opts...).ToFunc()
+}
+
+// ByRole orders the results by the role field.
+func ByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRole, opts...).ToFunc()
+}
+
+// ByMaxAgeRating orders the results by the max_age_rating field.
+func ByMaxAgeRating(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxAgeRating, opts...).ToFunc()
+}
+
 // ByFavoriteItemsCount orders the results by favorite_items count.
 func ByFavoriteItemsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -158,6 +285,90 @@ func ByProgress(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newProgressStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByBlockedItemsCount orders the results by blocked_items count.
+func ByBlockedItemsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlockedItemsStep(), opts...)
+	}
+}
+
+// ByBlockedItems orders the results by blocked_items terms.
+func ByBlockedItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlockedItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBlockedTagsCount orders the results by blocked_tags count.
+func ByBlockedTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlockedTagsStep(), opts...)
+	}
+}
+
+// ByBlockedTags orders the results by blocked_tags terms.
+func ByBlockedTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlockedTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySavedSearchesCount orders the results by saved_searches count.
+func BySavedSearchesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSavedSearchesStep(), opts...)
+	}
+}
+
+// BySavedSearches orders the results by saved_searches terms.
+func BySavedSearches(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSavedSearchesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySessionsCount orders the results by sessions count.
+func BySessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSessionsStep(), opts...)
+	}
+}
+
+// BySessions orders the results by sessions terms.
+func BySessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAPIKeysCount orders the results by api_keys count.
+func ByAPIKeysCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAPIKeysStep(), opts...)
+	}
+}
+
+// ByAPIKeys orders the results by api_keys terms.
+func ByAPIKeys(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAPIKeysStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDeniedTagsCount orders the results by denied_tags count.
+func ByDeniedTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDeniedTagsStep(), opts...)
+	}
+}
+
+// ByDeniedTags orders the results by denied_tags terms.
+func ByDeniedTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeniedTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newFavoriteItemsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -186,3 +397,45 @@ func newProgressStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ProgressTable, ProgressColumn),
 	)
 }
+func newBlockedItemsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BlockedItemsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, BlockedItemsTable, BlockedItemsPrimaryKey...),
+	)
+}
+func newBlockedTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BlockedTagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, BlockedTagsTable, BlockedTagsPrimaryKey...),
+	)
+}
+func newSavedSearchesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SavedSearchesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SavedSearchesTable, SavedSearchesColumn),
+	)
+}
+func newSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
+	)
+}
+func newAPIKeysStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(APIKeysInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, APIKeysTable, APIKeysColumn),
+	)
+}
+func newDeniedTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeniedTagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, DeniedTagsTable, DeniedTagsPrimaryKey...),
+	)
+}
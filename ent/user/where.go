@@ -63,6 +63,16 @@ func Active(v bool) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldActive, v))
 }
 
+// PasswordHash applies equality check predicate on the "password_hash" field. It's identical to PasswordHashEQ.
+func PasswordHash(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPasswordHash, v))
+}
+
+// MaxAgeRating applies equality check predicate on the "max_age_rating" field. It's identical to MaxAgeRatingEQ.
+func MaxAgeRating(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMaxAgeRating, v))
+}
+
 // EmailEQ applies the EQ predicate on the "email" field.
 func EmailEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldEmail, v))
@@ -138,6 +148,171 @@ func ActiveNEQ(v bool) predicate.User {
 	return predicate.User(sql.FieldNEQ(FieldActive, v))
 }
 
+// PasswordHashEQ applies the EQ predicate on the "password_hash" field.
+func PasswordHashEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPasswordHash, v))
+}
+
+// PasswordHashNEQ applies the NEQ predicate on the "password_hash" field.
+func PasswordHashNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldPasswordHash, v))
+}
+
+// PasswordHashIn applies the In predicate on the "password_hash" field.
+func PasswordHashIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldPasswordHash, vs...))
+}
+
+// PasswordHashNotIn applies the NotIn predicate on the "password_hash" field.
+func PasswordHashNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldPasswordHash, vs...))
+}
+
+// PasswordHashGT applies the GT predicate on the "password_hash" field.
+func PasswordHashGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldPasswordHash, v))
+}
+
+// PasswordHashGTE applies the GTE predicate on the "password_hash" field.
+func PasswordHashGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldPasswordHash, v))
+}
+
+// PasswordHashLT applies the LT predicate on the "password_hash" field.
+func PasswordHashLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldPasswordHash, v))
+}
+
+// PasswordHashLTE applies the LTE predicate on the "password_hash" field.
+func PasswordHashLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldPasswordHash, v))
+}
+
+// PasswordHashContains applies the Contains predicate on the "password_hash" field.
+func PasswordHashContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldPasswordHash, v))
+}
+
+// PasswordHashHasPrefix applies the HasPrefix predicate on the "password_hash" field.
+func PasswordHashHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldPasswordHash, v))
+}
+
+// PasswordHashHasSuffix applies the HasSuffix predicate on the "password_hash" field.
+func PasswordHashHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldPasswordHash, v))
+}
+
+// PasswordHashIsNil applies the IsNil predicate on the "password_hash" field.
+func PasswordHashIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldPasswordHash))
+}
+
+// PasswordHashNotNil applies the NotNil predicate on the "password_hash" field.
+func PasswordHashNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldPasswordHash))
+}
+
+// PasswordHashEqualFold applies the EqualFold predicate on the "password_hash" field.
+func PasswordHashEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldPasswordHash, v))
+}
+
+// PasswordHashContainsFold applies the ContainsFold predicate on the "password_hash" field.
+func PasswordHashContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldPasswordHash, v))
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v Role) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v Role) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...Role) predicate.User {
+	return predicate.User(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...Role) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// MaxAgeRatingEQ applies the EQ predicate on the "max_age_rating" field.
+func MaxAgeRatingEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMaxAgeRating, v))
+}
+
+// MaxAgeRatingNEQ applies the NEQ predicate on the "max_age_rating" field.
+func MaxAgeRatingNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldMaxAgeRating, v))
+}
+
+// MaxAgeRatingIn applies the In predicate on the "max_age_rating" field.
+func MaxAgeRatingIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldMaxAgeRating, vs...))
+}
+
+// MaxAgeRatingNotIn applies the NotIn predicate on the "max_age_rating" field.
+func MaxAgeRatingNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldMaxAgeRating, vs...))
+}
+
+// MaxAgeRatingGT applies the GT predicate on the "max_age_rating" field.
+func MaxAgeRatingGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldMaxAgeRating, v))
+}
+
+// MaxAgeRatingGTE applies the GTE predicate on the "max_age_rating" field.
+func MaxAgeRatingGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldMaxAgeRating, v))
+}
+
+// MaxAgeRatingLT applies the LT predicate on the "max_age_rating" field.
+func MaxAgeRatingLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldMaxAgeRating, v))
+}
+
+// MaxAgeRatingLTE applies the LTE predicate on the "max_age_rating" field.
+func MaxAgeRatingLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldMaxAgeRating, v))
+}
+
+// MaxAgeRatingIsNil applies the IsNil predicate on the "max_age_rating" field.
+func MaxAgeRatingIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldMaxAgeRating))
+}
+
+// MaxAgeRatingNotNil applies the NotNil predicate on the "max_age_rating" field.
+func MaxAgeRatingNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldMaxAgeRating))
+}
+
+// AllowedPathsIsNil applies the IsNil predicate on the "allowed_paths" field.
+func AllowedPathsIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldAllowedPaths))
+}
+
+// AllowedPathsNotNil applies the NotNil predicate on the "allowed_paths" field.
+func AllowedPathsNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldAllowedPaths))
+}
+
+// PreferencesIsNil applies the IsNil predicate on the "preferences" field.
+func PreferencesIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldPreferences))
+}
+
+// PreferencesNotNil applies the NotNil predicate on the "preferences" field.
+func PreferencesNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldPreferences))
+}
+
 // HasFavoriteItems applies the HasEdge predicate on the "favorite_items" edge.
 func HasFavoriteItems() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -230,6 +405,144 @@ func HasProgressWith(preds ...predicate.Progress) predicate.User {
 	})
 }
 
+// HasBlockedItems applies the HasEdge predicate on the "blocked_items" edge.
+func HasBlockedItems() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, BlockedItemsTable, BlockedItemsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlockedItemsWith applies the HasEdge predicate on the "blocked_items" edge with a given conditions (other predicates).
+func HasBlockedItemsWith(preds ...predicate.Meta) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newBlockedItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlockedTags applies the HasEdge predicate on the "blocked_tags" edge.
+func HasBlockedTags() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, BlockedTagsTable, BlockedTagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlockedTagsWith applies the HasEdge predicate on the "blocked_tags" edge with a given conditions (other predicates).
+func HasBlockedTagsWith(preds ...predicate.Tag) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newBlockedTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSavedSearches applies the HasEdge predicate on the "saved_searches" edge.
+func HasSavedSearches() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SavedSearchesTable, SavedSearchesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSavedSearchesWith applies the HasEdge predicate on the "saved_searches" edge with a given conditions (other predicates).
+func HasSavedSearchesWith(preds ...predicate.SavedSearch) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSavedSearchesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSessions applies the HasEdge predicate on the "sessions" edge.
+func HasSessions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSessionsWith applies the HasEdge predicate on the "sessions" edge with a given conditions (other predicates).
+func HasSessionsWith(preds ...predicate.Session) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSessionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAPIKeys applies the HasEdge predicate on the "api_keys" edge.
+func HasAPIKeys() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, APIKeysTable, APIKeysColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAPIKeysWith applies the HasEdge predicate on the "api_keys" edge with a given conditions (other predicates).
+func HasAPIKeysWith(preds ...predicate.APIKey) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newAPIKeysStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDeniedTags applies the HasEdge predicate on the "denied_tags" edge.
+func HasDeniedTags() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, DeniedTagsTable, DeniedTagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeniedTagsWith applies the HasEdge predicate on the "denied_tags" edge with a given conditions (other predicates).
+func HasDeniedTagsWith(preds ...predicate.Tag) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newDeniedTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
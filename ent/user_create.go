@@ -10,11 +10,15 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/mangaweb4/mangaweb4-backend/ent/apikey"
 	"github.com/mangaweb4/mangaweb4-backend/ent/history"
 	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
 	"github.com/mangaweb4/mangaweb4-backend/ent/progress"
+	"github.com/mangaweb4/mangaweb4-backend/ent/savedsearch"
+	"github.com/mangaweb4/mangaweb4-backend/ent/session"
 	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
 	"github.com/mangaweb4/mangaweb4-backend/ent/user"
+	"github.com/mangaweb4/mangaweb4-backend/preference"
 )
 
 // UserCreate is the builder for creating a User entity.
@@ -45,6 +49,68 @@ func (_c *UserCreate) SetNillableActive(v *bool) *UserCreate {
 	return _c
 }
 
+// SetPasswordHash sets the "password_hash" field.
+func (_c *UserCreate) SetPasswordHash(v string) *UserCreate {
+	_c.mutation.SetPasswordHash(v)
+	return _c
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (_c *UserCreate) SetNillablePasswordHash(v *string) *UserCreate {
+	if v != nil {
+		_c.SetPasswordHash(*v)
+	}
+	return _c
+}
+
+// SetRole sets the "role" field.
+func (_c *UserCreate) SetRole(v user.Role) *UserCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_c *UserCreate) SetNillableRole(v *user.Role) *UserCreate {
+	if v != nil {
+		_c.SetRole(*v)
+	}
+	return _c
+}
+
+// SetMaxAgeRating sets the "max_age_rating" field.
+func (_c *UserCreate) SetMaxAgeRating(v int) *UserCreate {
+	_c.mutation.SetMaxAgeRating(v)
+	return _c
+}
+
+// SetNillableMaxAgeRating sets the "max_age_rating" field if the given value is not nil.
+func (_c *UserCreate) SetNillableMaxAgeRating(v *int) *UserCreate {
+	if v != nil {
+		_c.SetMaxAgeRating(*v)
+	}
+	return _c
+}
+
+// SetAllowedPaths sets the "allowed_paths" field.
+func (_c *UserCreate) SetAllowedPaths(v []string) *UserCreate {
+	_c.mutation.SetAllowedPaths(v)
+	return _c
+}
+
+// SetPreferences sets the "preferences" field.
+func (_c *UserCreate) SetPreferences(v preference.Document) *UserCreate {
+	_c.mutation.SetPreferences(v)
+	return _c
+}
+
+// SetNillablePreferences sets the "preferences" field if the given value is not nil.
+func (_c *UserCreate) SetNillablePreferences(v *preference.Document) *UserCreate {
+	if v != nil {
+		_c.SetPreferences(*v)
+	}
+	return _c
+}
+
 // AddFavoriteItemIDs adds the "favorite_items" edge to the Meta entity by IDs.
 func (_c *UserCreate) AddFavoriteItemIDs(ids ...int) *UserCreate {
 	_c.mutation.AddFavoriteItemIDs(ids...)
@@ -105,6 +171,96 @@ func (_c *UserCreate) AddProgress(v ...*Progress) *UserCreate {
 	return _c.AddProgresIDs(ids...)
 }
 
+// AddBlockedItemIDs adds the "blocked_items" edge to the Meta entity by IDs.
+func (_c *UserCreate) AddBlockedItemIDs(ids ...int) *UserCreate {
+	_c.mutation.AddBlockedItemIDs(ids...)
+	return _c
+}
+
+// AddBlockedItems adds the "blocked_items" edges to the Meta entity.
+func (_c *UserCreate) AddBlockedItems(v ...*Meta) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBlockedItemIDs(ids...)
+}
+
+// AddBlockedTagIDs adds the "blocked_tags" edge to the Tag entity by IDs.
+func (_c *UserCreate) AddBlockedTagIDs(ids ...int) *UserCreate {
+	_c.mutation.AddBlockedTagIDs(ids...)
+	return _c
+}
+
+// AddBlockedTags adds the "blocked_tags" edges to the Tag entity.
+func (_c *UserCreate) AddBlockedTags(v ...*Tag) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBlockedTagIDs(ids...)
+}
+
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by IDs.
+func (_c *UserCreate) AddSavedSearchIDs(ids ...int) *UserCreate {
+	_c.mutation.AddSavedSearchIDs(ids...)
+	return _c
+}
+
+// AddSavedSearches adds the "saved_searches" edges to the SavedSearch entity.
+func (_c *UserCreate) AddSavedSearches(v ...*SavedSearch) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSavedSearchIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (_c *UserCreate) AddSessionIDs(ids ...int) *UserCreate {
+	_c.mutation.AddSessionIDs(ids...)
+	return _c
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (_c *UserCreate) AddSessions(v ...*Session) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSessionIDs(ids...)
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
+func (_c *UserCreate) AddAPIKeyIDs(ids ...int) *UserCreate {
+	_c.mutation.AddAPIKeyIDs(ids...)
+	return _c
+}
+
+// AddAPIKeys adds the "api_keys" edges to the APIKey entity.
+func (_c *UserCreate) AddAPIKeys(v ...*APIKey) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAPIKeyIDs(ids...)
+}
+
+// AddDeniedTagIDs adds the "denied_tags" edge to the Tag entity by IDs.
+func (_c *UserCreate) AddDeniedTagIDs(ids ...int) *UserCreate {
+	_c.mutation.AddDeniedTagIDs(ids...)
+	return _c
+}
+
+// AddDeniedTags adds the "denied_tags" edges to the Tag entity.
+func (_c *UserCreate) AddDeniedTags(v ...*Tag) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDeniedTagIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -112,7 +268,9 @@ func (_c *UserCreate) Mutation() *UserMutation {
 
 // Save creates the User in the database.
 func (_c *UserCreate) Save(ctx context.Context) (*User, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -139,11 +297,16 @@ func (_c *UserCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *UserCreate) defaults() {
+func (_c *UserCreate) defaults() error {
 	if _, ok := _c.mutation.Active(); !ok {
 		v := user.DefaultActive
 		_c.mutation.SetActive(v)
 	}
+	if _, ok := _c.mutation.Role(); !ok {
+		v := user.DefaultRole
+		_c.mutation.SetRole(v)
+	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -159,6 +322,24 @@ func (_c *UserCreate) check() error {
 	if _, ok := _c.mutation.Active(); !ok {
 		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "User.active"`)}
 	}
+	if _, ok := _c.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "User.role"`)}
+	}
+	if v, ok := _c.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MaxAgeRating(); ok {
+		if err := user.MaxAgeRatingValidator(v); err != nil {
+			return &ValidationError{Name: "max_age_rating", err: fmt.Errorf(`ent: validator failed for field "User.max_age_rating": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Preferences(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "preferences", err: fmt.Errorf(`ent: validator failed for field "User.preferences": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -194,6 +375,26 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldActive, field.TypeBool, value)
 		_node.Active = value
 	}
+	if value, ok := _c.mutation.PasswordHash(); ok {
+		_spec.SetField(user.FieldPasswordHash, field.TypeString, value)
+		_node.PasswordHash = value
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := _c.mutation.MaxAgeRating(); ok {
+		_spec.SetField(user.FieldMaxAgeRating, field.TypeInt, value)
+		_node.MaxAgeRating = &value
+	}
+	if value, ok := _c.mutation.AllowedPaths(); ok {
+		_spec.SetField(user.FieldAllowedPaths, field.TypeJSON, value)
+		_node.AllowedPaths = value
+	}
+	if value, ok := _c.mutation.Preferences(); ok {
+		_spec.SetField(user.FieldPreferences, field.TypeJSON, value)
+		_node.Preferences = value
+	}
 	if nodes := _c.mutation.FavoriteItemsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -258,6 +459,102 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.BlockedItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.BlockedItemsTable,
+			Columns: user.BlockedItemsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(meta.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BlockedTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.BlockedTagsTable,
+			Columns: user.BlockedTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SavedSearchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SavedSearchesTable,
+			Columns: []string{user.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.APIKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APIKeysTable,
+			Columns: []string{user.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DeniedTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.DeniedTagsTable,
+			Columns: user.DeniedTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -334,6 +631,96 @@ func (u *UserUpsert) UpdateActive() *UserUpsert {
 	return u
 }
 
+// SetPasswordHash sets the "password_hash" field.
+func (u *UserUpsert) SetPasswordHash(v string) *UserUpsert {
+	u.Set(user.FieldPasswordHash, v)
+	return u
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePasswordHash() *UserUpsert {
+	u.SetExcluded(user.FieldPasswordHash)
+	return u
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (u *UserUpsert) ClearPasswordHash() *UserUpsert {
+	u.SetNull(user.FieldPasswordHash)
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *UserUpsert) SetRole(v user.Role) *UserUpsert {
+	u.Set(user.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *UserUpsert) UpdateRole() *UserUpsert {
+	u.SetExcluded(user.FieldRole)
+	return u
+}
+
+// SetMaxAgeRating sets the "max_age_rating" field.
+func (u *UserUpsert) SetMaxAgeRating(v int) *UserUpsert {
+	u.Set(user.FieldMaxAgeRating, v)
+	return u
+}
+
+// UpdateMaxAgeRating sets the "max_age_rating" field to the value that was provided on create.
+func (u *UserUpsert) UpdateMaxAgeRating() *UserUpsert {
+	u.SetExcluded(user.FieldMaxAgeRating)
+	return u
+}
+
+// AddMaxAgeRating adds v to the "max_age_rating" field.
+func (u *UserUpsert) AddMaxAgeRating(v int) *UserUpsert {
+	u.Add(user.FieldMaxAgeRating, v)
+	return u
+}
+
+// ClearMaxAgeRating clears the value of the "max_age_rating" field.
+func (u *UserUpsert) ClearMaxAgeRating() *UserUpsert {
+	u.SetNull(user.FieldMaxAgeRating)
+	return u
+}
+
+// SetAllowedPaths sets the "allowed_paths" field.
+func (u *UserUpsert) SetAllowedPaths(v []string) *UserUpsert {
+	u.Set(user.FieldAllowedPaths, v)
+	return u
+}
+
+// UpdateAllowedPaths sets the "allowed_paths" field to the value that was provided on create.
+func (u *UserUpsert) UpdateAllowedPaths() *UserUpsert {
+	u.SetExcluded(user.FieldAllowedPaths)
+	return u
+}
+
+// ClearAllowedPaths clears the value of the "allowed_paths" field.
+func (u *UserUpsert) ClearAllowedPaths() *UserUpsert {
+	u.SetNull(user.FieldAllowedPaths)
+	return u
+}
+
+// SetPreferences sets the "preferences" field.
+func (u *UserUpsert) SetPreferences(v preference.Document) *UserUpsert {
+	u.Set(user.FieldPreferences, v)
+	return u
+}
+
+// UpdatePreferences sets the "preferences" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePreferences() *UserUpsert {
+	u.SetExcluded(user.FieldPreferences)
+	return u
+}
+
+// ClearPreferences clears the value of the "preferences" field.
+func (u *UserUpsert) ClearPreferences() *UserUpsert {
+	u.SetNull(user.FieldPreferences)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -402,6 +789,111 @@ func (u *UserUpsertOne) UpdateActive() *UserUpsertOne {
 	})
 }
 
+// SetPasswordHash sets the "password_hash" field.
+func (u *UserUpsertOne) SetPasswordHash(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPasswordHash(v)
+	})
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePasswordHash() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePasswordHash()
+	})
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (u *UserUpsertOne) ClearPasswordHash() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPasswordHash()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *UserUpsertOne) SetRole(v user.Role) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateRole() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetMaxAgeRating sets the "max_age_rating" field.
+func (u *UserUpsertOne) SetMaxAgeRating(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetMaxAgeRating(v)
+	})
+}
+
+// AddMaxAgeRating adds v to the "max_age_rating" field.
+func (u *UserUpsertOne) AddMaxAgeRating(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddMaxAgeRating(v)
+	})
+}
+
+// UpdateMaxAgeRating sets the "max_age_rating" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateMaxAgeRating() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateMaxAgeRating()
+	})
+}
+
+// ClearMaxAgeRating clears the value of the "max_age_rating" field.
+func (u *UserUpsertOne) ClearMaxAgeRating() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearMaxAgeRating()
+	})
+}
+
+// SetAllowedPaths sets the "allowed_paths" field.
+func (u *UserUpsertOne) SetAllowedPaths(v []string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetAllowedPaths(v)
+	})
+}
+
+// UpdateAllowedPaths sets the "allowed_paths" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateAllowedPaths() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateAllowedPaths()
+	})
+}
+
+// ClearAllowedPaths clears the value of the "allowed_paths" field.
+func (u *UserUpsertOne) ClearAllowedPaths() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearAllowedPaths()
+	})
+}
+
+// SetPreferences sets the "preferences" field.
+func (u *UserUpsertOne) SetPreferences(v preference.Document) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPreferences(v)
+	})
+}
+
+// UpdatePreferences sets the "preferences" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePreferences() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePreferences()
+	})
+}
+
+// ClearPreferences clears the value of the "preferences" field.
+func (u *UserUpsertOne) ClearPreferences() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPreferences()
+	})
+}
+
 // Exec executes the query.
 func (u *UserUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -634,6 +1126,111 @@ func (u *UserUpsertBulk) UpdateActive() *UserUpsertBulk {
 	})
 }
 
+// SetPasswordHash sets the "password_hash" field.
+func (u *UserUpsertBulk) SetPasswordHash(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPasswordHash(v)
+	})
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePasswordHash() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePasswordHash()
+	})
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (u *UserUpsertBulk) ClearPasswordHash() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPasswordHash()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *UserUpsertBulk) SetRole(v user.Role) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateRole() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetMaxAgeRating sets the "max_age_rating" field.
+func (u *UserUpsertBulk) SetMaxAgeRating(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetMaxAgeRating(v)
+	})
+}
+
+// AddMaxAgeRating adds v to the "max_age_rating" field.
+func (u *UserUpsertBulk) AddMaxAgeRating(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddMaxAgeRating(v)
+	})
+}
+
+// UpdateMaxAgeRating sets the "max_age_rating" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateMaxAgeRating() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateMaxAgeRating()
+	})
+}
+
+// ClearMaxAgeRating clears the value of the "max_age_rating" field.
+func (u *UserUpsertBulk) ClearMaxAgeRating() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearMaxAgeRating()
+	})
+}
+
+// SetAllowedPaths sets the "allowed_paths" field.
+func (u *UserUpsertBulk) SetAllowedPaths(v []string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetAllowedPaths(v)
+	})
+}
+
+// UpdateAllowedPaths sets the "allowed_paths" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateAllowedPaths() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateAllowedPaths()
+	})
+}
+
+// ClearAllowedPaths clears the value of the "allowed_paths" field.
+func (u *UserUpsertBulk) ClearAllowedPaths() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearAllowedPaths()
+	})
+}
+
+// SetPreferences sets the "preferences" field.
+func (u *UserUpsertBulk) SetPreferences(v preference.Document) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPreferences(v)
+	})
+}
+
+// UpdatePreferences sets the "preferences" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePreferences() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePreferences()
+	})
+}
+
+// ClearPreferences clears the value of the "preferences" field.
+func (u *UserUpsertBulk) ClearPreferences() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPreferences()
+	})
+}
+
 // Exec executes the query.
 func (u *UserUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -9,20 +9,26 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/mangaweb4/mangaweb4-backend/ent/apikey"
 	"github.com/mangaweb4/mangaweb4-backend/ent/history"
 	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
 	"github.com/mangaweb4/mangaweb4-backend/ent/predicate"
 	"github.com/mangaweb4/mangaweb4-backend/ent/progress"
+	"github.com/mangaweb4/mangaweb4-backend/ent/savedsearch"
+	"github.com/mangaweb4/mangaweb4-backend/ent/session"
 	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
 	"github.com/mangaweb4/mangaweb4-backend/ent/user"
+	"github.com/mangaweb4/mangaweb4-backend/preference"
 )
 
 // UserUpdate is the builder for updating User entities.
 type UserUpdate struct {
 	config
-	hooks    []Hook
-	mutation *UserMutation
+	hooks     []Hook
+	mutation  *UserMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the UserUpdate builder.
@@ -59,6 +65,105 @@ func (_u *UserUpdate) SetNillableActive(v *bool) *UserUpdate {
 	return _u
 }
 
+// SetPasswordHash sets the "password_hash" field.
+func (_u *UserUpdate) SetPasswordHash(v string) *UserUpdate {
+	_u.mutation.SetPasswordHash(v)
+	return _u
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (_u *UserUpdate) SetNillablePasswordHash(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetPasswordHash(*v)
+	}
+	return _u
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (_u *UserUpdate) ClearPasswordHash() *UserUpdate {
+	_u.mutation.ClearPasswordHash()
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *UserUpdate) SetRole(v user.Role) *UserUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableRole(v *user.Role) *UserUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetMaxAgeRating sets the "max_age_rating" field.
+func (_u *UserUpdate) SetMaxAgeRating(v int) *UserUpdate {
+	_u.mutation.ResetMaxAgeRating()
+	_u.mutation.SetMaxAgeRating(v)
+	return _u
+}
+
+// SetNillableMaxAgeRating sets the "max_age_rating" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableMaxAgeRating(v *int) *UserUpdate {
+	if v != nil {
+		_u.SetMaxAgeRating(*v)
+	}
+	return _u
+}
+
+// AddMaxAgeRating adds value to the "max_age_rating" field.
+func (_u *UserUpdate) AddMaxAgeRating(v int) *UserUpdate {
+	_u.mutation.AddMaxAgeRating(v)
+	return _u
+}
+
+// ClearMaxAgeRating clears the value of the "max_age_rating" field.
+func (_u *UserUpdate) ClearMaxAgeRating() *UserUpdate {
+	_u.mutation.ClearMaxAgeRating()
+	return _u
+}
+
+// SetAllowedPaths sets the "allowed_paths" field.
+func (_u *UserUpdate) SetAllowedPaths(v []string) *UserUpdate {
+	_u.mutation.SetAllowedPaths(v)
+	return _u
+}
+
+// AppendAllowedPaths appends value to the "allowed_paths" field.
+func (_u *UserUpdate) AppendAllowedPaths(v []string) *UserUpdate {
+	_u.mutation.AppendAllowedPaths(v)
+	return _u
+}
+
+// ClearAllowedPaths clears the value of the "allowed_paths" field.
+func (_u *UserUpdate) ClearAllowedPaths() *UserUpdate {
+	_u.mutation.ClearAllowedPaths()
+	return _u
+}
+
+// SetPreferences sets the "preferences" field.
+func (_u *UserUpdate) SetPreferences(v preference.Document) *UserUpdate {
+	_u.mutation.SetPreferences(v)
+	return _u
+}
+
+// SetNillablePreferences sets the "preferences" field if the given value is not nil.
+func (_u *UserUpdate) SetNillablePreferences(v *preference.Document) *UserUpdate {
+	if v != nil {
+		_u.SetPreferences(*v)
+	}
+	return _u
+}
+
+// ClearPreferences clears the value of the "preferences" field.
+func (_u *UserUpdate) ClearPreferences() *UserUpdate {
+	_u.mutation.ClearPreferences()
+	return _u
+}
+
 // AddFavoriteItemIDs adds the "favorite_items" edge to the Meta entity by IDs.
 func (_u *UserUpdate) AddFavoriteItemIDs(ids ...int) *UserUpdate {
 	_u.mutation.AddFavoriteItemIDs(ids...)
@@ -119,6 +224,96 @@ func (_u *UserUpdate) AddProgress(v ...*Progress) *UserUpdate {
 	return _u.AddProgresIDs(ids...)
 }
 
+// AddBlockedItemIDs adds the "blocked_items" edge to the Meta entity by IDs.
+func (_u *UserUpdate) AddBlockedItemIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddBlockedItemIDs(ids...)
+	return _u
+}
+
+// AddBlockedItems adds the "blocked_items" edges to the Meta entity.
+func (_u *UserUpdate) AddBlockedItems(v ...*Meta) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBlockedItemIDs(ids...)
+}
+
+// AddBlockedTagIDs adds the "blocked_tags" edge to the Tag entity by IDs.
+func (_u *UserUpdate) AddBlockedTagIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddBlockedTagIDs(ids...)
+	return _u
+}
+
+// AddBlockedTags adds the "blocked_tags" edges to the Tag entity.
+func (_u *UserUpdate) AddBlockedTags(v ...*Tag) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBlockedTagIDs(ids...)
+}
+
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by IDs.
+func (_u *UserUpdate) AddSavedSearchIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddSavedSearchIDs(ids...)
+	return _u
+}
+
+// AddSavedSearches adds the "saved_searches" edges to the SavedSearch entity.
+func (_u *UserUpdate) AddSavedSearches(v ...*SavedSearch) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSavedSearchIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (_u *UserUpdate) AddSessionIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddSessionIDs(ids...)
+	return _u
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (_u *UserUpdate) AddSessions(v ...*Session) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSessionIDs(ids...)
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
+func (_u *UserUpdate) AddAPIKeyIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddAPIKeyIDs(ids...)
+	return _u
+}
+
+// AddAPIKeys adds the "api_keys" edges to the APIKey entity.
+func (_u *UserUpdate) AddAPIKeys(v ...*APIKey) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAPIKeyIDs(ids...)
+}
+
+// AddDeniedTagIDs adds the "denied_tags" edge to the Tag entity by IDs.
+func (_u *UserUpdate) AddDeniedTagIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddDeniedTagIDs(ids...)
+	return _u
+}
+
+// AddDeniedTags adds the "denied_tags" edges to the Tag entity.
+func (_u *UserUpdate) AddDeniedTags(v ...*Tag) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeniedTagIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
@@ -208,6 +403,132 @@ func (_u *UserUpdate) RemoveProgress(v ...*Progress) *UserUpdate {
 	return _u.RemoveProgresIDs(ids...)
 }
 
+// ClearBlockedItems clears all "blocked_items" edges to the Meta entity.
+func (_u *UserUpdate) ClearBlockedItems() *UserUpdate {
+	_u.mutation.ClearBlockedItems()
+	return _u
+}
+
+// RemoveBlockedItemIDs removes the "blocked_items" edge to Meta entities by IDs.
+func (_u *UserUpdate) RemoveBlockedItemIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveBlockedItemIDs(ids...)
+	return _u
+}
+
+// RemoveBlockedItems removes "blocked_items" edges to Meta entities.
+func (_u *UserUpdate) RemoveBlockedItems(v ...*Meta) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBlockedItemIDs(ids...)
+}
+
+// ClearBlockedTags clears all "blocked_tags" edges to the Tag entity.
+func (_u *UserUpdate) ClearBlockedTags() *UserUpdate {
+	_u.mutation.ClearBlockedTags()
+	return _u
+}
+
+// RemoveBlockedTagIDs removes the "blocked_tags" edge to Tag entities by IDs.
+func (_u *UserUpdate) RemoveBlockedTagIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveBlockedTagIDs(ids...)
+	return _u
+}
+
+// RemoveBlockedTags removes "blocked_tags" edges to Tag entities.
+func (_u *UserUpdate) RemoveBlockedTags(v ...*Tag) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBlockedTagIDs(ids...)
+}
+
+// ClearSavedSearches clears all "saved_searches" edges to the SavedSearch entity.
+func (_u *UserUpdate) ClearSavedSearches() *UserUpdate {
+	_u.mutation.ClearSavedSearches()
+	return _u
+}
+
+// RemoveSavedSearchIDs removes the "saved_searches" edge to SavedSearch entities by IDs.
+func (_u *UserUpdate) RemoveSavedSearchIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveSavedSearchIDs(ids...)
+	return _u
+}
+
+// RemoveSavedSearches removes "saved_searches" edges to SavedSearch entities.
+func (_u *UserUpdate) RemoveSavedSearches(v ...*SavedSearch) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSavedSearchIDs(ids...)
+}
+
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (_u *UserUpdate) ClearSessions() *UserUpdate {
+	_u.mutation.ClearSessions()
+	return _u
+}
+
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (_u *UserUpdate) RemoveSessionIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveSessionIDs(ids...)
+	return _u
+}
+
+// RemoveSessions removes "sessions" edges to Session entities.
+func (_u *UserUpdate) RemoveSessions(v ...*Session) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSessionIDs(ids...)
+}
+
+// ClearAPIKeys clears all "api_keys" edges to the APIKey entity.
+func (_u *UserUpdate) ClearAPIKeys() *UserUpdate {
+	_u.mutation.ClearAPIKeys()
+	return _u
+}
+
+// RemoveAPIKeyIDs removes the "api_keys" edge to APIKey entities by IDs.
+func (_u *UserUpdate) RemoveAPIKeyIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveAPIKeyIDs(ids...)
+	return _u
+}
+
+// RemoveAPIKeys removes "api_keys" edges to APIKey entities.
+func (_u *UserUpdate) RemoveAPIKeys(v ...*APIKey) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAPIKeyIDs(ids...)
+}
+
+// ClearDeniedTags clears all "denied_tags" edges to the Tag entity.
+func (_u *UserUpdate) ClearDeniedTags() *UserUpdate {
+	_u.mutation.ClearDeniedTags()
+	return _u
+}
+
+// RemoveDeniedTagIDs removes the "denied_tags" edge to Tag entities by IDs.
+func (_u *UserUpdate) RemoveDeniedTagIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveDeniedTagIDs(ids...)
+	return _u
+}
+
+// RemoveDeniedTags removes "denied_tags" edges to Tag entities.
+func (_u *UserUpdate) RemoveDeniedTags(v ...*Tag) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeniedTagIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -242,9 +563,30 @@ func (_u *UserUpdate) check() error {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxAgeRating(); ok {
+		if err := user.MaxAgeRatingValidator(v); err != nil {
+			return &ValidationError{Name: "max_age_rating", err: fmt.Errorf(`ent: validator failed for field "User.max_age_rating": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Preferences(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "preferences", err: fmt.Errorf(`ent: validator failed for field "User.preferences": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UserUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -263,6 +605,41 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Active(); ok {
 		_spec.SetField(user.FieldActive, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.PasswordHash(); ok {
+		_spec.SetField(user.FieldPasswordHash, field.TypeString, value)
+	}
+	if _u.mutation.PasswordHashCleared() {
+		_spec.ClearField(user.FieldPasswordHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MaxAgeRating(); ok {
+		_spec.SetField(user.FieldMaxAgeRating, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAgeRating(); ok {
+		_spec.AddField(user.FieldMaxAgeRating, field.TypeInt, value)
+	}
+	if _u.mutation.MaxAgeRatingCleared() {
+		_spec.ClearField(user.FieldMaxAgeRating, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AllowedPaths(); ok {
+		_spec.SetField(user.FieldAllowedPaths, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedPaths(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldAllowedPaths, value)
+		})
+	}
+	if _u.mutation.AllowedPathsCleared() {
+		_spec.ClearField(user.FieldAllowedPaths, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Preferences(); ok {
+		_spec.SetField(user.FieldPreferences, field.TypeJSON, value)
+	}
+	if _u.mutation.PreferencesCleared() {
+		_spec.ClearField(user.FieldPreferences, field.TypeJSON)
+	}
 	if _u.mutation.FavoriteItemsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -443,54 +820,425 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.BlockedItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.BlockedItemsTable,
+			Columns: user.BlockedItemsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(meta.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
-}
-
-// SetEmail sets the "email" field.
-func (_u *UserUpdateOne) SetEmail(v string) *UserUpdateOne {
-	_u.mutation.SetEmail(v)
-	return _u
-}
-
-// SetNillableEmail sets the "email" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableEmail(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetEmail(*v)
+	if nodes := _u.mutation.RemovedBlockedItemsIDs(); len(nodes) > 0 && !_u.mutation.BlockedItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.BlockedItemsTable,
+			Columns: user.BlockedItemsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(meta.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetActive sets the "active" field.
-func (_u *UserUpdateOne) SetActive(v bool) *UserUpdateOne {
-	_u.mutation.SetActive(v)
-	return _u
-}
-
-// SetNillableActive sets the "active" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableActive(v *bool) *UserUpdateOne {
-	if v != nil {
+	if nodes := _u.mutation.BlockedItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.BlockedItemsTable,
+			Columns: user.BlockedItemsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(meta.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BlockedTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.BlockedTagsTable,
+			Columns: user.BlockedTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBlockedTagsIDs(); len(nodes) > 0 && !_u.mutation.BlockedTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.BlockedTagsTable,
+			Columns: user.BlockedTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BlockedTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.BlockedTagsTable,
+			Columns: user.BlockedTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SavedSearchesTable,
+			Columns: []string{user.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSavedSearchesIDs(); len(nodes) > 0 && !_u.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SavedSearchesTable,
+			Columns: []string{user.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SavedSearchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SavedSearchesTable,
+			Columns: []string{user.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APIKeysTable,
+			Columns: []string{user.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAPIKeysIDs(); len(nodes) > 0 && !_u.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APIKeysTable,
+			Columns: []string{user.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.APIKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APIKeysTable,
+			Columns: []string{user.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DeniedTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.DeniedTagsTable,
+			Columns: user.DeniedTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDeniedTagsIDs(); len(nodes) > 0 && !_u.mutation.DeniedTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.DeniedTagsTable,
+			Columns: user.DeniedTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeniedTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.DeniedTagsTable,
+			Columns: user.DeniedTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *UserMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetEmail sets the "email" field.
+func (_u *UserUpdateOne) SetEmail(v string) *UserUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEmail(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *UserUpdateOne) SetActive(v bool) *UserUpdateOne {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableActive(v *bool) *UserUpdateOne {
+	if v != nil {
 		_u.SetActive(*v)
 	}
 	return _u
 }
 
+// SetPasswordHash sets the "password_hash" field.
+func (_u *UserUpdateOne) SetPasswordHash(v string) *UserUpdateOne {
+	_u.mutation.SetPasswordHash(v)
+	return _u
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillablePasswordHash(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetPasswordHash(*v)
+	}
+	return _u
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (_u *UserUpdateOne) ClearPasswordHash() *UserUpdateOne {
+	_u.mutation.ClearPasswordHash()
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *UserUpdateOne) SetRole(v user.Role) *UserUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableRole(v *user.Role) *UserUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetMaxAgeRating sets the "max_age_rating" field.
+func (_u *UserUpdateOne) SetMaxAgeRating(v int) *UserUpdateOne {
+	_u.mutation.ResetMaxAgeRating()
+	_u.mutation.SetMaxAgeRating(v)
+	return _u
+}
+
+// SetNillableMaxAgeRating sets the "max_age_rating" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableMaxAgeRating(v *int) *UserUpdateOne {
+	if v != nil {
+		_u.SetMaxAgeRating(*v)
+	}
+	return _u
+}
+
+// AddMaxAgeRating adds value to the "max_age_rating" field.
+func (_u *UserUpdateOne) AddMaxAgeRating(v int) *UserUpdateOne {
+	_u.mutation.AddMaxAgeRating(v)
+	return _u
+}
+
+// ClearMaxAgeRating clears the value of the "max_age_rating" field.
+func (_u *UserUpdateOne) ClearMaxAgeRating() *UserUpdateOne {
+	_u.mutation.ClearMaxAgeRating()
+	return _u
+}
+
+// SetAllowedPaths sets the "allowed_paths" field.
+func (_u *UserUpdateOne) SetAllowedPaths(v []string) *UserUpdateOne {
+	_u.mutation.SetAllowedPaths(v)
+	return _u
+}
+
+// AppendAllowedPaths appends value to the "allowed_paths" field.
+func (_u *UserUpdateOne) AppendAllowedPaths(v []string) *UserUpdateOne {
+	_u.mutation.AppendAllowedPaths(v)
+	return _u
+}
+
+// ClearAllowedPaths clears the value of the "allowed_paths" field.
+func (_u *UserUpdateOne) ClearAllowedPaths() *UserUpdateOne {
+	_u.mutation.ClearAllowedPaths()
+	return _u
+}
+
+// SetPreferences sets the "preferences" field.
+func (_u *UserUpdateOne) SetPreferences(v preference.Document) *UserUpdateOne {
+	_u.mutation.SetPreferences(v)
+	return _u
+}
+
+// SetNillablePreferences sets the "preferences" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillablePreferences(v *preference.Document) *UserUpdateOne {
+	if v != nil {
+		_u.SetPreferences(*v)
+	}
+	return _u
+}
+
+// ClearPreferences clears the value of the "preferences" field.
+func (_u *UserUpdateOne) ClearPreferences() *UserUpdateOne {
+	_u.mutation.ClearPreferences()
+	return _u
+}
+
 // AddFavoriteItemIDs adds the "favorite_items" edge to the Meta entity by IDs.
 func (_u *UserUpdateOne) AddFavoriteItemIDs(ids ...int) *UserUpdateOne {
 	_u.mutation.AddFavoriteItemIDs(ids...)
@@ -551,6 +1299,96 @@ func (_u *UserUpdateOne) AddProgress(v ...*Progress) *UserUpdateOne {
 	return _u.AddProgresIDs(ids...)
 }
 
+// AddBlockedItemIDs adds the "blocked_items" edge to the Meta entity by IDs.
+func (_u *UserUpdateOne) AddBlockedItemIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddBlockedItemIDs(ids...)
+	return _u
+}
+
+// AddBlockedItems adds the "blocked_items" edges to the Meta entity.
+func (_u *UserUpdateOne) AddBlockedItems(v ...*Meta) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBlockedItemIDs(ids...)
+}
+
+// AddBlockedTagIDs adds the "blocked_tags" edge to the Tag entity by IDs.
+func (_u *UserUpdateOne) AddBlockedTagIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddBlockedTagIDs(ids...)
+	return _u
+}
+
+// AddBlockedTags adds the "blocked_tags" edges to the Tag entity.
+func (_u *UserUpdateOne) AddBlockedTags(v ...*Tag) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBlockedTagIDs(ids...)
+}
+
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by IDs.
+func (_u *UserUpdateOne) AddSavedSearchIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddSavedSearchIDs(ids...)
+	return _u
+}
+
+// AddSavedSearches adds the "saved_searches" edges to the SavedSearch entity.
+func (_u *UserUpdateOne) AddSavedSearches(v ...*SavedSearch) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSavedSearchIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (_u *UserUpdateOne) AddSessionIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddSessionIDs(ids...)
+	return _u
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (_u *UserUpdateOne) AddSessions(v ...*Session) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSessionIDs(ids...)
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
+func (_u *UserUpdateOne) AddAPIKeyIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddAPIKeyIDs(ids...)
+	return _u
+}
+
+// AddAPIKeys adds the "api_keys" edges to the APIKey entity.
+func (_u *UserUpdateOne) AddAPIKeys(v ...*APIKey) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAPIKeyIDs(ids...)
+}
+
+// AddDeniedTagIDs adds the "denied_tags" edge to the Tag entity by IDs.
+func (_u *UserUpdateOne) AddDeniedTagIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddDeniedTagIDs(ids...)
+	return _u
+}
+
+// AddDeniedTags adds the "denied_tags" edges to the Tag entity.
+func (_u *UserUpdateOne) AddDeniedTags(v ...*Tag) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeniedTagIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
@@ -574,70 +1412,196 @@ func (_u *UserUpdateOne) RemoveFavoriteItems(v ...*Meta) *UserUpdateOne {
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveFavoriteItemIDs(ids...)
+	return _u.RemoveFavoriteItemIDs(ids...)
+}
+
+// ClearFavoriteTags clears all "favorite_tags" edges to the Tag entity.
+func (_u *UserUpdateOne) ClearFavoriteTags() *UserUpdateOne {
+	_u.mutation.ClearFavoriteTags()
+	return _u
+}
+
+// RemoveFavoriteTagIDs removes the "favorite_tags" edge to Tag entities by IDs.
+func (_u *UserUpdateOne) RemoveFavoriteTagIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveFavoriteTagIDs(ids...)
+	return _u
+}
+
+// RemoveFavoriteTags removes "favorite_tags" edges to Tag entities.
+func (_u *UserUpdateOne) RemoveFavoriteTags(v ...*Tag) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFavoriteTagIDs(ids...)
+}
+
+// ClearHistories clears all "histories" edges to the History entity.
+func (_u *UserUpdateOne) ClearHistories() *UserUpdateOne {
+	_u.mutation.ClearHistories()
+	return _u
+}
+
+// RemoveHistoryIDs removes the "histories" edge to History entities by IDs.
+func (_u *UserUpdateOne) RemoveHistoryIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveHistoryIDs(ids...)
+	return _u
+}
+
+// RemoveHistories removes "histories" edges to History entities.
+func (_u *UserUpdateOne) RemoveHistories(v ...*History) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveHistoryIDs(ids...)
+}
+
+// ClearProgress clears all "progress" edges to the Progress entity.
+func (_u *UserUpdateOne) ClearProgress() *UserUpdateOne {
+	_u.mutation.ClearProgress()
+	return _u
+}
+
+// RemoveProgresIDs removes the "progress" edge to Progress entities by IDs.
+func (_u *UserUpdateOne) RemoveProgresIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveProgresIDs(ids...)
+	return _u
+}
+
+// RemoveProgress removes "progress" edges to Progress entities.
+func (_u *UserUpdateOne) RemoveProgress(v ...*Progress) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveProgresIDs(ids...)
+}
+
+// ClearBlockedItems clears all "blocked_items" edges to the Meta entity.
+func (_u *UserUpdateOne) ClearBlockedItems() *UserUpdateOne {
+	_u.mutation.ClearBlockedItems()
+	return _u
+}
+
+// RemoveBlockedItemIDs removes the "blocked_items" edge to Meta entities by IDs.
+func (_u *UserUpdateOne) RemoveBlockedItemIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveBlockedItemIDs(ids...)
+	return _u
+}
+
+// RemoveBlockedItems removes "blocked_items" edges to Meta entities.
+func (_u *UserUpdateOne) RemoveBlockedItems(v ...*Meta) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBlockedItemIDs(ids...)
+}
+
+// ClearBlockedTags clears all "blocked_tags" edges to the Tag entity.
+func (_u *UserUpdateOne) ClearBlockedTags() *UserUpdateOne {
+	_u.mutation.ClearBlockedTags()
+	return _u
+}
+
+// RemoveBlockedTagIDs removes the "blocked_tags" edge to Tag entities by IDs.
+func (_u *UserUpdateOne) RemoveBlockedTagIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveBlockedTagIDs(ids...)
+	return _u
+}
+
+// RemoveBlockedTags removes "blocked_tags" edges to Tag entities.
+func (_u *UserUpdateOne) RemoveBlockedTags(v ...*Tag) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBlockedTagIDs(ids...)
+}
+
+// ClearSavedSearches clears all "saved_searches" edges to the SavedSearch entity.
+func (_u *UserUpdateOne) ClearSavedSearches() *UserUpdateOne {
+	_u.mutation.ClearSavedSearches()
+	return _u
+}
+
+// RemoveSavedSearchIDs removes the "saved_searches" edge to SavedSearch entities by IDs.
+func (_u *UserUpdateOne) RemoveSavedSearchIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveSavedSearchIDs(ids...)
+	return _u
+}
+
+// RemoveSavedSearches removes "saved_searches" edges to SavedSearch entities.
+func (_u *UserUpdateOne) RemoveSavedSearches(v ...*SavedSearch) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSavedSearchIDs(ids...)
 }
 
-// ClearFavoriteTags clears all "favorite_tags" edges to the Tag entity.
-func (_u *UserUpdateOne) ClearFavoriteTags() *UserUpdateOne {
-	_u.mutation.ClearFavoriteTags()
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (_u *UserUpdateOne) ClearSessions() *UserUpdateOne {
+	_u.mutation.ClearSessions()
 	return _u
 }
 
-// RemoveFavoriteTagIDs removes the "favorite_tags" edge to Tag entities by IDs.
-func (_u *UserUpdateOne) RemoveFavoriteTagIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveFavoriteTagIDs(ids...)
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (_u *UserUpdateOne) RemoveSessionIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveSessionIDs(ids...)
 	return _u
 }
 
-// RemoveFavoriteTags removes "favorite_tags" edges to Tag entities.
-func (_u *UserUpdateOne) RemoveFavoriteTags(v ...*Tag) *UserUpdateOne {
+// RemoveSessions removes "sessions" edges to Session entities.
+func (_u *UserUpdateOne) RemoveSessions(v ...*Session) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveFavoriteTagIDs(ids...)
+	return _u.RemoveSessionIDs(ids...)
 }
 
-// ClearHistories clears all "histories" edges to the History entity.
-func (_u *UserUpdateOne) ClearHistories() *UserUpdateOne {
-	_u.mutation.ClearHistories()
+// ClearAPIKeys clears all "api_keys" edges to the APIKey entity.
+func (_u *UserUpdateOne) ClearAPIKeys() *UserUpdateOne {
+	_u.mutation.ClearAPIKeys()
 	return _u
 }
 
-// RemoveHistoryIDs removes the "histories" edge to History entities by IDs.
-func (_u *UserUpdateOne) RemoveHistoryIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveHistoryIDs(ids...)
+// RemoveAPIKeyIDs removes the "api_keys" edge to APIKey entities by IDs.
+func (_u *UserUpdateOne) RemoveAPIKeyIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveAPIKeyIDs(ids...)
 	return _u
 }
 
-// RemoveHistories removes "histories" edges to History entities.
-func (_u *UserUpdateOne) RemoveHistories(v ...*History) *UserUpdateOne {
+// RemoveAPIKeys removes "api_keys" edges to APIKey entities.
+func (_u *UserUpdateOne) RemoveAPIKeys(v ...*APIKey) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveHistoryIDs(ids...)
+	return _u.RemoveAPIKeyIDs(ids...)
 }
 
-// ClearProgress clears all "progress" edges to the Progress entity.
-func (_u *UserUpdateOne) ClearProgress() *UserUpdateOne {
-	_u.mutation.ClearProgress()
+// ClearDeniedTags clears all "denied_tags" edges to the Tag entity.
+func (_u *UserUpdateOne) ClearDeniedTags() *UserUpdateOne {
+	_u.mutation.ClearDeniedTags()
 	return _u
 }
 
-// RemoveProgresIDs removes the "progress" edge to Progress entities by IDs.
-func (_u *UserUpdateOne) RemoveProgresIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveProgresIDs(ids...)
+// RemoveDeniedTagIDs removes the "denied_tags" edge to Tag entities by IDs.
+func (_u *UserUpdateOne) RemoveDeniedTagIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveDeniedTagIDs(ids...)
 	return _u
 }
 
-// RemoveProgress removes "progress" edges to Progress entities.
-func (_u *UserUpdateOne) RemoveProgress(v ...*Progress) *UserUpdateOne {
+// RemoveDeniedTags removes "denied_tags" edges to Tag entities.
+func (_u *UserUpdateOne) RemoveDeniedTags(v ...*Tag) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveProgresIDs(ids...)
+	return _u.RemoveDeniedTagIDs(ids...)
 }
 
 // Where appends a list predicates to the UserUpdate builder.
@@ -687,9 +1651,30 @@ func (_u *UserUpdateOne) check() error {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxAgeRating(); ok {
+		if err := user.MaxAgeRatingValidator(v); err != nil {
+			return &ValidationError{Name: "max_age_rating", err: fmt.Errorf(`ent: validator failed for field "User.max_age_rating": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Preferences(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "preferences", err: fmt.Errorf(`ent: validator failed for field "User.preferences": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UserUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -725,6 +1710,41 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if value, ok := _u.mutation.Active(); ok {
 		_spec.SetField(user.FieldActive, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.PasswordHash(); ok {
+		_spec.SetField(user.FieldPasswordHash, field.TypeString, value)
+	}
+	if _u.mutation.PasswordHashCleared() {
+		_spec.ClearField(user.FieldPasswordHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MaxAgeRating(); ok {
+		_spec.SetField(user.FieldMaxAgeRating, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAgeRating(); ok {
+		_spec.AddField(user.FieldMaxAgeRating, field.TypeInt, value)
+	}
+	if _u.mutation.MaxAgeRatingCleared() {
+		_spec.ClearField(user.FieldMaxAgeRating, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AllowedPaths(); ok {
+		_spec.SetField(user.FieldAllowedPaths, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedPaths(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldAllowedPaths, value)
+		})
+	}
+	if _u.mutation.AllowedPathsCleared() {
+		_spec.ClearField(user.FieldAllowedPaths, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Preferences(); ok {
+		_spec.SetField(user.FieldPreferences, field.TypeJSON, value)
+	}
+	if _u.mutation.PreferencesCleared() {
+		_spec.ClearField(user.FieldPreferences, field.TypeJSON)
+	}
 	if _u.mutation.FavoriteItemsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -905,6 +1925,277 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.BlockedItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.BlockedItemsTable,
+			Columns: user.BlockedItemsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(meta.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBlockedItemsIDs(); len(nodes) > 0 && !_u.mutation.BlockedItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.BlockedItemsTable,
+			Columns: user.BlockedItemsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(meta.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BlockedItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.BlockedItemsTable,
+			Columns: user.BlockedItemsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(meta.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BlockedTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.BlockedTagsTable,
+			Columns: user.BlockedTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBlockedTagsIDs(); len(nodes) > 0 && !_u.mutation.BlockedTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.BlockedTagsTable,
+			Columns: user.BlockedTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BlockedTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.BlockedTagsTable,
+			Columns: user.BlockedTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SavedSearchesTable,
+			Columns: []string{user.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSavedSearchesIDs(); len(nodes) > 0 && !_u.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SavedSearchesTable,
+			Columns: []string{user.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SavedSearchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SavedSearchesTable,
+			Columns: []string{user.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APIKeysTable,
+			Columns: []string{user.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAPIKeysIDs(); len(nodes) > 0 && !_u.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APIKeysTable,
+			Columns: []string{user.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.APIKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APIKeysTable,
+			Columns: []string{user.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DeniedTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.DeniedTagsTable,
+			Columns: user.DeniedTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDeniedTagsIDs(); len(nodes) > 0 && !_u.mutation.DeniedTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.DeniedTagsTable,
+			Columns: user.DeniedTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeniedTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.DeniedTagsTable,
+			Columns: user.DeniedTagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
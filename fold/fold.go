@@ -0,0 +1,79 @@
+// Package fold computes the keys that item and tag names are matched and
+// sorted by, so that differently written forms of a name find each other and
+// numbered names sort in natural order.
+package fold
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// Kana voicing marks are kept, so that for example が and か stay apart.
+	combiningVoicedMark     = '\u3099'
+	combiningSemiVoicedMark = '\u309a'
+
+	katakanaFirst = 'ァ'
+	katakanaLast  = 'ヶ'
+	kanaOffset    = katakanaFirst - 'ぁ'
+
+	// naturalDigits is the width numbers are padded to in a natural key.
+	naturalDigits = 20
+)
+
+// SearchKey returns the search key of a text. The text is NFKC-normalized,
+// which also folds full-width and half-width forms, case-folded, stripped of
+// diacritics, and its katakana are folded into hiragana. Surrounding
+// whitespace is removed and inner whitespace collapsed.
+func SearchKey(s string) string {
+	s = cases.Fold().String(norm.NFKC.String(s))
+
+	b := strings.Builder{}
+	for _, r := range norm.NFD.String(s) {
+		switch {
+		case unicode.Is(unicode.Mn, r) && r != combiningVoicedMark && r != combiningSemiVoicedMark:
+			continue
+		case r >= katakanaFirst && r <= katakanaLast:
+			b.WriteRune(r - kanaOffset)
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return strings.Join(strings.Fields(norm.NFC.String(b.String())), " ")
+}
+
+// NaturalKey returns a key whose byte order is the natural order of the text,
+// as used for the pages of an item: runs of ASCII digits are compared by their
+// value, so "Vol 2" comes before "Vol 10". Leading zeros are dropped and the
+// numbers are zero-padded to a fixed width.
+func NaturalKey(s string) string {
+	b := strings.Builder{}
+	for i := 0; i < len(s); {
+		if !isDigit(s[i]) {
+			b.WriteByte(s[i])
+			i++
+			continue
+		}
+
+		start := i
+		for i < len(s) && isDigit(s[i]) {
+			i++
+		}
+
+		number := strings.TrimLeft(s[start:i], "0")
+		if len(number) < naturalDigits {
+			b.WriteString(strings.Repeat("0", naturalDigits-len(number)))
+		}
+		b.WriteString(number)
+	}
+
+	return b.String()
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,35 @@
+package fold
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type FoldTestSuite struct {
+	suite.Suite
+}
+
+func TestFoldTestSuite(t *testing.T) {
+	suite.Run(t, new(FoldTestSuite))
+}
+
+func (s *FoldTestSuite) TestSearchKey() {
+	s.Assert().Equal(SearchKey("マンガ"), SearchKey("ﾏﾝｶﾞ"))
+	s.Assert().Equal(SearchKey("マンガ"), SearchKey("まんが"))
+	s.Assert().Equal("まんが", SearchKey("マンガ"))
+	s.Assert().Equal("ぱんだ", SearchKey("ﾊﾟﾝﾀﾞ"))
+	s.Assert().NotEqual(SearchKey("カ"), SearchKey("ガ"))
+	s.Assert().Equal("abc 123", SearchKey("  ＡＢＣ　１２３ "))
+	s.Assert().Equal("pokemon cafe", SearchKey("Pokémon Café"))
+	s.Assert().Equal("strasse", SearchKey("Straße"))
+	s.Assert().Equal("漫画", SearchKey("漫画"))
+}
+
+func (s *FoldTestSuite) TestNaturalKey() {
+	s.Assert().Less(NaturalKey("Vol 2"), NaturalKey("Vol 10"))
+	s.Assert().Less(NaturalKey("Vol 2 Ch 9"), NaturalKey("Vol 02 Ch 10"))
+	s.Assert().Less(NaturalKey("a"), NaturalKey("a1"))
+	s.Assert().Equal(NaturalKey("Vol 007"), NaturalKey("Vol 7"))
+	s.Assert().Equal("Vol 00000000000000000007.zip", NaturalKey("Vol 7.zip"))
+}
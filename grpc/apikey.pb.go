@@ -0,0 +1,572 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.0
+// source: apikey.proto
+
+package grpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ApiKeyScope int32
+
+const (
+	ApiKeyScope_API_KEY_SCOPE_UNSPECIFIED    ApiKeyScope = 0
+	ApiKeyScope_API_KEY_SCOPE_READ           ApiKeyScope = 1
+	ApiKeyScope_API_KEY_SCOPE_PROGRESS_WRITE ApiKeyScope = 2
+	ApiKeyScope_API_KEY_SCOPE_ADMIN          ApiKeyScope = 3
+)
+
+// Enum value maps for ApiKeyScope.
+var (
+	ApiKeyScope_name = map[int32]string{
+		0: "API_KEY_SCOPE_UNSPECIFIED",
+		1: "API_KEY_SCOPE_READ",
+		2: "API_KEY_SCOPE_PROGRESS_WRITE",
+		3: "API_KEY_SCOPE_ADMIN",
+	}
+	ApiKeyScope_value = map[string]int32{
+		"API_KEY_SCOPE_UNSPECIFIED":    0,
+		"API_KEY_SCOPE_READ":           1,
+		"API_KEY_SCOPE_PROGRESS_WRITE": 2,
+		"API_KEY_SCOPE_ADMIN":          3,
+	}
+)
+
+func (x ApiKeyScope) Enum() *ApiKeyScope {
+	p := new(ApiKeyScope)
+	*p = x
+	return p
+}
+
+func (x ApiKeyScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApiKeyScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_apikey_proto_enumTypes[0].Descriptor()
+}
+
+func (ApiKeyScope) Type() protoreflect.EnumType {
+	return &file_apikey_proto_enumTypes[0]
+}
+
+func (x ApiKeyScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApiKeyScope.Descriptor instead.
+func (ApiKeyScope) EnumDescriptor() ([]byte, []int) {
+	return file_apikey_proto_rawDescGZIP(), []int{0}
+}
+
+type ApiKeyItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Prefix        string                 `protobuf:"bytes,3,opt,name=Prefix,proto3" json:"Prefix,omitempty"`
+	Scopes        []ApiKeyScope          `protobuf:"varint,4,rep,packed,name=Scopes,proto3,enum=ApiKeyScope" json:"Scopes,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=CreateTime,proto3" json:"CreateTime,omitempty"`
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ExpireTime,proto3" json:"ExpireTime,omitempty"`
+	LastUsedTime  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=LastUsedTime,proto3" json:"LastUsedTime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKeyItem) Reset() {
+	*x = ApiKeyItem{}
+	mi := &file_apikey_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKeyItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKeyItem) ProtoMessage() {}
+
+func (x *ApiKeyItem) ProtoReflect() protoreflect.Message {
+	mi := &file_apikey_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKeyItem.ProtoReflect.Descriptor instead.
+func (*ApiKeyItem) Descriptor() ([]byte, []int) {
+	return file_apikey_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ApiKeyItem) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApiKeyItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiKeyItem) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ApiKeyItem) GetScopes() []ApiKeyScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ApiKeyItem) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *ApiKeyItem) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *ApiKeyItem) GetLastUsedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedTime
+	}
+	return nil
+}
+
+type ApiKeyCreateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Scopes        []ApiKeyScope          `protobuf:"varint,2,rep,packed,name=Scopes,proto3,enum=ApiKeyScope" json:"Scopes,omitempty"`
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ExpireTime,proto3" json:"ExpireTime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKeyCreateRequest) Reset() {
+	*x = ApiKeyCreateRequest{}
+	mi := &file_apikey_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKeyCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKeyCreateRequest) ProtoMessage() {}
+
+func (x *ApiKeyCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apikey_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKeyCreateRequest.ProtoReflect.Descriptor instead.
+func (*ApiKeyCreateRequest) Descriptor() ([]byte, []int) {
+	return file_apikey_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ApiKeyCreateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiKeyCreateRequest) GetScopes() []ApiKeyScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ApiKeyCreateRequest) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type ApiKeyCreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Item          *ApiKeyItem            `protobuf:"bytes,2,opt,name=Item,proto3" json:"Item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKeyCreateResponse) Reset() {
+	*x = ApiKeyCreateResponse{}
+	mi := &file_apikey_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKeyCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKeyCreateResponse) ProtoMessage() {}
+
+func (x *ApiKeyCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apikey_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKeyCreateResponse.ProtoReflect.Descriptor instead.
+func (*ApiKeyCreateResponse) Descriptor() ([]byte, []int) {
+	return file_apikey_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ApiKeyCreateResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ApiKeyCreateResponse) GetItem() *ApiKeyItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type ApiKeyListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKeyListRequest) Reset() {
+	*x = ApiKeyListRequest{}
+	mi := &file_apikey_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKeyListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKeyListRequest) ProtoMessage() {}
+
+func (x *ApiKeyListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apikey_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKeyListRequest.ProtoReflect.Descriptor instead.
+func (*ApiKeyListRequest) Descriptor() ([]byte, []int) {
+	return file_apikey_proto_rawDescGZIP(), []int{3}
+}
+
+type ApiKeyListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ApiKeyItem          `protobuf:"bytes,1,rep,name=Items,proto3" json:"Items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKeyListResponse) Reset() {
+	*x = ApiKeyListResponse{}
+	mi := &file_apikey_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKeyListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKeyListResponse) ProtoMessage() {}
+
+func (x *ApiKeyListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apikey_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKeyListResponse.ProtoReflect.Descriptor instead.
+func (*ApiKeyListResponse) Descriptor() ([]byte, []int) {
+	return file_apikey_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ApiKeyListResponse) GetItems() []*ApiKeyItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ApiKeyRevokeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKeyRevokeRequest) Reset() {
+	*x = ApiKeyRevokeRequest{}
+	mi := &file_apikey_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKeyRevokeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKeyRevokeRequest) ProtoMessage() {}
+
+func (x *ApiKeyRevokeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apikey_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKeyRevokeRequest.ProtoReflect.Descriptor instead.
+func (*ApiKeyRevokeRequest) Descriptor() ([]byte, []int) {
+	return file_apikey_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApiKeyRevokeRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ApiKeyRevokeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=Success,proto3" json:"Success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKeyRevokeResponse) Reset() {
+	*x = ApiKeyRevokeResponse{}
+	mi := &file_apikey_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKeyRevokeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKeyRevokeResponse) ProtoMessage() {}
+
+func (x *ApiKeyRevokeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apikey_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKeyRevokeResponse.ProtoReflect.Descriptor instead.
+func (*ApiKeyRevokeResponse) Descriptor() ([]byte, []int) {
+	return file_apikey_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ApiKeyRevokeResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApiKeyRevokeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+var File_apikey_proto protoreflect.FileDescriptor
+
+const file_apikey_proto_rawDesc = "" +
+	"\n" +
+	"\fapikey.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa6\x02\n" +
+	"\n" +
+	"ApiKeyItem\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x05R\x02Id\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x16\n" +
+	"\x06Prefix\x18\x03 \x01(\tR\x06Prefix\x12$\n" +
+	"\x06Scopes\x18\x04 \x03(\x0e2\f.ApiKeyScopeR\x06Scopes\x12:\n" +
+	"\n" +
+	"CreateTime\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"CreateTime\x12:\n" +
+	"\n" +
+	"ExpireTime\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"ExpireTime\x12>\n" +
+	"\fLastUsedTime\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\fLastUsedTime\"\x8b\x01\n" +
+	"\x13ApiKeyCreateRequest\x12\x12\n" +
+	"\x04Name\x18\x01 \x01(\tR\x04Name\x12$\n" +
+	"\x06Scopes\x18\x02 \x03(\x0e2\f.ApiKeyScopeR\x06Scopes\x12:\n" +
+	"\n" +
+	"ExpireTime\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"ExpireTime\"I\n" +
+	"\x14ApiKeyCreateResponse\x12\x10\n" +
+	"\x03Key\x18\x01 \x01(\tR\x03Key\x12\x1f\n" +
+	"\x04Item\x18\x02 \x01(\v2\v.ApiKeyItemR\x04Item\"\x13\n" +
+	"\x11ApiKeyListRequest\"7\n" +
+	"\x12ApiKeyListResponse\x12!\n" +
+	"\x05Items\x18\x01 \x03(\v2\v.ApiKeyItemR\x05Items\"%\n" +
+	"\x13ApiKeyRevokeRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x05R\x02Id\"@\n" +
+	"\x14ApiKeyRevokeResponse\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x05R\x02Id\x12\x18\n" +
+	"\aSuccess\x18\x02 \x01(\bR\aSuccess*\x7f\n" +
+	"\vApiKeyScope\x12\x1d\n" +
+	"\x19API_KEY_SCOPE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12API_KEY_SCOPE_READ\x10\x01\x12 \n" +
+	"\x1cAPI_KEY_SCOPE_PROGRESS_WRITE\x10\x02\x12\x17\n" +
+	"\x13API_KEY_SCOPE_ADMIN\x10\x032\xad\x01\n" +
+	"\x06ApiKey\x127\n" +
+	"\x06Create\x12\x14.ApiKeyCreateRequest\x1a\x15.ApiKeyCreateResponse\"\x00\x121\n" +
+	"\x04List\x12\x12.ApiKeyListRequest\x1a\x13.ApiKeyListResponse\"\x00\x127\n" +
+	"\x06Revoke\x12\x14.ApiKeyRevokeRequest\x1a\x15.ApiKeyRevokeResponse\"\x00B-Z+github.com/mangaweb4/mangaweb4-backend/grpcb\x06proto3"
+
+var (
+	file_apikey_proto_rawDescOnce sync.Once
+	file_apikey_proto_rawDescData []byte
+)
+
+func file_apikey_proto_rawDescGZIP() []byte {
+	file_apikey_proto_rawDescOnce.Do(func() {
+		file_apikey_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_apikey_proto_rawDesc), len(file_apikey_proto_rawDesc)))
+	})
+	return file_apikey_proto_rawDescData
+}
+
+var file_apikey_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_apikey_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_apikey_proto_goTypes = []any{
+	(ApiKeyScope)(0),              // 0: ApiKeyScope
+	(*ApiKeyItem)(nil),            // 1: ApiKeyItem
+	(*ApiKeyCreateRequest)(nil),   // 2: ApiKeyCreateRequest
+	(*ApiKeyCreateResponse)(nil),  // 3: ApiKeyCreateResponse
+	(*ApiKeyListRequest)(nil),     // 4: ApiKeyListRequest
+	(*ApiKeyListResponse)(nil),    // 5: ApiKeyListResponse
+	(*ApiKeyRevokeRequest)(nil),   // 6: ApiKeyRevokeRequest
+	(*ApiKeyRevokeResponse)(nil),  // 7: ApiKeyRevokeResponse
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+}
+var file_apikey_proto_depIdxs = []int32{
+	0,  // 0: ApiKeyItem.Scopes:type_name -> ApiKeyScope
+	8,  // 1: ApiKeyItem.CreateTime:type_name -> google.protobuf.Timestamp
+	8,  // 2: ApiKeyItem.ExpireTime:type_name -> google.protobuf.Timestamp
+	8,  // 3: ApiKeyItem.LastUsedTime:type_name -> google.protobuf.Timestamp
+	0,  // 4: ApiKeyCreateRequest.Scopes:type_name -> ApiKeyScope
+	8,  // 5: ApiKeyCreateRequest.ExpireTime:type_name -> google.protobuf.Timestamp
+	1,  // 6: ApiKeyCreateResponse.Item:type_name -> ApiKeyItem
+	1,  // 7: ApiKeyListResponse.Items:type_name -> ApiKeyItem
+	2,  // 8: ApiKey.Create:input_type -> ApiKeyCreateRequest
+	4,  // 9: ApiKey.List:input_type -> ApiKeyListRequest
+	6,  // 10: ApiKey.Revoke:input_type -> ApiKeyRevokeRequest
+	3,  // 11: ApiKey.Create:output_type -> ApiKeyCreateResponse
+	5,  // 12: ApiKey.List:output_type -> ApiKeyListResponse
+	7,  // 13: ApiKey.Revoke:output_type -> ApiKeyRevokeResponse
+	11, // [11:14] is the sub-list for method output_type
+	8,  // [8:11] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_apikey_proto_init() }
+func file_apikey_proto_init() {
+	if File_apikey_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_apikey_proto_rawDesc), len(file_apikey_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_apikey_proto_goTypes,
+		DependencyIndexes: file_apikey_proto_depIdxs,
+		EnumInfos:         file_apikey_proto_enumTypes,
+		MessageInfos:      file_apikey_proto_msgTypes,
+	}.Build()
+	File_apikey_proto = out.File
+	file_apikey_proto_goTypes = nil
+	file_apikey_proto_depIdxs = nil
+}
@@ -0,0 +1,197 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.1
+// - protoc             v7.34.0
+// source: apikey.proto
+
+package grpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ApiKey_Create_FullMethodName = "/ApiKey/Create"
+	ApiKey_List_FullMethodName   = "/ApiKey/List"
+	ApiKey_Revoke_FullMethodName = "/ApiKey/Revoke"
+)
+
+// ApiKeyClient is the client API for ApiKey service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ApiKeyClient interface {
+	Create(ctx context.Context, in *ApiKeyCreateRequest, opts ...grpc.CallOption) (*ApiKeyCreateResponse, error)
+	List(ctx context.Context, in *ApiKeyListRequest, opts ...grpc.CallOption) (*ApiKeyListResponse, error)
+	Revoke(ctx context.Context, in *ApiKeyRevokeRequest, opts ...grpc.CallOption) (*ApiKeyRevokeResponse, error)
+}
+
+type apiKeyClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewApiKeyClient(cc grpc.ClientConnInterface) ApiKeyClient {
+	return &apiKeyClient{cc}
+}
+
+func (c *apiKeyClient) Create(ctx context.Context, in *ApiKeyCreateRequest, opts ...grpc.CallOption) (*ApiKeyCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiKeyCreateResponse)
+	err := c.cc.Invoke(ctx, ApiKey_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiKeyClient) List(ctx context.Context, in *ApiKeyListRequest, opts ...grpc.CallOption) (*ApiKeyListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiKeyListResponse)
+	err := c.cc.Invoke(ctx, ApiKey_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiKeyClient) Revoke(ctx context.Context, in *ApiKeyRevokeRequest, opts ...grpc.CallOption) (*ApiKeyRevokeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiKeyRevokeResponse)
+	err := c.cc.Invoke(ctx, ApiKey_Revoke_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ApiKeyServer is the server API for ApiKey service.
+// All implementations must embed UnimplementedApiKeyServer
+// for forward compatibility.
+type ApiKeyServer interface {
+	Create(context.Context, *ApiKeyCreateRequest) (*ApiKeyCreateResponse, error)
+	List(context.Context, *ApiKeyListRequest) (*ApiKeyListResponse, error)
+	Revoke(context.Context, *ApiKeyRevokeRequest) (*ApiKeyRevokeResponse, error)
+	mustEmbedUnimplementedApiKeyServer()
+}
+
+// UnimplementedApiKeyServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedApiKeyServer struct{}
+
+func (UnimplementedApiKeyServer) Create(context.Context, *ApiKeyCreateRequest) (*ApiKeyCreateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedApiKeyServer) List(context.Context, *ApiKeyListRequest) (*ApiKeyListResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedApiKeyServer) Revoke(context.Context, *ApiKeyRevokeRequest) (*ApiKeyRevokeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Revoke not implemented")
+}
+func (UnimplementedApiKeyServer) mustEmbedUnimplementedApiKeyServer() {}
+func (UnimplementedApiKeyServer) testEmbeddedByValue()                {}
+
+// UnsafeApiKeyServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ApiKeyServer will
+// result in compilation errors.
+type UnsafeApiKeyServer interface {
+	mustEmbedUnimplementedApiKeyServer()
+}
+
+func RegisterApiKeyServer(s grpc.ServiceRegistrar, srv ApiKeyServer) {
+	// If the following call panics, it indicates UnimplementedApiKeyServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ApiKey_ServiceDesc, srv)
+}
+
+func _ApiKey_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiKeyCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiKeyServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiKey_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiKeyServer).Create(ctx, req.(*ApiKeyCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiKey_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiKeyListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiKeyServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiKey_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiKeyServer).List(ctx, req.(*ApiKeyListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiKey_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiKeyRevokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiKeyServer).Revoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiKey_Revoke_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiKeyServer).Revoke(ctx, req.(*ApiKeyRevokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ApiKey_ServiceDesc is the grpc.ServiceDesc for ApiKey service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ApiKey_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ApiKey",
+	HandlerType: (*ApiKeyServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _ApiKey_Create_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _ApiKey_List_Handler,
+		},
+		{
+			MethodName: "Revoke",
+			Handler:    _ApiKey_Revoke_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "apikey.proto",
+}
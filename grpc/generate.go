@@ -1,3 +1,3 @@
 package grpc
 
-//go:generate protoc  --go_out=. --go-grpc_out=. --proto_path=./schema --go_opt=paths=source_relative --go-grpc_opt=paths=source_relative manga.proto history.proto tag.proto types.proto maintenance.proto system.proto user.proto
+//go:generate protoc  --go_out=. --go-grpc_out=. --proto_path=./schema --go_opt=paths=source_relative --go-grpc_opt=paths=source_relative manga.proto history.proto tag.proto types.proto maintenance.proto system.proto user.proto search.proto savedsearch.proto auth.proto apikey.proto
@@ -27,6 +27,7 @@ type HistoryListRequest struct {
 	User          string                 `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
 	Page          int32                  `protobuf:"varint,2,opt,name=Page,proto3" json:"Page,omitempty"`
 	ItemPerPage   int32                  `protobuf:"varint,3,opt,name=ItemPerPage,proto3" json:"ItemPerPage,omitempty"`
+	Cursor        string                 `protobuf:"bytes,4,opt,name=Cursor,proto3" json:"Cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -82,10 +83,18 @@ func (x *HistoryListRequest) GetItemPerPage() int32 {
 	return 0
 }
 
+func (x *HistoryListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 type HistoryListResponse struct {
 	state         protoimpl.MessageState     `protogen:"open.v1"`
 	TotalPage     int32                      `protobuf:"varint,1,opt,name=TotalPage,proto3" json:"TotalPage,omitempty"`
 	Items         []*HistoryListResponseItem `protobuf:"bytes,2,rep,name=Items,proto3" json:"Items,omitempty"`
+	NextCursor    string                     `protobuf:"bytes,3,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -134,6 +143,13 @@ func (x *HistoryListResponse) GetItems() []*HistoryListResponseItem {
 	return nil
 }
 
+func (x *HistoryListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type HistoryListResponseItem struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             int32                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
@@ -230,14 +246,18 @@ var File_history_proto protoreflect.FileDescriptor
 
 const file_history_proto_rawDesc = "" +
 	"\n" +
-	"\rhistory.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"^\n" +
+	"\rhistory.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"v\n" +
 	"\x12HistoryListRequest\x12\x12\n" +
 	"\x04User\x18\x01 \x01(\tR\x04User\x12\x12\n" +
 	"\x04Page\x18\x02 \x01(\x05R\x04Page\x12 \n" +
-	"\vItemPerPage\x18\x03 \x01(\x05R\vItemPerPage\"c\n" +
+	"\vItemPerPage\x18\x03 \x01(\x05R\vItemPerPage\x12\x16\n" +
+	"\x06Cursor\x18\x04 \x01(\tR\x06Cursor\"\x83\x01\n" +
 	"\x13HistoryListResponse\x12\x1c\n" +
 	"\tTotalPage\x18\x01 \x01(\x05R\tTotalPage\x12.\n" +
-	"\x05Items\x18\x02 \x03(\v2\x18.HistoryListResponseItemR\x05Items\"\xf7\x01\n" +
+	"\x05Items\x18\x02 \x03(\v2\x18.HistoryListResponseItemR\x05Items\x12\x1e\n" +
+	"\n" +
+	"NextCursor\x18\x03 \x01(\tR\n" +
+	"NextCursor\"\xf7\x01\n" +
 	"\x17HistoryListResponseItem\x12\x0e\n" +
 	"\x02Id\x18\x01 \x01(\x05R\x02Id\x12\x12\n" +
 	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x1e\n" +
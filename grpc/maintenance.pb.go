@@ -261,6 +261,86 @@ func (x *MaintenancePopulateTagsResponse) GetIsSuccess() bool {
 	return false
 }
 
+type MaintenanceRebuildSearchIndexRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaintenanceRebuildSearchIndexRequest) Reset() {
+	*x = MaintenanceRebuildSearchIndexRequest{}
+	mi := &file_maintenance_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceRebuildSearchIndexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceRebuildSearchIndexRequest) ProtoMessage() {}
+
+func (x *MaintenanceRebuildSearchIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_maintenance_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceRebuildSearchIndexRequest.ProtoReflect.Descriptor instead.
+func (*MaintenanceRebuildSearchIndexRequest) Descriptor() ([]byte, []int) {
+	return file_maintenance_proto_rawDescGZIP(), []int{6}
+}
+
+type MaintenanceRebuildSearchIndexResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsSuccess     bool                   `protobuf:"varint,1,opt,name=IsSuccess,proto3" json:"IsSuccess,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaintenanceRebuildSearchIndexResponse) Reset() {
+	*x = MaintenanceRebuildSearchIndexResponse{}
+	mi := &file_maintenance_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceRebuildSearchIndexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceRebuildSearchIndexResponse) ProtoMessage() {}
+
+func (x *MaintenanceRebuildSearchIndexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_maintenance_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceRebuildSearchIndexResponse.ProtoReflect.Descriptor instead.
+func (*MaintenanceRebuildSearchIndexResponse) Descriptor() ([]byte, []int) {
+	return file_maintenance_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MaintenanceRebuildSearchIndexResponse) GetIsSuccess() bool {
+	if x != nil {
+		return x.IsSuccess
+	}
+	return false
+}
+
 var File_maintenance_proto protoreflect.FileDescriptor
 
 const file_maintenance_proto_rawDesc = "" +
@@ -274,12 +354,16 @@ const file_maintenance_proto_rawDesc = "" +
 	"\tIsSuccess\x18\x01 \x01(\bR\tIsSuccess\" \n" +
 	"\x1eMaintenancePopulateTagsRequest\"?\n" +
 	"\x1fMaintenancePopulateTagsResponse\x12\x1c\n" +
-	"\tIsSuccess\x18\x01 \x01(\bR\tIsSuccess2\x89\x02\n" +
+	"\tIsSuccess\x18\x01 \x01(\bR\tIsSuccess\"&\n" +
+	"$MaintenanceRebuildSearchIndexRequest\"E\n" +
+	"%MaintenanceRebuildSearchIndexResponse\x12\x1c\n" +
+	"\tIsSuccess\x18\x01 \x01(\bR\tIsSuccess2\xf0\x02\n" +
 	"\vMaintenance\x12M\n" +
 	"\n" +
 	"PurgeCache\x12\x1d.MaintenancePurgeCacheRequest\x1a\x1e.MaintenancePurgeCacheResponse\"\x00\x12V\n" +
 	"\rUpdateLibrary\x12 .MaintenanceUpdateLibraryRequest\x1a!.MaintenanceUpdateLibraryResponse\"\x00\x12S\n" +
-	"\fPopulateTags\x12\x1f.MaintenancePopulateTagsRequest\x1a .MaintenancePopulateTagsResponse\"\x00B-Z+github.com/mangaweb4/mangaweb4-backend/grpcb\x06proto3"
+	"\fPopulateTags\x12\x1f.MaintenancePopulateTagsRequest\x1a .MaintenancePopulateTagsResponse\"\x00\x12e\n" +
+	"\x12RebuildSearchIndex\x12%.MaintenanceRebuildSearchIndexRequest\x1a&.MaintenanceRebuildSearchIndexResponse\"\x00B-Z+github.com/mangaweb4/mangaweb4-backend/grpcb\x06proto3"
 
 var (
 	file_maintenance_proto_rawDescOnce sync.Once
@@ -293,24 +377,28 @@ func file_maintenance_proto_rawDescGZIP() []byte {
 	return file_maintenance_proto_rawDescData
 }
 
-var file_maintenance_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_maintenance_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_maintenance_proto_goTypes = []any{
-	(*MaintenancePurgeCacheRequest)(nil),     // 0: MaintenancePurgeCacheRequest
-	(*MaintenancePurgeCacheResponse)(nil),    // 1: MaintenancePurgeCacheResponse
-	(*MaintenanceUpdateLibraryRequest)(nil),  // 2: MaintenanceUpdateLibraryRequest
-	(*MaintenanceUpdateLibraryResponse)(nil), // 3: MaintenanceUpdateLibraryResponse
-	(*MaintenancePopulateTagsRequest)(nil),   // 4: MaintenancePopulateTagsRequest
-	(*MaintenancePopulateTagsResponse)(nil),  // 5: MaintenancePopulateTagsResponse
+	(*MaintenancePurgeCacheRequest)(nil),          // 0: MaintenancePurgeCacheRequest
+	(*MaintenancePurgeCacheResponse)(nil),         // 1: MaintenancePurgeCacheResponse
+	(*MaintenanceUpdateLibraryRequest)(nil),       // 2: MaintenanceUpdateLibraryRequest
+	(*MaintenanceUpdateLibraryResponse)(nil),      // 3: MaintenanceUpdateLibraryResponse
+	(*MaintenancePopulateTagsRequest)(nil),        // 4: MaintenancePopulateTagsRequest
+	(*MaintenancePopulateTagsResponse)(nil),       // 5: MaintenancePopulateTagsResponse
+	(*MaintenanceRebuildSearchIndexRequest)(nil),  // 6: MaintenanceRebuildSearchIndexRequest
+	(*MaintenanceRebuildSearchIndexResponse)(nil), // 7: MaintenanceRebuildSearchIndexResponse
 }
 var file_maintenance_proto_depIdxs = []int32{
 	0, // 0: Maintenance.PurgeCache:input_type -> MaintenancePurgeCacheRequest
 	2, // 1: Maintenance.UpdateLibrary:input_type -> MaintenanceUpdateLibraryRequest
 	4, // 2: Maintenance.PopulateTags:input_type -> MaintenancePopulateTagsRequest
-	1, // 3: Maintenance.PurgeCache:output_type -> MaintenancePurgeCacheResponse
-	3, // 4: Maintenance.UpdateLibrary:output_type -> MaintenanceUpdateLibraryResponse
-	5, // 5: Maintenance.PopulateTags:output_type -> MaintenancePopulateTagsResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
+	6, // 3: Maintenance.RebuildSearchIndex:input_type -> MaintenanceRebuildSearchIndexRequest
+	1, // 4: Maintenance.PurgeCache:output_type -> MaintenancePurgeCacheResponse
+	3, // 5: Maintenance.UpdateLibrary:output_type -> MaintenanceUpdateLibraryResponse
+	5, // 6: Maintenance.PopulateTags:output_type -> MaintenancePopulateTagsResponse
+	7, // 7: Maintenance.RebuildSearchIndex:output_type -> MaintenanceRebuildSearchIndexResponse
+	4, // [4:8] is the sub-list for method output_type
+	0, // [0:4] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -327,7 +415,7 @@ func file_maintenance_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_maintenance_proto_rawDesc), len(file_maintenance_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
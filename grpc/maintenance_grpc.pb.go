@@ -19,9 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Maintenance_PurgeCache_FullMethodName    = "/Maintenance/PurgeCache"
-	Maintenance_UpdateLibrary_FullMethodName = "/Maintenance/UpdateLibrary"
-	Maintenance_PopulateTags_FullMethodName  = "/Maintenance/PopulateTags"
+	Maintenance_PurgeCache_FullMethodName         = "/Maintenance/PurgeCache"
+	Maintenance_UpdateLibrary_FullMethodName      = "/Maintenance/UpdateLibrary"
+	Maintenance_PopulateTags_FullMethodName       = "/Maintenance/PopulateTags"
+	Maintenance_RebuildSearchIndex_FullMethodName = "/Maintenance/RebuildSearchIndex"
 )
 
 // MaintenanceClient is the client API for Maintenance service.
@@ -31,6 +32,7 @@ type MaintenanceClient interface {
 	PurgeCache(ctx context.Context, in *MaintenancePurgeCacheRequest, opts ...grpc.CallOption) (*MaintenancePurgeCacheResponse, error)
 	UpdateLibrary(ctx context.Context, in *MaintenanceUpdateLibraryRequest, opts ...grpc.CallOption) (*MaintenanceUpdateLibraryResponse, error)
 	PopulateTags(ctx context.Context, in *MaintenancePopulateTagsRequest, opts ...grpc.CallOption) (*MaintenancePopulateTagsResponse, error)
+	RebuildSearchIndex(ctx context.Context, in *MaintenanceRebuildSearchIndexRequest, opts ...grpc.CallOption) (*MaintenanceRebuildSearchIndexResponse, error)
 }
 
 type maintenanceClient struct {
@@ -71,6 +73,16 @@ func (c *maintenanceClient) PopulateTags(ctx context.Context, in *MaintenancePop
 	return out, nil
 }
 
+func (c *maintenanceClient) RebuildSearchIndex(ctx context.Context, in *MaintenanceRebuildSearchIndexRequest, opts ...grpc.CallOption) (*MaintenanceRebuildSearchIndexResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MaintenanceRebuildSearchIndexResponse)
+	err := c.cc.Invoke(ctx, Maintenance_RebuildSearchIndex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MaintenanceServer is the server API for Maintenance service.
 // All implementations must embed UnimplementedMaintenanceServer
 // for forward compatibility.
@@ -78,6 +90,7 @@ type MaintenanceServer interface {
 	PurgeCache(context.Context, *MaintenancePurgeCacheRequest) (*MaintenancePurgeCacheResponse, error)
 	UpdateLibrary(context.Context, *MaintenanceUpdateLibraryRequest) (*MaintenanceUpdateLibraryResponse, error)
 	PopulateTags(context.Context, *MaintenancePopulateTagsRequest) (*MaintenancePopulateTagsResponse, error)
+	RebuildSearchIndex(context.Context, *MaintenanceRebuildSearchIndexRequest) (*MaintenanceRebuildSearchIndexResponse, error)
 	mustEmbedUnimplementedMaintenanceServer()
 }
 
@@ -97,6 +110,9 @@ func (UnimplementedMaintenanceServer) UpdateLibrary(context.Context, *Maintenanc
 func (UnimplementedMaintenanceServer) PopulateTags(context.Context, *MaintenancePopulateTagsRequest) (*MaintenancePopulateTagsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method PopulateTags not implemented")
 }
+func (UnimplementedMaintenanceServer) RebuildSearchIndex(context.Context, *MaintenanceRebuildSearchIndexRequest) (*MaintenanceRebuildSearchIndexResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RebuildSearchIndex not implemented")
+}
 func (UnimplementedMaintenanceServer) mustEmbedUnimplementedMaintenanceServer() {}
 func (UnimplementedMaintenanceServer) testEmbeddedByValue()                     {}
 
@@ -172,6 +188,24 @@ func _Maintenance_PopulateTags_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Maintenance_RebuildSearchIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaintenanceRebuildSearchIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServer).RebuildSearchIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Maintenance_RebuildSearchIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServer).RebuildSearchIndex(ctx, req.(*MaintenanceRebuildSearchIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Maintenance_ServiceDesc is the grpc.ServiceDesc for Maintenance service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +225,10 @@ var Maintenance_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PopulateTags",
 			Handler:    _Maintenance_PopulateTags_Handler,
 		},
+		{
+			MethodName: "RebuildSearchIndex",
+			Handler:    _Maintenance_RebuildSearchIndex_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "maintenance.proto",
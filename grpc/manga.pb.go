@@ -22,16 +22,25 @@ const (
 )
 
 type MangaListRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          string                 `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
-	Filter        Filter                 `protobuf:"varint,3,opt,name=Filter,proto3,enum=mangaweb4.types.Filter" json:"Filter,omitempty"`
-	Page          int32                  `protobuf:"varint,4,opt,name=Page,proto3" json:"Page,omitempty"`
-	ItemPerPage   int32                  `protobuf:"varint,5,opt,name=ItemPerPage,proto3" json:"ItemPerPage,omitempty"`
-	Search        string                 `protobuf:"bytes,6,opt,name=Search,proto3" json:"Search,omitempty"`
-	Sort          SortField              `protobuf:"varint,7,opt,name=Sort,proto3,enum=mangaweb4.types.SortField" json:"Sort,omitempty"`
-	Order         SortOrder              `protobuf:"varint,8,opt,name=Order,proto3,enum=mangaweb4.types.SortOrder" json:"Order,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	User             string                 `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
+	Filter           Filter                 `protobuf:"varint,3,opt,name=Filter,proto3,enum=mangaweb4.types.Filter" json:"Filter,omitempty"`
+	Page             int32                  `protobuf:"varint,4,opt,name=Page,proto3" json:"Page,omitempty"`
+	ItemPerPage      int32                  `protobuf:"varint,5,opt,name=ItemPerPage,proto3" json:"ItemPerPage,omitempty"`
+	Search           string                 `protobuf:"bytes,6,opt,name=Search,proto3" json:"Search,omitempty"`
+	Sort             SortField              `protobuf:"varint,7,opt,name=Sort,proto3,enum=mangaweb4.types.SortField" json:"Sort,omitempty"`
+	Order            SortOrder              `protobuf:"varint,8,opt,name=Order,proto3,enum=mangaweb4.types.SortOrder" json:"Order,omitempty"`
+	Series           string                 `protobuf:"bytes,9,opt,name=Series,proto3" json:"Series,omitempty"`
+	IncludeTags      []int32                `protobuf:"varint,10,rep,packed,name=IncludeTags,proto3" json:"IncludeTags,omitempty"`
+	TagMatch         TagMatch               `protobuf:"varint,11,opt,name=TagMatch,proto3,enum=mangaweb4.types.TagMatch" json:"TagMatch,omitempty"`
+	ExcludeTags      []int32                `protobuf:"varint,12,rep,packed,name=ExcludeTags,proto3" json:"ExcludeTags,omitempty"`
+	RandomSeed       int64                  `protobuf:"varint,13,opt,name=RandomSeed,proto3" json:"RandomSeed,omitempty"`
+	Cursor           string                 `protobuf:"bytes,14,opt,name=Cursor,proto3" json:"Cursor,omitempty"`
+	Query            string                 `protobuf:"bytes,15,opt,name=Query,proto3" json:"Query,omitempty"`
+	IncludeFacets    bool                   `protobuf:"varint,16,opt,name=IncludeFacets,proto3" json:"IncludeFacets,omitempty"`
+	UsePreferredSort bool                   `protobuf:"varint,17,opt,name=UsePreferredSort,proto3" json:"UsePreferredSort,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *MangaListRequest) Reset() {
@@ -113,10 +122,75 @@ func (x *MangaListRequest) GetOrder() SortOrder {
 	return SortOrder_SORT_ORDER_ASCENDING
 }
 
+func (x *MangaListRequest) GetSeries() string {
+	if x != nil {
+		return x.Series
+	}
+	return ""
+}
+
+func (x *MangaListRequest) GetIncludeTags() []int32 {
+	if x != nil {
+		return x.IncludeTags
+	}
+	return nil
+}
+
+func (x *MangaListRequest) GetTagMatch() TagMatch {
+	if x != nil {
+		return x.TagMatch
+	}
+	return TagMatch_TAG_MATCH_ALL
+}
+
+func (x *MangaListRequest) GetExcludeTags() []int32 {
+	if x != nil {
+		return x.ExcludeTags
+	}
+	return nil
+}
+
+func (x *MangaListRequest) GetRandomSeed() int64 {
+	if x != nil {
+		return x.RandomSeed
+	}
+	return 0
+}
+
+func (x *MangaListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *MangaListRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *MangaListRequest) GetIncludeFacets() bool {
+	if x != nil {
+		return x.IncludeFacets
+	}
+	return false
+}
+
+func (x *MangaListRequest) GetUsePreferredSort() bool {
+	if x != nil {
+		return x.UsePreferredSort
+	}
+	return false
+}
+
 type MangaListResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	TotalPage     int32                    `protobuf:"varint,2,opt,name=TotalPage,proto3" json:"TotalPage,omitempty"`
-	Items         []*MangaListResponseItem `protobuf:"bytes,3,rep,name=Items,proto3" json:"Items,omitempty"`
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	TotalPage     int32                        `protobuf:"varint,2,opt,name=TotalPage,proto3" json:"TotalPage,omitempty"`
+	Items         []*MangaListResponseItem     `protobuf:"bytes,3,rep,name=Items,proto3" json:"Items,omitempty"`
+	TagFacets     []*MangaListResponseTagFacet `protobuf:"bytes,4,rep,name=TagFacets,proto3" json:"TagFacets,omitempty"`
+	NextCursor    string                       `protobuf:"bytes,5,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -165,6 +239,20 @@ func (x *MangaListResponse) GetItems() []*MangaListResponseItem {
 	return nil
 }
 
+func (x *MangaListResponse) GetTagFacets() []*MangaListResponseTagFacet {
+	if x != nil {
+		return x.TagFacets
+	}
+	return nil
+}
+
+func (x *MangaListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type MangaListResponseItem struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             int32                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
@@ -175,6 +263,10 @@ type MangaListResponseItem struct {
 	HasFavoriteTag bool                   `protobuf:"varint,6,opt,name=HasFavoriteTag,proto3" json:"HasFavoriteTag,omitempty"`
 	CurrentPage    int32                  `protobuf:"varint,7,opt,name=CurrentPage,proto3" json:"CurrentPage,omitempty"`
 	MaxProgress    int32                  `protobuf:"varint,8,opt,name=MaxProgress,proto3" json:"MaxProgress,omitempty"`
+	Series         string                 `protobuf:"bytes,9,opt,name=Series,proto3" json:"Series,omitempty"`
+	Volume         float64                `protobuf:"fixed64,10,opt,name=Volume,proto3" json:"Volume,omitempty"`
+	Chapter        float64                `protobuf:"fixed64,11,opt,name=Chapter,proto3" json:"Chapter,omitempty"`
+	IsHidden       bool                   `protobuf:"varint,12,opt,name=IsHidden,proto3" json:"IsHidden,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -265,6 +357,34 @@ func (x *MangaListResponseItem) GetMaxProgress() int32 {
 	return 0
 }
 
+func (x *MangaListResponseItem) GetSeries() string {
+	if x != nil {
+		return x.Series
+	}
+	return ""
+}
+
+func (x *MangaListResponseItem) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *MangaListResponseItem) GetChapter() float64 {
+	if x != nil {
+		return x.Chapter
+	}
+	return 0
+}
+
+func (x *MangaListResponseItem) GetIsHidden() bool {
+	if x != nil {
+		return x.IsHidden
+	}
+	return false
+}
+
 type MangaThumbnailRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,2,opt,name=Id,proto3" json:"Id,omitempty"`
@@ -420,6 +540,12 @@ type MangaDetailResponse struct {
 	PageCount     int32                         `protobuf:"varint,3,opt,name=PageCount,proto3" json:"PageCount,omitempty"`
 	CurrentPage   int32                         `protobuf:"varint,4,opt,name=CurrentPage,proto3" json:"CurrentPage,omitempty"`
 	Tags          []*MangaDetailResponseTagItem `protobuf:"bytes,5,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	Series        string                        `protobuf:"bytes,6,opt,name=Series,proto3" json:"Series,omitempty"`
+	Volume        float64                       `protobuf:"fixed64,7,opt,name=Volume,proto3" json:"Volume,omitempty"`
+	Chapter       float64                       `protobuf:"fixed64,8,opt,name=Chapter,proto3" json:"Chapter,omitempty"`
+	Artist        string                        `protobuf:"bytes,9,opt,name=Artist,proto3" json:"Artist,omitempty"`
+	Year          int32                         `protobuf:"varint,10,opt,name=Year,proto3" json:"Year,omitempty"`
+	AgeRating     int32                         `protobuf:"varint,11,opt,name=AgeRating,proto3" json:"AgeRating,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -489,12 +615,56 @@ func (x *MangaDetailResponse) GetTags() []*MangaDetailResponseTagItem {
 	return nil
 }
 
+func (x *MangaDetailResponse) GetSeries() string {
+	if x != nil {
+		return x.Series
+	}
+	return ""
+}
+
+func (x *MangaDetailResponse) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *MangaDetailResponse) GetChapter() float64 {
+	if x != nil {
+		return x.Chapter
+	}
+	return 0
+}
+
+func (x *MangaDetailResponse) GetArtist() string {
+	if x != nil {
+		return x.Artist
+	}
+	return ""
+}
+
+func (x *MangaDetailResponse) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *MangaDetailResponse) GetAgeRating() int32 {
+	if x != nil {
+		return x.AgeRating
+	}
+	return 0
+}
+
 type MangaDetailResponseTagItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
 	IsFavorite    bool                   `protobuf:"varint,3,opt,name=IsFavorite,proto3" json:"IsFavorite,omitempty"`
 	IsHidden      bool                   `protobuf:"varint,4,opt,name=IsHidden,proto3" json:"IsHidden,omitempty"`
+	Category      TagCategory            `protobuf:"varint,5,opt,name=Category,proto3,enum=mangaweb4.types.TagCategory" json:"Category,omitempty"`
+	Source        TagSource              `protobuf:"varint,6,opt,name=Source,proto3,enum=mangaweb4.types.TagSource" json:"Source,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -557,6 +727,20 @@ func (x *MangaDetailResponseTagItem) GetIsHidden() bool {
 	return false
 }
 
+func (x *MangaDetailResponseTagItem) GetCategory() TagCategory {
+	if x != nil {
+		return x.Category
+	}
+	return TagCategory_TAG_CATEGORY_UNSPECIFIED
+}
+
+func (x *MangaDetailResponseTagItem) GetSource() TagSource {
+	if x != nil {
+		return x.Source
+	}
+	return TagSource_TAG_SOURCE_UNSPECIFIED
+}
+
 type MangaSetFavoriteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          string                 `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
@@ -1321,115 +1505,792 @@ func (x *MangaDownloadResponse) GetSize() int32 {
 	return 0
 }
 
-var File_manga_proto protoreflect.FileDescriptor
+type MangaAddTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          string                 `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	TagId         int32                  `protobuf:"varint,3,opt,name=TagId,proto3" json:"TagId,omitempty"`
+	TagName       string                 `protobuf:"bytes,4,opt,name=TagName,proto3" json:"TagName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_manga_proto_rawDesc = "" +
-	"\n" +
-	"\vmanga.proto\x1a\vtypes.proto\"\x8d\x02\n" +
-	"\x10MangaListRequest\x12\x12\n" +
-	"\x04User\x18\x01 \x01(\tR\x04User\x12/\n" +
-	"\x06Filter\x18\x03 \x01(\x0e2\x17.mangaweb4.types.FilterR\x06Filter\x12\x12\n" +
-	"\x04Page\x18\x04 \x01(\x05R\x04Page\x12 \n" +
-	"\vItemPerPage\x18\x05 \x01(\x05R\vItemPerPage\x12\x16\n" +
-	"\x06Search\x18\x06 \x01(\tR\x06Search\x12.\n" +
-	"\x04Sort\x18\a \x01(\x0e2\x1a.mangaweb4.types.SortFieldR\x04Sort\x120\n" +
-	"\x05Order\x18\b \x01(\x0e2\x1a.mangaweb4.types.SortOrderR\x05OrderJ\x04\b\x02\x10\x03\"e\n" +
-	"\x11MangaListResponse\x12\x1c\n" +
-	"\tTotalPage\x18\x02 \x01(\x05R\tTotalPage\x12,\n" +
-	"\x05Items\x18\x03 \x03(\v2\x16.MangaListResponseItemR\x05ItemsJ\x04\b\x01\x10\x02\"\xfd\x01\n" +
-	"\x15MangaListResponseItem\x12\x0e\n" +
-	"\x02Id\x18\x01 \x01(\x05R\x02Id\x12\x12\n" +
-	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x1e\n" +
-	"\n" +
-	"IsFavorite\x18\x03 \x01(\bR\n" +
-	"IsFavorite\x12\x16\n" +
-	"\x06IsRead\x18\x04 \x01(\bR\x06IsRead\x12\x1c\n" +
-	"\tPageCount\x18\x05 \x01(\x05R\tPageCount\x12&\n" +
-	"\x0eHasFavoriteTag\x18\x06 \x01(\bR\x0eHasFavoriteTag\x12 \n" +
-	"\vCurrentPage\x18\a \x01(\x05R\vCurrentPage\x12 \n" +
-	"\vMaxProgress\x18\b \x01(\x05R\vMaxProgress\"-\n" +
-	"\x15MangaThumbnailRequest\x12\x0e\n" +
-	"\x02Id\x18\x02 \x01(\x05R\x02IdJ\x04\b\x01\x10\x02\"N\n" +
-	"\x16MangaThumbnailResponse\x12 \n" +
-	"\vContentType\x18\x01 \x01(\tR\vContentType\x12\x12\n" +
-	"\x04Data\x18\x02 \x01(\fR\x04Data\">\n" +
-	"\x12MangaDetailRequest\x12\x12\n" +
-	"\x04User\x18\x01 \x01(\tR\x04User\x12\x0e\n" +
-	"\x02Id\x18\x03 \x01(\x05R\x02IdJ\x04\b\x02\x10\x03\"\xb6\x01\n" +
-	"\x13MangaDetailResponse\x12\x12\n" +
-	"\x04Name\x18\x01 \x01(\tR\x04Name\x12\x1a\n" +
-	"\bFavorite\x18\x02 \x01(\bR\bFavorite\x12\x1c\n" +
-	"\tPageCount\x18\x03 \x01(\x05R\tPageCount\x12 \n" +
-	"\vCurrentPage\x18\x04 \x01(\x05R\vCurrentPage\x12/\n" +
-	"\x04Tags\x18\x05 \x03(\v2\x1b.MangaDetailResponseTagItemR\x04Tags\"|\n" +
-	"\x1aMangaDetailResponseTagItem\x12\x0e\n" +
-	"\x02Id\x18\x01 \x01(\x05R\x02Id\x12\x12\n" +
-	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x1e\n" +
-	"\n" +
-	"IsFavorite\x18\x03 \x01(\bR\n" +
-	"IsFavorite\x12\x1a\n" +
-	"\bIsHidden\x18\x04 \x01(\bR\bIsHidden\"_\n" +
-	"\x17MangaSetFavoriteRequest\x12\x12\n" +
-	"\x04User\x18\x01 \x01(\tR\x04User\x12\x1a\n" +
-	"\bFavorite\x18\x03 \x01(\bR\bFavorite\x12\x0e\n" +
-	"\x02Id\x18\x04 \x01(\x05R\x02IdJ\x04\b\x02\x10\x03\"J\n" +
-	"\x18MangaSetFavoriteResponse\x12\x12\n" +
-	"\x04Name\x18\x01 \x01(\tR\x04Name\x12\x1a\n" +
-	"\bFavorite\x18\x02 \x01(\bR\bFavorite\"W\n" +
-	"\x17MangaSetProgressRequest\x12\x12\n" +
-	"\x04User\x18\x01 \x01(\tR\x04User\x12\x12\n" +
-	"\x04Page\x18\x03 \x01(\x05R\x04Page\x12\x0e\n" +
-	"\x02Id\x18\x04 \x01(\x05R\x02IdJ\x04\b\x02\x10\x03\"p\n" +
-	"\x18MangaSetProgressResponse\x12\x12\n" +
-	"\x04User\x18\x01 \x01(\tR\x04User\x12\x12\n" +
-	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x12\n" +
-	"\x04Page\x18\x03 \x01(\x05R\x04Page\x12\x18\n" +
-	"\aSucceed\x18\x04 \x01(\bR\aSucceed\"\x8f\x01\n" +
-	"\x17MangaUpdateCoverRequest\x12\x14\n" +
-	"\x05Index\x18\x02 \x01(\x05R\x05Index\x12\f\n" +
-	"\x01X\x18\x03 \x01(\x05R\x01X\x12\f\n" +
-	"\x01Y\x18\x04 \x01(\x05R\x01Y\x12\x14\n" +
-	"\x05Width\x18\x05 \x01(\x05R\x05Width\x12\x16\n" +
-	"\x06Height\x18\x06 \x01(\x05R\x06Height\x12\x0e\n" +
-	"\x02Id\x18\a \x01(\x05R\x02IdJ\x04\b\x01\x10\x02\"4\n" +
-	"\x18MangaUpdateCoverResponse\x12\x18\n" +
-	"\aSuccess\x18\x01 \x01(\bR\aSuccess\"\x9c\x01\n" +
-	"\x15MangaPageImageRequest\x12\x12\n" +
-	"\x04User\x18\x01 \x01(\tR\x04User\x12\x14\n" +
-	"\x05Index\x18\x03 \x01(\x05R\x05Index\x12\x0e\n" +
-	"\x02Id\x18\x06 \x01(\x05R\x02Id\x127\n" +
-	"\aQuality\x18\a \x01(\x0e2\x1d.mangaweb4.types.ImageQualityR\aQualityJ\x04\b\x02\x10\x03J\x04\b\x04\x10\x05J\x04\b\x05\x10\x06\"N\n" +
-	"\x16MangaPageImageResponse\x12 \n" +
-	"\vContentType\x18\x01 \x01(\tR\vContentType\x12\x12\n" +
-	"\x04Data\x18\x02 \x01(\fR\x04Data\"\x84\x01\n" +
-	"\x1cMangaPageImageStreamResponse\x12\x1a\n" +
-	"\bFilename\x18\x01 \x01(\tR\bFilename\x12 \n" +
-	"\vContentType\x18\x02 \x01(\tR\vContentType\x12\x12\n" +
-	"\x04Data\x18\x03 \x01(\fR\x04Data\x12\x12\n" +
-	"\x04Size\x18\x04 \x01(\x05R\x04Size\"*\n" +
-	"\x12MangaRepairRequest\x12\x0e\n" +
-	"\x02Id\x18\x03 \x01(\x05R\x02IdJ\x04\b\x01\x10\x02\"G\n" +
-	"\x13MangaRepairResponse\x12\x12\n" +
-	"\x04Name\x18\x01 \x01(\tR\x04Name\x12\x1c\n" +
-	"\tIsSuccess\x18\x02 \x01(\bR\tIsSuccess\",\n" +
-	"\x14MangaDownloadRequest\x12\x0e\n" +
-	"\x02Id\x18\x02 \x01(\x05R\x02IdJ\x04\b\x01\x10\x02\"}\n" +
-	"\x15MangaDownloadResponse\x12\x1a\n" +
-	"\bFilename\x18\x01 \x01(\tR\bFilename\x12 \n" +
-	"\vContentType\x18\x02 \x01(\tR\vContentType\x12\x12\n" +
-	"\x04Data\x18\x03 \x01(\fR\x04Data\x12\x12\n" +
-	"\x04Size\x18\x04 \x01(\x05R\x04Size2\x88\x05\n" +
-	"\x05Manga\x12/\n" +
-	"\x04List\x12\x11.MangaListRequest\x1a\x12.MangaListResponse\"\x00\x125\n" +
-	"\x06Detail\x12\x13.MangaDetailRequest\x1a\x14.MangaDetailResponse\"\x00\x12>\n" +
-	"\tThumbnail\x12\x16.MangaThumbnailRequest\x1a\x17.MangaThumbnailResponse\"\x00\x12D\n" +
-	"\vSetFavorite\x12\x18.MangaSetFavoriteRequest\x1a\x19.MangaSetFavoriteResponse\"\x00\x12D\n" +
-	"\vSetProgress\x12\x18.MangaSetProgressRequest\x1a\x19.MangaSetProgressResponse\"\x00\x12D\n" +
-	"\vUpdateCover\x12\x18.MangaUpdateCoverRequest\x1a\x19.MangaUpdateCoverResponse\"\x00\x12A\n" +
-	"\tPageImage\x12\x16.MangaPageImageRequest\x1a\x17.MangaPageImageResponse\"\x03\x88\x02\x01\x12L\n" +
-	"\x0fPageImageStream\x12\x16.MangaPageImageRequest\x1a\x1d.MangaPageImageStreamResponse\"\x000\x01\x125\n" +
-	"\x06Repair\x12\x13.MangaRepairRequest\x1a\x14.MangaRepairResponse\"\x00\x12=\n" +
-	"\bDownload\x12\x15.MangaDownloadRequest\x1a\x16.MangaDownloadResponse\"\x000\x01B-Z+github.com/mangaweb4/mangaweb4-backend/grpcb\x06proto3"
+func (x *MangaAddTagRequest) Reset() {
+	*x = MangaAddTagRequest{}
+	mi := &file_manga_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MangaAddTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MangaAddTagRequest) ProtoMessage() {}
+
+func (x *MangaAddTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_manga_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MangaAddTagRequest.ProtoReflect.Descriptor instead.
+func (*MangaAddTagRequest) Descriptor() ([]byte, []int) {
+	return file_manga_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MangaAddTagRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *MangaAddTagRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MangaAddTagRequest) GetTagId() int32 {
+	if x != nil {
+		return x.TagId
+	}
+	return 0
+}
+
+func (x *MangaAddTagRequest) GetTagName() string {
+	if x != nil {
+		return x.TagName
+	}
+	return ""
+}
+
+type MangaAddTagResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Tags          []*MangaDetailResponseTagItem `protobuf:"bytes,1,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MangaAddTagResponse) Reset() {
+	*x = MangaAddTagResponse{}
+	mi := &file_manga_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MangaAddTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MangaAddTagResponse) ProtoMessage() {}
+
+func (x *MangaAddTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_manga_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MangaAddTagResponse.ProtoReflect.Descriptor instead.
+func (*MangaAddTagResponse) Descriptor() ([]byte, []int) {
+	return file_manga_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MangaAddTagResponse) GetTags() []*MangaDetailResponseTagItem {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type MangaRemoveTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          string                 `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	TagId         int32                  `protobuf:"varint,3,opt,name=TagId,proto3" json:"TagId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MangaRemoveTagRequest) Reset() {
+	*x = MangaRemoveTagRequest{}
+	mi := &file_manga_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MangaRemoveTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MangaRemoveTagRequest) ProtoMessage() {}
+
+func (x *MangaRemoveTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_manga_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MangaRemoveTagRequest.ProtoReflect.Descriptor instead.
+func (*MangaRemoveTagRequest) Descriptor() ([]byte, []int) {
+	return file_manga_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MangaRemoveTagRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *MangaRemoveTagRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MangaRemoveTagRequest) GetTagId() int32 {
+	if x != nil {
+		return x.TagId
+	}
+	return 0
+}
+
+type MangaRemoveTagResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Tags          []*MangaDetailResponseTagItem `protobuf:"bytes,1,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MangaRemoveTagResponse) Reset() {
+	*x = MangaRemoveTagResponse{}
+	mi := &file_manga_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MangaRemoveTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MangaRemoveTagResponse) ProtoMessage() {}
+
+func (x *MangaRemoveTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_manga_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MangaRemoveTagResponse.ProtoReflect.Descriptor instead.
+func (*MangaRemoveTagResponse) Descriptor() ([]byte, []int) {
+	return file_manga_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MangaRemoveTagResponse) GetTags() []*MangaDetailResponseTagItem {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type MangaSetHiddenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Hidden        bool                   `protobuf:"varint,2,opt,name=Hidden,proto3" json:"Hidden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MangaSetHiddenRequest) Reset() {
+	*x = MangaSetHiddenRequest{}
+	mi := &file_manga_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MangaSetHiddenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MangaSetHiddenRequest) ProtoMessage() {}
+
+func (x *MangaSetHiddenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_manga_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MangaSetHiddenRequest.ProtoReflect.Descriptor instead.
+func (*MangaSetHiddenRequest) Descriptor() ([]byte, []int) {
+	return file_manga_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MangaSetHiddenRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MangaSetHiddenRequest) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+type MangaSetHiddenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Hidden        bool                   `protobuf:"varint,2,opt,name=Hidden,proto3" json:"Hidden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MangaSetHiddenResponse) Reset() {
+	*x = MangaSetHiddenResponse{}
+	mi := &file_manga_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MangaSetHiddenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MangaSetHiddenResponse) ProtoMessage() {}
+
+func (x *MangaSetHiddenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_manga_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MangaSetHiddenResponse.ProtoReflect.Descriptor instead.
+func (*MangaSetHiddenResponse) Descriptor() ([]byte, []int) {
+	return file_manga_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MangaSetHiddenResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MangaSetHiddenResponse) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+type MangaSetBlockedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          string                 `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	Blocked       bool                   `protobuf:"varint,3,opt,name=Blocked,proto3" json:"Blocked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MangaSetBlockedRequest) Reset() {
+	*x = MangaSetBlockedRequest{}
+	mi := &file_manga_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MangaSetBlockedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MangaSetBlockedRequest) ProtoMessage() {}
+
+func (x *MangaSetBlockedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_manga_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MangaSetBlockedRequest.ProtoReflect.Descriptor instead.
+func (*MangaSetBlockedRequest) Descriptor() ([]byte, []int) {
+	return file_manga_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MangaSetBlockedRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *MangaSetBlockedRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MangaSetBlockedRequest) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+type MangaSetBlockedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Blocked       bool                   `protobuf:"varint,2,opt,name=Blocked,proto3" json:"Blocked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MangaSetBlockedResponse) Reset() {
+	*x = MangaSetBlockedResponse{}
+	mi := &file_manga_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MangaSetBlockedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MangaSetBlockedResponse) ProtoMessage() {}
+
+func (x *MangaSetBlockedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_manga_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MangaSetBlockedResponse.ProtoReflect.Descriptor instead.
+func (*MangaSetBlockedResponse) Descriptor() ([]byte, []int) {
+	return file_manga_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MangaSetBlockedResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MangaSetBlockedResponse) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+type MangaListResponseTagFacet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Category      TagCategory            `protobuf:"varint,3,opt,name=Category,proto3,enum=mangaweb4.types.TagCategory" json:"Category,omitempty"`
+	Count         int32                  `protobuf:"varint,4,opt,name=Count,proto3" json:"Count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MangaListResponseTagFacet) Reset() {
+	*x = MangaListResponseTagFacet{}
+	mi := &file_manga_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MangaListResponseTagFacet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MangaListResponseTagFacet) ProtoMessage() {}
+
+func (x *MangaListResponseTagFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_manga_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MangaListResponseTagFacet.ProtoReflect.Descriptor instead.
+func (*MangaListResponseTagFacet) Descriptor() ([]byte, []int) {
+	return file_manga_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *MangaListResponseTagFacet) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MangaListResponseTagFacet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MangaListResponseTagFacet) GetCategory() TagCategory {
+	if x != nil {
+		return x.Category
+	}
+	return TagCategory_TAG_CATEGORY_UNSPECIFIED
+}
+
+func (x *MangaListResponseTagFacet) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type MangaSetAgeRatingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	AgeRating     int32                  `protobuf:"varint,2,opt,name=AgeRating,proto3" json:"AgeRating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MangaSetAgeRatingRequest) Reset() {
+	*x = MangaSetAgeRatingRequest{}
+	mi := &file_manga_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MangaSetAgeRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MangaSetAgeRatingRequest) ProtoMessage() {}
+
+func (x *MangaSetAgeRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_manga_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MangaSetAgeRatingRequest.ProtoReflect.Descriptor instead.
+func (*MangaSetAgeRatingRequest) Descriptor() ([]byte, []int) {
+	return file_manga_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MangaSetAgeRatingRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MangaSetAgeRatingRequest) GetAgeRating() int32 {
+	if x != nil {
+		return x.AgeRating
+	}
+	return 0
+}
+
+type MangaSetAgeRatingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	AgeRating     int32                  `protobuf:"varint,2,opt,name=AgeRating,proto3" json:"AgeRating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MangaSetAgeRatingResponse) Reset() {
+	*x = MangaSetAgeRatingResponse{}
+	mi := &file_manga_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MangaSetAgeRatingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MangaSetAgeRatingResponse) ProtoMessage() {}
+
+func (x *MangaSetAgeRatingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_manga_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MangaSetAgeRatingResponse.ProtoReflect.Descriptor instead.
+func (*MangaSetAgeRatingResponse) Descriptor() ([]byte, []int) {
+	return file_manga_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MangaSetAgeRatingResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MangaSetAgeRatingResponse) GetAgeRating() int32 {
+	if x != nil {
+		return x.AgeRating
+	}
+	return 0
+}
+
+var File_manga_proto protoreflect.FileDescriptor
+
+const file_manga_proto_rawDesc = "" +
+	"\n" +
+	"\vmanga.proto\x1a\vtypes.proto\"\xc0\x04\n" +
+	"\x10MangaListRequest\x12\x12\n" +
+	"\x04User\x18\x01 \x01(\tR\x04User\x12/\n" +
+	"\x06Filter\x18\x03 \x01(\x0e2\x17.mangaweb4.types.FilterR\x06Filter\x12\x12\n" +
+	"\x04Page\x18\x04 \x01(\x05R\x04Page\x12 \n" +
+	"\vItemPerPage\x18\x05 \x01(\x05R\vItemPerPage\x12\x16\n" +
+	"\x06Search\x18\x06 \x01(\tR\x06Search\x12.\n" +
+	"\x04Sort\x18\a \x01(\x0e2\x1a.mangaweb4.types.SortFieldR\x04Sort\x120\n" +
+	"\x05Order\x18\b \x01(\x0e2\x1a.mangaweb4.types.SortOrderR\x05Order\x12\x16\n" +
+	"\x06Series\x18\t \x01(\tR\x06Series\x12 \n" +
+	"\vIncludeTags\x18\n" +
+	" \x03(\x05R\vIncludeTags\x125\n" +
+	"\bTagMatch\x18\v \x01(\x0e2\x19.mangaweb4.types.TagMatchR\bTagMatch\x12 \n" +
+	"\vExcludeTags\x18\f \x03(\x05R\vExcludeTags\x12\x1e\n" +
+	"\n" +
+	"RandomSeed\x18\r \x01(\x03R\n" +
+	"RandomSeed\x12\x16\n" +
+	"\x06Cursor\x18\x0e \x01(\tR\x06Cursor\x12\x14\n" +
+	"\x05Query\x18\x0f \x01(\tR\x05Query\x12$\n" +
+	"\rIncludeFacets\x18\x10 \x01(\bR\rIncludeFacets\x12*\n" +
+	"\x10UsePreferredSort\x18\x11 \x01(\bR\x10UsePreferredSortJ\x04\b\x02\x10\x03\"\xbf\x01\n" +
+	"\x11MangaListResponse\x12\x1c\n" +
+	"\tTotalPage\x18\x02 \x01(\x05R\tTotalPage\x12,\n" +
+	"\x05Items\x18\x03 \x03(\v2\x16.MangaListResponseItemR\x05Items\x128\n" +
+	"\tTagFacets\x18\x04 \x03(\v2\x1a.MangaListResponseTagFacetR\tTagFacets\x12\x1e\n" +
+	"\n" +
+	"NextCursor\x18\x05 \x01(\tR\n" +
+	"NextCursorJ\x04\b\x01\x10\x02\"\xe3\x02\n" +
+	"\x15MangaListResponseItem\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x05R\x02Id\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x1e\n" +
+	"\n" +
+	"IsFavorite\x18\x03 \x01(\bR\n" +
+	"IsFavorite\x12\x16\n" +
+	"\x06IsRead\x18\x04 \x01(\bR\x06IsRead\x12\x1c\n" +
+	"\tPageCount\x18\x05 \x01(\x05R\tPageCount\x12&\n" +
+	"\x0eHasFavoriteTag\x18\x06 \x01(\bR\x0eHasFavoriteTag\x12 \n" +
+	"\vCurrentPage\x18\a \x01(\x05R\vCurrentPage\x12 \n" +
+	"\vMaxProgress\x18\b \x01(\x05R\vMaxProgress\x12\x16\n" +
+	"\x06Series\x18\t \x01(\tR\x06Series\x12\x16\n" +
+	"\x06Volume\x18\n" +
+	" \x01(\x01R\x06Volume\x12\x18\n" +
+	"\aChapter\x18\v \x01(\x01R\aChapter\x12\x1a\n" +
+	"\bIsHidden\x18\f \x01(\bR\bIsHidden\"-\n" +
+	"\x15MangaThumbnailRequest\x12\x0e\n" +
+	"\x02Id\x18\x02 \x01(\x05R\x02IdJ\x04\b\x01\x10\x02\"N\n" +
+	"\x16MangaThumbnailResponse\x12 \n" +
+	"\vContentType\x18\x01 \x01(\tR\vContentType\x12\x12\n" +
+	"\x04Data\x18\x02 \x01(\fR\x04Data\">\n" +
+	"\x12MangaDetailRequest\x12\x12\n" +
+	"\x04User\x18\x01 \x01(\tR\x04User\x12\x0e\n" +
+	"\x02Id\x18\x03 \x01(\x05R\x02IdJ\x04\b\x02\x10\x03\"\xca\x02\n" +
+	"\x13MangaDetailResponse\x12\x12\n" +
+	"\x04Name\x18\x01 \x01(\tR\x04Name\x12\x1a\n" +
+	"\bFavorite\x18\x02 \x01(\bR\bFavorite\x12\x1c\n" +
+	"\tPageCount\x18\x03 \x01(\x05R\tPageCount\x12 \n" +
+	"\vCurrentPage\x18\x04 \x01(\x05R\vCurrentPage\x12/\n" +
+	"\x04Tags\x18\x05 \x03(\v2\x1b.MangaDetailResponseTagItemR\x04Tags\x12\x16\n" +
+	"\x06Series\x18\x06 \x01(\tR\x06Series\x12\x16\n" +
+	"\x06Volume\x18\a \x01(\x01R\x06Volume\x12\x18\n" +
+	"\aChapter\x18\b \x01(\x01R\aChapter\x12\x16\n" +
+	"\x06Artist\x18\t \x01(\tR\x06Artist\x12\x12\n" +
+	"\x04Year\x18\n" +
+	" \x01(\x05R\x04Year\x12\x1c\n" +
+	"\tAgeRating\x18\v \x01(\x05R\tAgeRating\"\xea\x01\n" +
+	"\x1aMangaDetailResponseTagItem\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x05R\x02Id\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x1e\n" +
+	"\n" +
+	"IsFavorite\x18\x03 \x01(\bR\n" +
+	"IsFavorite\x12\x1a\n" +
+	"\bIsHidden\x18\x04 \x01(\bR\bIsHidden\x128\n" +
+	"\bCategory\x18\x05 \x01(\x0e2\x1c.mangaweb4.types.TagCategoryR\bCategory\x122\n" +
+	"\x06Source\x18\x06 \x01(\x0e2\x1a.mangaweb4.types.TagSourceR\x06Source\"_\n" +
+	"\x17MangaSetFavoriteRequest\x12\x12\n" +
+	"\x04User\x18\x01 \x01(\tR\x04User\x12\x1a\n" +
+	"\bFavorite\x18\x03 \x01(\bR\bFavorite\x12\x0e\n" +
+	"\x02Id\x18\x04 \x01(\x05R\x02IdJ\x04\b\x02\x10\x03\"J\n" +
+	"\x18MangaSetFavoriteResponse\x12\x12\n" +
+	"\x04Name\x18\x01 \x01(\tR\x04Name\x12\x1a\n" +
+	"\bFavorite\x18\x02 \x01(\bR\bFavorite\"W\n" +
+	"\x17MangaSetProgressRequest\x12\x12\n" +
+	"\x04User\x18\x01 \x01(\tR\x04User\x12\x12\n" +
+	"\x04Page\x18\x03 \x01(\x05R\x04Page\x12\x0e\n" +
+	"\x02Id\x18\x04 \x01(\x05R\x02IdJ\x04\b\x02\x10\x03\"p\n" +
+	"\x18MangaSetProgressResponse\x12\x12\n" +
+	"\x04User\x18\x01 \x01(\tR\x04User\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x12\n" +
+	"\x04Page\x18\x03 \x01(\x05R\x04Page\x12\x18\n" +
+	"\aSucceed\x18\x04 \x01(\bR\aSucceed\"\x8f\x01\n" +
+	"\x17MangaUpdateCoverRequest\x12\x14\n" +
+	"\x05Index\x18\x02 \x01(\x05R\x05Index\x12\f\n" +
+	"\x01X\x18\x03 \x01(\x05R\x01X\x12\f\n" +
+	"\x01Y\x18\x04 \x01(\x05R\x01Y\x12\x14\n" +
+	"\x05Width\x18\x05 \x01(\x05R\x05Width\x12\x16\n" +
+	"\x06Height\x18\x06 \x01(\x05R\x06Height\x12\x0e\n" +
+	"\x02Id\x18\a \x01(\x05R\x02IdJ\x04\b\x01\x10\x02\"4\n" +
+	"\x18MangaUpdateCoverResponse\x12\x18\n" +
+	"\aSuccess\x18\x01 \x01(\bR\aSuccess\"\x9c\x01\n" +
+	"\x15MangaPageImageRequest\x12\x12\n" +
+	"\x04User\x18\x01 \x01(\tR\x04User\x12\x14\n" +
+	"\x05Index\x18\x03 \x01(\x05R\x05Index\x12\x0e\n" +
+	"\x02Id\x18\x06 \x01(\x05R\x02Id\x127\n" +
+	"\aQuality\x18\a \x01(\x0e2\x1d.mangaweb4.types.ImageQualityR\aQualityJ\x04\b\x02\x10\x03J\x04\b\x04\x10\x05J\x04\b\x05\x10\x06\"N\n" +
+	"\x16MangaPageImageResponse\x12 \n" +
+	"\vContentType\x18\x01 \x01(\tR\vContentType\x12\x12\n" +
+	"\x04Data\x18\x02 \x01(\fR\x04Data\"\x84\x01\n" +
+	"\x1cMangaPageImageStreamResponse\x12\x1a\n" +
+	"\bFilename\x18\x01 \x01(\tR\bFilename\x12 \n" +
+	"\vContentType\x18\x02 \x01(\tR\vContentType\x12\x12\n" +
+	"\x04Data\x18\x03 \x01(\fR\x04Data\x12\x12\n" +
+	"\x04Size\x18\x04 \x01(\x05R\x04Size\"*\n" +
+	"\x12MangaRepairRequest\x12\x0e\n" +
+	"\x02Id\x18\x03 \x01(\x05R\x02IdJ\x04\b\x01\x10\x02\"G\n" +
+	"\x13MangaRepairResponse\x12\x12\n" +
+	"\x04Name\x18\x01 \x01(\tR\x04Name\x12\x1c\n" +
+	"\tIsSuccess\x18\x02 \x01(\bR\tIsSuccess\",\n" +
+	"\x14MangaDownloadRequest\x12\x0e\n" +
+	"\x02Id\x18\x02 \x01(\x05R\x02IdJ\x04\b\x01\x10\x02\"}\n" +
+	"\x15MangaDownloadResponse\x12\x1a\n" +
+	"\bFilename\x18\x01 \x01(\tR\bFilename\x12 \n" +
+	"\vContentType\x18\x02 \x01(\tR\vContentType\x12\x12\n" +
+	"\x04Data\x18\x03 \x01(\fR\x04Data\x12\x12\n" +
+	"\x04Size\x18\x04 \x01(\x05R\x04Size\"h\n" +
+	"\x12MangaAddTagRequest\x12\x12\n" +
+	"\x04User\x18\x01 \x01(\tR\x04User\x12\x0e\n" +
+	"\x02Id\x18\x02 \x01(\x05R\x02Id\x12\x14\n" +
+	"\x05TagId\x18\x03 \x01(\x05R\x05TagId\x12\x18\n" +
+	"\aTagName\x18\x04 \x01(\tR\aTagName\"F\n" +
+	"\x13MangaAddTagResponse\x12/\n" +
+	"\x04Tags\x18\x01 \x03(\v2\x1b.MangaDetailResponseTagItemR\x04Tags\"Q\n" +
+	"\x15MangaRemoveTagRequest\x12\x12\n" +
+	"\x04User\x18\x01 \x01(\tR\x04User\x12\x0e\n" +
+	"\x02Id\x18\x02 \x01(\x05R\x02Id\x12\x14\n" +
+	"\x05TagId\x18\x03 \x01(\x05R\x05TagId\"I\n" +
+	"\x16MangaRemoveTagResponse\x12/\n" +
+	"\x04Tags\x18\x01 \x03(\v2\x1b.MangaDetailResponseTagItemR\x04Tags\"?\n" +
+	"\x15MangaSetHiddenRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x05R\x02Id\x12\x16\n" +
+	"\x06Hidden\x18\x02 \x01(\bR\x06Hidden\"D\n" +
+	"\x16MangaSetHiddenResponse\x12\x12\n" +
+	"\x04Name\x18\x01 \x01(\tR\x04Name\x12\x16\n" +
+	"\x06Hidden\x18\x02 \x01(\bR\x06Hidden\"V\n" +
+	"\x16MangaSetBlockedRequest\x12\x12\n" +
+	"\x04User\x18\x01 \x01(\tR\x04User\x12\x0e\n" +
+	"\x02Id\x18\x02 \x01(\x05R\x02Id\x12\x18\n" +
+	"\aBlocked\x18\x03 \x01(\bR\aBlocked\"G\n" +
+	"\x17MangaSetBlockedResponse\x12\x12\n" +
+	"\x04Name\x18\x01 \x01(\tR\x04Name\x12\x18\n" +
+	"\aBlocked\x18\x02 \x01(\bR\aBlocked\"\x8f\x01\n" +
+	"\x19MangaListResponseTagFacet\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x05R\x02Id\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x128\n" +
+	"\bCategory\x18\x03 \x01(\x0e2\x1c.mangaweb4.types.TagCategoryR\bCategory\x12\x14\n" +
+	"\x05Count\x18\x04 \x01(\x05R\x05Count\"H\n" +
+	"\x18MangaSetAgeRatingRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x05R\x02Id\x12\x1c\n" +
+	"\tAgeRating\x18\x02 \x01(\x05R\tAgeRating\"M\n" +
+	"\x19MangaSetAgeRatingResponse\x12\x12\n" +
+	"\x04Name\x18\x01 \x01(\tR\x04Name\x12\x1c\n" +
+	"\tAgeRating\x18\x02 \x01(\x05R\tAgeRating2\xcb\a\n" +
+	"\x05Manga\x12/\n" +
+	"\x04List\x12\x11.MangaListRequest\x1a\x12.MangaListResponse\"\x00\x125\n" +
+	"\x06Detail\x12\x13.MangaDetailRequest\x1a\x14.MangaDetailResponse\"\x00\x12>\n" +
+	"\tThumbnail\x12\x16.MangaThumbnailRequest\x1a\x17.MangaThumbnailResponse\"\x00\x12D\n" +
+	"\vSetFavorite\x12\x18.MangaSetFavoriteRequest\x1a\x19.MangaSetFavoriteResponse\"\x00\x12D\n" +
+	"\vSetProgress\x12\x18.MangaSetProgressRequest\x1a\x19.MangaSetProgressResponse\"\x00\x12D\n" +
+	"\vUpdateCover\x12\x18.MangaUpdateCoverRequest\x1a\x19.MangaUpdateCoverResponse\"\x00\x12A\n" +
+	"\tPageImage\x12\x16.MangaPageImageRequest\x1a\x17.MangaPageImageResponse\"\x03\x88\x02\x01\x12L\n" +
+	"\x0fPageImageStream\x12\x16.MangaPageImageRequest\x1a\x1d.MangaPageImageStreamResponse\"\x000\x01\x125\n" +
+	"\x06Repair\x12\x13.MangaRepairRequest\x1a\x14.MangaRepairResponse\"\x00\x12=\n" +
+	"\bDownload\x12\x15.MangaDownloadRequest\x1a\x16.MangaDownloadResponse\"\x000\x01\x125\n" +
+	"\x06AddTag\x12\x13.MangaAddTagRequest\x1a\x14.MangaAddTagResponse\"\x00\x12>\n" +
+	"\tRemoveTag\x12\x16.MangaRemoveTagRequest\x1a\x17.MangaRemoveTagResponse\"\x00\x12>\n" +
+	"\tSetHidden\x12\x16.MangaSetHiddenRequest\x1a\x17.MangaSetHiddenResponse\"\x00\x12A\n" +
+	"\n" +
+	"SetBlocked\x12\x17.MangaSetBlockedRequest\x1a\x18.MangaSetBlockedResponse\"\x00\x12G\n" +
+	"\fSetAgeRating\x12\x19.MangaSetAgeRatingRequest\x1a\x1a.MangaSetAgeRatingResponse\"\x00B-Z+github.com/mangaweb4/mangaweb4-backend/grpcb\x06proto3"
 
 var (
 	file_manga_proto_rawDescOnce sync.Once
@@ -1443,7 +2304,7 @@ func file_manga_proto_rawDescGZIP() []byte {
 	return file_manga_proto_rawDescData
 }
 
-var file_manga_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_manga_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_manga_proto_goTypes = []any{
 	(*MangaListRequest)(nil),             // 0: MangaListRequest
 	(*MangaListResponse)(nil),            // 1: MangaListResponse
@@ -1466,43 +2327,74 @@ var file_manga_proto_goTypes = []any{
 	(*MangaRepairResponse)(nil),          // 18: MangaRepairResponse
 	(*MangaDownloadRequest)(nil),         // 19: MangaDownloadRequest
 	(*MangaDownloadResponse)(nil),        // 20: MangaDownloadResponse
-	(Filter)(0),                          // 21: mangaweb4.types.Filter
-	(SortField)(0),                       // 22: mangaweb4.types.SortField
-	(SortOrder)(0),                       // 23: mangaweb4.types.SortOrder
-	(ImageQuality)(0),                    // 24: mangaweb4.types.ImageQuality
+	(*MangaAddTagRequest)(nil),           // 21: MangaAddTagRequest
+	(*MangaAddTagResponse)(nil),          // 22: MangaAddTagResponse
+	(*MangaRemoveTagRequest)(nil),        // 23: MangaRemoveTagRequest
+	(*MangaRemoveTagResponse)(nil),       // 24: MangaRemoveTagResponse
+	(*MangaSetHiddenRequest)(nil),        // 25: MangaSetHiddenRequest
+	(*MangaSetHiddenResponse)(nil),       // 26: MangaSetHiddenResponse
+	(*MangaSetBlockedRequest)(nil),       // 27: MangaSetBlockedRequest
+	(*MangaSetBlockedResponse)(nil),      // 28: MangaSetBlockedResponse
+	(*MangaListResponseTagFacet)(nil),    // 29: MangaListResponseTagFacet
+	(*MangaSetAgeRatingRequest)(nil),     // 30: MangaSetAgeRatingRequest
+	(*MangaSetAgeRatingResponse)(nil),    // 31: MangaSetAgeRatingResponse
+	(Filter)(0),                          // 32: mangaweb4.types.Filter
+	(SortField)(0),                       // 33: mangaweb4.types.SortField
+	(SortOrder)(0),                       // 34: mangaweb4.types.SortOrder
+	(TagMatch)(0),                        // 35: mangaweb4.types.TagMatch
+	(TagCategory)(0),                     // 36: mangaweb4.types.TagCategory
+	(TagSource)(0),                       // 37: mangaweb4.types.TagSource
+	(ImageQuality)(0),                    // 38: mangaweb4.types.ImageQuality
 }
 var file_manga_proto_depIdxs = []int32{
-	21, // 0: MangaListRequest.Filter:type_name -> mangaweb4.types.Filter
-	22, // 1: MangaListRequest.Sort:type_name -> mangaweb4.types.SortField
-	23, // 2: MangaListRequest.Order:type_name -> mangaweb4.types.SortOrder
-	2,  // 3: MangaListResponse.Items:type_name -> MangaListResponseItem
-	7,  // 4: MangaDetailResponse.Tags:type_name -> MangaDetailResponseTagItem
-	24, // 5: MangaPageImageRequest.Quality:type_name -> mangaweb4.types.ImageQuality
-	0,  // 6: Manga.List:input_type -> MangaListRequest
-	5,  // 7: Manga.Detail:input_type -> MangaDetailRequest
-	3,  // 8: Manga.Thumbnail:input_type -> MangaThumbnailRequest
-	8,  // 9: Manga.SetFavorite:input_type -> MangaSetFavoriteRequest
-	10, // 10: Manga.SetProgress:input_type -> MangaSetProgressRequest
-	12, // 11: Manga.UpdateCover:input_type -> MangaUpdateCoverRequest
-	14, // 12: Manga.PageImage:input_type -> MangaPageImageRequest
-	14, // 13: Manga.PageImageStream:input_type -> MangaPageImageRequest
-	17, // 14: Manga.Repair:input_type -> MangaRepairRequest
-	19, // 15: Manga.Download:input_type -> MangaDownloadRequest
-	1,  // 16: Manga.List:output_type -> MangaListResponse
-	6,  // 17: Manga.Detail:output_type -> MangaDetailResponse
-	4,  // 18: Manga.Thumbnail:output_type -> MangaThumbnailResponse
-	9,  // 19: Manga.SetFavorite:output_type -> MangaSetFavoriteResponse
-	11, // 20: Manga.SetProgress:output_type -> MangaSetProgressResponse
-	13, // 21: Manga.UpdateCover:output_type -> MangaUpdateCoverResponse
-	15, // 22: Manga.PageImage:output_type -> MangaPageImageResponse
-	16, // 23: Manga.PageImageStream:output_type -> MangaPageImageStreamResponse
-	18, // 24: Manga.Repair:output_type -> MangaRepairResponse
-	20, // 25: Manga.Download:output_type -> MangaDownloadResponse
-	16, // [16:26] is the sub-list for method output_type
-	6,  // [6:16] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	32, // 0: MangaListRequest.Filter:type_name -> mangaweb4.types.Filter
+	33, // 1: MangaListRequest.Sort:type_name -> mangaweb4.types.SortField
+	34, // 2: MangaListRequest.Order:type_name -> mangaweb4.types.SortOrder
+	35, // 3: MangaListRequest.TagMatch:type_name -> mangaweb4.types.TagMatch
+	2,  // 4: MangaListResponse.Items:type_name -> MangaListResponseItem
+	29, // 5: MangaListResponse.TagFacets:type_name -> MangaListResponseTagFacet
+	7,  // 6: MangaDetailResponse.Tags:type_name -> MangaDetailResponseTagItem
+	36, // 7: MangaDetailResponseTagItem.Category:type_name -> mangaweb4.types.TagCategory
+	37, // 8: MangaDetailResponseTagItem.Source:type_name -> mangaweb4.types.TagSource
+	38, // 9: MangaPageImageRequest.Quality:type_name -> mangaweb4.types.ImageQuality
+	7,  // 10: MangaAddTagResponse.Tags:type_name -> MangaDetailResponseTagItem
+	7,  // 11: MangaRemoveTagResponse.Tags:type_name -> MangaDetailResponseTagItem
+	36, // 12: MangaListResponseTagFacet.Category:type_name -> mangaweb4.types.TagCategory
+	0,  // 13: Manga.List:input_type -> MangaListRequest
+	5,  // 14: Manga.Detail:input_type -> MangaDetailRequest
+	3,  // 15: Manga.Thumbnail:input_type -> MangaThumbnailRequest
+	8,  // 16: Manga.SetFavorite:input_type -> MangaSetFavoriteRequest
+	10, // 17: Manga.SetProgress:input_type -> MangaSetProgressRequest
+	12, // 18: Manga.UpdateCover:input_type -> MangaUpdateCoverRequest
+	14, // 19: Manga.PageImage:input_type -> MangaPageImageRequest
+	14, // 20: Manga.PageImageStream:input_type -> MangaPageImageRequest
+	17, // 21: Manga.Repair:input_type -> MangaRepairRequest
+	19, // 22: Manga.Download:input_type -> MangaDownloadRequest
+	21, // 23: Manga.AddTag:input_type -> MangaAddTagRequest
+	23, // 24: Manga.RemoveTag:input_type -> MangaRemoveTagRequest
+	25, // 25: Manga.SetHidden:input_type -> MangaSetHiddenRequest
+	27, // 26: Manga.SetBlocked:input_type -> MangaSetBlockedRequest
+	30, // 27: Manga.SetAgeRating:input_type -> MangaSetAgeRatingRequest
+	1,  // 28: Manga.List:output_type -> MangaListResponse
+	6,  // 29: Manga.Detail:output_type -> MangaDetailResponse
+	4,  // 30: Manga.Thumbnail:output_type -> MangaThumbnailResponse
+	9,  // 31: Manga.SetFavorite:output_type -> MangaSetFavoriteResponse
+	11, // 32: Manga.SetProgress:output_type -> MangaSetProgressResponse
+	13, // 33: Manga.UpdateCover:output_type -> MangaUpdateCoverResponse
+	15, // 34: Manga.PageImage:output_type -> MangaPageImageResponse
+	16, // 35: Manga.PageImageStream:output_type -> MangaPageImageStreamResponse
+	18, // 36: Manga.Repair:output_type -> MangaRepairResponse
+	20, // 37: Manga.Download:output_type -> MangaDownloadResponse
+	22, // 38: Manga.AddTag:output_type -> MangaAddTagResponse
+	24, // 39: Manga.RemoveTag:output_type -> MangaRemoveTagResponse
+	26, // 40: Manga.SetHidden:output_type -> MangaSetHiddenResponse
+	28, // 41: Manga.SetBlocked:output_type -> MangaSetBlockedResponse
+	31, // 42: Manga.SetAgeRating:output_type -> MangaSetAgeRatingResponse
+	28, // [28:43] is the sub-list for method output_type
+	13, // [13:28] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_manga_proto_init() }
@@ -1517,7 +2409,7 @@ func file_manga_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_manga_proto_rawDesc), len(file_manga_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -29,6 +29,11 @@ const (
 	Manga_PageImageStream_FullMethodName = "/Manga/PageImageStream"
 	Manga_Repair_FullMethodName          = "/Manga/Repair"
 	Manga_Download_FullMethodName        = "/Manga/Download"
+	Manga_AddTag_FullMethodName          = "/Manga/AddTag"
+	Manga_RemoveTag_FullMethodName       = "/Manga/RemoveTag"
+	Manga_SetHidden_FullMethodName       = "/Manga/SetHidden"
+	Manga_SetBlocked_FullMethodName      = "/Manga/SetBlocked"
+	Manga_SetAgeRating_FullMethodName    = "/Manga/SetAgeRating"
 )
 
 // MangaClient is the client API for Manga service.
@@ -46,6 +51,11 @@ type MangaClient interface {
 	PageImageStream(ctx context.Context, in *MangaPageImageRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MangaPageImageStreamResponse], error)
 	Repair(ctx context.Context, in *MangaRepairRequest, opts ...grpc.CallOption) (*MangaRepairResponse, error)
 	Download(ctx context.Context, in *MangaDownloadRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MangaDownloadResponse], error)
+	AddTag(ctx context.Context, in *MangaAddTagRequest, opts ...grpc.CallOption) (*MangaAddTagResponse, error)
+	RemoveTag(ctx context.Context, in *MangaRemoveTagRequest, opts ...grpc.CallOption) (*MangaRemoveTagResponse, error)
+	SetHidden(ctx context.Context, in *MangaSetHiddenRequest, opts ...grpc.CallOption) (*MangaSetHiddenResponse, error)
+	SetBlocked(ctx context.Context, in *MangaSetBlockedRequest, opts ...grpc.CallOption) (*MangaSetBlockedResponse, error)
+	SetAgeRating(ctx context.Context, in *MangaSetAgeRatingRequest, opts ...grpc.CallOption) (*MangaSetAgeRatingResponse, error)
 }
 
 type mangaClient struct {
@@ -175,6 +185,56 @@ func (c *mangaClient) Download(ctx context.Context, in *MangaDownloadRequest, op
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Manga_DownloadClient = grpc.ServerStreamingClient[MangaDownloadResponse]
 
+func (c *mangaClient) AddTag(ctx context.Context, in *MangaAddTagRequest, opts ...grpc.CallOption) (*MangaAddTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MangaAddTagResponse)
+	err := c.cc.Invoke(ctx, Manga_AddTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mangaClient) RemoveTag(ctx context.Context, in *MangaRemoveTagRequest, opts ...grpc.CallOption) (*MangaRemoveTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MangaRemoveTagResponse)
+	err := c.cc.Invoke(ctx, Manga_RemoveTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mangaClient) SetHidden(ctx context.Context, in *MangaSetHiddenRequest, opts ...grpc.CallOption) (*MangaSetHiddenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MangaSetHiddenResponse)
+	err := c.cc.Invoke(ctx, Manga_SetHidden_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mangaClient) SetBlocked(ctx context.Context, in *MangaSetBlockedRequest, opts ...grpc.CallOption) (*MangaSetBlockedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MangaSetBlockedResponse)
+	err := c.cc.Invoke(ctx, Manga_SetBlocked_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mangaClient) SetAgeRating(ctx context.Context, in *MangaSetAgeRatingRequest, opts ...grpc.CallOption) (*MangaSetAgeRatingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MangaSetAgeRatingResponse)
+	err := c.cc.Invoke(ctx, Manga_SetAgeRating_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MangaServer is the server API for Manga service.
 // All implementations must embed UnimplementedMangaServer
 // for forward compatibility.
@@ -190,6 +250,11 @@ type MangaServer interface {
 	PageImageStream(*MangaPageImageRequest, grpc.ServerStreamingServer[MangaPageImageStreamResponse]) error
 	Repair(context.Context, *MangaRepairRequest) (*MangaRepairResponse, error)
 	Download(*MangaDownloadRequest, grpc.ServerStreamingServer[MangaDownloadResponse]) error
+	AddTag(context.Context, *MangaAddTagRequest) (*MangaAddTagResponse, error)
+	RemoveTag(context.Context, *MangaRemoveTagRequest) (*MangaRemoveTagResponse, error)
+	SetHidden(context.Context, *MangaSetHiddenRequest) (*MangaSetHiddenResponse, error)
+	SetBlocked(context.Context, *MangaSetBlockedRequest) (*MangaSetBlockedResponse, error)
+	SetAgeRating(context.Context, *MangaSetAgeRatingRequest) (*MangaSetAgeRatingResponse, error)
 	mustEmbedUnimplementedMangaServer()
 }
 
@@ -230,6 +295,21 @@ func (UnimplementedMangaServer) Repair(context.Context, *MangaRepairRequest) (*M
 func (UnimplementedMangaServer) Download(*MangaDownloadRequest, grpc.ServerStreamingServer[MangaDownloadResponse]) error {
 	return status.Error(codes.Unimplemented, "method Download not implemented")
 }
+func (UnimplementedMangaServer) AddTag(context.Context, *MangaAddTagRequest) (*MangaAddTagResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddTag not implemented")
+}
+func (UnimplementedMangaServer) RemoveTag(context.Context, *MangaRemoveTagRequest) (*MangaRemoveTagResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveTag not implemented")
+}
+func (UnimplementedMangaServer) SetHidden(context.Context, *MangaSetHiddenRequest) (*MangaSetHiddenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetHidden not implemented")
+}
+func (UnimplementedMangaServer) SetBlocked(context.Context, *MangaSetBlockedRequest) (*MangaSetBlockedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetBlocked not implemented")
+}
+func (UnimplementedMangaServer) SetAgeRating(context.Context, *MangaSetAgeRatingRequest) (*MangaSetAgeRatingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetAgeRating not implemented")
+}
 func (UnimplementedMangaServer) mustEmbedUnimplementedMangaServer() {}
 func (UnimplementedMangaServer) testEmbeddedByValue()               {}
 
@@ -417,6 +497,96 @@ func _Manga_Download_Handler(srv interface{}, stream grpc.ServerStream) error {
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Manga_DownloadServer = grpc.ServerStreamingServer[MangaDownloadResponse]
 
+func _Manga_AddTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MangaAddTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MangaServer).AddTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Manga_AddTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MangaServer).AddTag(ctx, req.(*MangaAddTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manga_RemoveTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MangaRemoveTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MangaServer).RemoveTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Manga_RemoveTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MangaServer).RemoveTag(ctx, req.(*MangaRemoveTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manga_SetHidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MangaSetHiddenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MangaServer).SetHidden(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Manga_SetHidden_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MangaServer).SetHidden(ctx, req.(*MangaSetHiddenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manga_SetBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MangaSetBlockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MangaServer).SetBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Manga_SetBlocked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MangaServer).SetBlocked(ctx, req.(*MangaSetBlockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manga_SetAgeRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MangaSetAgeRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MangaServer).SetAgeRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Manga_SetAgeRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MangaServer).SetAgeRating(ctx, req.(*MangaSetAgeRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Manga_ServiceDesc is the grpc.ServiceDesc for Manga service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -456,6 +626,26 @@ var Manga_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Repair",
 			Handler:    _Manga_Repair_Handler,
 		},
+		{
+			MethodName: "AddTag",
+			Handler:    _Manga_AddTag_Handler,
+		},
+		{
+			MethodName: "RemoveTag",
+			Handler:    _Manga_RemoveTag_Handler,
+		},
+		{
+			MethodName: "SetHidden",
+			Handler:    _Manga_SetHidden_Handler,
+		},
+		{
+			MethodName: "SetBlocked",
+			Handler:    _Manga_SetBlocked_Handler,
+		},
+		{
+			MethodName: "SetAgeRating",
+			Handler:    _Manga_SetAgeRating_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
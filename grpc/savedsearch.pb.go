@@ -0,0 +1,1042 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.0
+// source: savedsearch.proto
+
+package grpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SavedSearchItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Search        string                 `protobuf:"bytes,3,opt,name=Search,proto3" json:"Search,omitempty"`
+	Filter        Filter                 `protobuf:"varint,4,opt,name=Filter,proto3,enum=mangaweb4.types.Filter" json:"Filter,omitempty"`
+	Sort          SortField              `protobuf:"varint,5,opt,name=Sort,proto3,enum=mangaweb4.types.SortField" json:"Sort,omitempty"`
+	Order         SortOrder              `protobuf:"varint,6,opt,name=Order,proto3,enum=mangaweb4.types.SortOrder" json:"Order,omitempty"`
+	Series        string                 `protobuf:"bytes,7,opt,name=Series,proto3" json:"Series,omitempty"`
+	IncludeTags   []int32                `protobuf:"varint,8,rep,packed,name=IncludeTags,proto3" json:"IncludeTags,omitempty"`
+	TagMatch      TagMatch               `protobuf:"varint,9,opt,name=TagMatch,proto3,enum=mangaweb4.types.TagMatch" json:"TagMatch,omitempty"`
+	ExcludeTags   []int32                `protobuf:"varint,10,rep,packed,name=ExcludeTags,proto3" json:"ExcludeTags,omitempty"`
+	RandomSeed    int64                  `protobuf:"varint,11,opt,name=RandomSeed,proto3" json:"RandomSeed,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=CreateTime,proto3" json:"CreateTime,omitempty"`
+	LastOpenTime  *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=LastOpenTime,proto3" json:"LastOpenTime,omitempty"`
+	Query         string                 `protobuf:"bytes,14,opt,name=Query,proto3" json:"Query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedSearchItem) Reset() {
+	*x = SavedSearchItem{}
+	mi := &file_savedsearch_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearchItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearchItem) ProtoMessage() {}
+
+func (x *SavedSearchItem) ProtoReflect() protoreflect.Message {
+	mi := &file_savedsearch_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearchItem.ProtoReflect.Descriptor instead.
+func (*SavedSearchItem) Descriptor() ([]byte, []int) {
+	return file_savedsearch_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SavedSearchItem) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SavedSearchItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedSearchItem) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *SavedSearchItem) GetFilter() Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return Filter_FILTER_UNKNOWN
+}
+
+func (x *SavedSearchItem) GetSort() SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return SortField_SORT_FIELD_NAME
+}
+
+func (x *SavedSearchItem) GetOrder() SortOrder {
+	if x != nil {
+		return x.Order
+	}
+	return SortOrder_SORT_ORDER_ASCENDING
+}
+
+func (x *SavedSearchItem) GetSeries() string {
+	if x != nil {
+		return x.Series
+	}
+	return ""
+}
+
+func (x *SavedSearchItem) GetIncludeTags() []int32 {
+	if x != nil {
+		return x.IncludeTags
+	}
+	return nil
+}
+
+func (x *SavedSearchItem) GetTagMatch() TagMatch {
+	if x != nil {
+		return x.TagMatch
+	}
+	return TagMatch_TAG_MATCH_ALL
+}
+
+func (x *SavedSearchItem) GetExcludeTags() []int32 {
+	if x != nil {
+		return x.ExcludeTags
+	}
+	return nil
+}
+
+func (x *SavedSearchItem) GetRandomSeed() int64 {
+	if x != nil {
+		return x.RandomSeed
+	}
+	return 0
+}
+
+func (x *SavedSearchItem) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *SavedSearchItem) GetLastOpenTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastOpenTime
+	}
+	return nil
+}
+
+func (x *SavedSearchItem) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type SavedSearchCreateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          string                 `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Search        string                 `protobuf:"bytes,3,opt,name=Search,proto3" json:"Search,omitempty"`
+	Filter        Filter                 `protobuf:"varint,4,opt,name=Filter,proto3,enum=mangaweb4.types.Filter" json:"Filter,omitempty"`
+	Sort          SortField              `protobuf:"varint,5,opt,name=Sort,proto3,enum=mangaweb4.types.SortField" json:"Sort,omitempty"`
+	Order         SortOrder              `protobuf:"varint,6,opt,name=Order,proto3,enum=mangaweb4.types.SortOrder" json:"Order,omitempty"`
+	Series        string                 `protobuf:"bytes,7,opt,name=Series,proto3" json:"Series,omitempty"`
+	IncludeTags   []int32                `protobuf:"varint,8,rep,packed,name=IncludeTags,proto3" json:"IncludeTags,omitempty"`
+	TagMatch      TagMatch               `protobuf:"varint,9,opt,name=TagMatch,proto3,enum=mangaweb4.types.TagMatch" json:"TagMatch,omitempty"`
+	ExcludeTags   []int32                `protobuf:"varint,10,rep,packed,name=ExcludeTags,proto3" json:"ExcludeTags,omitempty"`
+	RandomSeed    int64                  `protobuf:"varint,11,opt,name=RandomSeed,proto3" json:"RandomSeed,omitempty"`
+	Query         string                 `protobuf:"bytes,12,opt,name=Query,proto3" json:"Query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedSearchCreateRequest) Reset() {
+	*x = SavedSearchCreateRequest{}
+	mi := &file_savedsearch_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearchCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearchCreateRequest) ProtoMessage() {}
+
+func (x *SavedSearchCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_savedsearch_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearchCreateRequest.ProtoReflect.Descriptor instead.
+func (*SavedSearchCreateRequest) Descriptor() ([]byte, []int) {
+	return file_savedsearch_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SavedSearchCreateRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *SavedSearchCreateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedSearchCreateRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *SavedSearchCreateRequest) GetFilter() Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return Filter_FILTER_UNKNOWN
+}
+
+func (x *SavedSearchCreateRequest) GetSort() SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return SortField_SORT_FIELD_NAME
+}
+
+func (x *SavedSearchCreateRequest) GetOrder() SortOrder {
+	if x != nil {
+		return x.Order
+	}
+	return SortOrder_SORT_ORDER_ASCENDING
+}
+
+func (x *SavedSearchCreateRequest) GetSeries() string {
+	if x != nil {
+		return x.Series
+	}
+	return ""
+}
+
+func (x *SavedSearchCreateRequest) GetIncludeTags() []int32 {
+	if x != nil {
+		return x.IncludeTags
+	}
+	return nil
+}
+
+func (x *SavedSearchCreateRequest) GetTagMatch() TagMatch {
+	if x != nil {
+		return x.TagMatch
+	}
+	return TagMatch_TAG_MATCH_ALL
+}
+
+func (x *SavedSearchCreateRequest) GetExcludeTags() []int32 {
+	if x != nil {
+		return x.ExcludeTags
+	}
+	return nil
+}
+
+func (x *SavedSearchCreateRequest) GetRandomSeed() int64 {
+	if x != nil {
+		return x.RandomSeed
+	}
+	return 0
+}
+
+func (x *SavedSearchCreateRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type SavedSearchCreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *SavedSearchItem       `protobuf:"bytes,1,opt,name=Item,proto3" json:"Item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedSearchCreateResponse) Reset() {
+	*x = SavedSearchCreateResponse{}
+	mi := &file_savedsearch_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearchCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearchCreateResponse) ProtoMessage() {}
+
+func (x *SavedSearchCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_savedsearch_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearchCreateResponse.ProtoReflect.Descriptor instead.
+func (*SavedSearchCreateResponse) Descriptor() ([]byte, []int) {
+	return file_savedsearch_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SavedSearchCreateResponse) GetItem() *SavedSearchItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type SavedSearchListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          string                 `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedSearchListRequest) Reset() {
+	*x = SavedSearchListRequest{}
+	mi := &file_savedsearch_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearchListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearchListRequest) ProtoMessage() {}
+
+func (x *SavedSearchListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_savedsearch_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearchListRequest.ProtoReflect.Descriptor instead.
+func (*SavedSearchListRequest) Descriptor() ([]byte, []int) {
+	return file_savedsearch_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SavedSearchListRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type SavedSearchListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*SavedSearchItem     `protobuf:"bytes,1,rep,name=Items,proto3" json:"Items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedSearchListResponse) Reset() {
+	*x = SavedSearchListResponse{}
+	mi := &file_savedsearch_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearchListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearchListResponse) ProtoMessage() {}
+
+func (x *SavedSearchListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_savedsearch_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearchListResponse.ProtoReflect.Descriptor instead.
+func (*SavedSearchListResponse) Descriptor() ([]byte, []int) {
+	return file_savedsearch_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SavedSearchListResponse) GetItems() []*SavedSearchItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type SavedSearchUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          string                 `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	Search        string                 `protobuf:"bytes,4,opt,name=Search,proto3" json:"Search,omitempty"`
+	Filter        Filter                 `protobuf:"varint,5,opt,name=Filter,proto3,enum=mangaweb4.types.Filter" json:"Filter,omitempty"`
+	Sort          SortField              `protobuf:"varint,6,opt,name=Sort,proto3,enum=mangaweb4.types.SortField" json:"Sort,omitempty"`
+	Order         SortOrder              `protobuf:"varint,7,opt,name=Order,proto3,enum=mangaweb4.types.SortOrder" json:"Order,omitempty"`
+	Series        string                 `protobuf:"bytes,8,opt,name=Series,proto3" json:"Series,omitempty"`
+	IncludeTags   []int32                `protobuf:"varint,9,rep,packed,name=IncludeTags,proto3" json:"IncludeTags,omitempty"`
+	TagMatch      TagMatch               `protobuf:"varint,10,opt,name=TagMatch,proto3,enum=mangaweb4.types.TagMatch" json:"TagMatch,omitempty"`
+	ExcludeTags   []int32                `protobuf:"varint,11,rep,packed,name=ExcludeTags,proto3" json:"ExcludeTags,omitempty"`
+	RandomSeed    int64                  `protobuf:"varint,12,opt,name=RandomSeed,proto3" json:"RandomSeed,omitempty"`
+	Query         string                 `protobuf:"bytes,13,opt,name=Query,proto3" json:"Query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedSearchUpdateRequest) Reset() {
+	*x = SavedSearchUpdateRequest{}
+	mi := &file_savedsearch_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearchUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearchUpdateRequest) ProtoMessage() {}
+
+func (x *SavedSearchUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_savedsearch_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearchUpdateRequest.ProtoReflect.Descriptor instead.
+func (*SavedSearchUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_savedsearch_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SavedSearchUpdateRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *SavedSearchUpdateRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SavedSearchUpdateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedSearchUpdateRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *SavedSearchUpdateRequest) GetFilter() Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return Filter_FILTER_UNKNOWN
+}
+
+func (x *SavedSearchUpdateRequest) GetSort() SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return SortField_SORT_FIELD_NAME
+}
+
+func (x *SavedSearchUpdateRequest) GetOrder() SortOrder {
+	if x != nil {
+		return x.Order
+	}
+	return SortOrder_SORT_ORDER_ASCENDING
+}
+
+func (x *SavedSearchUpdateRequest) GetSeries() string {
+	if x != nil {
+		return x.Series
+	}
+	return ""
+}
+
+func (x *SavedSearchUpdateRequest) GetIncludeTags() []int32 {
+	if x != nil {
+		return x.IncludeTags
+	}
+	return nil
+}
+
+func (x *SavedSearchUpdateRequest) GetTagMatch() TagMatch {
+	if x != nil {
+		return x.TagMatch
+	}
+	return TagMatch_TAG_MATCH_ALL
+}
+
+func (x *SavedSearchUpdateRequest) GetExcludeTags() []int32 {
+	if x != nil {
+		return x.ExcludeTags
+	}
+	return nil
+}
+
+func (x *SavedSearchUpdateRequest) GetRandomSeed() int64 {
+	if x != nil {
+		return x.RandomSeed
+	}
+	return 0
+}
+
+func (x *SavedSearchUpdateRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type SavedSearchUpdateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *SavedSearchItem       `protobuf:"bytes,1,opt,name=Item,proto3" json:"Item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedSearchUpdateResponse) Reset() {
+	*x = SavedSearchUpdateResponse{}
+	mi := &file_savedsearch_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearchUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearchUpdateResponse) ProtoMessage() {}
+
+func (x *SavedSearchUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_savedsearch_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearchUpdateResponse.ProtoReflect.Descriptor instead.
+func (*SavedSearchUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_savedsearch_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SavedSearchUpdateResponse) GetItem() *SavedSearchItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type SavedSearchDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          string                 `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedSearchDeleteRequest) Reset() {
+	*x = SavedSearchDeleteRequest{}
+	mi := &file_savedsearch_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearchDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearchDeleteRequest) ProtoMessage() {}
+
+func (x *SavedSearchDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_savedsearch_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearchDeleteRequest.ProtoReflect.Descriptor instead.
+func (*SavedSearchDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_savedsearch_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SavedSearchDeleteRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *SavedSearchDeleteRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SavedSearchDeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=Success,proto3" json:"Success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedSearchDeleteResponse) Reset() {
+	*x = SavedSearchDeleteResponse{}
+	mi := &file_savedsearch_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearchDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearchDeleteResponse) ProtoMessage() {}
+
+func (x *SavedSearchDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_savedsearch_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearchDeleteResponse.ProtoReflect.Descriptor instead.
+func (*SavedSearchDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_savedsearch_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SavedSearchDeleteResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SavedSearchDeleteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SavedSearchRunRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          string                 `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=Page,proto3" json:"Page,omitempty"`
+	ItemPerPage   int32                  `protobuf:"varint,4,opt,name=ItemPerPage,proto3" json:"ItemPerPage,omitempty"`
+	Cursor        string                 `protobuf:"bytes,5,opt,name=Cursor,proto3" json:"Cursor,omitempty"`
+	IncludeFacets bool                   `protobuf:"varint,6,opt,name=IncludeFacets,proto3" json:"IncludeFacets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedSearchRunRequest) Reset() {
+	*x = SavedSearchRunRequest{}
+	mi := &file_savedsearch_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearchRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearchRunRequest) ProtoMessage() {}
+
+func (x *SavedSearchRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_savedsearch_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearchRunRequest.ProtoReflect.Descriptor instead.
+func (*SavedSearchRunRequest) Descriptor() ([]byte, []int) {
+	return file_savedsearch_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SavedSearchRunRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *SavedSearchRunRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SavedSearchRunRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SavedSearchRunRequest) GetItemPerPage() int32 {
+	if x != nil {
+		return x.ItemPerPage
+	}
+	return 0
+}
+
+func (x *SavedSearchRunRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SavedSearchRunRequest) GetIncludeFacets() bool {
+	if x != nil {
+		return x.IncludeFacets
+	}
+	return false
+}
+
+type SavedSearchRunResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          *MangaListResponse     `protobuf:"bytes,1,opt,name=List,proto3" json:"List,omitempty"`
+	NewItemCount  int32                  `protobuf:"varint,2,opt,name=NewItemCount,proto3" json:"NewItemCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedSearchRunResponse) Reset() {
+	*x = SavedSearchRunResponse{}
+	mi := &file_savedsearch_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearchRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearchRunResponse) ProtoMessage() {}
+
+func (x *SavedSearchRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_savedsearch_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearchRunResponse.ProtoReflect.Descriptor instead.
+func (*SavedSearchRunResponse) Descriptor() ([]byte, []int) {
+	return file_savedsearch_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SavedSearchRunResponse) GetList() *MangaListResponse {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *SavedSearchRunResponse) GetNewItemCount() int32 {
+	if x != nil {
+		return x.NewItemCount
+	}
+	return 0
+}
+
+var File_savedsearch_proto protoreflect.FileDescriptor
+
+const file_savedsearch_proto_rawDesc = "" +
+	"\n" +
+	"\x11savedsearch.proto\x1a\vtypes.proto\x1a\vmanga.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa5\x04\n" +
+	"\x0fSavedSearchItem\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x05R\x02Id\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x16\n" +
+	"\x06Search\x18\x03 \x01(\tR\x06Search\x12/\n" +
+	"\x06Filter\x18\x04 \x01(\x0e2\x17.mangaweb4.types.FilterR\x06Filter\x12.\n" +
+	"\x04Sort\x18\x05 \x01(\x0e2\x1a.mangaweb4.types.SortFieldR\x04Sort\x120\n" +
+	"\x05Order\x18\x06 \x01(\x0e2\x1a.mangaweb4.types.SortOrderR\x05Order\x12\x16\n" +
+	"\x06Series\x18\a \x01(\tR\x06Series\x12 \n" +
+	"\vIncludeTags\x18\b \x03(\x05R\vIncludeTags\x125\n" +
+	"\bTagMatch\x18\t \x01(\x0e2\x19.mangaweb4.types.TagMatchR\bTagMatch\x12 \n" +
+	"\vExcludeTags\x18\n" +
+	" \x03(\x05R\vExcludeTags\x12\x1e\n" +
+	"\n" +
+	"RandomSeed\x18\v \x01(\x03R\n" +
+	"RandomSeed\x12:\n" +
+	"\n" +
+	"CreateTime\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"CreateTime\x12>\n" +
+	"\fLastOpenTime\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\fLastOpenTime\x12\x14\n" +
+	"\x05Query\x18\x0e \x01(\tR\x05Query\"\xb6\x03\n" +
+	"\x18SavedSearchCreateRequest\x12\x12\n" +
+	"\x04User\x18\x01 \x01(\tR\x04User\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x16\n" +
+	"\x06Search\x18\x03 \x01(\tR\x06Search\x12/\n" +
+	"\x06Filter\x18\x04 \x01(\x0e2\x17.mangaweb4.types.FilterR\x06Filter\x12.\n" +
+	"\x04Sort\x18\x05 \x01(\x0e2\x1a.mangaweb4.types.SortFieldR\x04Sort\x120\n" +
+	"\x05Order\x18\x06 \x01(\x0e2\x1a.mangaweb4.types.SortOrderR\x05Order\x12\x16\n" +
+	"\x06Series\x18\a \x01(\tR\x06Series\x12 \n" +
+	"\vIncludeTags\x18\b \x03(\x05R\vIncludeTags\x125\n" +
+	"\bTagMatch\x18\t \x01(\x0e2\x19.mangaweb4.types.TagMatchR\bTagMatch\x12 \n" +
+	"\vExcludeTags\x18\n" +
+	" \x03(\x05R\vExcludeTags\x12\x1e\n" +
+	"\n" +
+	"RandomSeed\x18\v \x01(\x03R\n" +
+	"RandomSeed\x12\x14\n" +
+	"\x05Query\x18\f \x01(\tR\x05Query\"A\n" +
+	"\x19SavedSearchCreateResponse\x12$\n" +
+	"\x04Item\x18\x01 \x01(\v2\x10.SavedSearchItemR\x04Item\",\n" +
+	"\x16SavedSearchListRequest\x12\x12\n" +
+	"\x04User\x18\x01 \x01(\tR\x04User\"A\n" +
+	"\x17SavedSearchListResponse\x12&\n" +
+	"\x05Items\x18\x01 \x03(\v2\x10.SavedSearchItemR\x05Items\"\xc6\x03\n" +
+	"\x18SavedSearchUpdateRequest\x12\x12\n" +
+	"\x04User\x18\x01 \x01(\tR\x04User\x12\x0e\n" +
+	"\x02Id\x18\x02 \x01(\x05R\x02Id\x12\x12\n" +
+	"\x04Name\x18\x03 \x01(\tR\x04Name\x12\x16\n" +
+	"\x06Search\x18\x04 \x01(\tR\x06Search\x12/\n" +
+	"\x06Filter\x18\x05 \x01(\x0e2\x17.mangaweb4.types.FilterR\x06Filter\x12.\n" +
+	"\x04Sort\x18\x06 \x01(\x0e2\x1a.mangaweb4.types.SortFieldR\x04Sort\x120\n" +
+	"\x05Order\x18\a \x01(\x0e2\x1a.mangaweb4.types.SortOrderR\x05Order\x12\x16\n" +
+	"\x06Series\x18\b \x01(\tR\x06Series\x12 \n" +
+	"\vIncludeTags\x18\t \x03(\x05R\vIncludeTags\x125\n" +
+	"\bTagMatch\x18\n" +
+	" \x01(\x0e2\x19.mangaweb4.types.TagMatchR\bTagMatch\x12 \n" +
+	"\vExcludeTags\x18\v \x03(\x05R\vExcludeTags\x12\x1e\n" +
+	"\n" +
+	"RandomSeed\x18\f \x01(\x03R\n" +
+	"RandomSeed\x12\x14\n" +
+	"\x05Query\x18\r \x01(\tR\x05Query\"A\n" +
+	"\x19SavedSearchUpdateResponse\x12$\n" +
+	"\x04Item\x18\x01 \x01(\v2\x10.SavedSearchItemR\x04Item\">\n" +
+	"\x18SavedSearchDeleteRequest\x12\x12\n" +
+	"\x04User\x18\x01 \x01(\tR\x04User\x12\x0e\n" +
+	"\x02Id\x18\x02 \x01(\x05R\x02Id\"E\n" +
+	"\x19SavedSearchDeleteResponse\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x05R\x02Id\x12\x18\n" +
+	"\aSuccess\x18\x02 \x01(\bR\aSuccess\"\xaf\x01\n" +
+	"\x15SavedSearchRunRequest\x12\x12\n" +
+	"\x04User\x18\x01 \x01(\tR\x04User\x12\x0e\n" +
+	"\x02Id\x18\x02 \x01(\x05R\x02Id\x12\x12\n" +
+	"\x04Page\x18\x03 \x01(\x05R\x04Page\x12 \n" +
+	"\vItemPerPage\x18\x04 \x01(\x05R\vItemPerPage\x12\x16\n" +
+	"\x06Cursor\x18\x05 \x01(\tR\x06Cursor\x12$\n" +
+	"\rIncludeFacets\x18\x06 \x01(\bR\rIncludeFacets\"d\n" +
+	"\x16SavedSearchRunResponse\x12&\n" +
+	"\x04List\x18\x01 \x01(\v2\x12.MangaListResponseR\x04List\x12\"\n" +
+	"\fNewItemCount\x18\x02 \x01(\x05R\fNewItemCount2\xcd\x02\n" +
+	"\vSavedSearch\x12A\n" +
+	"\x06Create\x12\x19.SavedSearchCreateRequest\x1a\x1a.SavedSearchCreateResponse\"\x00\x12;\n" +
+	"\x04List\x12\x17.SavedSearchListRequest\x1a\x18.SavedSearchListResponse\"\x00\x12A\n" +
+	"\x06Update\x12\x19.SavedSearchUpdateRequest\x1a\x1a.SavedSearchUpdateResponse\"\x00\x12A\n" +
+	"\x06Delete\x12\x19.SavedSearchDeleteRequest\x1a\x1a.SavedSearchDeleteResponse\"\x00\x128\n" +
+	"\x03Run\x12\x16.SavedSearchRunRequest\x1a\x17.SavedSearchRunResponse\"\x00B-Z+github.com/mangaweb4/mangaweb4-backend/grpcb\x06proto3"
+
+var (
+	file_savedsearch_proto_rawDescOnce sync.Once
+	file_savedsearch_proto_rawDescData []byte
+)
+
+func file_savedsearch_proto_rawDescGZIP() []byte {
+	file_savedsearch_proto_rawDescOnce.Do(func() {
+		file_savedsearch_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_savedsearch_proto_rawDesc), len(file_savedsearch_proto_rawDesc)))
+	})
+	return file_savedsearch_proto_rawDescData
+}
+
+var file_savedsearch_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_savedsearch_proto_goTypes = []any{
+	(*SavedSearchItem)(nil),           // 0: SavedSearchItem
+	(*SavedSearchCreateRequest)(nil),  // 1: SavedSearchCreateRequest
+	(*SavedSearchCreateResponse)(nil), // 2: SavedSearchCreateResponse
+	(*SavedSearchListRequest)(nil),    // 3: SavedSearchListRequest
+	(*SavedSearchListResponse)(nil),   // 4: SavedSearchListResponse
+	(*SavedSearchUpdateRequest)(nil),  // 5: SavedSearchUpdateRequest
+	(*SavedSearchUpdateResponse)(nil), // 6: SavedSearchUpdateResponse
+	(*SavedSearchDeleteRequest)(nil),  // 7: SavedSearchDeleteRequest
+	(*SavedSearchDeleteResponse)(nil), // 8: SavedSearchDeleteResponse
+	(*SavedSearchRunRequest)(nil),     // 9: SavedSearchRunRequest
+	(*SavedSearchRunResponse)(nil),    // 10: SavedSearchRunResponse
+	(Filter)(0),                       // 11: mangaweb4.types.Filter
+	(SortField)(0),                    // 12: mangaweb4.types.SortField
+	(SortOrder)(0),                    // 13: mangaweb4.types.SortOrder
+	(TagMatch)(0),                     // 14: mangaweb4.types.TagMatch
+	(*timestamppb.Timestamp)(nil),     // 15: google.protobuf.Timestamp
+	(*MangaListResponse)(nil),         // 16: MangaListResponse
+}
+var file_savedsearch_proto_depIdxs = []int32{
+	11, // 0: SavedSearchItem.Filter:type_name -> mangaweb4.types.Filter
+	12, // 1: SavedSearchItem.Sort:type_name -> mangaweb4.types.SortField
+	13, // 2: SavedSearchItem.Order:type_name -> mangaweb4.types.SortOrder
+	14, // 3: SavedSearchItem.TagMatch:type_name -> mangaweb4.types.TagMatch
+	15, // 4: SavedSearchItem.CreateTime:type_name -> google.protobuf.Timestamp
+	15, // 5: SavedSearchItem.LastOpenTime:type_name -> google.protobuf.Timestamp
+	11, // 6: SavedSearchCreateRequest.Filter:type_name -> mangaweb4.types.Filter
+	12, // 7: SavedSearchCreateRequest.Sort:type_name -> mangaweb4.types.SortField
+	13, // 8: SavedSearchCreateRequest.Order:type_name -> mangaweb4.types.SortOrder
+	14, // 9: SavedSearchCreateRequest.TagMatch:type_name -> mangaweb4.types.TagMatch
+	0,  // 10: SavedSearchCreateResponse.Item:type_name -> SavedSearchItem
+	0,  // 11: SavedSearchListResponse.Items:type_name -> SavedSearchItem
+	11, // 12: SavedSearchUpdateRequest.Filter:type_name -> mangaweb4.types.Filter
+	12, // 13: SavedSearchUpdateRequest.Sort:type_name -> mangaweb4.types.SortField
+	13, // 14: SavedSearchUpdateRequest.Order:type_name -> mangaweb4.types.SortOrder
+	14, // 15: SavedSearchUpdateRequest.TagMatch:type_name -> mangaweb4.types.TagMatch
+	0,  // 16: SavedSearchUpdateResponse.Item:type_name -> SavedSearchItem
+	16, // 17: SavedSearchRunResponse.List:type_name -> MangaListResponse
+	1,  // 18: SavedSearch.Create:input_type -> SavedSearchCreateRequest
+	3,  // 19: SavedSearch.List:input_type -> SavedSearchListRequest
+	5,  // 20: SavedSearch.Update:input_type -> SavedSearchUpdateRequest
+	7,  // 21: SavedSearch.Delete:input_type -> SavedSearchDeleteRequest
+	9,  // 22: SavedSearch.Run:input_type -> SavedSearchRunRequest
+	2,  // 23: SavedSearch.Create:output_type -> SavedSearchCreateResponse
+	4,  // 24: SavedSearch.List:output_type -> SavedSearchListResponse
+	6,  // 25: SavedSearch.Update:output_type -> SavedSearchUpdateResponse
+	8,  // 26: SavedSearch.Delete:output_type -> SavedSearchDeleteResponse
+	10, // 27: SavedSearch.Run:output_type -> SavedSearchRunResponse
+	23, // [23:28] is the sub-list for method output_type
+	18, // [18:23] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_savedsearch_proto_init() }
+func file_savedsearch_proto_init() {
+	if File_savedsearch_proto != nil {
+		return
+	}
+	file_types_proto_init()
+	file_manga_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_savedsearch_proto_rawDesc), len(file_savedsearch_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_savedsearch_proto_goTypes,
+		DependencyIndexes: file_savedsearch_proto_depIdxs,
+		MessageInfos:      file_savedsearch_proto_msgTypes,
+	}.Build()
+	File_savedsearch_proto = out.File
+	file_savedsearch_proto_goTypes = nil
+	file_savedsearch_proto_depIdxs = nil
+}
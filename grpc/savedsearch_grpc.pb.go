@@ -0,0 +1,273 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.1
+// - protoc             v7.34.0
+// source: savedsearch.proto
+
+package grpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SavedSearch_Create_FullMethodName = "/SavedSearch/Create"
+	SavedSearch_List_FullMethodName   = "/SavedSearch/List"
+	SavedSearch_Update_FullMethodName = "/SavedSearch/Update"
+	SavedSearch_Delete_FullMethodName = "/SavedSearch/Delete"
+	SavedSearch_Run_FullMethodName    = "/SavedSearch/Run"
+)
+
+// SavedSearchClient is the client API for SavedSearch service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SavedSearchClient interface {
+	Create(ctx context.Context, in *SavedSearchCreateRequest, opts ...grpc.CallOption) (*SavedSearchCreateResponse, error)
+	List(ctx context.Context, in *SavedSearchListRequest, opts ...grpc.CallOption) (*SavedSearchListResponse, error)
+	Update(ctx context.Context, in *SavedSearchUpdateRequest, opts ...grpc.CallOption) (*SavedSearchUpdateResponse, error)
+	Delete(ctx context.Context, in *SavedSearchDeleteRequest, opts ...grpc.CallOption) (*SavedSearchDeleteResponse, error)
+	Run(ctx context.Context, in *SavedSearchRunRequest, opts ...grpc.CallOption) (*SavedSearchRunResponse, error)
+}
+
+type savedSearchClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSavedSearchClient(cc grpc.ClientConnInterface) SavedSearchClient {
+	return &savedSearchClient{cc}
+}
+
+func (c *savedSearchClient) Create(ctx context.Context, in *SavedSearchCreateRequest, opts ...grpc.CallOption) (*SavedSearchCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedSearchCreateResponse)
+	err := c.cc.Invoke(ctx, SavedSearch_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchClient) List(ctx context.Context, in *SavedSearchListRequest, opts ...grpc.CallOption) (*SavedSearchListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedSearchListResponse)
+	err := c.cc.Invoke(ctx, SavedSearch_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchClient) Update(ctx context.Context, in *SavedSearchUpdateRequest, opts ...grpc.CallOption) (*SavedSearchUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedSearchUpdateResponse)
+	err := c.cc.Invoke(ctx, SavedSearch_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchClient) Delete(ctx context.Context, in *SavedSearchDeleteRequest, opts ...grpc.CallOption) (*SavedSearchDeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedSearchDeleteResponse)
+	err := c.cc.Invoke(ctx, SavedSearch_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchClient) Run(ctx context.Context, in *SavedSearchRunRequest, opts ...grpc.CallOption) (*SavedSearchRunResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedSearchRunResponse)
+	err := c.cc.Invoke(ctx, SavedSearch_Run_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SavedSearchServer is the server API for SavedSearch service.
+// All implementations must embed UnimplementedSavedSearchServer
+// for forward compatibility.
+type SavedSearchServer interface {
+	Create(context.Context, *SavedSearchCreateRequest) (*SavedSearchCreateResponse, error)
+	List(context.Context, *SavedSearchListRequest) (*SavedSearchListResponse, error)
+	Update(context.Context, *SavedSearchUpdateRequest) (*SavedSearchUpdateResponse, error)
+	Delete(context.Context, *SavedSearchDeleteRequest) (*SavedSearchDeleteResponse, error)
+	Run(context.Context, *SavedSearchRunRequest) (*SavedSearchRunResponse, error)
+	mustEmbedUnimplementedSavedSearchServer()
+}
+
+// UnimplementedSavedSearchServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSavedSearchServer struct{}
+
+func (UnimplementedSavedSearchServer) Create(context.Context, *SavedSearchCreateRequest) (*SavedSearchCreateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedSavedSearchServer) List(context.Context, *SavedSearchListRequest) (*SavedSearchListResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedSavedSearchServer) Update(context.Context, *SavedSearchUpdateRequest) (*SavedSearchUpdateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedSavedSearchServer) Delete(context.Context, *SavedSearchDeleteRequest) (*SavedSearchDeleteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedSavedSearchServer) Run(context.Context, *SavedSearchRunRequest) (*SavedSearchRunResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Run not implemented")
+}
+func (UnimplementedSavedSearchServer) mustEmbedUnimplementedSavedSearchServer() {}
+func (UnimplementedSavedSearchServer) testEmbeddedByValue()                     {}
+
+// UnsafeSavedSearchServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SavedSearchServer will
+// result in compilation errors.
+type UnsafeSavedSearchServer interface {
+	mustEmbedUnimplementedSavedSearchServer()
+}
+
+func RegisterSavedSearchServer(s grpc.ServiceRegistrar, srv SavedSearchServer) {
+	// If the following call panics, it indicates UnimplementedSavedSearchServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SavedSearch_ServiceDesc, srv)
+}
+
+func _SavedSearch_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavedSearchCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearch_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchServer).Create(ctx, req.(*SavedSearchCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedSearch_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavedSearchListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearch_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchServer).List(ctx, req.(*SavedSearchListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedSearch_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavedSearchUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearch_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchServer).Update(ctx, req.(*SavedSearchUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedSearch_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavedSearchDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearch_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchServer).Delete(ctx, req.(*SavedSearchDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedSearch_Run_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavedSearchRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchServer).Run(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearch_Run_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchServer).Run(ctx, req.(*SavedSearchRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SavedSearch_ServiceDesc is the grpc.ServiceDesc for SavedSearch service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SavedSearch_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "SavedSearch",
+	HandlerType: (*SavedSearchServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _SavedSearch_Create_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _SavedSearch_List_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _SavedSearch_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _SavedSearch_Delete_Handler,
+		},
+		{
+			MethodName: "Run",
+			Handler:    _SavedSearch_Run_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "savedsearch.proto",
+}
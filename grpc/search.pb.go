@@ -0,0 +1,357 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.0
+// source: search.proto
+
+package grpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SearchSuggestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          string                 `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=Query,proto3" json:"Query,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSuggestRequest) Reset() {
+	*x = SearchSuggestRequest{}
+	mi := &file_search_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSuggestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSuggestRequest) ProtoMessage() {}
+
+func (x *SearchSuggestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSuggestRequest.ProtoReflect.Descriptor instead.
+func (*SearchSuggestRequest) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SearchSuggestRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *SearchSuggestRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchSuggestRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchSuggestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*SearchSuggestTag    `protobuf:"bytes,1,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	Items         []*SearchSuggestItem   `protobuf:"bytes,2,rep,name=Items,proto3" json:"Items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSuggestResponse) Reset() {
+	*x = SearchSuggestResponse{}
+	mi := &file_search_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSuggestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSuggestResponse) ProtoMessage() {}
+
+func (x *SearchSuggestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSuggestResponse.ProtoReflect.Descriptor instead.
+func (*SearchSuggestResponse) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchSuggestResponse) GetTags() []*SearchSuggestTag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SearchSuggestResponse) GetItems() []*SearchSuggestItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type SearchSuggestTag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Category      TagCategory            `protobuf:"varint,3,opt,name=Category,proto3,enum=mangaweb4.types.TagCategory" json:"Category,omitempty"`
+	ItemCount     int32                  `protobuf:"varint,4,opt,name=ItemCount,proto3" json:"ItemCount,omitempty"`
+	IsFavorite    bool                   `protobuf:"varint,5,opt,name=IsFavorite,proto3" json:"IsFavorite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSuggestTag) Reset() {
+	*x = SearchSuggestTag{}
+	mi := &file_search_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSuggestTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSuggestTag) ProtoMessage() {}
+
+func (x *SearchSuggestTag) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSuggestTag.ProtoReflect.Descriptor instead.
+func (*SearchSuggestTag) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchSuggestTag) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SearchSuggestTag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchSuggestTag) GetCategory() TagCategory {
+	if x != nil {
+		return x.Category
+	}
+	return TagCategory_TAG_CATEGORY_UNSPECIFIED
+}
+
+func (x *SearchSuggestTag) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *SearchSuggestTag) GetIsFavorite() bool {
+	if x != nil {
+		return x.IsFavorite
+	}
+	return false
+}
+
+type SearchSuggestItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	IsFavorite    bool                   `protobuf:"varint,3,opt,name=IsFavorite,proto3" json:"IsFavorite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSuggestItem) Reset() {
+	*x = SearchSuggestItem{}
+	mi := &file_search_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSuggestItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSuggestItem) ProtoMessage() {}
+
+func (x *SearchSuggestItem) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSuggestItem.ProtoReflect.Descriptor instead.
+func (*SearchSuggestItem) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SearchSuggestItem) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SearchSuggestItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchSuggestItem) GetIsFavorite() bool {
+	if x != nil {
+		return x.IsFavorite
+	}
+	return false
+}
+
+var File_search_proto protoreflect.FileDescriptor
+
+const file_search_proto_rawDesc = "" +
+	"\n" +
+	"\fsearch.proto\x1a\vtypes.proto\"V\n" +
+	"\x14SearchSuggestRequest\x12\x12\n" +
+	"\x04User\x18\x01 \x01(\tR\x04User\x12\x14\n" +
+	"\x05Query\x18\x02 \x01(\tR\x05Query\x12\x14\n" +
+	"\x05Limit\x18\x03 \x01(\x05R\x05Limit\"h\n" +
+	"\x15SearchSuggestResponse\x12%\n" +
+	"\x04Tags\x18\x01 \x03(\v2\x11.SearchSuggestTagR\x04Tags\x12(\n" +
+	"\x05Items\x18\x02 \x03(\v2\x12.SearchSuggestItemR\x05Items\"\xae\x01\n" +
+	"\x10SearchSuggestTag\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x05R\x02Id\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x128\n" +
+	"\bCategory\x18\x03 \x01(\x0e2\x1c.mangaweb4.types.TagCategoryR\bCategory\x12\x1c\n" +
+	"\tItemCount\x18\x04 \x01(\x05R\tItemCount\x12\x1e\n" +
+	"\n" +
+	"IsFavorite\x18\x05 \x01(\bR\n" +
+	"IsFavorite\"W\n" +
+	"\x11SearchSuggestItem\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x05R\x02Id\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x1e\n" +
+	"\n" +
+	"IsFavorite\x18\x03 \x01(\bR\n" +
+	"IsFavorite2D\n" +
+	"\x06Search\x12:\n" +
+	"\aSuggest\x12\x15.SearchSuggestRequest\x1a\x16.SearchSuggestResponse\"\x00B-Z+github.com/mangaweb4/mangaweb4-backend/grpcb\x06proto3"
+
+var (
+	file_search_proto_rawDescOnce sync.Once
+	file_search_proto_rawDescData []byte
+)
+
+func file_search_proto_rawDescGZIP() []byte {
+	file_search_proto_rawDescOnce.Do(func() {
+		file_search_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_search_proto_rawDesc), len(file_search_proto_rawDesc)))
+	})
+	return file_search_proto_rawDescData
+}
+
+var file_search_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_search_proto_goTypes = []any{
+	(*SearchSuggestRequest)(nil),  // 0: SearchSuggestRequest
+	(*SearchSuggestResponse)(nil), // 1: SearchSuggestResponse
+	(*SearchSuggestTag)(nil),      // 2: SearchSuggestTag
+	(*SearchSuggestItem)(nil),     // 3: SearchSuggestItem
+	(TagCategory)(0),              // 4: mangaweb4.types.TagCategory
+}
+var file_search_proto_depIdxs = []int32{
+	2, // 0: SearchSuggestResponse.Tags:type_name -> SearchSuggestTag
+	3, // 1: SearchSuggestResponse.Items:type_name -> SearchSuggestItem
+	4, // 2: SearchSuggestTag.Category:type_name -> mangaweb4.types.TagCategory
+	0, // 3: Search.Suggest:input_type -> SearchSuggestRequest
+	1, // 4: Search.Suggest:output_type -> SearchSuggestResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_search_proto_init() }
+func file_search_proto_init() {
+	if File_search_proto != nil {
+		return
+	}
+	file_types_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_search_proto_rawDesc), len(file_search_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_search_proto_goTypes,
+		DependencyIndexes: file_search_proto_depIdxs,
+		MessageInfos:      file_search_proto_msgTypes,
+	}.Build()
+	File_search_proto = out.File
+	file_search_proto_goTypes = nil
+	file_search_proto_depIdxs = nil
+}
@@ -30,6 +30,8 @@ type TagListRequest struct {
 	Search        string                 `protobuf:"bytes,6,opt,name=Search,proto3" json:"Search,omitempty"`
 	Sort          SortField              `protobuf:"varint,7,opt,name=Sort,proto3,enum=mangaweb4.types.SortField" json:"Sort,omitempty"`
 	Order         SortOrder              `protobuf:"varint,8,opt,name=Order,proto3,enum=mangaweb4.types.SortOrder" json:"Order,omitempty"`
+	Category      TagCategory            `protobuf:"varint,9,opt,name=Category,proto3,enum=mangaweb4.types.TagCategory" json:"Category,omitempty"`
+	Cursor        string                 `protobuf:"bytes,10,opt,name=Cursor,proto3" json:"Cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -113,11 +115,26 @@ func (x *TagListRequest) GetOrder() SortOrder {
 	return SortOrder_SORT_ORDER_ASCENDING
 }
 
+func (x *TagListRequest) GetCategory() TagCategory {
+	if x != nil {
+		return x.Category
+	}
+	return TagCategory_TAG_CATEGORY_UNSPECIFIED
+}
+
+func (x *TagListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 type TagListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TagFavorite   bool                   `protobuf:"varint,1,opt,name=TagFavorite,proto3" json:"TagFavorite,omitempty"`
 	TotalPage     int32                  `protobuf:"varint,2,opt,name=TotalPage,proto3" json:"TotalPage,omitempty"`
 	Items         []*TagListResponseItem `protobuf:"bytes,3,rep,name=Items,proto3" json:"Items,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,4,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -173,18 +190,28 @@ func (x *TagListResponse) GetItems() []*TagListResponseItem {
 	return nil
 }
 
+func (x *TagListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type TagDetailRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          string                 `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
-	Id            int32                  `protobuf:"varint,2,opt,name=Id,proto3" json:"Id,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=Page,proto3" json:"Page,omitempty"`
-	ItemPerPage   int32                  `protobuf:"varint,4,opt,name=ItemPerPage,proto3" json:"ItemPerPage,omitempty"`
-	Search        string                 `protobuf:"bytes,5,opt,name=Search,proto3" json:"Search,omitempty"`
-	Filter        Filter                 `protobuf:"varint,6,opt,name=Filter,proto3,enum=mangaweb4.types.Filter" json:"Filter,omitempty"`
-	Sort          SortField              `protobuf:"varint,7,opt,name=Sort,proto3,enum=mangaweb4.types.SortField" json:"Sort,omitempty"`
-	Order         SortOrder              `protobuf:"varint,8,opt,name=Order,proto3,enum=mangaweb4.types.SortOrder" json:"Order,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	User             string                 `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
+	Id               int32                  `protobuf:"varint,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	Page             int32                  `protobuf:"varint,3,opt,name=Page,proto3" json:"Page,omitempty"`
+	ItemPerPage      int32                  `protobuf:"varint,4,opt,name=ItemPerPage,proto3" json:"ItemPerPage,omitempty"`
+	Search           string                 `protobuf:"bytes,5,opt,name=Search,proto3" json:"Search,omitempty"`
+	Filter           Filter                 `protobuf:"varint,6,opt,name=Filter,proto3,enum=mangaweb4.types.Filter" json:"Filter,omitempty"`
+	Sort             SortField              `protobuf:"varint,7,opt,name=Sort,proto3,enum=mangaweb4.types.SortField" json:"Sort,omitempty"`
+	Order            SortOrder              `protobuf:"varint,8,opt,name=Order,proto3,enum=mangaweb4.types.SortOrder" json:"Order,omitempty"`
+	RandomSeed       int64                  `protobuf:"varint,9,opt,name=RandomSeed,proto3" json:"RandomSeed,omitempty"`
+	Cursor           string                 `protobuf:"bytes,10,opt,name=Cursor,proto3" json:"Cursor,omitempty"`
+	UsePreferredSort bool                   `protobuf:"varint,11,opt,name=UsePreferredSort,proto3" json:"UsePreferredSort,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *TagDetailRequest) Reset() {
@@ -273,12 +300,38 @@ func (x *TagDetailRequest) GetOrder() SortOrder {
 	return SortOrder_SORT_ORDER_ASCENDING
 }
 
+func (x *TagDetailRequest) GetRandomSeed() int64 {
+	if x != nil {
+		return x.RandomSeed
+	}
+	return 0
+}
+
+func (x *TagDetailRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *TagDetailRequest) GetUsePreferredSort() bool {
+	if x != nil {
+		return x.UsePreferredSort
+	}
+	return false
+}
+
 type TagDetailResponse struct {
 	state          protoimpl.MessageState   `protogen:"open.v1"`
 	Name           string                   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
 	TagFavorite    bool                     `protobuf:"varint,2,opt,name=TagFavorite,proto3" json:"TagFavorite,omitempty"`
 	TotalItemCount int32                    `protobuf:"varint,3,opt,name=TotalItemCount,proto3" json:"TotalItemCount,omitempty"`
 	Items          []*TagDetailResponseItem `protobuf:"bytes,4,rep,name=Items,proto3" json:"Items,omitempty"`
+	Description    string                   `protobuf:"bytes,5,opt,name=Description,proto3" json:"Description,omitempty"`
+	Links          []string                 `protobuf:"bytes,6,rep,name=Links,proto3" json:"Links,omitempty"`
+	Category       TagCategory              `protobuf:"varint,7,opt,name=Category,proto3,enum=mangaweb4.types.TagCategory" json:"Category,omitempty"`
+	Aliases        []string                 `protobuf:"bytes,8,rep,name=Aliases,proto3" json:"Aliases,omitempty"`
+	NextCursor     string                   `protobuf:"bytes,9,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -341,6 +394,41 @@ func (x *TagDetailResponse) GetItems() []*TagDetailResponseItem {
 	return nil
 }
 
+func (x *TagDetailResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TagDetailResponse) GetLinks() []string {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *TagDetailResponse) GetCategory() TagCategory {
+	if x != nil {
+		return x.Category
+	}
+	return TagCategory_TAG_CATEGORY_UNSPECIFIED
+}
+
+func (x *TagDetailResponse) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *TagDetailResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type TagDetailResponseItem struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             int32                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
@@ -449,6 +537,8 @@ type TagListResponseItem struct {
 	IsRead         bool                   `protobuf:"varint,4,opt,name=IsRead,proto3" json:"IsRead,omitempty"`
 	PageCount      int32                  `protobuf:"varint,5,opt,name=PageCount,proto3" json:"PageCount,omitempty"`
 	HasFavoriteTag bool                   `protobuf:"varint,6,opt,name=HasFavoriteTag,proto3" json:"HasFavoriteTag,omitempty"`
+	Category       TagCategory            `protobuf:"varint,7,opt,name=Category,proto3,enum=mangaweb4.types.TagCategory" json:"Category,omitempty"`
+	IsHidden       bool                   `protobuf:"varint,8,opt,name=IsHidden,proto3" json:"IsHidden,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -525,6 +615,20 @@ func (x *TagListResponseItem) GetHasFavoriteTag() bool {
 	return false
 }
 
+func (x *TagListResponseItem) GetCategory() TagCategory {
+	if x != nil {
+		return x.Category
+	}
+	return TagCategory_TAG_CATEGORY_UNSPECIFIED
+}
+
+func (x *TagListResponseItem) GetIsHidden() bool {
+	if x != nil {
+		return x.IsHidden
+	}
+	return false
+}
+
 type TagThumbnailRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,2,opt,name=Id,proto3" json:"Id,omitempty"`
@@ -733,74 +837,955 @@ func (x *TagSetFavoriteResponse) GetFavorite() bool {
 	return false
 }
 
-var File_tag_proto protoreflect.FileDescriptor
+type TagMergeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      int32                  `protobuf:"varint,1,opt,name=SourceId,proto3" json:"SourceId,omitempty"`
+	TargetId      int32                  `protobuf:"varint,2,opt,name=TargetId,proto3" json:"TargetId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_tag_proto_rawDesc = "" +
-	"\n" +
-	"\ttag.proto\x1a\vtypes.proto\"\x8b\x02\n" +
-	"\x0eTagListRequest\x12\x12\n" +
-	"\x04User\x18\x01 \x01(\tR\x04User\x12/\n" +
-	"\x06Filter\x18\x03 \x01(\x0e2\x17.mangaweb4.types.FilterR\x06Filter\x12\x12\n" +
-	"\x04Page\x18\x04 \x01(\x05R\x04Page\x12 \n" +
-	"\vItemPerPage\x18\x05 \x01(\x05R\vItemPerPage\x12\x16\n" +
-	"\x06Search\x18\x06 \x01(\tR\x06Search\x12.\n" +
-	"\x04Sort\x18\a \x01(\x0e2\x1a.mangaweb4.types.SortFieldR\x04Sort\x120\n" +
-	"\x05Order\x18\b \x01(\x0e2\x1a.mangaweb4.types.SortOrderR\x05OrderJ\x04\b\x02\x10\x03\"}\n" +
-	"\x0fTagListResponse\x12 \n" +
-	"\vTagFavorite\x18\x01 \x01(\bR\vTagFavorite\x12\x1c\n" +
-	"\tTotalPage\x18\x02 \x01(\x05R\tTotalPage\x12*\n" +
-	"\x05Items\x18\x03 \x03(\v2\x14.TagListResponseItemR\x05Items\"\x97\x02\n" +
-	"\x10TagDetailRequest\x12\x12\n" +
-	"\x04User\x18\x01 \x01(\tR\x04User\x12\x0e\n" +
-	"\x02Id\x18\x02 \x01(\x05R\x02Id\x12\x12\n" +
-	"\x04Page\x18\x03 \x01(\x05R\x04Page\x12 \n" +
-	"\vItemPerPage\x18\x04 \x01(\x05R\vItemPerPage\x12\x16\n" +
-	"\x06Search\x18\x05 \x01(\tR\x06Search\x12/\n" +
-	"\x06Filter\x18\x06 \x01(\x0e2\x17.mangaweb4.types.FilterR\x06Filter\x12.\n" +
-	"\x04Sort\x18\a \x01(\x0e2\x1a.mangaweb4.types.SortFieldR\x04Sort\x120\n" +
-	"\x05Order\x18\b \x01(\x0e2\x1a.mangaweb4.types.SortOrderR\x05Order\"\x9f\x01\n" +
-	"\x11TagDetailResponse\x12\x12\n" +
-	"\x04Name\x18\x01 \x01(\tR\x04Name\x12 \n" +
-	"\vTagFavorite\x18\x02 \x01(\bR\vTagFavorite\x12&\n" +
-	"\x0eTotalItemCount\x18\x03 \x01(\x05R\x0eTotalItemCount\x12,\n" +
-	"\x05Items\x18\x04 \x03(\v2\x16.TagDetailResponseItemR\x05Items\"\xfd\x01\n" +
-	"\x15TagDetailResponseItem\x12\x0e\n" +
-	"\x02Id\x18\x01 \x01(\x05R\x02Id\x12\x12\n" +
-	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x1e\n" +
-	"\n" +
-	"IsFavorite\x18\x03 \x01(\bR\n" +
-	"IsFavorite\x12\x16\n" +
-	"\x06IsRead\x18\x04 \x01(\bR\x06IsRead\x12\x1c\n" +
-	"\tPageCount\x18\x05 \x01(\x05R\tPageCount\x12&\n" +
-	"\x0eHasFavoriteTag\x18\x06 \x01(\bR\x0eHasFavoriteTag\x12 \n" +
-	"\vCurrentPage\x18\a \x01(\x05R\vCurrentPage\x12 \n" +
-	"\vMaxProgress\x18\b \x01(\x05R\vMaxProgress\"\xb7\x01\n" +
-	"\x13TagListResponseItem\x12\x0e\n" +
-	"\x02Id\x18\x01 \x01(\x05R\x02Id\x12\x12\n" +
-	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x1e\n" +
-	"\n" +
-	"IsFavorite\x18\x03 \x01(\bR\n" +
-	"IsFavorite\x12\x16\n" +
-	"\x06IsRead\x18\x04 \x01(\bR\x06IsRead\x12\x1c\n" +
-	"\tPageCount\x18\x05 \x01(\x05R\tPageCount\x12&\n" +
-	"\x0eHasFavoriteTag\x18\x06 \x01(\bR\x0eHasFavoriteTag\"+\n" +
-	"\x13TagThumbnailRequest\x12\x0e\n" +
-	"\x02Id\x18\x02 \x01(\x05R\x02IdJ\x04\b\x01\x10\x02\"L\n" +
-	"\x14TagThumbnailResponse\x12 \n" +
-	"\vContentType\x18\x01 \x01(\tR\vContentType\x12\x12\n" +
-	"\x04Data\x18\x02 \x01(\fR\x04Data\"]\n" +
-	"\x15TagSetFavoriteRequest\x12\x12\n" +
-	"\x04User\x18\x01 \x01(\tR\x04User\x12\x1a\n" +
-	"\bFavorite\x18\x03 \x01(\bR\bFavorite\x12\x0e\n" +
-	"\x02Id\x18\x04 \x01(\x05R\x02IdJ\x04\b\x02\x10\x03\"F\n" +
-	"\x16TagSetFavoriteResponse\x12\x10\n" +
-	"\x03Tag\x18\x01 \x01(\tR\x03Tag\x12\x1a\n" +
-	"\bFavorite\x18\x02 \x01(\bR\bFavorite2\xe3\x01\n" +
-	"\x03Tag\x12+\n" +
-	"\x04List\x12\x0f.TagListRequest\x1a\x10.TagListResponse\"\x00\x121\n" +
-	"\x06Detail\x12\x11.TagDetailRequest\x1a\x12.TagDetailResponse\"\x00\x12:\n" +
-	"\tThumbnail\x12\x14.TagThumbnailRequest\x1a\x15.TagThumbnailResponse\"\x00\x12@\n" +
-	"\vSetFavorite\x12\x16.TagSetFavoriteRequest\x1a\x17.TagSetFavoriteResponse\"\x00B-Z+github.com/mangaweb4/mangaweb4-backend/grpcb\x06proto3"
+func (x *TagMergeRequest) Reset() {
+	*x = TagMergeRequest{}
+	mi := &file_tag_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagMergeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagMergeRequest) ProtoMessage() {}
+
+func (x *TagMergeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagMergeRequest.ProtoReflect.Descriptor instead.
+func (*TagMergeRequest) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TagMergeRequest) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *TagMergeRequest) GetTargetId() int32 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+type TagMergeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Aliases       []string               `protobuf:"bytes,3,rep,name=Aliases,proto3" json:"Aliases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagMergeResponse) Reset() {
+	*x = TagMergeResponse{}
+	mi := &file_tag_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagMergeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagMergeResponse) ProtoMessage() {}
+
+func (x *TagMergeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagMergeResponse.ProtoReflect.Descriptor instead.
+func (*TagMergeResponse) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TagMergeResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TagMergeResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagMergeResponse) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+type TagSetHiddenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Hidden        bool                   `protobuf:"varint,2,opt,name=Hidden,proto3" json:"Hidden,omitempty"`
+	HideItems     bool                   `protobuf:"varint,3,opt,name=HideItems,proto3" json:"HideItems,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagSetHiddenRequest) Reset() {
+	*x = TagSetHiddenRequest{}
+	mi := &file_tag_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagSetHiddenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagSetHiddenRequest) ProtoMessage() {}
+
+func (x *TagSetHiddenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagSetHiddenRequest.ProtoReflect.Descriptor instead.
+func (*TagSetHiddenRequest) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TagSetHiddenRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TagSetHiddenRequest) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *TagSetHiddenRequest) GetHideItems() bool {
+	if x != nil {
+		return x.HideItems
+	}
+	return false
+}
+
+type TagSetHiddenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=Tag,proto3" json:"Tag,omitempty"`
+	Hidden        bool                   `protobuf:"varint,2,opt,name=Hidden,proto3" json:"Hidden,omitempty"`
+	HideItems     bool                   `protobuf:"varint,3,opt,name=HideItems,proto3" json:"HideItems,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagSetHiddenResponse) Reset() {
+	*x = TagSetHiddenResponse{}
+	mi := &file_tag_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagSetHiddenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagSetHiddenResponse) ProtoMessage() {}
+
+func (x *TagSetHiddenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagSetHiddenResponse.ProtoReflect.Descriptor instead.
+func (*TagSetHiddenResponse) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TagSetHiddenResponse) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagSetHiddenResponse) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *TagSetHiddenResponse) GetHideItems() bool {
+	if x != nil {
+		return x.HideItems
+	}
+	return false
+}
+
+type TagSetBlockedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          string                 `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	Blocked       bool                   `protobuf:"varint,3,opt,name=Blocked,proto3" json:"Blocked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagSetBlockedRequest) Reset() {
+	*x = TagSetBlockedRequest{}
+	mi := &file_tag_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagSetBlockedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagSetBlockedRequest) ProtoMessage() {}
+
+func (x *TagSetBlockedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagSetBlockedRequest.ProtoReflect.Descriptor instead.
+func (*TagSetBlockedRequest) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TagSetBlockedRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *TagSetBlockedRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TagSetBlockedRequest) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+type TagSetBlockedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=Tag,proto3" json:"Tag,omitempty"`
+	Blocked       bool                   `protobuf:"varint,2,opt,name=Blocked,proto3" json:"Blocked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagSetBlockedResponse) Reset() {
+	*x = TagSetBlockedResponse{}
+	mi := &file_tag_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagSetBlockedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagSetBlockedResponse) ProtoMessage() {}
+
+func (x *TagSetBlockedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagSetBlockedResponse.ProtoReflect.Descriptor instead.
+func (*TagSetBlockedResponse) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TagSetBlockedResponse) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagSetBlockedResponse) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+type TagRenameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagRenameRequest) Reset() {
+	*x = TagRenameRequest{}
+	mi := &file_tag_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagRenameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagRenameRequest) ProtoMessage() {}
+
+func (x *TagRenameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagRenameRequest.ProtoReflect.Descriptor instead.
+func (*TagRenameRequest) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TagRenameRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TagRenameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type TagRenameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Aliases       []string               `protobuf:"bytes,3,rep,name=Aliases,proto3" json:"Aliases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagRenameResponse) Reset() {
+	*x = TagRenameResponse{}
+	mi := &file_tag_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagRenameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagRenameResponse) ProtoMessage() {}
+
+func (x *TagRenameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagRenameResponse.ProtoReflect.Descriptor instead.
+func (*TagRenameResponse) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TagRenameResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TagRenameResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagRenameResponse) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+type TagUpdateInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	Links         []string               `protobuf:"bytes,3,rep,name=Links,proto3" json:"Links,omitempty"`
+	Category      TagCategory            `protobuf:"varint,4,opt,name=Category,proto3,enum=mangaweb4.types.TagCategory" json:"Category,omitempty"`
+	UpdateFields  []string               `protobuf:"bytes,5,rep,name=UpdateFields,proto3" json:"UpdateFields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagUpdateInfoRequest) Reset() {
+	*x = TagUpdateInfoRequest{}
+	mi := &file_tag_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagUpdateInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagUpdateInfoRequest) ProtoMessage() {}
+
+func (x *TagUpdateInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagUpdateInfoRequest.ProtoReflect.Descriptor instead.
+func (*TagUpdateInfoRequest) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TagUpdateInfoRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TagUpdateInfoRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TagUpdateInfoRequest) GetLinks() []string {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *TagUpdateInfoRequest) GetCategory() TagCategory {
+	if x != nil {
+		return x.Category
+	}
+	return TagCategory_TAG_CATEGORY_UNSPECIFIED
+}
+
+func (x *TagUpdateInfoRequest) GetUpdateFields() []string {
+	if x != nil {
+		return x.UpdateFields
+	}
+	return nil
+}
+
+type TagUpdateInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
+	Links         []string               `protobuf:"bytes,4,rep,name=Links,proto3" json:"Links,omitempty"`
+	Category      TagCategory            `protobuf:"varint,5,opt,name=Category,proto3,enum=mangaweb4.types.TagCategory" json:"Category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagUpdateInfoResponse) Reset() {
+	*x = TagUpdateInfoResponse{}
+	mi := &file_tag_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagUpdateInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagUpdateInfoResponse) ProtoMessage() {}
+
+func (x *TagUpdateInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagUpdateInfoResponse.ProtoReflect.Descriptor instead.
+func (*TagUpdateInfoResponse) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TagUpdateInfoResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TagUpdateInfoResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagUpdateInfoResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TagUpdateInfoResponse) GetLinks() []string {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *TagUpdateInfoResponse) GetCategory() TagCategory {
+	if x != nil {
+		return x.Category
+	}
+	return TagCategory_TAG_CATEGORY_UNSPECIFIED
+}
+
+type TagRelatedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          string                 `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	Category      TagCategory            `protobuf:"varint,3,opt,name=Category,proto3,enum=mangaweb4.types.TagCategory" json:"Category,omitempty"`
+	MinSupport    int32                  `protobuf:"varint,4,opt,name=MinSupport,proto3" json:"MinSupport,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagRelatedRequest) Reset() {
+	*x = TagRelatedRequest{}
+	mi := &file_tag_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagRelatedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagRelatedRequest) ProtoMessage() {}
+
+func (x *TagRelatedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagRelatedRequest.ProtoReflect.Descriptor instead.
+func (*TagRelatedRequest) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TagRelatedRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *TagRelatedRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TagRelatedRequest) GetCategory() TagCategory {
+	if x != nil {
+		return x.Category
+	}
+	return TagCategory_TAG_CATEGORY_UNSPECIFIED
+}
+
+func (x *TagRelatedRequest) GetMinSupport() int32 {
+	if x != nil {
+		return x.MinSupport
+	}
+	return 0
+}
+
+func (x *TagRelatedRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TagRelatedResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Tags          []*TagRelatedResponseItem `protobuf:"bytes,1,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagRelatedResponse) Reset() {
+	*x = TagRelatedResponse{}
+	mi := &file_tag_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagRelatedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagRelatedResponse) ProtoMessage() {}
+
+func (x *TagRelatedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagRelatedResponse.ProtoReflect.Descriptor instead.
+func (*TagRelatedResponse) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TagRelatedResponse) GetTags() []*TagRelatedResponseItem {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type TagRelatedResponseItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Category      TagCategory            `protobuf:"varint,3,opt,name=Category,proto3,enum=mangaweb4.types.TagCategory" json:"Category,omitempty"`
+	Count         int32                  `protobuf:"varint,4,opt,name=Count,proto3" json:"Count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagRelatedResponseItem) Reset() {
+	*x = TagRelatedResponseItem{}
+	mi := &file_tag_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagRelatedResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagRelatedResponseItem) ProtoMessage() {}
+
+func (x *TagRelatedResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_tag_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagRelatedResponseItem.ProtoReflect.Descriptor instead.
+func (*TagRelatedResponseItem) Descriptor() ([]byte, []int) {
+	return file_tag_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TagRelatedResponseItem) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TagRelatedResponseItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagRelatedResponseItem) GetCategory() TagCategory {
+	if x != nil {
+		return x.Category
+	}
+	return TagCategory_TAG_CATEGORY_UNSPECIFIED
+}
+
+func (x *TagRelatedResponseItem) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_tag_proto protoreflect.FileDescriptor
+
+const file_tag_proto_rawDesc = "" +
+	"\n" +
+	"\ttag.proto\x1a\vtypes.proto\"\xdd\x02\n" +
+	"\x0eTagListRequest\x12\x12\n" +
+	"\x04User\x18\x01 \x01(\tR\x04User\x12/\n" +
+	"\x06Filter\x18\x03 \x01(\x0e2\x17.mangaweb4.types.FilterR\x06Filter\x12\x12\n" +
+	"\x04Page\x18\x04 \x01(\x05R\x04Page\x12 \n" +
+	"\vItemPerPage\x18\x05 \x01(\x05R\vItemPerPage\x12\x16\n" +
+	"\x06Search\x18\x06 \x01(\tR\x06Search\x12.\n" +
+	"\x04Sort\x18\a \x01(\x0e2\x1a.mangaweb4.types.SortFieldR\x04Sort\x120\n" +
+	"\x05Order\x18\b \x01(\x0e2\x1a.mangaweb4.types.SortOrderR\x05Order\x128\n" +
+	"\bCategory\x18\t \x01(\x0e2\x1c.mangaweb4.types.TagCategoryR\bCategory\x12\x16\n" +
+	"\x06Cursor\x18\n" +
+	" \x01(\tR\x06CursorJ\x04\b\x02\x10\x03\"\x9d\x01\n" +
+	"\x0fTagListResponse\x12 \n" +
+	"\vTagFavorite\x18\x01 \x01(\bR\vTagFavorite\x12\x1c\n" +
+	"\tTotalPage\x18\x02 \x01(\x05R\tTotalPage\x12*\n" +
+	"\x05Items\x18\x03 \x03(\v2\x14.TagListResponseItemR\x05Items\x12\x1e\n" +
+	"\n" +
+	"NextCursor\x18\x04 \x01(\tR\n" +
+	"NextCursor\"\xfb\x02\n" +
+	"\x10TagDetailRequest\x12\x12\n" +
+	"\x04User\x18\x01 \x01(\tR\x04User\x12\x0e\n" +
+	"\x02Id\x18\x02 \x01(\x05R\x02Id\x12\x12\n" +
+	"\x04Page\x18\x03 \x01(\x05R\x04Page\x12 \n" +
+	"\vItemPerPage\x18\x04 \x01(\x05R\vItemPerPage\x12\x16\n" +
+	"\x06Search\x18\x05 \x01(\tR\x06Search\x12/\n" +
+	"\x06Filter\x18\x06 \x01(\x0e2\x17.mangaweb4.types.FilterR\x06Filter\x12.\n" +
+	"\x04Sort\x18\a \x01(\x0e2\x1a.mangaweb4.types.SortFieldR\x04Sort\x120\n" +
+	"\x05Order\x18\b \x01(\x0e2\x1a.mangaweb4.types.SortOrderR\x05Order\x12\x1e\n" +
+	"\n" +
+	"RandomSeed\x18\t \x01(\x03R\n" +
+	"RandomSeed\x12\x16\n" +
+	"\x06Cursor\x18\n" +
+	" \x01(\tR\x06Cursor\x12*\n" +
+	"\x10UsePreferredSort\x18\v \x01(\bR\x10UsePreferredSort\"\xcb\x02\n" +
+	"\x11TagDetailResponse\x12\x12\n" +
+	"\x04Name\x18\x01 \x01(\tR\x04Name\x12 \n" +
+	"\vTagFavorite\x18\x02 \x01(\bR\vTagFavorite\x12&\n" +
+	"\x0eTotalItemCount\x18\x03 \x01(\x05R\x0eTotalItemCount\x12,\n" +
+	"\x05Items\x18\x04 \x03(\v2\x16.TagDetailResponseItemR\x05Items\x12 \n" +
+	"\vDescription\x18\x05 \x01(\tR\vDescription\x12\x14\n" +
+	"\x05Links\x18\x06 \x03(\tR\x05Links\x128\n" +
+	"\bCategory\x18\a \x01(\x0e2\x1c.mangaweb4.types.TagCategoryR\bCategory\x12\x18\n" +
+	"\aAliases\x18\b \x03(\tR\aAliases\x12\x1e\n" +
+	"\n" +
+	"NextCursor\x18\t \x01(\tR\n" +
+	"NextCursor\"\xfd\x01\n" +
+	"\x15TagDetailResponseItem\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x05R\x02Id\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x1e\n" +
+	"\n" +
+	"IsFavorite\x18\x03 \x01(\bR\n" +
+	"IsFavorite\x12\x16\n" +
+	"\x06IsRead\x18\x04 \x01(\bR\x06IsRead\x12\x1c\n" +
+	"\tPageCount\x18\x05 \x01(\x05R\tPageCount\x12&\n" +
+	"\x0eHasFavoriteTag\x18\x06 \x01(\bR\x0eHasFavoriteTag\x12 \n" +
+	"\vCurrentPage\x18\a \x01(\x05R\vCurrentPage\x12 \n" +
+	"\vMaxProgress\x18\b \x01(\x05R\vMaxProgress\"\x8d\x02\n" +
+	"\x13TagListResponseItem\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x05R\x02Id\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x1e\n" +
+	"\n" +
+	"IsFavorite\x18\x03 \x01(\bR\n" +
+	"IsFavorite\x12\x16\n" +
+	"\x06IsRead\x18\x04 \x01(\bR\x06IsRead\x12\x1c\n" +
+	"\tPageCount\x18\x05 \x01(\x05R\tPageCount\x12&\n" +
+	"\x0eHasFavoriteTag\x18\x06 \x01(\bR\x0eHasFavoriteTag\x128\n" +
+	"\bCategory\x18\a \x01(\x0e2\x1c.mangaweb4.types.TagCategoryR\bCategory\x12\x1a\n" +
+	"\bIsHidden\x18\b \x01(\bR\bIsHidden\"+\n" +
+	"\x13TagThumbnailRequest\x12\x0e\n" +
+	"\x02Id\x18\x02 \x01(\x05R\x02IdJ\x04\b\x01\x10\x02\"L\n" +
+	"\x14TagThumbnailResponse\x12 \n" +
+	"\vContentType\x18\x01 \x01(\tR\vContentType\x12\x12\n" +
+	"\x04Data\x18\x02 \x01(\fR\x04Data\"]\n" +
+	"\x15TagSetFavoriteRequest\x12\x12\n" +
+	"\x04User\x18\x01 \x01(\tR\x04User\x12\x1a\n" +
+	"\bFavorite\x18\x03 \x01(\bR\bFavorite\x12\x0e\n" +
+	"\x02Id\x18\x04 \x01(\x05R\x02IdJ\x04\b\x02\x10\x03\"F\n" +
+	"\x16TagSetFavoriteResponse\x12\x10\n" +
+	"\x03Tag\x18\x01 \x01(\tR\x03Tag\x12\x1a\n" +
+	"\bFavorite\x18\x02 \x01(\bR\bFavorite\"I\n" +
+	"\x0fTagMergeRequest\x12\x1a\n" +
+	"\bSourceId\x18\x01 \x01(\x05R\bSourceId\x12\x1a\n" +
+	"\bTargetId\x18\x02 \x01(\x05R\bTargetId\"P\n" +
+	"\x10TagMergeResponse\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x05R\x02Id\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x18\n" +
+	"\aAliases\x18\x03 \x03(\tR\aAliases\"[\n" +
+	"\x13TagSetHiddenRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x05R\x02Id\x12\x16\n" +
+	"\x06Hidden\x18\x02 \x01(\bR\x06Hidden\x12\x1c\n" +
+	"\tHideItems\x18\x03 \x01(\bR\tHideItems\"^\n" +
+	"\x14TagSetHiddenResponse\x12\x10\n" +
+	"\x03Tag\x18\x01 \x01(\tR\x03Tag\x12\x16\n" +
+	"\x06Hidden\x18\x02 \x01(\bR\x06Hidden\x12\x1c\n" +
+	"\tHideItems\x18\x03 \x01(\bR\tHideItems\"T\n" +
+	"\x14TagSetBlockedRequest\x12\x12\n" +
+	"\x04User\x18\x01 \x01(\tR\x04User\x12\x0e\n" +
+	"\x02Id\x18\x02 \x01(\x05R\x02Id\x12\x18\n" +
+	"\aBlocked\x18\x03 \x01(\bR\aBlocked\"C\n" +
+	"\x15TagSetBlockedResponse\x12\x10\n" +
+	"\x03Tag\x18\x01 \x01(\tR\x03Tag\x12\x18\n" +
+	"\aBlocked\x18\x02 \x01(\bR\aBlocked\"6\n" +
+	"\x10TagRenameRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x05R\x02Id\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\"Q\n" +
+	"\x11TagRenameResponse\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x05R\x02Id\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x18\n" +
+	"\aAliases\x18\x03 \x03(\tR\aAliases\"\xbc\x01\n" +
+	"\x14TagUpdateInfoRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x05R\x02Id\x12 \n" +
+	"\vDescription\x18\x02 \x01(\tR\vDescription\x12\x14\n" +
+	"\x05Links\x18\x03 \x03(\tR\x05Links\x128\n" +
+	"\bCategory\x18\x04 \x01(\x0e2\x1c.mangaweb4.types.TagCategoryR\bCategory\x12\"\n" +
+	"\fUpdateFields\x18\x05 \x03(\tR\fUpdateFields\"\xad\x01\n" +
+	"\x15TagUpdateInfoResponse\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x05R\x02Id\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12 \n" +
+	"\vDescription\x18\x03 \x01(\tR\vDescription\x12\x14\n" +
+	"\x05Links\x18\x04 \x03(\tR\x05Links\x128\n" +
+	"\bCategory\x18\x05 \x01(\x0e2\x1c.mangaweb4.types.TagCategoryR\bCategory\"\xa7\x01\n" +
+	"\x11TagRelatedRequest\x12\x12\n" +
+	"\x04User\x18\x01 \x01(\tR\x04User\x12\x0e\n" +
+	"\x02Id\x18\x02 \x01(\x05R\x02Id\x128\n" +
+	"\bCategory\x18\x03 \x01(\x0e2\x1c.mangaweb4.types.TagCategoryR\bCategory\x12\x1e\n" +
+	"\n" +
+	"MinSupport\x18\x04 \x01(\x05R\n" +
+	"MinSupport\x12\x14\n" +
+	"\x05Limit\x18\x05 \x01(\x05R\x05Limit\"A\n" +
+	"\x12TagRelatedResponse\x12+\n" +
+	"\x04Tags\x18\x01 \x03(\v2\x17.TagRelatedResponseItemR\x04Tags\"\x8c\x01\n" +
+	"\x16TagRelatedResponseItem\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x05R\x02Id\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x128\n" +
+	"\bCategory\x18\x03 \x01(\x0e2\x1c.mangaweb4.types.TagCategoryR\bCategory\x12\x14\n" +
+	"\x05Count\x18\x04 \x01(\x05R\x05Count2\xb6\x04\n" +
+	"\x03Tag\x12+\n" +
+	"\x04List\x12\x0f.TagListRequest\x1a\x10.TagListResponse\"\x00\x121\n" +
+	"\x06Detail\x12\x11.TagDetailRequest\x1a\x12.TagDetailResponse\"\x00\x12:\n" +
+	"\tThumbnail\x12\x14.TagThumbnailRequest\x1a\x15.TagThumbnailResponse\"\x00\x12@\n" +
+	"\vSetFavorite\x12\x16.TagSetFavoriteRequest\x1a\x17.TagSetFavoriteResponse\"\x00\x12.\n" +
+	"\x05Merge\x12\x10.TagMergeRequest\x1a\x11.TagMergeResponse\"\x00\x12:\n" +
+	"\tSetHidden\x12\x14.TagSetHiddenRequest\x1a\x15.TagSetHiddenResponse\"\x00\x12=\n" +
+	"\n" +
+	"SetBlocked\x12\x15.TagSetBlockedRequest\x1a\x16.TagSetBlockedResponse\"\x00\x121\n" +
+	"\x06Rename\x12\x11.TagRenameRequest\x1a\x12.TagRenameResponse\"\x00\x12=\n" +
+	"\n" +
+	"UpdateInfo\x12\x15.TagUpdateInfoRequest\x1a\x16.TagUpdateInfoResponse\"\x00\x124\n" +
+	"\aRelated\x12\x12.TagRelatedRequest\x1a\x13.TagRelatedResponse\"\x00B-Z+github.com/mangaweb4/mangaweb4-backend/grpcb\x06proto3"
 
 var (
 	file_tag_proto_rawDescOnce sync.Once
@@ -814,7 +1799,7 @@ func file_tag_proto_rawDescGZIP() []byte {
 	return file_tag_proto_rawDescData
 }
 
-var file_tag_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_tag_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_tag_proto_goTypes = []any{
 	(*TagListRequest)(nil),         // 0: TagListRequest
 	(*TagListResponse)(nil),        // 1: TagListResponse
@@ -826,32 +1811,66 @@ var file_tag_proto_goTypes = []any{
 	(*TagThumbnailResponse)(nil),   // 7: TagThumbnailResponse
 	(*TagSetFavoriteRequest)(nil),  // 8: TagSetFavoriteRequest
 	(*TagSetFavoriteResponse)(nil), // 9: TagSetFavoriteResponse
-	(Filter)(0),                    // 10: mangaweb4.types.Filter
-	(SortField)(0),                 // 11: mangaweb4.types.SortField
-	(SortOrder)(0),                 // 12: mangaweb4.types.SortOrder
+	(*TagMergeRequest)(nil),        // 10: TagMergeRequest
+	(*TagMergeResponse)(nil),       // 11: TagMergeResponse
+	(*TagSetHiddenRequest)(nil),    // 12: TagSetHiddenRequest
+	(*TagSetHiddenResponse)(nil),   // 13: TagSetHiddenResponse
+	(*TagSetBlockedRequest)(nil),   // 14: TagSetBlockedRequest
+	(*TagSetBlockedResponse)(nil),  // 15: TagSetBlockedResponse
+	(*TagRenameRequest)(nil),       // 16: TagRenameRequest
+	(*TagRenameResponse)(nil),      // 17: TagRenameResponse
+	(*TagUpdateInfoRequest)(nil),   // 18: TagUpdateInfoRequest
+	(*TagUpdateInfoResponse)(nil),  // 19: TagUpdateInfoResponse
+	(*TagRelatedRequest)(nil),      // 20: TagRelatedRequest
+	(*TagRelatedResponse)(nil),     // 21: TagRelatedResponse
+	(*TagRelatedResponseItem)(nil), // 22: TagRelatedResponseItem
+	(Filter)(0),                    // 23: mangaweb4.types.Filter
+	(SortField)(0),                 // 24: mangaweb4.types.SortField
+	(SortOrder)(0),                 // 25: mangaweb4.types.SortOrder
+	(TagCategory)(0),               // 26: mangaweb4.types.TagCategory
 }
 var file_tag_proto_depIdxs = []int32{
-	10, // 0: TagListRequest.Filter:type_name -> mangaweb4.types.Filter
-	11, // 1: TagListRequest.Sort:type_name -> mangaweb4.types.SortField
-	12, // 2: TagListRequest.Order:type_name -> mangaweb4.types.SortOrder
-	5,  // 3: TagListResponse.Items:type_name -> TagListResponseItem
-	10, // 4: TagDetailRequest.Filter:type_name -> mangaweb4.types.Filter
-	11, // 5: TagDetailRequest.Sort:type_name -> mangaweb4.types.SortField
-	12, // 6: TagDetailRequest.Order:type_name -> mangaweb4.types.SortOrder
-	4,  // 7: TagDetailResponse.Items:type_name -> TagDetailResponseItem
-	0,  // 8: Tag.List:input_type -> TagListRequest
-	2,  // 9: Tag.Detail:input_type -> TagDetailRequest
-	6,  // 10: Tag.Thumbnail:input_type -> TagThumbnailRequest
-	8,  // 11: Tag.SetFavorite:input_type -> TagSetFavoriteRequest
-	1,  // 12: Tag.List:output_type -> TagListResponse
-	3,  // 13: Tag.Detail:output_type -> TagDetailResponse
-	7,  // 14: Tag.Thumbnail:output_type -> TagThumbnailResponse
-	9,  // 15: Tag.SetFavorite:output_type -> TagSetFavoriteResponse
-	12, // [12:16] is the sub-list for method output_type
-	8,  // [8:12] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	23, // 0: TagListRequest.Filter:type_name -> mangaweb4.types.Filter
+	24, // 1: TagListRequest.Sort:type_name -> mangaweb4.types.SortField
+	25, // 2: TagListRequest.Order:type_name -> mangaweb4.types.SortOrder
+	26, // 3: TagListRequest.Category:type_name -> mangaweb4.types.TagCategory
+	5,  // 4: TagListResponse.Items:type_name -> TagListResponseItem
+	23, // 5: TagDetailRequest.Filter:type_name -> mangaweb4.types.Filter
+	24, // 6: TagDetailRequest.Sort:type_name -> mangaweb4.types.SortField
+	25, // 7: TagDetailRequest.Order:type_name -> mangaweb4.types.SortOrder
+	4,  // 8: TagDetailResponse.Items:type_name -> TagDetailResponseItem
+	26, // 9: TagDetailResponse.Category:type_name -> mangaweb4.types.TagCategory
+	26, // 10: TagListResponseItem.Category:type_name -> mangaweb4.types.TagCategory
+	26, // 11: TagUpdateInfoRequest.Category:type_name -> mangaweb4.types.TagCategory
+	26, // 12: TagUpdateInfoResponse.Category:type_name -> mangaweb4.types.TagCategory
+	26, // 13: TagRelatedRequest.Category:type_name -> mangaweb4.types.TagCategory
+	22, // 14: TagRelatedResponse.Tags:type_name -> TagRelatedResponseItem
+	26, // 15: TagRelatedResponseItem.Category:type_name -> mangaweb4.types.TagCategory
+	0,  // 16: Tag.List:input_type -> TagListRequest
+	2,  // 17: Tag.Detail:input_type -> TagDetailRequest
+	6,  // 18: Tag.Thumbnail:input_type -> TagThumbnailRequest
+	8,  // 19: Tag.SetFavorite:input_type -> TagSetFavoriteRequest
+	10, // 20: Tag.Merge:input_type -> TagMergeRequest
+	12, // 21: Tag.SetHidden:input_type -> TagSetHiddenRequest
+	14, // 22: Tag.SetBlocked:input_type -> TagSetBlockedRequest
+	16, // 23: Tag.Rename:input_type -> TagRenameRequest
+	18, // 24: Tag.UpdateInfo:input_type -> TagUpdateInfoRequest
+	20, // 25: Tag.Related:input_type -> TagRelatedRequest
+	1,  // 26: Tag.List:output_type -> TagListResponse
+	3,  // 27: Tag.Detail:output_type -> TagDetailResponse
+	7,  // 28: Tag.Thumbnail:output_type -> TagThumbnailResponse
+	9,  // 29: Tag.SetFavorite:output_type -> TagSetFavoriteResponse
+	11, // 30: Tag.Merge:output_type -> TagMergeResponse
+	13, // 31: Tag.SetHidden:output_type -> TagSetHiddenResponse
+	15, // 32: Tag.SetBlocked:output_type -> TagSetBlockedResponse
+	17, // 33: Tag.Rename:output_type -> TagRenameResponse
+	19, // 34: Tag.UpdateInfo:output_type -> TagUpdateInfoResponse
+	21, // 35: Tag.Related:output_type -> TagRelatedResponse
+	26, // [26:36] is the sub-list for method output_type
+	16, // [16:26] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_tag_proto_init() }
@@ -866,7 +1885,7 @@ func file_tag_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tag_proto_rawDesc), len(file_tag_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
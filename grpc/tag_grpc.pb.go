@@ -23,6 +23,12 @@ const (
 	Tag_Detail_FullMethodName      = "/Tag/Detail"
 	Tag_Thumbnail_FullMethodName   = "/Tag/Thumbnail"
 	Tag_SetFavorite_FullMethodName = "/Tag/SetFavorite"
+	Tag_Merge_FullMethodName       = "/Tag/Merge"
+	Tag_SetHidden_FullMethodName   = "/Tag/SetHidden"
+	Tag_SetBlocked_FullMethodName  = "/Tag/SetBlocked"
+	Tag_Rename_FullMethodName      = "/Tag/Rename"
+	Tag_UpdateInfo_FullMethodName  = "/Tag/UpdateInfo"
+	Tag_Related_FullMethodName     = "/Tag/Related"
 )
 
 // TagClient is the client API for Tag service.
@@ -33,6 +39,12 @@ type TagClient interface {
 	Detail(ctx context.Context, in *TagDetailRequest, opts ...grpc.CallOption) (*TagDetailResponse, error)
 	Thumbnail(ctx context.Context, in *TagThumbnailRequest, opts ...grpc.CallOption) (*TagThumbnailResponse, error)
 	SetFavorite(ctx context.Context, in *TagSetFavoriteRequest, opts ...grpc.CallOption) (*TagSetFavoriteResponse, error)
+	Merge(ctx context.Context, in *TagMergeRequest, opts ...grpc.CallOption) (*TagMergeResponse, error)
+	SetHidden(ctx context.Context, in *TagSetHiddenRequest, opts ...grpc.CallOption) (*TagSetHiddenResponse, error)
+	SetBlocked(ctx context.Context, in *TagSetBlockedRequest, opts ...grpc.CallOption) (*TagSetBlockedResponse, error)
+	Rename(ctx context.Context, in *TagRenameRequest, opts ...grpc.CallOption) (*TagRenameResponse, error)
+	UpdateInfo(ctx context.Context, in *TagUpdateInfoRequest, opts ...grpc.CallOption) (*TagUpdateInfoResponse, error)
+	Related(ctx context.Context, in *TagRelatedRequest, opts ...grpc.CallOption) (*TagRelatedResponse, error)
 }
 
 type tagClient struct {
@@ -83,6 +95,66 @@ func (c *tagClient) SetFavorite(ctx context.Context, in *TagSetFavoriteRequest,
 	return out, nil
 }
 
+func (c *tagClient) Merge(ctx context.Context, in *TagMergeRequest, opts ...grpc.CallOption) (*TagMergeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagMergeResponse)
+	err := c.cc.Invoke(ctx, Tag_Merge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagClient) SetHidden(ctx context.Context, in *TagSetHiddenRequest, opts ...grpc.CallOption) (*TagSetHiddenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagSetHiddenResponse)
+	err := c.cc.Invoke(ctx, Tag_SetHidden_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagClient) SetBlocked(ctx context.Context, in *TagSetBlockedRequest, opts ...grpc.CallOption) (*TagSetBlockedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagSetBlockedResponse)
+	err := c.cc.Invoke(ctx, Tag_SetBlocked_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagClient) Rename(ctx context.Context, in *TagRenameRequest, opts ...grpc.CallOption) (*TagRenameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagRenameResponse)
+	err := c.cc.Invoke(ctx, Tag_Rename_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagClient) UpdateInfo(ctx context.Context, in *TagUpdateInfoRequest, opts ...grpc.CallOption) (*TagUpdateInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagUpdateInfoResponse)
+	err := c.cc.Invoke(ctx, Tag_UpdateInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagClient) Related(ctx context.Context, in *TagRelatedRequest, opts ...grpc.CallOption) (*TagRelatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagRelatedResponse)
+	err := c.cc.Invoke(ctx, Tag_Related_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TagServer is the server API for Tag service.
 // All implementations must embed UnimplementedTagServer
 // for forward compatibility.
@@ -91,6 +163,12 @@ type TagServer interface {
 	Detail(context.Context, *TagDetailRequest) (*TagDetailResponse, error)
 	Thumbnail(context.Context, *TagThumbnailRequest) (*TagThumbnailResponse, error)
 	SetFavorite(context.Context, *TagSetFavoriteRequest) (*TagSetFavoriteResponse, error)
+	Merge(context.Context, *TagMergeRequest) (*TagMergeResponse, error)
+	SetHidden(context.Context, *TagSetHiddenRequest) (*TagSetHiddenResponse, error)
+	SetBlocked(context.Context, *TagSetBlockedRequest) (*TagSetBlockedResponse, error)
+	Rename(context.Context, *TagRenameRequest) (*TagRenameResponse, error)
+	UpdateInfo(context.Context, *TagUpdateInfoRequest) (*TagUpdateInfoResponse, error)
+	Related(context.Context, *TagRelatedRequest) (*TagRelatedResponse, error)
 	mustEmbedUnimplementedTagServer()
 }
 
@@ -113,6 +191,24 @@ func (UnimplementedTagServer) Thumbnail(context.Context, *TagThumbnailRequest) (
 func (UnimplementedTagServer) SetFavorite(context.Context, *TagSetFavoriteRequest) (*TagSetFavoriteResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetFavorite not implemented")
 }
+func (UnimplementedTagServer) Merge(context.Context, *TagMergeRequest) (*TagMergeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Merge not implemented")
+}
+func (UnimplementedTagServer) SetHidden(context.Context, *TagSetHiddenRequest) (*TagSetHiddenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetHidden not implemented")
+}
+func (UnimplementedTagServer) SetBlocked(context.Context, *TagSetBlockedRequest) (*TagSetBlockedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetBlocked not implemented")
+}
+func (UnimplementedTagServer) Rename(context.Context, *TagRenameRequest) (*TagRenameResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Rename not implemented")
+}
+func (UnimplementedTagServer) UpdateInfo(context.Context, *TagUpdateInfoRequest) (*TagUpdateInfoResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateInfo not implemented")
+}
+func (UnimplementedTagServer) Related(context.Context, *TagRelatedRequest) (*TagRelatedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Related not implemented")
+}
 func (UnimplementedTagServer) mustEmbedUnimplementedTagServer() {}
 func (UnimplementedTagServer) testEmbeddedByValue()             {}
 
@@ -206,6 +302,114 @@ func _Tag_SetFavorite_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Tag_Merge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagMergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServer).Merge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tag_Merge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServer).Merge(ctx, req.(*TagMergeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tag_SetHidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagSetHiddenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServer).SetHidden(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tag_SetHidden_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServer).SetHidden(ctx, req.(*TagSetHiddenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tag_SetBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagSetBlockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServer).SetBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tag_SetBlocked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServer).SetBlocked(ctx, req.(*TagSetBlockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tag_Rename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagRenameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServer).Rename(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tag_Rename_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServer).Rename(ctx, req.(*TagRenameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tag_UpdateInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagUpdateInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServer).UpdateInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tag_UpdateInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServer).UpdateInfo(ctx, req.(*TagUpdateInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tag_Related_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagRelatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServer).Related(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tag_Related_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServer).Related(ctx, req.(*TagRelatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Tag_ServiceDesc is the grpc.ServiceDesc for Tag service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +433,30 @@ var Tag_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetFavorite",
 			Handler:    _Tag_SetFavorite_Handler,
 		},
+		{
+			MethodName: "Merge",
+			Handler:    _Tag_Merge_Handler,
+		},
+		{
+			MethodName: "SetHidden",
+			Handler:    _Tag_SetHidden_Handler,
+		},
+		{
+			MethodName: "SetBlocked",
+			Handler:    _Tag_SetBlocked_Handler,
+		},
+		{
+			MethodName: "Rename",
+			Handler:    _Tag_Rename_Handler,
+		},
+		{
+			MethodName: "UpdateInfo",
+			Handler:    _Tag_UpdateInfo_Handler,
+		},
+		{
+			MethodName: "Related",
+			Handler:    _Tag_Related_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "tag.proto",
@@ -27,6 +27,13 @@ const (
 	Filter_FILTER_UNKNOWN        Filter = 0
 	Filter_FILTER_FAVORITE_ITEMS Filter = 1
 	Filter_FILTER_FAVORITE_TAGS  Filter = 2
+	Filter_FILTER_HIDDEN         Filter = 3
+	Filter_FILTER_BLOCKED        Filter = 4
+	Filter_FILTER_UNREAD         Filter = 5
+	Filter_FILTER_IN_PROGRESS    Filter = 6
+	Filter_FILTER_COMPLETED      Filter = 7
+	Filter_FILTER_RECENTLY_ADDED Filter = 8
+	Filter_FILTER_NEW_PAGES      Filter = 9
 )
 
 // Enum value maps for Filter.
@@ -35,11 +42,25 @@ var (
 		0: "FILTER_UNKNOWN",
 		1: "FILTER_FAVORITE_ITEMS",
 		2: "FILTER_FAVORITE_TAGS",
+		3: "FILTER_HIDDEN",
+		4: "FILTER_BLOCKED",
+		5: "FILTER_UNREAD",
+		6: "FILTER_IN_PROGRESS",
+		7: "FILTER_COMPLETED",
+		8: "FILTER_RECENTLY_ADDED",
+		9: "FILTER_NEW_PAGES",
 	}
 	Filter_value = map[string]int32{
 		"FILTER_UNKNOWN":        0,
 		"FILTER_FAVORITE_ITEMS": 1,
 		"FILTER_FAVORITE_TAGS":  2,
+		"FILTER_HIDDEN":         3,
+		"FILTER_BLOCKED":        4,
+		"FILTER_UNREAD":         5,
+		"FILTER_IN_PROGRESS":    6,
+		"FILTER_COMPLETED":      7,
+		"FILTER_RECENTLY_ADDED": 8,
+		"FILTER_NEW_PAGES":      9,
 	}
 )
 
@@ -78,16 +99,30 @@ const (
 	SortField_SORT_FIELD_PAGECOUNT     SortField = 2
 	SortField_SORT_FIELD_ITEMCOUNT     SortField = 3
 	SortField_SORT_FIELD_LAST_UPDATE   SortField = 4
+	SortField_SORT_FIELD_SERIES        SortField = 5
+	SortField_SORT_FIELD_RELEVANCE     SortField = 6
+	SortField_SORT_FIELD_LAST_READ     SortField = 7
+	SortField_SORT_FIELD_PROGRESS      SortField = 8
+	SortField_SORT_FIELD_POPULARITY    SortField = 9
+	SortField_SORT_FIELD_NATURAL_NAME  SortField = 10
+	SortField_SORT_FIELD_RANDOM        SortField = 11
 )
 
 // Enum value maps for SortField.
 var (
 	SortField_name = map[int32]string{
-		0: "SORT_FIELD_NAME",
-		1: "SORT_FIELD_CREATION_TIME",
-		2: "SORT_FIELD_PAGECOUNT",
-		3: "SORT_FIELD_ITEMCOUNT",
-		4: "SORT_FIELD_LAST_UPDATE",
+		0:  "SORT_FIELD_NAME",
+		1:  "SORT_FIELD_CREATION_TIME",
+		2:  "SORT_FIELD_PAGECOUNT",
+		3:  "SORT_FIELD_ITEMCOUNT",
+		4:  "SORT_FIELD_LAST_UPDATE",
+		5:  "SORT_FIELD_SERIES",
+		6:  "SORT_FIELD_RELEVANCE",
+		7:  "SORT_FIELD_LAST_READ",
+		8:  "SORT_FIELD_PROGRESS",
+		9:  "SORT_FIELD_POPULARITY",
+		10: "SORT_FIELD_NATURAL_NAME",
+		11: "SORT_FIELD_RANDOM",
 	}
 	SortField_value = map[string]int32{
 		"SORT_FIELD_NAME":          0,
@@ -95,6 +130,13 @@ var (
 		"SORT_FIELD_PAGECOUNT":     2,
 		"SORT_FIELD_ITEMCOUNT":     3,
 		"SORT_FIELD_LAST_UPDATE":   4,
+		"SORT_FIELD_SERIES":        5,
+		"SORT_FIELD_RELEVANCE":     6,
+		"SORT_FIELD_LAST_READ":     7,
+		"SORT_FIELD_PROGRESS":      8,
+		"SORT_FIELD_POPULARITY":    9,
+		"SORT_FIELD_NATURAL_NAME":  10,
+		"SORT_FIELD_RANDOM":        11,
 	}
 )
 
@@ -223,21 +265,351 @@ func (ImageQuality) EnumDescriptor() ([]byte, []int) {
 	return file_types_proto_rawDescGZIP(), []int{3}
 }
 
+type TagCategory int32
+
+const (
+	TagCategory_TAG_CATEGORY_UNSPECIFIED TagCategory = 0
+	TagCategory_TAG_CATEGORY_ARTIST      TagCategory = 1
+	TagCategory_TAG_CATEGORY_CIRCLE      TagCategory = 2
+	TagCategory_TAG_CATEGORY_EVENT       TagCategory = 3
+	TagCategory_TAG_CATEGORY_PARODY      TagCategory = 4
+	TagCategory_TAG_CATEGORY_LANGUAGE    TagCategory = 5
+	TagCategory_TAG_CATEGORY_GROUP       TagCategory = 6
+)
+
+// Enum value maps for TagCategory.
+var (
+	TagCategory_name = map[int32]string{
+		0: "TAG_CATEGORY_UNSPECIFIED",
+		1: "TAG_CATEGORY_ARTIST",
+		2: "TAG_CATEGORY_CIRCLE",
+		3: "TAG_CATEGORY_EVENT",
+		4: "TAG_CATEGORY_PARODY",
+		5: "TAG_CATEGORY_LANGUAGE",
+		6: "TAG_CATEGORY_GROUP",
+	}
+	TagCategory_value = map[string]int32{
+		"TAG_CATEGORY_UNSPECIFIED": 0,
+		"TAG_CATEGORY_ARTIST":      1,
+		"TAG_CATEGORY_CIRCLE":      2,
+		"TAG_CATEGORY_EVENT":       3,
+		"TAG_CATEGORY_PARODY":      4,
+		"TAG_CATEGORY_LANGUAGE":    5,
+		"TAG_CATEGORY_GROUP":       6,
+	}
+)
+
+func (x TagCategory) Enum() *TagCategory {
+	p := new(TagCategory)
+	*p = x
+	return p
+}
+
+func (x TagCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TagCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_proto_enumTypes[4].Descriptor()
+}
+
+func (TagCategory) Type() protoreflect.EnumType {
+	return &file_types_proto_enumTypes[4]
+}
+
+func (x TagCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TagCategory.Descriptor instead.
+func (TagCategory) EnumDescriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{4}
+}
+
+type TagSource int32
+
+const (
+	TagSource_TAG_SOURCE_UNSPECIFIED TagSource = 0
+	TagSource_TAG_SOURCE_PARSED      TagSource = 1
+	TagSource_TAG_SOURCE_COMIC_INFO  TagSource = 2
+	TagSource_TAG_SOURCE_MANUAL      TagSource = 3
+)
+
+// Enum value maps for TagSource.
+var (
+	TagSource_name = map[int32]string{
+		0: "TAG_SOURCE_UNSPECIFIED",
+		1: "TAG_SOURCE_PARSED",
+		2: "TAG_SOURCE_COMIC_INFO",
+		3: "TAG_SOURCE_MANUAL",
+	}
+	TagSource_value = map[string]int32{
+		"TAG_SOURCE_UNSPECIFIED": 0,
+		"TAG_SOURCE_PARSED":      1,
+		"TAG_SOURCE_COMIC_INFO":  2,
+		"TAG_SOURCE_MANUAL":      3,
+	}
+)
+
+func (x TagSource) Enum() *TagSource {
+	p := new(TagSource)
+	*p = x
+	return p
+}
+
+func (x TagSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TagSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_proto_enumTypes[5].Descriptor()
+}
+
+func (TagSource) Type() protoreflect.EnumType {
+	return &file_types_proto_enumTypes[5]
+}
+
+func (x TagSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TagSource.Descriptor instead.
+func (TagSource) EnumDescriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{5}
+}
+
+type TagMatch int32
+
+const (
+	TagMatch_TAG_MATCH_ALL TagMatch = 0
+	TagMatch_TAG_MATCH_ANY TagMatch = 1
+)
+
+// Enum value maps for TagMatch.
+var (
+	TagMatch_name = map[int32]string{
+		0: "TAG_MATCH_ALL",
+		1: "TAG_MATCH_ANY",
+	}
+	TagMatch_value = map[string]int32{
+		"TAG_MATCH_ALL": 0,
+		"TAG_MATCH_ANY": 1,
+	}
+)
+
+func (x TagMatch) Enum() *TagMatch {
+	p := new(TagMatch)
+	*p = x
+	return p
+}
+
+func (x TagMatch) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TagMatch) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_proto_enumTypes[6].Descriptor()
+}
+
+func (TagMatch) Type() protoreflect.EnumType {
+	return &file_types_proto_enumTypes[6]
+}
+
+func (x TagMatch) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TagMatch.Descriptor instead.
+func (TagMatch) EnumDescriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{6}
+}
+
+type Role int32
+
+const (
+	Role_ROLE_UNSPECIFIED Role = 0
+	Role_ROLE_GUEST       Role = 1
+	Role_ROLE_READER      Role = 2
+	Role_ROLE_EDITOR      Role = 3
+	Role_ROLE_ADMIN       Role = 4
+)
+
+// Enum value maps for Role.
+var (
+	Role_name = map[int32]string{
+		0: "ROLE_UNSPECIFIED",
+		1: "ROLE_GUEST",
+		2: "ROLE_READER",
+		3: "ROLE_EDITOR",
+		4: "ROLE_ADMIN",
+	}
+	Role_value = map[string]int32{
+		"ROLE_UNSPECIFIED": 0,
+		"ROLE_GUEST":       1,
+		"ROLE_READER":      2,
+		"ROLE_EDITOR":      3,
+		"ROLE_ADMIN":       4,
+	}
+)
+
+func (x Role) Enum() *Role {
+	p := new(Role)
+	*p = x
+	return p
+}
+
+func (x Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_proto_enumTypes[7].Descriptor()
+}
+
+func (Role) Type() protoreflect.EnumType {
+	return &file_types_proto_enumTypes[7]
+}
+
+func (x Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Role.Descriptor instead.
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{7}
+}
+
+type ReadingDirection int32
+
+const (
+	ReadingDirection_READING_DIRECTION_UNSPECIFIED   ReadingDirection = 0
+	ReadingDirection_READING_DIRECTION_LEFT_TO_RIGHT ReadingDirection = 1
+	ReadingDirection_READING_DIRECTION_RIGHT_TO_LEFT ReadingDirection = 2
+	ReadingDirection_READING_DIRECTION_VERTICAL      ReadingDirection = 3
+)
+
+// Enum value maps for ReadingDirection.
+var (
+	ReadingDirection_name = map[int32]string{
+		0: "READING_DIRECTION_UNSPECIFIED",
+		1: "READING_DIRECTION_LEFT_TO_RIGHT",
+		2: "READING_DIRECTION_RIGHT_TO_LEFT",
+		3: "READING_DIRECTION_VERTICAL",
+	}
+	ReadingDirection_value = map[string]int32{
+		"READING_DIRECTION_UNSPECIFIED":   0,
+		"READING_DIRECTION_LEFT_TO_RIGHT": 1,
+		"READING_DIRECTION_RIGHT_TO_LEFT": 2,
+		"READING_DIRECTION_VERTICAL":      3,
+	}
+)
+
+func (x ReadingDirection) Enum() *ReadingDirection {
+	p := new(ReadingDirection)
+	*p = x
+	return p
+}
+
+func (x ReadingDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReadingDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_proto_enumTypes[8].Descriptor()
+}
+
+func (ReadingDirection) Type() protoreflect.EnumType {
+	return &file_types_proto_enumTypes[8]
+}
+
+func (x ReadingDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReadingDirection.Descriptor instead.
+func (ReadingDirection) EnumDescriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{8}
+}
+
+type HiddenTagDisplay int32
+
+const (
+	HiddenTagDisplay_HIDDEN_TAG_DISPLAY_UNSPECIFIED HiddenTagDisplay = 0
+	HiddenTagDisplay_HIDDEN_TAG_DISPLAY_SHOW        HiddenTagDisplay = 1
+	HiddenTagDisplay_HIDDEN_TAG_DISPLAY_HIDE        HiddenTagDisplay = 2
+)
+
+// Enum value maps for HiddenTagDisplay.
+var (
+	HiddenTagDisplay_name = map[int32]string{
+		0: "HIDDEN_TAG_DISPLAY_UNSPECIFIED",
+		1: "HIDDEN_TAG_DISPLAY_SHOW",
+		2: "HIDDEN_TAG_DISPLAY_HIDE",
+	}
+	HiddenTagDisplay_value = map[string]int32{
+		"HIDDEN_TAG_DISPLAY_UNSPECIFIED": 0,
+		"HIDDEN_TAG_DISPLAY_SHOW":        1,
+		"HIDDEN_TAG_DISPLAY_HIDE":        2,
+	}
+)
+
+func (x HiddenTagDisplay) Enum() *HiddenTagDisplay {
+	p := new(HiddenTagDisplay)
+	*p = x
+	return p
+}
+
+func (x HiddenTagDisplay) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HiddenTagDisplay) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_proto_enumTypes[9].Descriptor()
+}
+
+func (HiddenTagDisplay) Type() protoreflect.EnumType {
+	return &file_types_proto_enumTypes[9]
+}
+
+func (x HiddenTagDisplay) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HiddenTagDisplay.Descriptor instead.
+func (HiddenTagDisplay) EnumDescriptor() ([]byte, []int) {
+	return file_types_proto_rawDescGZIP(), []int{9}
+}
+
 var File_types_proto protoreflect.FileDescriptor
 
 const file_types_proto_rawDesc = "" +
 	"\n" +
-	"\vtypes.proto\x12\x0fmangaweb4.types*Q\n" +
+	"\vtypes.proto\x12\x0fmangaweb4.types*\xea\x01\n" +
 	"\x06Filter\x12\x12\n" +
 	"\x0eFILTER_UNKNOWN\x10\x00\x12\x19\n" +
 	"\x15FILTER_FAVORITE_ITEMS\x10\x01\x12\x18\n" +
-	"\x14FILTER_FAVORITE_TAGS\x10\x02*\x8e\x01\n" +
+	"\x14FILTER_FAVORITE_TAGS\x10\x02\x12\x11\n" +
+	"\rFILTER_HIDDEN\x10\x03\x12\x12\n" +
+	"\x0eFILTER_BLOCKED\x10\x04\x12\x11\n" +
+	"\rFILTER_UNREAD\x10\x05\x12\x16\n" +
+	"\x12FILTER_IN_PROGRESS\x10\x06\x12\x14\n" +
+	"\x10FILTER_COMPLETED\x10\a\x12\x19\n" +
+	"\x15FILTER_RECENTLY_ADDED\x10\b\x12\x14\n" +
+	"\x10FILTER_NEW_PAGES\x10\t*\xc1\x02\n" +
 	"\tSortField\x12\x13\n" +
 	"\x0fSORT_FIELD_NAME\x10\x00\x12\x1c\n" +
 	"\x18SORT_FIELD_CREATION_TIME\x10\x01\x12\x18\n" +
 	"\x14SORT_FIELD_PAGECOUNT\x10\x02\x12\x18\n" +
 	"\x14SORT_FIELD_ITEMCOUNT\x10\x03\x12\x1a\n" +
-	"\x16SORT_FIELD_LAST_UPDATE\x10\x04*@\n" +
+	"\x16SORT_FIELD_LAST_UPDATE\x10\x04\x12\x15\n" +
+	"\x11SORT_FIELD_SERIES\x10\x05\x12\x18\n" +
+	"\x14SORT_FIELD_RELEVANCE\x10\x06\x12\x18\n" +
+	"\x14SORT_FIELD_LAST_READ\x10\a\x12\x17\n" +
+	"\x13SORT_FIELD_PROGRESS\x10\b\x12\x19\n" +
+	"\x15SORT_FIELD_POPULARITY\x10\t\x12\x1b\n" +
+	"\x17SORT_FIELD_NATURAL_NAME\x10\n" +
+	"\x12\x15\n" +
+	"\x11SORT_FIELD_RANDOM\x10\v*@\n" +
 	"\tSortOrder\x12\x18\n" +
 	"\x14SORT_ORDER_ASCENDING\x10\x00\x12\x19\n" +
 	"\x15SORT_ORDER_DESCENDING\x10\x01*x\n" +
@@ -245,7 +617,40 @@ const file_types_proto_rawDesc = "" +
 	"\x19IMAGE_QUALITY_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11IMAGE_QUALITY_LOW\x10\x01\x12\x16\n" +
 	"\x12IMAGE_QUALITY_HIGH\x10\x02\x12\x1a\n" +
-	"\x16IMAGE_QUALITY_ORIGINAL\x10\x03B-Z+github.com/mangaweb4/mangaweb4-backend/grpcb\x06proto3"
+	"\x16IMAGE_QUALITY_ORIGINAL\x10\x03*\xc1\x01\n" +
+	"\vTagCategory\x12\x1c\n" +
+	"\x18TAG_CATEGORY_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13TAG_CATEGORY_ARTIST\x10\x01\x12\x17\n" +
+	"\x13TAG_CATEGORY_CIRCLE\x10\x02\x12\x16\n" +
+	"\x12TAG_CATEGORY_EVENT\x10\x03\x12\x17\n" +
+	"\x13TAG_CATEGORY_PARODY\x10\x04\x12\x19\n" +
+	"\x15TAG_CATEGORY_LANGUAGE\x10\x05\x12\x16\n" +
+	"\x12TAG_CATEGORY_GROUP\x10\x06*p\n" +
+	"\tTagSource\x12\x1a\n" +
+	"\x16TAG_SOURCE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11TAG_SOURCE_PARSED\x10\x01\x12\x19\n" +
+	"\x15TAG_SOURCE_COMIC_INFO\x10\x02\x12\x15\n" +
+	"\x11TAG_SOURCE_MANUAL\x10\x03*0\n" +
+	"\bTagMatch\x12\x11\n" +
+	"\rTAG_MATCH_ALL\x10\x00\x12\x11\n" +
+	"\rTAG_MATCH_ANY\x10\x01*^\n" +
+	"\x04Role\x12\x14\n" +
+	"\x10ROLE_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"ROLE_GUEST\x10\x01\x12\x0f\n" +
+	"\vROLE_READER\x10\x02\x12\x0f\n" +
+	"\vROLE_EDITOR\x10\x03\x12\x0e\n" +
+	"\n" +
+	"ROLE_ADMIN\x10\x04*\x9f\x01\n" +
+	"\x10ReadingDirection\x12!\n" +
+	"\x1dREADING_DIRECTION_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fREADING_DIRECTION_LEFT_TO_RIGHT\x10\x01\x12#\n" +
+	"\x1fREADING_DIRECTION_RIGHT_TO_LEFT\x10\x02\x12\x1e\n" +
+	"\x1aREADING_DIRECTION_VERTICAL\x10\x03*p\n" +
+	"\x10HiddenTagDisplay\x12\"\n" +
+	"\x1eHIDDEN_TAG_DISPLAY_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17HIDDEN_TAG_DISPLAY_SHOW\x10\x01\x12\x1b\n" +
+	"\x17HIDDEN_TAG_DISPLAY_HIDE\x10\x02B-Z+github.com/mangaweb4/mangaweb4-backend/grpcb\x06proto3"
 
 var (
 	file_types_proto_rawDescOnce sync.Once
@@ -259,12 +664,18 @@ func file_types_proto_rawDescGZIP() []byte {
 	return file_types_proto_rawDescData
 }
 
-var file_types_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_types_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
 var file_types_proto_goTypes = []any{
-	(Filter)(0),       // 0: mangaweb4.types.Filter
-	(SortField)(0),    // 1: mangaweb4.types.SortField
-	(SortOrder)(0),    // 2: mangaweb4.types.SortOrder
-	(ImageQuality)(0), // 3: mangaweb4.types.ImageQuality
+	(Filter)(0),           // 0: mangaweb4.types.Filter
+	(SortField)(0),        // 1: mangaweb4.types.SortField
+	(SortOrder)(0),        // 2: mangaweb4.types.SortOrder
+	(ImageQuality)(0),     // 3: mangaweb4.types.ImageQuality
+	(TagCategory)(0),      // 4: mangaweb4.types.TagCategory
+	(TagSource)(0),        // 5: mangaweb4.types.TagSource
+	(TagMatch)(0),         // 6: mangaweb4.types.TagMatch
+	(Role)(0),             // 7: mangaweb4.types.Role
+	(ReadingDirection)(0), // 8: mangaweb4.types.ReadingDirection
+	(HiddenTagDisplay)(0), // 9: mangaweb4.types.HiddenTagDisplay
 }
 var file_types_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -284,7 +695,7 @@ func file_types_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_types_proto_rawDesc), len(file_types_proto_rawDesc)),
-			NumEnums:      4,
+			NumEnums:      10,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -71,6 +71,7 @@ type UserInfoResponse struct {
 	FavoriteItemCount int32                  `protobuf:"varint,2,opt,name=favoriteItemCount,proto3" json:"favoriteItemCount,omitempty"`
 	FavoriteTagCount  int32                  `protobuf:"varint,3,opt,name=favoriteTagCount,proto3" json:"favoriteTagCount,omitempty"`
 	ReadItemCount     int32                  `protobuf:"varint,4,opt,name=readItemCount,proto3" json:"readItemCount,omitempty"`
+	Role              Role                   `protobuf:"varint,5,opt,name=role,proto3,enum=mangaweb4.types.Role" json:"role,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -133,21 +134,1378 @@ func (x *UserInfoResponse) GetReadItemCount() int32 {
 	return 0
 }
 
+func (x *UserInfoResponse) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+type UserItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role          Role                   `protobuf:"varint,3,opt,name=role,proto3,enum=mangaweb4.types.Role" json:"role,omitempty"`
+	Active        bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	HasPassword   bool                   `protobuf:"varint,5,opt,name=hasPassword,proto3" json:"hasPassword,omitempty"`
+	Restrictions  *UserRestrictions      `protobuf:"bytes,6,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserItem) Reset() {
+	*x = UserItem{}
+	mi := &file_user_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserItem) ProtoMessage() {}
+
+func (x *UserItem) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserItem.ProtoReflect.Descriptor instead.
+func (*UserItem) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UserItem) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserItem) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserItem) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+func (x *UserItem) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *UserItem) GetHasPassword() bool {
+	if x != nil {
+		return x.HasPassword
+	}
+	return false
+}
+
+func (x *UserItem) GetRestrictions() *UserRestrictions {
+	if x != nil {
+		return x.Restrictions
+	}
+	return nil
+}
+
+type UserListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserListRequest) Reset() {
+	*x = UserListRequest{}
+	mi := &file_user_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserListRequest) ProtoMessage() {}
+
+func (x *UserListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserListRequest.ProtoReflect.Descriptor instead.
+func (*UserListRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{3}
+}
+
+type UserListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*UserItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserListResponse) Reset() {
+	*x = UserListResponse{}
+	mi := &file_user_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserListResponse) ProtoMessage() {}
+
+func (x *UserListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserListResponse.ProtoReflect.Descriptor instead.
+func (*UserListResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UserListResponse) GetItems() []*UserItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type UserCreateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Role          Role                   `protobuf:"varint,3,opt,name=role,proto3,enum=mangaweb4.types.Role" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserCreateRequest) Reset() {
+	*x = UserCreateRequest{}
+	mi := &file_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCreateRequest) ProtoMessage() {}
+
+func (x *UserCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCreateRequest.ProtoReflect.Descriptor instead.
+func (*UserCreateRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UserCreateRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserCreateRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *UserCreateRequest) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+type UserCreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *UserItem              `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserCreateResponse) Reset() {
+	*x = UserCreateResponse{}
+	mi := &file_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCreateResponse) ProtoMessage() {}
+
+func (x *UserCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCreateResponse.ProtoReflect.Descriptor instead.
+func (*UserCreateResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UserCreateResponse) GetItem() *UserItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type UserSetActiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Active        bool                   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSetActiveRequest) Reset() {
+	*x = UserSetActiveRequest{}
+	mi := &file_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSetActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSetActiveRequest) ProtoMessage() {}
+
+func (x *UserSetActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSetActiveRequest.ProtoReflect.Descriptor instead.
+func (*UserSetActiveRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UserSetActiveRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserSetActiveRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type UserSetActiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *UserItem              `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSetActiveResponse) Reset() {
+	*x = UserSetActiveResponse{}
+	mi := &file_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSetActiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSetActiveResponse) ProtoMessage() {}
+
+func (x *UserSetActiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSetActiveResponse.ProtoReflect.Descriptor instead.
+func (*UserSetActiveResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UserSetActiveResponse) GetItem() *UserItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type UserSetRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Role          Role                   `protobuf:"varint,2,opt,name=role,proto3,enum=mangaweb4.types.Role" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSetRoleRequest) Reset() {
+	*x = UserSetRoleRequest{}
+	mi := &file_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSetRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSetRoleRequest) ProtoMessage() {}
+
+func (x *UserSetRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSetRoleRequest.ProtoReflect.Descriptor instead.
+func (*UserSetRoleRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UserSetRoleRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserSetRoleRequest) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+type UserSetRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *UserItem              `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSetRoleResponse) Reset() {
+	*x = UserSetRoleResponse{}
+	mi := &file_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSetRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSetRoleResponse) ProtoMessage() {}
+
+func (x *UserSetRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSetRoleResponse.ProtoReflect.Descriptor instead.
+func (*UserSetRoleResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UserSetRoleResponse) GetItem() *UserItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type UserDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDeleteRequest) Reset() {
+	*x = UserDeleteRequest{}
+	mi := &file_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDeleteRequest) ProtoMessage() {}
+
+func (x *UserDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDeleteRequest.ProtoReflect.Descriptor instead.
+func (*UserDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UserDeleteRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UserDeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDeleteResponse) Reset() {
+	*x = UserDeleteResponse{}
+	mi := &file_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDeleteResponse) ProtoMessage() {}
+
+func (x *UserDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDeleteResponse.ProtoReflect.Descriptor instead.
+func (*UserDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UserDeleteResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserDeleteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UserResetPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserResetPasswordRequest) Reset() {
+	*x = UserResetPasswordRequest{}
+	mi := &file_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserResetPasswordRequest) ProtoMessage() {}
+
+func (x *UserResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*UserResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UserResetPasswordRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserResetPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type UserResetPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserResetPasswordResponse) Reset() {
+	*x = UserResetPasswordResponse{}
+	mi := &file_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserResetPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserResetPasswordResponse) ProtoMessage() {}
+
+func (x *UserResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*UserResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UserResetPasswordResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UserTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromId        int32                  `protobuf:"varint,1,opt,name=fromId,proto3" json:"fromId,omitempty"`
+	ToId          int32                  `protobuf:"varint,2,opt,name=toId,proto3" json:"toId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserTransferRequest) Reset() {
+	*x = UserTransferRequest{}
+	mi := &file_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserTransferRequest) ProtoMessage() {}
+
+func (x *UserTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserTransferRequest.ProtoReflect.Descriptor instead.
+func (*UserTransferRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UserTransferRequest) GetFromId() int32 {
+	if x != nil {
+		return x.FromId
+	}
+	return 0
+}
+
+func (x *UserTransferRequest) GetToId() int32 {
+	if x != nil {
+		return x.ToId
+	}
+	return 0
+}
+
+type UserTransferResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	FavoriteItemCount int32                  `protobuf:"varint,1,opt,name=favoriteItemCount,proto3" json:"favoriteItemCount,omitempty"`
+	FavoriteTagCount  int32                  `protobuf:"varint,2,opt,name=favoriteTagCount,proto3" json:"favoriteTagCount,omitempty"`
+	ProgressCount     int32                  `protobuf:"varint,3,opt,name=progressCount,proto3" json:"progressCount,omitempty"`
+	HistoryCount      int32                  `protobuf:"varint,4,opt,name=historyCount,proto3" json:"historyCount,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UserTransferResponse) Reset() {
+	*x = UserTransferResponse{}
+	mi := &file_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserTransferResponse) ProtoMessage() {}
+
+func (x *UserTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserTransferResponse.ProtoReflect.Descriptor instead.
+func (*UserTransferResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UserTransferResponse) GetFavoriteItemCount() int32 {
+	if x != nil {
+		return x.FavoriteItemCount
+	}
+	return 0
+}
+
+func (x *UserTransferResponse) GetFavoriteTagCount() int32 {
+	if x != nil {
+		return x.FavoriteTagCount
+	}
+	return 0
+}
+
+func (x *UserTransferResponse) GetProgressCount() int32 {
+	if x != nil {
+		return x.ProgressCount
+	}
+	return 0
+}
+
+func (x *UserTransferResponse) GetHistoryCount() int32 {
+	if x != nil {
+		return x.HistoryCount
+	}
+	return 0
+}
+
+type UserRestrictions struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	HasMaxAgeRating bool                   `protobuf:"varint,1,opt,name=hasMaxAgeRating,proto3" json:"hasMaxAgeRating,omitempty"`
+	MaxAgeRating    int32                  `protobuf:"varint,2,opt,name=maxAgeRating,proto3" json:"maxAgeRating,omitempty"`
+	AllowedPaths    []string               `protobuf:"bytes,3,rep,name=allowedPaths,proto3" json:"allowedPaths,omitempty"`
+	DeniedTagIds    []int32                `protobuf:"varint,4,rep,packed,name=deniedTagIds,proto3" json:"deniedTagIds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UserRestrictions) Reset() {
+	*x = UserRestrictions{}
+	mi := &file_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserRestrictions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRestrictions) ProtoMessage() {}
+
+func (x *UserRestrictions) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRestrictions.ProtoReflect.Descriptor instead.
+func (*UserRestrictions) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UserRestrictions) GetHasMaxAgeRating() bool {
+	if x != nil {
+		return x.HasMaxAgeRating
+	}
+	return false
+}
+
+func (x *UserRestrictions) GetMaxAgeRating() int32 {
+	if x != nil {
+		return x.MaxAgeRating
+	}
+	return 0
+}
+
+func (x *UserRestrictions) GetAllowedPaths() []string {
+	if x != nil {
+		return x.AllowedPaths
+	}
+	return nil
+}
+
+func (x *UserRestrictions) GetDeniedTagIds() []int32 {
+	if x != nil {
+		return x.DeniedTagIds
+	}
+	return nil
+}
+
+type UserSetRestrictionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Restrictions  *UserRestrictions      `protobuf:"bytes,2,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSetRestrictionsRequest) Reset() {
+	*x = UserSetRestrictionsRequest{}
+	mi := &file_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSetRestrictionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSetRestrictionsRequest) ProtoMessage() {}
+
+func (x *UserSetRestrictionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSetRestrictionsRequest.ProtoReflect.Descriptor instead.
+func (*UserSetRestrictionsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UserSetRestrictionsRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserSetRestrictionsRequest) GetRestrictions() *UserRestrictions {
+	if x != nil {
+		return x.Restrictions
+	}
+	return nil
+}
+
+type UserSetRestrictionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *UserItem              `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSetRestrictionsResponse) Reset() {
+	*x = UserSetRestrictionsResponse{}
+	mi := &file_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSetRestrictionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSetRestrictionsResponse) ProtoMessage() {}
+
+func (x *UserSetRestrictionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSetRestrictionsResponse.ProtoReflect.Descriptor instead.
+func (*UserSetRestrictionsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UserSetRestrictionsResponse) GetItem() *UserItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type UserPreferences struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ReadingDirection ReadingDirection       `protobuf:"varint,1,opt,name=readingDirection,proto3,enum=mangaweb4.types.ReadingDirection" json:"readingDirection,omitempty"`
+	ImageQuality     ImageQuality           `protobuf:"varint,2,opt,name=imageQuality,proto3,enum=mangaweb4.types.ImageQuality" json:"imageQuality,omitempty"`
+	ItemsPerPage     int32                  `protobuf:"varint,3,opt,name=itemsPerPage,proto3" json:"itemsPerPage,omitempty"`
+	HasSort          bool                   `protobuf:"varint,4,opt,name=hasSort,proto3" json:"hasSort,omitempty"`
+	Sort             SortField              `protobuf:"varint,5,opt,name=sort,proto3,enum=mangaweb4.types.SortField" json:"sort,omitempty"`
+	Order            SortOrder              `protobuf:"varint,6,opt,name=order,proto3,enum=mangaweb4.types.SortOrder" json:"order,omitempty"`
+	HiddenTags       HiddenTagDisplay       `protobuf:"varint,7,opt,name=hiddenTags,proto3,enum=mangaweb4.types.HiddenTagDisplay" json:"hiddenTags,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UserPreferences) Reset() {
+	*x = UserPreferences{}
+	mi := &file_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPreferences) ProtoMessage() {}
+
+func (x *UserPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPreferences.ProtoReflect.Descriptor instead.
+func (*UserPreferences) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UserPreferences) GetReadingDirection() ReadingDirection {
+	if x != nil {
+		return x.ReadingDirection
+	}
+	return ReadingDirection_READING_DIRECTION_UNSPECIFIED
+}
+
+func (x *UserPreferences) GetImageQuality() ImageQuality {
+	if x != nil {
+		return x.ImageQuality
+	}
+	return ImageQuality_IMAGE_QUALITY_UNSPECIFIED
+}
+
+func (x *UserPreferences) GetItemsPerPage() int32 {
+	if x != nil {
+		return x.ItemsPerPage
+	}
+	return 0
+}
+
+func (x *UserPreferences) GetHasSort() bool {
+	if x != nil {
+		return x.HasSort
+	}
+	return false
+}
+
+func (x *UserPreferences) GetSort() SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return SortField_SORT_FIELD_NAME
+}
+
+func (x *UserPreferences) GetOrder() SortOrder {
+	if x != nil {
+		return x.Order
+	}
+	return SortOrder_SORT_ORDER_ASCENDING
+}
+
+func (x *UserPreferences) GetHiddenTags() HiddenTagDisplay {
+	if x != nil {
+		return x.HiddenTags
+	}
+	return HiddenTagDisplay_HIDDEN_TAG_DISPLAY_UNSPECIFIED
+}
+
+type UserGetPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Device        string                 `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserGetPreferencesRequest) Reset() {
+	*x = UserGetPreferencesRequest{}
+	mi := &file_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGetPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGetPreferencesRequest) ProtoMessage() {}
+
+func (x *UserGetPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGetPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UserGetPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UserGetPreferencesRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+type UserGetPreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Preferences   *UserPreferences       `protobuf:"bytes,2,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	Device        *UserPreferences       `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
+	Effective     *UserPreferences       `protobuf:"bytes,4,opt,name=effective,proto3" json:"effective,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserGetPreferencesResponse) Reset() {
+	*x = UserGetPreferencesResponse{}
+	mi := &file_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGetPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGetPreferencesResponse) ProtoMessage() {}
+
+func (x *UserGetPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGetPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UserGetPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UserGetPreferencesResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UserGetPreferencesResponse) GetPreferences() *UserPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *UserGetPreferencesResponse) GetDevice() *UserPreferences {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+func (x *UserGetPreferencesResponse) GetEffective() *UserPreferences {
+	if x != nil {
+		return x.Effective
+	}
+	return nil
+}
+
+type UserUpdatePreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Device        string                 `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	Preferences   *UserPreferences       `protobuf:"bytes,2,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserUpdatePreferencesRequest) Reset() {
+	*x = UserUpdatePreferencesRequest{}
+	mi := &file_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserUpdatePreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserUpdatePreferencesRequest) ProtoMessage() {}
+
+func (x *UserUpdatePreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserUpdatePreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UserUpdatePreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UserUpdatePreferencesRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *UserUpdatePreferencesRequest) GetPreferences() *UserPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type UserUpdatePreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Preferences   *UserPreferences       `protobuf:"bytes,2,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	Device        *UserPreferences       `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
+	Effective     *UserPreferences       `protobuf:"bytes,4,opt,name=effective,proto3" json:"effective,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserUpdatePreferencesResponse) Reset() {
+	*x = UserUpdatePreferencesResponse{}
+	mi := &file_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserUpdatePreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserUpdatePreferencesResponse) ProtoMessage() {}
+
+func (x *UserUpdatePreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserUpdatePreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UserUpdatePreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UserUpdatePreferencesResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UserUpdatePreferencesResponse) GetPreferences() *UserPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *UserUpdatePreferencesResponse) GetDevice() *UserPreferences {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+func (x *UserUpdatePreferencesResponse) GetEffective() *UserPreferences {
+	if x != nil {
+		return x.Effective
+	}
+	return nil
+}
+
 var File_user_proto protoreflect.FileDescriptor
 
 const file_user_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"user.proto\"%\n" +
+	"user.proto\x1a\vtypes.proto\"%\n" +
 	"\x0fUserInfoRequest\x12\x12\n" +
-	"\x04User\x18\x01 \x01(\tR\x04User\"\xaa\x01\n" +
+	"\x04User\x18\x01 \x01(\tR\x04User\"\xd5\x01\n" +
 	"\x10UserInfoResponse\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\x05R\x06userId\x12,\n" +
 	"\x11favoriteItemCount\x18\x02 \x01(\x05R\x11favoriteItemCount\x12*\n" +
 	"\x10favoriteTagCount\x18\x03 \x01(\x05R\x10favoriteTagCount\x12$\n" +
-	"\rreadItemCount\x18\x04 \x01(\x05R\rreadItemCount25\n" +
+	"\rreadItemCount\x18\x04 \x01(\x05R\rreadItemCount\x12)\n" +
+	"\x04role\x18\x05 \x01(\x0e2\x15.mangaweb4.types.RoleR\x04role\"\xcc\x01\n" +
+	"\bUserItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12)\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x15.mangaweb4.types.RoleR\x04role\x12\x16\n" +
+	"\x06active\x18\x04 \x01(\bR\x06active\x12 \n" +
+	"\vhasPassword\x18\x05 \x01(\bR\vhasPassword\x125\n" +
+	"\frestrictions\x18\x06 \x01(\v2\x11.UserRestrictionsR\frestrictions\"\x11\n" +
+	"\x0fUserListRequest\"3\n" +
+	"\x10UserListResponse\x12\x1f\n" +
+	"\x05items\x18\x01 \x03(\v2\t.UserItemR\x05items\"p\n" +
+	"\x11UserCreateRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12)\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x15.mangaweb4.types.RoleR\x04role\"3\n" +
+	"\x12UserCreateResponse\x12\x1d\n" +
+	"\x04item\x18\x01 \x01(\v2\t.UserItemR\x04item\">\n" +
+	"\x14UserSetActiveRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06active\x18\x02 \x01(\bR\x06active\"6\n" +
+	"\x15UserSetActiveResponse\x12\x1d\n" +
+	"\x04item\x18\x01 \x01(\v2\t.UserItemR\x04item\"O\n" +
+	"\x12UserSetRoleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12)\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x15.mangaweb4.types.RoleR\x04role\"4\n" +
+	"\x13UserSetRoleResponse\x12\x1d\n" +
+	"\x04item\x18\x01 \x01(\v2\t.UserItemR\x04item\"#\n" +
+	"\x11UserDeleteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\">\n" +
+	"\x12UserDeleteResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"F\n" +
+	"\x18UserResetPasswordRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"5\n" +
+	"\x19UserResetPasswordResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"A\n" +
+	"\x13UserTransferRequest\x12\x16\n" +
+	"\x06fromId\x18\x01 \x01(\x05R\x06fromId\x12\x12\n" +
+	"\x04toId\x18\x02 \x01(\x05R\x04toId\"\xba\x01\n" +
+	"\x14UserTransferResponse\x12,\n" +
+	"\x11favoriteItemCount\x18\x01 \x01(\x05R\x11favoriteItemCount\x12*\n" +
+	"\x10favoriteTagCount\x18\x02 \x01(\x05R\x10favoriteTagCount\x12$\n" +
+	"\rprogressCount\x18\x03 \x01(\x05R\rprogressCount\x12\"\n" +
+	"\fhistoryCount\x18\x04 \x01(\x05R\fhistoryCount\"\xa8\x01\n" +
+	"\x10UserRestrictions\x12(\n" +
+	"\x0fhasMaxAgeRating\x18\x01 \x01(\bR\x0fhasMaxAgeRating\x12\"\n" +
+	"\fmaxAgeRating\x18\x02 \x01(\x05R\fmaxAgeRating\x12\"\n" +
+	"\fallowedPaths\x18\x03 \x03(\tR\fallowedPaths\x12\"\n" +
+	"\fdeniedTagIds\x18\x04 \x03(\x05R\fdeniedTagIds\"c\n" +
+	"\x1aUserSetRestrictionsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x125\n" +
+	"\frestrictions\x18\x02 \x01(\v2\x11.UserRestrictionsR\frestrictions\"<\n" +
+	"\x1bUserSetRestrictionsResponse\x12\x1d\n" +
+	"\x04item\x18\x01 \x01(\v2\t.UserItemR\x04item\"\x86\x03\n" +
+	"\x0fUserPreferences\x12M\n" +
+	"\x10readingDirection\x18\x01 \x01(\x0e2!.mangaweb4.types.ReadingDirectionR\x10readingDirection\x12A\n" +
+	"\fimageQuality\x18\x02 \x01(\x0e2\x1d.mangaweb4.types.ImageQualityR\fimageQuality\x12\"\n" +
+	"\fitemsPerPage\x18\x03 \x01(\x05R\fitemsPerPage\x12\x18\n" +
+	"\ahasSort\x18\x04 \x01(\bR\ahasSort\x12.\n" +
+	"\x04sort\x18\x05 \x01(\x0e2\x1a.mangaweb4.types.SortFieldR\x04sort\x120\n" +
+	"\x05order\x18\x06 \x01(\x0e2\x1a.mangaweb4.types.SortOrderR\x05order\x12A\n" +
+	"\n" +
+	"hiddenTags\x18\a \x01(\x0e2!.mangaweb4.types.HiddenTagDisplayR\n" +
+	"hiddenTags\"3\n" +
+	"\x19UserGetPreferencesRequest\x12\x16\n" +
+	"\x06device\x18\x01 \x01(\tR\x06device\"\xc4\x01\n" +
+	"\x1aUserGetPreferencesResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x122\n" +
+	"\vpreferences\x18\x02 \x01(\v2\x10.UserPreferencesR\vpreferences\x12(\n" +
+	"\x06device\x18\x03 \x01(\v2\x10.UserPreferencesR\x06device\x12.\n" +
+	"\teffective\x18\x04 \x01(\v2\x10.UserPreferencesR\teffective\"j\n" +
+	"\x1cUserUpdatePreferencesRequest\x12\x16\n" +
+	"\x06device\x18\x01 \x01(\tR\x06device\x122\n" +
+	"\vpreferences\x18\x02 \x01(\v2\x10.UserPreferencesR\vpreferences\"\xc7\x01\n" +
+	"\x1dUserUpdatePreferencesResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x122\n" +
+	"\vpreferences\x18\x02 \x01(\v2\x10.UserPreferencesR\vpreferences\x12(\n" +
+	"\x06device\x18\x03 \x01(\v2\x10.UserPreferencesR\x06device\x12.\n" +
+	"\teffective\x18\x04 \x01(\v2\x10.UserPreferencesR\teffective2\xbc\x05\n" +
 	"\x04User\x12-\n" +
-	"\x04Info\x12\x10.UserInfoRequest\x1a\x11.UserInfoResponse\"\x00B-Z+github.com/mangaweb4/mangaweb4-backend/grpcb\x06proto3"
+	"\x04Info\x12\x10.UserInfoRequest\x1a\x11.UserInfoResponse\"\x00\x12-\n" +
+	"\x04List\x12\x10.UserListRequest\x1a\x11.UserListResponse\"\x00\x123\n" +
+	"\x06Create\x12\x12.UserCreateRequest\x1a\x13.UserCreateResponse\"\x00\x12<\n" +
+	"\tSetActive\x12\x15.UserSetActiveRequest\x1a\x16.UserSetActiveResponse\"\x00\x126\n" +
+	"\aSetRole\x12\x13.UserSetRoleRequest\x1a\x14.UserSetRoleResponse\"\x00\x123\n" +
+	"\x06Delete\x12\x12.UserDeleteRequest\x1a\x13.UserDeleteResponse\"\x00\x12H\n" +
+	"\rResetPassword\x12\x19.UserResetPasswordRequest\x1a\x1a.UserResetPasswordResponse\"\x00\x129\n" +
+	"\bTransfer\x12\x14.UserTransferRequest\x1a\x15.UserTransferResponse\"\x00\x12N\n" +
+	"\x0fSetRestrictions\x12\x1b.UserSetRestrictionsRequest\x1a\x1c.UserSetRestrictionsResponse\"\x00\x12K\n" +
+	"\x0eGetPreferences\x12\x1a.UserGetPreferencesRequest\x1a\x1b.UserGetPreferencesResponse\"\x00\x12T\n" +
+	"\x11UpdatePreferences\x12\x1d.UserUpdatePreferencesRequest\x1a\x1e.UserUpdatePreferencesResponse\"\x00B-Z+github.com/mangaweb4/mangaweb4-backend/grpcb\x06proto3"
 
 var (
 	file_user_proto_rawDescOnce sync.Once
@@ -161,19 +1519,91 @@ func file_user_proto_rawDescGZIP() []byte {
 	return file_user_proto_rawDescData
 }
 
-var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_user_proto_goTypes = []any{
-	(*UserInfoRequest)(nil),  // 0: UserInfoRequest
-	(*UserInfoResponse)(nil), // 1: UserInfoResponse
+	(*UserInfoRequest)(nil),               // 0: UserInfoRequest
+	(*UserInfoResponse)(nil),              // 1: UserInfoResponse
+	(*UserItem)(nil),                      // 2: UserItem
+	(*UserListRequest)(nil),               // 3: UserListRequest
+	(*UserListResponse)(nil),              // 4: UserListResponse
+	(*UserCreateRequest)(nil),             // 5: UserCreateRequest
+	(*UserCreateResponse)(nil),            // 6: UserCreateResponse
+	(*UserSetActiveRequest)(nil),          // 7: UserSetActiveRequest
+	(*UserSetActiveResponse)(nil),         // 8: UserSetActiveResponse
+	(*UserSetRoleRequest)(nil),            // 9: UserSetRoleRequest
+	(*UserSetRoleResponse)(nil),           // 10: UserSetRoleResponse
+	(*UserDeleteRequest)(nil),             // 11: UserDeleteRequest
+	(*UserDeleteResponse)(nil),            // 12: UserDeleteResponse
+	(*UserResetPasswordRequest)(nil),      // 13: UserResetPasswordRequest
+	(*UserResetPasswordResponse)(nil),     // 14: UserResetPasswordResponse
+	(*UserTransferRequest)(nil),           // 15: UserTransferRequest
+	(*UserTransferResponse)(nil),          // 16: UserTransferResponse
+	(*UserRestrictions)(nil),              // 17: UserRestrictions
+	(*UserSetRestrictionsRequest)(nil),    // 18: UserSetRestrictionsRequest
+	(*UserSetRestrictionsResponse)(nil),   // 19: UserSetRestrictionsResponse
+	(*UserPreferences)(nil),               // 20: UserPreferences
+	(*UserGetPreferencesRequest)(nil),     // 21: UserGetPreferencesRequest
+	(*UserGetPreferencesResponse)(nil),    // 22: UserGetPreferencesResponse
+	(*UserUpdatePreferencesRequest)(nil),  // 23: UserUpdatePreferencesRequest
+	(*UserUpdatePreferencesResponse)(nil), // 24: UserUpdatePreferencesResponse
+	(Role)(0),                             // 25: mangaweb4.types.Role
+	(ReadingDirection)(0),                 // 26: mangaweb4.types.ReadingDirection
+	(ImageQuality)(0),                     // 27: mangaweb4.types.ImageQuality
+	(SortField)(0),                        // 28: mangaweb4.types.SortField
+	(SortOrder)(0),                        // 29: mangaweb4.types.SortOrder
+	(HiddenTagDisplay)(0),                 // 30: mangaweb4.types.HiddenTagDisplay
 }
 var file_user_proto_depIdxs = []int32{
-	0, // 0: User.Info:input_type -> UserInfoRequest
-	1, // 1: User.Info:output_type -> UserInfoResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	25, // 0: UserInfoResponse.role:type_name -> mangaweb4.types.Role
+	25, // 1: UserItem.role:type_name -> mangaweb4.types.Role
+	17, // 2: UserItem.restrictions:type_name -> UserRestrictions
+	2,  // 3: UserListResponse.items:type_name -> UserItem
+	25, // 4: UserCreateRequest.role:type_name -> mangaweb4.types.Role
+	2,  // 5: UserCreateResponse.item:type_name -> UserItem
+	2,  // 6: UserSetActiveResponse.item:type_name -> UserItem
+	25, // 7: UserSetRoleRequest.role:type_name -> mangaweb4.types.Role
+	2,  // 8: UserSetRoleResponse.item:type_name -> UserItem
+	17, // 9: UserSetRestrictionsRequest.restrictions:type_name -> UserRestrictions
+	2,  // 10: UserSetRestrictionsResponse.item:type_name -> UserItem
+	26, // 11: UserPreferences.readingDirection:type_name -> mangaweb4.types.ReadingDirection
+	27, // 12: UserPreferences.imageQuality:type_name -> mangaweb4.types.ImageQuality
+	28, // 13: UserPreferences.sort:type_name -> mangaweb4.types.SortField
+	29, // 14: UserPreferences.order:type_name -> mangaweb4.types.SortOrder
+	30, // 15: UserPreferences.hiddenTags:type_name -> mangaweb4.types.HiddenTagDisplay
+	20, // 16: UserGetPreferencesResponse.preferences:type_name -> UserPreferences
+	20, // 17: UserGetPreferencesResponse.device:type_name -> UserPreferences
+	20, // 18: UserGetPreferencesResponse.effective:type_name -> UserPreferences
+	20, // 19: UserUpdatePreferencesRequest.preferences:type_name -> UserPreferences
+	20, // 20: UserUpdatePreferencesResponse.preferences:type_name -> UserPreferences
+	20, // 21: UserUpdatePreferencesResponse.device:type_name -> UserPreferences
+	20, // 22: UserUpdatePreferencesResponse.effective:type_name -> UserPreferences
+	0,  // 23: User.Info:input_type -> UserInfoRequest
+	3,  // 24: User.List:input_type -> UserListRequest
+	5,  // 25: User.Create:input_type -> UserCreateRequest
+	7,  // 26: User.SetActive:input_type -> UserSetActiveRequest
+	9,  // 27: User.SetRole:input_type -> UserSetRoleRequest
+	11, // 28: User.Delete:input_type -> UserDeleteRequest
+	13, // 29: User.ResetPassword:input_type -> UserResetPasswordRequest
+	15, // 30: User.Transfer:input_type -> UserTransferRequest
+	18, // 31: User.SetRestrictions:input_type -> UserSetRestrictionsRequest
+	21, // 32: User.GetPreferences:input_type -> UserGetPreferencesRequest
+	23, // 33: User.UpdatePreferences:input_type -> UserUpdatePreferencesRequest
+	1,  // 34: User.Info:output_type -> UserInfoResponse
+	4,  // 35: User.List:output_type -> UserListResponse
+	6,  // 36: User.Create:output_type -> UserCreateResponse
+	8,  // 37: User.SetActive:output_type -> UserSetActiveResponse
+	10, // 38: User.SetRole:output_type -> UserSetRoleResponse
+	12, // 39: User.Delete:output_type -> UserDeleteResponse
+	14, // 40: User.ResetPassword:output_type -> UserResetPasswordResponse
+	16, // 41: User.Transfer:output_type -> UserTransferResponse
+	19, // 42: User.SetRestrictions:output_type -> UserSetRestrictionsResponse
+	22, // 43: User.GetPreferences:output_type -> UserGetPreferencesResponse
+	24, // 44: User.UpdatePreferences:output_type -> UserUpdatePreferencesResponse
+	34, // [34:45] is the sub-list for method output_type
+	23, // [23:34] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_user_proto_init() }
@@ -181,13 +1611,14 @@ func file_user_proto_init() {
 	if File_user_proto != nil {
 		return
 	}
+	file_types_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,7 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	User_Info_FullMethodName = "/User/Info"
+	User_Info_FullMethodName              = "/User/Info"
+	User_List_FullMethodName              = "/User/List"
+	User_Create_FullMethodName            = "/User/Create"
+	User_SetActive_FullMethodName         = "/User/SetActive"
+	User_SetRole_FullMethodName           = "/User/SetRole"
+	User_Delete_FullMethodName            = "/User/Delete"
+	User_ResetPassword_FullMethodName     = "/User/ResetPassword"
+	User_Transfer_FullMethodName          = "/User/Transfer"
+	User_SetRestrictions_FullMethodName   = "/User/SetRestrictions"
+	User_GetPreferences_FullMethodName    = "/User/GetPreferences"
+	User_UpdatePreferences_FullMethodName = "/User/UpdatePreferences"
 )
 
 // UserClient is the client API for User service.
@@ -27,6 +37,16 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type UserClient interface {
 	Info(ctx context.Context, in *UserInfoRequest, opts ...grpc.CallOption) (*UserInfoResponse, error)
+	List(ctx context.Context, in *UserListRequest, opts ...grpc.CallOption) (*UserListResponse, error)
+	Create(ctx context.Context, in *UserCreateRequest, opts ...grpc.CallOption) (*UserCreateResponse, error)
+	SetActive(ctx context.Context, in *UserSetActiveRequest, opts ...grpc.CallOption) (*UserSetActiveResponse, error)
+	SetRole(ctx context.Context, in *UserSetRoleRequest, opts ...grpc.CallOption) (*UserSetRoleResponse, error)
+	Delete(ctx context.Context, in *UserDeleteRequest, opts ...grpc.CallOption) (*UserDeleteResponse, error)
+	ResetPassword(ctx context.Context, in *UserResetPasswordRequest, opts ...grpc.CallOption) (*UserResetPasswordResponse, error)
+	Transfer(ctx context.Context, in *UserTransferRequest, opts ...grpc.CallOption) (*UserTransferResponse, error)
+	SetRestrictions(ctx context.Context, in *UserSetRestrictionsRequest, opts ...grpc.CallOption) (*UserSetRestrictionsResponse, error)
+	GetPreferences(ctx context.Context, in *UserGetPreferencesRequest, opts ...grpc.CallOption) (*UserGetPreferencesResponse, error)
+	UpdatePreferences(ctx context.Context, in *UserUpdatePreferencesRequest, opts ...grpc.CallOption) (*UserUpdatePreferencesResponse, error)
 }
 
 type userClient struct {
@@ -47,11 +67,121 @@ func (c *userClient) Info(ctx context.Context, in *UserInfoRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *userClient) List(ctx context.Context, in *UserListRequest, opts ...grpc.CallOption) (*UserListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserListResponse)
+	err := c.cc.Invoke(ctx, User_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) Create(ctx context.Context, in *UserCreateRequest, opts ...grpc.CallOption) (*UserCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserCreateResponse)
+	err := c.cc.Invoke(ctx, User_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SetActive(ctx context.Context, in *UserSetActiveRequest, opts ...grpc.CallOption) (*UserSetActiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserSetActiveResponse)
+	err := c.cc.Invoke(ctx, User_SetActive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SetRole(ctx context.Context, in *UserSetRoleRequest, opts ...grpc.CallOption) (*UserSetRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserSetRoleResponse)
+	err := c.cc.Invoke(ctx, User_SetRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) Delete(ctx context.Context, in *UserDeleteRequest, opts ...grpc.CallOption) (*UserDeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserDeleteResponse)
+	err := c.cc.Invoke(ctx, User_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ResetPassword(ctx context.Context, in *UserResetPasswordRequest, opts ...grpc.CallOption) (*UserResetPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserResetPasswordResponse)
+	err := c.cc.Invoke(ctx, User_ResetPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) Transfer(ctx context.Context, in *UserTransferRequest, opts ...grpc.CallOption) (*UserTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserTransferResponse)
+	err := c.cc.Invoke(ctx, User_Transfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SetRestrictions(ctx context.Context, in *UserSetRestrictionsRequest, opts ...grpc.CallOption) (*UserSetRestrictionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserSetRestrictionsResponse)
+	err := c.cc.Invoke(ctx, User_SetRestrictions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetPreferences(ctx context.Context, in *UserGetPreferencesRequest, opts ...grpc.CallOption) (*UserGetPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserGetPreferencesResponse)
+	err := c.cc.Invoke(ctx, User_GetPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UpdatePreferences(ctx context.Context, in *UserUpdatePreferencesRequest, opts ...grpc.CallOption) (*UserUpdatePreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserUpdatePreferencesResponse)
+	err := c.cc.Invoke(ctx, User_UpdatePreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServer is the server API for User service.
 // All implementations must embed UnimplementedUserServer
 // for forward compatibility.
 type UserServer interface {
 	Info(context.Context, *UserInfoRequest) (*UserInfoResponse, error)
+	List(context.Context, *UserListRequest) (*UserListResponse, error)
+	Create(context.Context, *UserCreateRequest) (*UserCreateResponse, error)
+	SetActive(context.Context, *UserSetActiveRequest) (*UserSetActiveResponse, error)
+	SetRole(context.Context, *UserSetRoleRequest) (*UserSetRoleResponse, error)
+	Delete(context.Context, *UserDeleteRequest) (*UserDeleteResponse, error)
+	ResetPassword(context.Context, *UserResetPasswordRequest) (*UserResetPasswordResponse, error)
+	Transfer(context.Context, *UserTransferRequest) (*UserTransferResponse, error)
+	SetRestrictions(context.Context, *UserSetRestrictionsRequest) (*UserSetRestrictionsResponse, error)
+	GetPreferences(context.Context, *UserGetPreferencesRequest) (*UserGetPreferencesResponse, error)
+	UpdatePreferences(context.Context, *UserUpdatePreferencesRequest) (*UserUpdatePreferencesResponse, error)
 	mustEmbedUnimplementedUserServer()
 }
 
@@ -65,6 +195,36 @@ type UnimplementedUserServer struct{}
 func (UnimplementedUserServer) Info(context.Context, *UserInfoRequest) (*UserInfoResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Info not implemented")
 }
+func (UnimplementedUserServer) List(context.Context, *UserListRequest) (*UserListResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedUserServer) Create(context.Context, *UserCreateRequest) (*UserCreateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedUserServer) SetActive(context.Context, *UserSetActiveRequest) (*UserSetActiveResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetActive not implemented")
+}
+func (UnimplementedUserServer) SetRole(context.Context, *UserSetRoleRequest) (*UserSetRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRole not implemented")
+}
+func (UnimplementedUserServer) Delete(context.Context, *UserDeleteRequest) (*UserDeleteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedUserServer) ResetPassword(context.Context, *UserResetPasswordRequest) (*UserResetPasswordResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedUserServer) Transfer(context.Context, *UserTransferRequest) (*UserTransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Transfer not implemented")
+}
+func (UnimplementedUserServer) SetRestrictions(context.Context, *UserSetRestrictionsRequest) (*UserSetRestrictionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRestrictions not implemented")
+}
+func (UnimplementedUserServer) GetPreferences(context.Context, *UserGetPreferencesRequest) (*UserGetPreferencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPreferences not implemented")
+}
+func (UnimplementedUserServer) UpdatePreferences(context.Context, *UserUpdatePreferencesRequest) (*UserUpdatePreferencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePreferences not implemented")
+}
 func (UnimplementedUserServer) mustEmbedUnimplementedUserServer() {}
 func (UnimplementedUserServer) testEmbeddedByValue()              {}
 
@@ -104,6 +264,186 @@ func _User_Info_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).List(ctx, req.(*UserListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Create(ctx, req.(*UserCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SetActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserSetActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SetActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_SetActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SetActive(ctx, req.(*UserSetActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SetRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserSetRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SetRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_SetRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SetRole(ctx, req.(*UserSetRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Delete(ctx, req.(*UserDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ResetPassword(ctx, req.(*UserResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_Transfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Transfer(ctx, req.(*UserTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SetRestrictions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserSetRestrictionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SetRestrictions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_SetRestrictions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SetRestrictions(ctx, req.(*UserSetRestrictionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserGetPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetPreferences(ctx, req.(*UserGetPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UpdatePreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserUpdatePreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UpdatePreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_UpdatePreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UpdatePreferences(ctx, req.(*UserUpdatePreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // User_ServiceDesc is the grpc.ServiceDesc for User service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +455,46 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Info",
 			Handler:    _User_Info_Handler,
 		},
+		{
+			MethodName: "List",
+			Handler:    _User_List_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _User_Create_Handler,
+		},
+		{
+			MethodName: "SetActive",
+			Handler:    _User_SetActive_Handler,
+		},
+		{
+			MethodName: "SetRole",
+			Handler:    _User_SetRole_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _User_Delete_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _User_ResetPassword_Handler,
+		},
+		{
+			MethodName: "Transfer",
+			Handler:    _User_Transfer_Handler,
+		},
+		{
+			MethodName: "SetRestrictions",
+			Handler:    _User_SetRestrictions_Handler,
+		},
+		{
+			MethodName: "GetPreferences",
+			Handler:    _User_GetPreferences_Handler,
+		},
+		{
+			MethodName: "UpdatePreferences",
+			Handler:    _User_UpdatePreferences_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
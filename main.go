@@ -11,11 +11,14 @@ import (
 
 	"entgo.io/ent/dialect"
 	"github.com/joho/godotenv"
+	"github.com/mangaweb4/mangaweb4-backend/auth"
 	"github.com/mangaweb4/mangaweb4-backend/configuration"
 	"github.com/mangaweb4/mangaweb4-backend/database"
 	"github.com/mangaweb4/mangaweb4-backend/grpc"
 	"github.com/mangaweb4/mangaweb4-backend/maintenance"
+	"github.com/mangaweb4/mangaweb4-backend/meta"
 	"github.com/mangaweb4/mangaweb4-backend/server"
+	"github.com/mangaweb4/mangaweb4-backend/tag"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 
@@ -109,12 +112,112 @@ func main() {
 		firstLevelDirAsTag, _ = strconv.ParseBool(value)
 	}
 
+	var tagRules []configuration.TagRule
+	if value, valid := os.LookupEnv("MANGAWEB_TAG_RULES_FILE"); valid {
+		rules, err := configuration.LoadTagRules(value)
+		if err != nil {
+			log.Error().Err(err).Str("file", value).Msg("Loading tag rules fails.")
+			return
+		}
+
+		if _, err := tag.CompileRules(rules); err != nil {
+			log.Error().Err(err).Str("file", value).Msg("Invalid tag rules.")
+			return
+		}
+
+		tagRules = rules
+	}
+
+	var pathTemplates []string
+	if value, valid := os.LookupEnv("MANGAWEB_PATH_TEMPLATES_FILE"); valid {
+		templates, err := configuration.LoadPathTemplates(value)
+		if err != nil {
+			log.Error().Err(err).Str("file", value).Msg("Loading path templates fails.")
+			return
+		}
+
+		if _, err := meta.CompileTemplates(templates); err != nil {
+			log.Error().Err(err).Str("file", value).Msg("Invalid path templates.")
+			return
+		}
+
+		pathTemplates = templates
+	}
+
+	tagNormalizationStr := "trim,casefold,nfkc"
+	if value, valid := os.LookupEnv("MANGAWEB_TAG_NORMALIZATION"); valid {
+		tagNormalizationStr = value
+	}
+
+	tagNormalization, err := configuration.ParseTagNormalization(tagNormalizationStr)
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid tag normalization.")
+		return
+	}
+
+	recentlyAddedDays := configuration.DefaultRecentlyAddedDays
+	if value, valid := os.LookupEnv("MANGAWEB_RECENTLY_ADDED_DAYS"); valid {
+		days, err := strconv.Atoi(value)
+		if err != nil || days <= 0 {
+			log.Error().Str("value", value).Msg("Invalid recently added days.")
+			return
+		}
+
+		recentlyAddedDays = days
+	}
+
+	authRequired := false
+	if value, valid := os.LookupEnv("MANGAWEB_AUTH_REQUIRED"); valid {
+		authRequired, _ = strconv.ParseBool(value)
+	}
+	if !authRequired {
+		log.Warn().Msg("MANGAWEB_AUTH_REQUIRED is not set, calls without a login act as the user named in the request.")
+	}
+
+	sessionDays := configuration.DefaultSessionDays
+	if value, valid := os.LookupEnv("MANGAWEB_SESSION_DAYS"); valid {
+		days, err := strconv.Atoi(value)
+		if err != nil || days <= 0 {
+			log.Error().Str("value", value).Msg("Invalid session days.")
+			return
+		}
+
+		sessionDays = days
+	}
+
+	oidc := configuration.OIDC{
+		KeySet:    os.Getenv("MANGAWEB_OIDC_JWKS"),
+		Issuer:    os.Getenv("MANGAWEB_OIDC_ISSUER"),
+		Audience:  os.Getenv("MANGAWEB_OIDC_AUDIENCE"),
+		UserClaim: configuration.DefaultOIDCUserClaim,
+	}
+	if value, valid := os.LookupEnv("MANGAWEB_OIDC_USER_CLAIM"); valid {
+		oidc.UserClaim = value
+	}
+	if value, valid := os.LookupEnv("MANGAWEB_OIDC_AUTO_PROVISION"); valid {
+		oidc.AutoProvision, _ = strconv.ParseBool(value)
+	}
+
+	if oidc.KeySet != "" {
+		if _, err := auth.NewVerifier(ctx, oidc); err != nil {
+			log.Error().Err(err).Str("source", oidc.KeySet).Msg("Invalid OIDC configuration.")
+			return
+		}
+	}
+
 	log.Info().
 		Bool("debugMode", debugMode).
 		Str("version", versionStr).
 		Str("dataPath", dataPath).
 		Str("cachePath", cachePath).
 		Bool("firstLevelDirAsTag", firstLevelDirAsTag).
+		Int("tagRules", len(tagRules)).
+		Int("pathTemplates", len(pathTemplates)).
+		Str("tagNormalization", tagNormalizationStr).
+		Int("recentlyAddedDays", recentlyAddedDays).
+		Bool("authRequired", authRequired).
+		Int("sessionDays", sessionDays).
+		Str("oidcIssuer", oidc.Issuer).
 		Msg("Server initializes.")
 
 	configuration.Init(configuration.Config{
@@ -122,6 +225,13 @@ func main() {
 		DataPath:           dataPath,
 		CachePath:          cachePath,
 		FirstLevelDirAsTag: firstLevelDirAsTag,
+		TagRules:           tagRules,
+		PathTemplates:      pathTemplates,
+		TagNormalization:   tagNormalization,
+		RecentlyAddedDays:  recentlyAddedDays,
+		AuthRequired:       authRequired,
+		SessionDays:        sessionDays,
+		OIDC:               oidc,
 	})
 
 	log.Info().Str("dbType", dbType).Str("dbConnection", connectionStr).Msg("Database open.")
@@ -137,6 +247,18 @@ func main() {
 		return
 	}
 
+	if value, valid := os.LookupEnv("MANGAWEB_ADMIN_EMAIL"); valid {
+		client := database.CreateEntClient()
+		_, err := auth.Bootstrap(ctx, client, value, os.Getenv("MANGAWEB_ADMIN_PASSWORD"))
+		log.Err(client.Close()).Msg("database client close on admin bootstrap")
+		if err != nil {
+			log.Error().Err(err).Str("email", value).Msg("Creating admin fails.")
+			return
+		}
+
+		log.Info().Str("email", value).Msg("Admin ready.")
+	}
+
 	go maintenance.UpdateLibrary(context.Background())
 
 	log.Info().Msg("Server starts.")
@@ -145,7 +267,10 @@ func main() {
 	if err != nil {
 		log.Error().Err(err).Str("address", address).Msg("failed to listen")
 	}
-	var opts []grpclib.ServerOption
+	opts := []grpclib.ServerOption{
+		grpclib.UnaryInterceptor(auth.UnaryInterceptor),
+		grpclib.StreamInterceptor(auth.StreamInterceptor),
+	}
 
 	grpcServer := grpclib.NewServer(opts...)
 	grpc.RegisterHistoryServer(grpcServer, &server.HistoryServer{})
@@ -154,6 +279,10 @@ func main() {
 	grpc.RegisterTagServer(grpcServer, &server.TagServer{})
 	grpc.RegisterSystemServer(grpcServer, &server.SystemServer{})
 	grpc.RegisterUserServer(grpcServer, &server.UserServer{})
+	grpc.RegisterSearchServer(grpcServer, &server.SearchServer{})
+	grpc.RegisterSavedSearchServer(grpcServer, &server.SavedSearchServer{})
+	grpc.RegisterAuthServer(grpcServer, &server.AuthServer{})
+	grpc.RegisterApiKeyServer(grpcServer, &server.ApiKeyServer{})
 
 	if err := grpcServer.Serve(listener); err != nil {
 		log.Error().Err(err).Msg("Starting server fails")
@@ -5,6 +5,7 @@ import (
 
 	"github.com/mangaweb4/mangaweb4-backend/database"
 	"github.com/mangaweb4/mangaweb4-backend/meta"
+	"github.com/mangaweb4/mangaweb4-backend/tag"
 	"github.com/rs/zerolog/log"
 )
 
@@ -25,4 +26,6 @@ func PopulateTags(ctx context.Context) {
 			log.Err(err).Msg("fails to populate tags.")
 		}
 	}
+
+	log.Err(tag.Refresh(ctx, client)).Msg("Refresh tags.")
 }
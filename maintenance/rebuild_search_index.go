@@ -0,0 +1,12 @@
+package maintenance
+
+import (
+	"context"
+
+	"github.com/mangaweb4/mangaweb4-backend/database"
+	"github.com/rs/zerolog/log"
+)
+
+func RebuildSearchIndex(ctx context.Context) {
+	log.Err(database.RebuildSearchIndex(ctx)).Msg("Rebuild search index.")
+}
@@ -25,9 +25,15 @@ func ScanLibrary(ctx context.Context, client *ent.Client) error {
 			if m.Name == file.Name {
 				found = true
 
-				if m.Active {
-					break
+				// Templates configured after the item was scanned are applied
+				// to it too.
+				if meta.PopulateFields(m) {
+					if err := meta.Write(ctx, client, m); err != nil {
+						log.Error().Str("name", m.Name).Err(err).Msg("Failed to update meta fields")
+					}
 				}
+
+				break
 			}
 		}
 		if found {
@@ -41,8 +47,14 @@ func ScanLibrary(ctx context.Context, client *ent.Client) error {
 
 		if item, err := meta.Read(ctx, client, file.Name); err == nil {
 			item.Active = true
+			meta.PopulateFields(item)
 			if err := meta.Write(ctx, client, item); err != nil {
 				log.Error().Str("name", item.Name).Err(err).Msg("Failed to re-activate meta")
+				continue
+			}
+
+			if _, _, err := meta.PopulateTags(ctx, client, item); err != nil {
+				log.Error().Str("name", item.Name).Err(err).Msg("Failed to populate tags.")
 			}
 		} else {
 			item, err := meta.NewItem(ctx, client, file.Name, file.Type)
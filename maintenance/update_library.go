@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/mangaweb4/mangaweb4-backend/database"
+	"github.com/mangaweb4/mangaweb4-backend/meta"
+	"github.com/mangaweb4/mangaweb4-backend/tag"
 	"github.com/rs/zerolog/log"
 )
 
@@ -11,5 +13,8 @@ func UpdateLibrary(ctx context.Context) {
 	client := database.CreateEntClient()
 	defer func() { log.Err(client.Close()).Msg("Update metadata close client.") }()
 
+	log.Err(tag.UpdateNormalizedNames(ctx, client)).Msg("Update normalized tag names.")
+	log.Err(meta.UpdateNameKeys(ctx, client)).Msg("Update item name keys.")
 	log.Err(ScanLibrary(ctx, client)).Msg("Update metadata set.")
+	log.Err(tag.Refresh(ctx, client)).Msg("Refresh tags.")
 }
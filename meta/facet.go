@@ -0,0 +1,60 @@
+package meta
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/mangaweb4/mangaweb4-backend/browse"
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/mangaweb4/mangaweb4-backend/ent/metatag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+)
+
+const MaxTagFacets = 100
+
+// TagFacet is a tag found on the items of a listing. Count is the number of
+// those items that carry the tag.
+type TagFacet struct {
+	ID       int          `sql:"id"`
+	Name     string       `sql:"name"`
+	Category tag.Category `sql:"category"`
+	Count    int          `sql:"count"`
+}
+
+// TagFacets returns the tags of the items matching the query parameters, in
+// descending order of item count, so that the listing can be narrowed down
+// further. Sorting and paging are ignored, and only tags the user can see are
+// returned.
+func TagFacets(ctx context.Context, client *ent.Client, u *ent.User, q QueryParams) (out []TagFacet, err error) {
+	p, err := Predicate(ctx, client, u, q)
+	if err != nil {
+		return
+	}
+
+	out = make([]TagFacet, 0)
+	err = client.Tag.Query().
+		Where(browse.Tags(u, grpc.Filter_FILTER_UNKNOWN)).
+		Limit(MaxTagFacets).
+		Modify(func(s *sql.Selector) {
+			matched := sql.Dialect(s.Dialect()).Select(meta.FieldID).From(sql.Table(meta.Table))
+			p(matched)
+
+			items := sql.Table(metatag.Table)
+			s.Join(items).
+				On(s.C(tag.FieldID), items.C(metatag.TagColumn)).
+				Where(sql.In(items.C(metatag.MetaColumn), matched)).
+				GroupBy(s.C(tag.FieldID), s.C(tag.FieldName), s.C(tag.FieldCategory)).
+				Select(
+					s.C(tag.FieldID),
+					s.C(tag.FieldName),
+					s.C(tag.FieldCategory),
+					sql.As(sql.Count("*"), "count"),
+				).
+				OrderBy(sql.Desc("count"), s.C(tag.FieldName))
+		}).
+		Scan(ctx, &out)
+
+	return
+}
@@ -0,0 +1,88 @@
+package meta
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+	"github.com/mangaweb4/mangaweb4-backend/user"
+	"github.com/stretchr/testify/suite"
+)
+
+type FacetTestSuite struct {
+	suite.Suite
+}
+
+func TestFacetTestSuite(t *testing.T) {
+	suite.Run(t, new(FacetTestSuite))
+}
+
+func (s *FacetTestSuite) TestTagFilterAndFacets() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	u, err := user.GetUser(ctx, client, "")
+	s.Assert().Nil(err)
+
+	action, err := client.Tag.Create().SetName("action").Save(ctx)
+	s.Assert().Nil(err)
+	comedy, err := client.Tag.Create().SetName("comedy").Save(ctx)
+	s.Assert().Nil(err)
+	horror, err := client.Tag.Create().SetName("horror").Save(ctx)
+	s.Assert().Nil(err)
+	secret, err := client.Tag.Create().SetName("secret").SetHidden(true).Save(ctx)
+	s.Assert().Nil(err)
+
+	_, err = client.Meta.Create().SetName("manga 1.zip").AddTags(action, comedy).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("manga 2.zip").AddTags(action, horror, secret).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("manga 3.zip").AddTags(comedy).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("manga 4.zip").Save(ctx)
+	s.Assert().Nil(err)
+
+	names := func(q QueryParams) []string {
+		q.SortBy = grpc.SortField_SORT_FIELD_NAME
+		items, err := ReadPage(ctx, client, u, q)
+		s.Assert().Nil(err)
+
+		out := make([]string, len(items))
+		for i, m := range items {
+			out[i] = m.Name
+		}
+
+		return out
+	}
+
+	s.Assert().Equal([]string{"manga 1.zip"}, names(QueryParams{
+		IncludeTags: []int{action.ID, comedy.ID},
+	}))
+	s.Assert().Equal([]string{"manga 1.zip", "manga 2.zip", "manga 3.zip"}, names(QueryParams{
+		IncludeTags: []int{action.ID, comedy.ID},
+		TagMatch:    grpc.TagMatch_TAG_MATCH_ANY,
+	}))
+	s.Assert().Equal([]string{"manga 1.zip", "manga 3.zip"}, names(QueryParams{
+		IncludeTags: []int{action.ID, comedy.ID},
+		TagMatch:    grpc.TagMatch_TAG_MATCH_ANY,
+		ExcludeTags: []int{horror.ID},
+	}))
+	s.Assert().Equal([]string{"manga 3.zip", "manga 4.zip"}, names(QueryParams{
+		ExcludeTags: []int{action.ID},
+	}))
+
+	facets, err := TagFacets(ctx, client, u, QueryParams{
+		IncludeTags: []int{action.ID},
+		SortBy:      grpc.SortField_SORT_FIELD_NAME,
+		ItemPerPage: 1,
+	})
+	s.Assert().Nil(err)
+	s.Assert().Equal([]TagFacet{
+		{ID: action.ID, Name: "action", Category: action.Category, Count: 2},
+		{ID: comedy.ID, Name: "comedy", Category: comedy.Category, Count: 1},
+		{ID: horror.ID, Name: "horror", Category: horror.Category, Count: 1},
+	}, facets)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/mangaweb4/mangaweb4-backend/container"
 	"github.com/mangaweb4/mangaweb4-backend/ent"
 	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/mangaweb4/mangaweb4-backend/ent/metatag"
 	tag_util "github.com/mangaweb4/mangaweb4-backend/tag"
 	"github.com/rs/zerolog/log"
 
@@ -51,11 +52,18 @@ func NewItem(ctx context.Context, client *ent.Client, name string, ct meta.Conta
 		return
 	}
 
+	PopulateFields(i)
+
 	return client.Meta.Create().
 		SetName(i.Name).
 		SetCreateTime(i.CreateTime).
 		SetFileIndices(i.FileIndices).
 		SetContainerType(ct).
+		SetSeries(i.Series).
+		SetVolume(i.Volume).
+		SetChapter(i.Chapter).
+		SetArtist(i.Artist).
+		SetYear(i.Year).
 		Save(ctx)
 }
 
@@ -180,6 +188,9 @@ func DeleteThumbnail(m *ent.Meta) error {
 	return err
 }
 
+// GenerateImageIndices lists the pages of the item. When the pages of a stored
+// item change, the time of the change is recorded so that readers can find
+// the items with new pages.
 func GenerateImageIndices(m *ent.Meta) error {
 	mutex := new(sync.Mutex)
 	mutex.Lock()
@@ -190,58 +201,119 @@ func GenerateImageIndices(m *ent.Meta) error {
 		return err
 	}
 
-	return c.PopulateImageIndices(context.Background())
+	indices := slices.Clone(m.FileIndices)
+	if err := c.PopulateImageIndices(context.Background()); err != nil {
+		return err
+	}
+
+	if m.ID != 0 && !slices.Equal(indices, m.FileIndices) {
+		now := time.Now()
+		m.PagesUpdateTime = &now
+	}
+
+	return nil
+}
+
+// parseTags returns the tags captured by the path templates followed by the
+// tags extracted by the tag rules.
+func parseTags(name string) []tag_util.Parsed {
+	match, _ := MatchTemplates(name)
+
+	output := make([]tag_util.Parsed, 0)
+	for _, p := range append(match.Tags, tag_util.Parse(name)...) {
+		if !slices.ContainsFunc(output, func(o tag_util.Parsed) bool { return o.Name == p.Name }) {
+			output = append(output, p)
+		}
+	}
+
+	return output
 }
 
+// PopulateTags synchronizes the parsed tags of the item with the tags found in
+// its name. Parsed tags that are no longer found are removed, while tags added
+// manually or from ComicInfo, and tags removed manually, are left untouched.
 func PopulateTags(ctx context.Context, client *ent.Client, m *ent.Meta) (out *ent.Meta, tags []*ent.Tag, err error) {
 	log.Debug().Msg("PopulateTags")
-	tagStrs := tag_util.ParseTag(m.Name)
+	parsed := parseTags(m.Name)
 
-	log.Debug().Strs("tagStrs", tagStrs).Msg("ParseTag")
-	currentTags, _ := m.QueryTags().All(ctx)
+	log.Debug().Any("parsed", parsed).Msg("ParseTag")
+	current, err := client.MetaTag.Query().Where(metatag.MetaID(m.ID)).All(ctx)
+	if err != nil {
+		return
+	}
 
-	log.Debug().Any("currentTags", currentTags).Msg("current tags")
+	log.Debug().Any("current", current).Msg("current tags")
 
-	newTags := make([]*ent.Tag, 0)
-	for _, t := range tagStrs {
-		if slices.ContainsFunc(currentTags, func(tag *ent.Tag) bool {
-			return tag.Name == t
+	excluded, err := m.QueryExcludedTags().IDs(ctx)
+	if err != nil {
+		return
+	}
+
+	parsedTags := make([]*ent.Tag, 0)
+	for _, p := range parsed {
+		tag, e := tag_util.Resolve(ctx, client, p.Name)
+		if ent.IsNotFound(e) {
+			tag, e = tag_util.Create(ctx, client, p)
+		} else if e == nil && tag.Category == "" && p.Category != "" {
+			tag, e = tag.Update().SetCategory(p.Category).Save(ctx)
+		}
+
+		if e != nil {
+			log.Warn().Err(e).Str("tag", p.Name).Msg("unable to resolve tag")
+			continue
+		}
+
+		if slices.Contains(excluded, tag.ID) || slices.ContainsFunc(parsedTags, func(t *ent.Tag) bool {
+			return t.ID == tag.ID
 		}) {
 			continue
 		}
 
-		var tag *ent.Tag
-		if temp, err := tag_util.Read(ctx, client, t); err != nil {
-			tag = &ent.Tag{
-				Name: t,
-			}
+		parsedTags = append(parsedTags, tag)
+	}
+
+	newTags := make([]*ent.Tag, 0)
+	for _, t := range parsedTags {
+		if !slices.ContainsFunc(current, func(mt *ent.MetaTag) bool { return mt.TagID == t.ID }) {
+			newTags = append(newTags, t)
+		}
+	}
 
-			tag, _ = client.Tag.Create().
-				SetName(tag.Name).
-				SetHidden(tag.Hidden).
-				Save(ctx)
+	staleTagIDs := make([]int, 0)
+	for _, mt := range current {
+		if mt.Source != metatag.SourceParsed {
+			continue
+		}
 
-		} else {
-			tag = temp
+		if !slices.ContainsFunc(parsedTags, func(t *ent.Tag) bool { return t.ID == mt.TagID }) {
+			staleTagIDs = append(staleTagIDs, mt.TagID)
 		}
-		newTags = append(newTags, tag)
 	}
 
-	m, _ = m.Update().
-		AddTags(newTags...).
-		Save(ctx)
+	if len(staleTagIDs) > 0 {
+		if _, err = client.MetaTag.Delete().
+			Where(metatag.MetaID(m.ID), metatag.TagIDIn(staleTagIDs...)).
+			Exec(ctx); err != nil {
+			return
+		}
+	}
+
+	if len(newTags) > 0 {
+		if err = client.MetaTag.MapCreateBulk(newTags, func(c *ent.MetaTagCreate, i int) {
+			c.SetMetaID(m.ID).SetTagID(newTags[i].ID).SetSource(metatag.SourceParsed)
+		}).Exec(ctx); err != nil {
+			return
+		}
+	}
 
 	for _, t := range newTags {
-		if m.CreateTime.After(t.LastUpdate) {
-			_, err = t.Update().SetLastUpdate(m.CreateTime).Save(ctx)
-			if err != nil {
-				return
-			}
+		if err = touchTag(ctx, m, t); err != nil {
+			return
 		}
 	}
 
 	out = m
-	tags = append(currentTags, newTags...)
+	tags, err = m.QueryTags().All(ctx)
 
 	return
 }
@@ -0,0 +1,137 @@
+package meta
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mangaweb4/mangaweb4-backend/configuration"
+	"github.com/mangaweb4/mangaweb4-backend/ent/metatag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	"github.com/stretchr/testify/suite"
+)
+
+type PopulateTagsTestSuite struct {
+	suite.Suite
+}
+
+func TestPopulateTagsTestSuite(t *testing.T) {
+	suite.Run(t, new(PopulateTagsTestSuite))
+}
+
+func (s *PopulateTagsTestSuite) SetupTest() {
+	configuration.Init(configuration.Config{
+		TagNormalization: configuration.TagNormalization{
+			TrimSpace: true,
+			CaseFold:  true,
+			NFKC:      true,
+		},
+	})
+}
+
+func (s *PopulateTagsTestSuite) TestPopulateTagsNormalizedNames() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	m1, err := client.Meta.Create().SetName("[Artist Name]manga 1.zip").Save(ctx)
+	s.Assert().Nil(err)
+	m2, err := client.Meta.Create().SetName("[artist name ]manga 2.zip").Save(ctx)
+	s.Assert().Nil(err)
+
+	_, tags1, err := PopulateTags(ctx, client, m1)
+	s.Assert().Nil(err)
+	_, tags2, err := PopulateTags(ctx, client, m2)
+	s.Assert().Nil(err)
+
+	s.Assert().Equal(1, len(tags1))
+	s.Assert().Equal(1, len(tags2))
+	s.Assert().Equal(tags1[0].ID, tags2[0].ID)
+	s.Assert().Equal(1, client.Tag.Query().CountX(ctx))
+}
+
+func (s *PopulateTagsTestSuite) TestPopulateTagsIsIdempotent() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	m, err := client.Meta.Create().SetName("[Artist][artist]manga 1.zip").Save(ctx)
+	s.Assert().Nil(err)
+
+	m, _, err = PopulateTags(ctx, client, m)
+	s.Assert().Nil(err)
+	_, tags, err := PopulateTags(ctx, client, m)
+	s.Assert().Nil(err)
+
+	s.Assert().Equal(1, len(tags))
+	s.Assert().Equal(1, m.QueryTags().CountX(ctx))
+}
+
+func (s *PopulateTagsTestSuite) TestPopulateTagsKeepsManualTags() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	m, err := client.Meta.Create().SetName("[Artist]manga 1.zip").Save(ctx)
+	s.Assert().Nil(err)
+
+	m, _, err = PopulateTags(ctx, client, m)
+	s.Assert().Nil(err)
+
+	manual, err := AddTagByName(ctx, client, m, "Manual")
+	s.Assert().Nil(err)
+
+	m.Name = "[Another Artist]manga 1.zip"
+	_, tags, err := PopulateTags(ctx, client, m)
+	s.Assert().Nil(err)
+
+	names := make([]string, len(tags))
+	for i, t := range tags {
+		names[i] = t.Name
+	}
+	s.Assert().ElementsMatch([]string{"Another Artist", "Manual"}, names)
+
+	metaTags, err := ReadTags(ctx, client, m)
+	s.Assert().Nil(err)
+	for _, mt := range metaTags {
+		if mt.TagID == manual.ID {
+			s.Assert().Equal(metatag.SourceManual, mt.Source)
+		} else {
+			s.Assert().Equal(metatag.SourceParsed, mt.Source)
+		}
+	}
+}
+
+func (s *PopulateTagsTestSuite) TestPopulateTagsSkipsRemovedTags() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	m, err := client.Meta.Create().SetName("[Artist][Wrong]manga 1.zip").Save(ctx)
+	s.Assert().Nil(err)
+
+	m, tags, err := PopulateTags(ctx, client, m)
+	s.Assert().Nil(err)
+	s.Assert().Equal(2, len(tags))
+
+	wrong, err := client.Tag.Query().Where(tag.Name("Wrong")).Only(ctx)
+	s.Assert().Nil(err)
+	s.Assert().Nil(RemoveTag(ctx, client, m, wrong))
+
+	_, tags, err = PopulateTags(ctx, client, m)
+	s.Assert().Nil(err)
+	s.Assert().Equal(1, len(tags))
+	s.Assert().Equal("Artist", tags[0].Name)
+
+	s.Assert().Nil(AddTag(ctx, client, m, wrong))
+	_, tags, err = PopulateTags(ctx, client, m)
+	s.Assert().Nil(err)
+	s.Assert().Equal(2, len(tags))
+}
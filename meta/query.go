@@ -2,28 +2,53 @@ package meta
 
 import (
 	"context"
-	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
-	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/mangaweb4/mangaweb4-backend/browse"
 	"github.com/mangaweb4/mangaweb4-backend/ent"
 	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/mangaweb4/mangaweb4-backend/ent/predicate"
 	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
 	"github.com/mangaweb4/mangaweb4-backend/ent/user"
+	"github.com/mangaweb4/mangaweb4-backend/fold"
 	"github.com/mangaweb4/mangaweb4-backend/grpc"
+	"github.com/mangaweb4/mangaweb4-backend/search"
 )
 
 type QueryParams struct {
-	SearchName  string
-	SearchTag   string
+	// Query is a search query in the syntax of ParseSearch.
+	Query      string
+	SearchName string
+	SearchTag  string
+	// IncludeTags are the IDs of the tags the items must carry, all of them or
+	// any of them depending on TagMatch.
+	IncludeTags []int
+	TagMatch    grpc.TagMatch
+	// ExcludeTags are the IDs of the tags the items must not carry.
+	ExcludeTags []int
+	Series      string
 	SortBy      grpc.SortField
 	SortOrder   grpc.SortOrder
+	// RandomSeed selects the order of SORT_FIELD_RANDOM.
+	RandomSeed  int64
 	Filter      grpc.Filter
 	Page        int
 	ItemPerPage int
+	// Cursor continues the listing after the page it was returned with. Page
+	// is ignored when it is set.
+	Cursor string
 }
 
-func CreateQuery(ctx context.Context, client *ent.Client, u *ent.User, q QueryParams) (query *ent.MetaQuery, err error) {
+// Predicate returns the predicate matching the items of the listing, without
+// its sort order and paging.
+func Predicate(ctx context.Context, client *ent.Client, u *ent.User, q QueryParams) (p predicate.Meta, err error) {
+	if err = browse.CheckFilter(u, q.Filter); err != nil {
+		return
+	}
+
+	predicates := []predicate.Meta{browse.Items(u, q.Filter)}
+
 	if q.SearchTag != "" {
 		t, e := client.Tag.Query().Where(tag.Name(q.SearchTag)).Only(ctx)
 		if e != nil {
@@ -31,63 +56,120 @@ func CreateQuery(ctx context.Context, client *ent.Client, u *ent.User, q QueryPa
 			return
 		}
 
-		query = t.QueryMeta()
-	} else {
-		query = client.Meta.Query()
+		predicates = append(predicates, meta.HasTagsWith(tag.ID(t.ID)))
+	}
+
+	if len(q.IncludeTags) > 0 {
+		if q.TagMatch == grpc.TagMatch_TAG_MATCH_ANY {
+			predicates = append(predicates, meta.HasTagsWith(tag.IDIn(q.IncludeTags...)))
+		} else {
+			for _, id := range q.IncludeTags {
+				predicates = append(predicates, meta.HasTagsWith(tag.ID(id)))
+			}
+		}
 	}
 
-	query = query.Where(meta.Active(true), meta.Hidden(false))
+	if len(q.ExcludeTags) > 0 {
+		predicates = append(predicates, meta.Not(meta.HasTagsWith(tag.IDIn(q.ExcludeTags...))))
+	}
+
+	if q.Query != "" {
+		sp, e := SearchPredicate(q.Query, u, time.Now())
+		if e != nil {
+			err = e
+			return
+		}
+
+		predicates = append(predicates, sp)
+	}
 
 	if q.SearchName != "" {
-		query = query.Where(meta.NameContainsFold(q.SearchName))
+		predicates = append(predicates, meta.SearchKeyContains(fold.SearchKey(q.SearchName)))
+	}
+
+	if q.Series != "" {
+		predicates = append(predicates, meta.Series(q.Series))
 	}
 
 	switch q.Filter {
 	case grpc.Filter_FILTER_FAVORITE_ITEMS:
-		query = query.Where(
-			meta.HasFavoriteOfUserWith(user.ID(u.ID)),
-		)
+		predicates = append(predicates, meta.HasFavoriteOfUserWith(user.ID(u.ID)))
 	case grpc.Filter_FILTER_FAVORITE_TAGS:
-		query = query.Where(
-			meta.HasTagsWith(tag.HasFavoriteOfUserWith(user.ID(u.ID))),
-		)
+		predicates = append(predicates, meta.HasTagsWith(tag.HasFavoriteOfUserWith(user.ID(u.ID))))
+	default:
+		if reading := browse.ReadingItems(u, q.Filter, time.Now()); reading != nil {
+			predicates = append(predicates, reading)
+		}
 	}
 
-	field := ""
-	switch q.SortBy {
-	case grpc.SortField_SORT_FIELD_NAME:
-		field = meta.FieldName
-	case grpc.SortField_SORT_FIELD_CREATION_TIME:
-		field = meta.FieldCreateTime
-	case grpc.SortField_SORT_FIELD_PAGECOUNT:
-		field = meta.FieldFileIndices
+	p = meta.And(predicates...)
+	return
+}
 
-	default:
-		err = fmt.Errorf("invalid filter value: %v", q.SortBy)
+func CreateQuery(ctx context.Context, client *ent.Client, u *ent.User, q QueryParams) (query *ent.MetaQuery, err error) {
+	p, err := Predicate(ctx, client, u, q)
+	if err != nil {
+		return
 	}
 
-	switch q.SortOrder {
-	case grpc.SortOrder_SORT_ORDER_ASCENDING:
-		if q.SortBy == grpc.SortField_SORT_FIELD_PAGECOUNT {
-			query = query.Order(sqljson.OrderLen(meta.FieldFileIndices)).Unique(false)
-		} else {
-			query = query.Order(ent.Asc(string(field)))
+	keys, err := SortKeys(u, q)
+	if err != nil {
+		return
+	}
+
+	query = client.Meta.Query().Where(p).Order(browse.Order(keys...)).Unique(false)
+
+	if q.Cursor != "" {
+		values, e := browse.DecodeCursor(q.Cursor, q.SortBy, q.SortOrder, len(keys))
+		if e != nil {
+			err = e
+			return
 		}
-	case grpc.SortOrder_SORT_ORDER_DESCENDING:
-		if q.SortBy == grpc.SortField_SORT_FIELD_PAGECOUNT {
-			query = query.Order(sqljson.OrderLenDesc(meta.FieldFileIndices)).Unique(false)
-		} else {
-			query = query.Order(ent.Desc(string(field)))
+
+		query = query.Where(browse.After(values, keys...))
+		if q.ItemPerPage > 0 {
+			query = query.Limit(q.ItemPerPage)
 		}
+	} else if q.ItemPerPage > 0 {
+		query = query.Limit(q.ItemPerPage).Offset(q.ItemPerPage * q.Page)
 	}
 
-	if q.ItemPerPage > 0 {
-		query = query.Limit(q.ItemPerPage).Offset(q.ItemPerPage * q.Page)
+	return
+}
+
+// SortKeys returns the sort keys of the listing. Relevance always puts the
+// best match first, the sort order only applies to the name used as a
+// tie-breaker.
+func SortKeys(u *ent.User, q QueryParams) (keys []browse.SortKey, err error) {
+	if q.SortBy != grpc.SortField_SORT_FIELD_RELEVANCE {
+		return browse.ItemKeys(u, q.SortBy, q.SortOrder, q.RandomSeed)
+	}
+
+	terms, err := ParseSearch(q.Query)
+	if err != nil {
+		return
+	}
+
+	keys, err = browse.ItemKeys(u, grpc.SortField_SORT_FIELD_NAME, q.SortOrder, q.RandomSeed)
+	if err != nil {
+		return
 	}
 
+	keys = append([]browse.SortKey{search.RelevanceKey(SearchText(terms))}, keys...)
 	return
 }
 
+// NextCursor returns the cursor of the page after items, which were read with
+// q. It is empty when there are no more items.
+func NextCursor(u *ent.User, items []*ent.Meta, q QueryParams) (cursor string, err error) {
+	keys, err := SortKeys(u, q)
+	if err != nil {
+		return
+	}
+
+	return browse.NextCursor(items, q.ItemPerPage, len(keys), q.SortBy, q.SortOrder)
+}
+
 func ReadPage(ctx context.Context, client *ent.Client, u *ent.User, q QueryParams) (items []*ent.Meta, err error) {
 	query, err := CreateQuery(ctx, client, u, q)
 	if err != nil {
@@ -120,6 +202,7 @@ func Write(ctx context.Context, client *ent.Client, m *ent.Meta) error {
 		SetName(m.Name).
 		SetCreateTime(m.CreateTime).
 		SetFileIndices(m.FileIndices).
+		SetNillablePagesUpdateTime(m.PagesUpdateTime).
 		SetActive(m.Active).
 		SetContainerType(m.ContainerType).
 		SetThumbnailIndex(m.ThumbnailIndex).
@@ -127,6 +210,12 @@ func Write(ctx context.Context, client *ent.Client, m *ent.Meta) error {
 		SetThumbnailY(m.ThumbnailY).
 		SetThumbnailWidth(m.ThumbnailWidth).
 		SetThumbnailHeight(m.ThumbnailHeight).
+		SetSeries(m.Series).
+		SetVolume(m.Volume).
+		SetChapter(m.Chapter).
+		SetArtist(m.Artist).
+		SetYear(m.Year).
+		SetAgeRating(m.AgeRating).
 		OnConflict(sql.ConflictColumns(meta.FieldName)).
 		UpdateNewValues().Exec(ctx)
 }
@@ -135,6 +224,33 @@ func Read(ctx context.Context, client *ent.Client, name string) (m *ent.Meta, er
 	return client.Meta.Query().Where(meta.Name(name)).Only(ctx)
 }
 
+// ReadAllowed returns the item with the ID, which is not found when the
+// restrictions of the user do not allow it.
+func ReadAllowed(ctx context.Context, client *ent.Client, u *ent.User, id int) (m *ent.Meta, err error) {
+	return client.Meta.Query().Where(meta.ID(id), browse.AllowedItems(u)).Only(ctx)
+}
+
 func ReadAll(ctx context.Context, client *ent.Client) (items []*ent.Meta, err error) {
 	return client.Meta.Query().Where(meta.Active(true)).All(ctx)
 }
+
+// UpdateNameKeys recomputes the search keys and sort names of the items, for
+// example for items created before they were stored.
+func UpdateNameKeys(ctx context.Context, client *ent.Client) error {
+	items, err := client.Meta.Query().Select(meta.FieldName, meta.FieldSearchKey, meta.FieldSortName).All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range items {
+		key, sortName := fold.SearchKey(m.Name), fold.NaturalKey(m.Name)
+		if key != m.SearchKey || sortName != m.SortName {
+			err := client.Meta.UpdateOneID(m.ID).SetSearchKey(key).SetSortName(sortName).Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
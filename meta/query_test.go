@@ -9,9 +9,12 @@ import (
 	dialect_sql "entgo.io/ent/dialect/sql"
 	"github.com/mangaweb4/mangaweb4-backend/ent"
 	"github.com/mangaweb4/mangaweb4-backend/ent/enttest"
+	ent_user "github.com/mangaweb4/mangaweb4-backend/ent/user"
 	"github.com/mangaweb4/mangaweb4-backend/grpc"
 	"github.com/mangaweb4/mangaweb4-backend/user"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	_ "modernc.org/sqlite"
 )
 
@@ -23,7 +26,7 @@ func TestQueryTestSuite(t *testing.T) {
 	suite.Run(t, new(QueryTestSuite))
 }
 
-func createTestDBClient(s *QueryTestSuite) (db *sql.DB, client *ent.Client, err error) {
+func createTestDBClient(s suite.TestingSuite) (db *sql.DB, client *ent.Client, err error) {
 	db, err = sql.Open("sqlite", "file:ent?mode=memory&_fk=1&_pragma=foreign_keys(1)")
 	if err != nil {
 		return
@@ -789,3 +792,464 @@ func (s *QueryTestSuite) TestReadSortByPageCountDesc() {
 	s.Assert().Equal("[some artist]manga 3 here.zip", tags[2].Name)
 	s.Assert().Equal("[some artist]manga 4 here.zip", tags[3].Name)
 }
+
+func (s *QueryTestSuite) TestReadPageSeriesSortBySeries() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	s.Assert().NotNil(db)
+	s.Assert().NotNil(client)
+	defer func() { s.T().Log("database close", db.Close()) }()
+	defer func() { s.T().Log("database client close", db.Close()) }()
+
+	_, err = client.Meta.Create().SetName("Series A v10.zip").SetSeries("Series A").SetVolume(10).Save(context.Background())
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("Series A v2.zip").SetSeries("Series A").SetVolume(2).Save(context.Background())
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("Series A v1.zip").SetSeries("Series A").SetVolume(1).Save(context.Background())
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("Series B v1.zip").SetSeries("Series B").SetVolume(1).Save(context.Background())
+	s.Assert().Nil(err)
+
+	u, err := user.GetUser(context.Background(), client, "")
+	s.Assert().Nil(err)
+	items, err := ReadPage(context.Background(), client, u, QueryParams{
+		Series:      "Series A",
+		SortBy:      grpc.SortField_SORT_FIELD_SERIES,
+		SortOrder:   grpc.SortOrder_SORT_ORDER_ASCENDING,
+		Page:        0,
+		ItemPerPage: 30,
+	})
+	s.Assert().Nil(err)
+
+	s.Assert().Equal(3, len(items))
+
+	s.Assert().Equal("Series A v1.zip", items[0].Name)
+	s.Assert().Equal("Series A v2.zip", items[1].Name)
+	s.Assert().Equal("Series A v10.zip", items[2].Name)
+}
+
+func (s *QueryTestSuite) TestReadPageHidden() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	s.Assert().NotNil(db)
+	s.Assert().NotNil(client)
+	defer func() { s.T().Log("database close", db.Close()) }()
+	defer func() { s.T().Log("database client close", db.Close()) }()
+
+	u, err := user.GetUser(context.Background(), client, "")
+	s.Assert().Nil(err)
+
+	hiding, err := client.Tag.Create().SetName("hiding").SetHidden(true).SetHideItems(true).Save(context.Background())
+	s.Assert().Nil(err)
+	hidden, err := client.Tag.Create().SetName("hidden").SetHidden(true).Save(context.Background())
+	s.Assert().Nil(err)
+
+	_, err = client.Meta.Create().SetName("[some artist]manga 1 here.zip").Save(context.Background())
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("[some artist]manga 2 here.zip").SetHidden(true).Save(context.Background())
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("[some artist]manga 3 here.zip").AddTags(hiding).Save(context.Background())
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("[some artist]manga 4 here.zip").AddTags(hidden).Save(context.Background())
+	s.Assert().Nil(err)
+
+	items, err := ReadPage(context.Background(), client, u, QueryParams{
+		SortBy:      grpc.SortField_SORT_FIELD_NAME,
+		SortOrder:   grpc.SortOrder_SORT_ORDER_ASCENDING,
+		Page:        0,
+		ItemPerPage: 30,
+	})
+	s.Assert().Nil(err)
+
+	s.Assert().Equal(2, len(items))
+	s.Assert().Equal("[some artist]manga 1 here.zip", items[0].Name)
+	s.Assert().Equal("[some artist]manga 4 here.zip", items[1].Name)
+
+	// Only editors can list what is hidden.
+	_, err = ReadPage(context.Background(), client, u, QueryParams{Filter: grpc.Filter_FILTER_HIDDEN})
+	s.Assert().Equal(codes.PermissionDenied, status.Code(err))
+
+	editor, err := user.Create(context.Background(), client, "editor@example.com", ent_user.RoleEditor)
+	s.Assert().Nil(err)
+
+	items, err = ReadPage(context.Background(), client, editor, QueryParams{
+		Filter:      grpc.Filter_FILTER_HIDDEN,
+		SortBy:      grpc.SortField_SORT_FIELD_NAME,
+		SortOrder:   grpc.SortOrder_SORT_ORDER_ASCENDING,
+		Page:        0,
+		ItemPerPage: 30,
+	})
+	s.Assert().Nil(err)
+
+	s.Assert().Equal(2, len(items))
+	s.Assert().Equal("[some artist]manga 2 here.zip", items[0].Name)
+	s.Assert().Equal("[some artist]manga 3 here.zip", items[1].Name)
+}
+
+func (s *QueryTestSuite) TestReadPageBlocked() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	s.Assert().NotNil(db)
+	s.Assert().NotNil(client)
+	defer func() { s.T().Log("database close", db.Close()) }()
+	defer func() { s.T().Log("database client close", db.Close()) }()
+
+	u, err := user.GetUser(context.Background(), client, "reader")
+	s.Assert().Nil(err)
+	other, err := user.GetUser(context.Background(), client, "other")
+	s.Assert().Nil(err)
+
+	blocked, err := client.Tag.Create().SetName("blocked").AddBlockedByUser(u).Save(context.Background())
+	s.Assert().Nil(err)
+
+	_, err = client.Meta.Create().SetName("[some artist]manga 1 here.zip").Save(context.Background())
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("[some artist]manga 2 here.zip").AddBlockedByUser(u).Save(context.Background())
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("[some artist]manga 3 here.zip").AddTags(blocked).Save(context.Background())
+	s.Assert().Nil(err)
+
+	items, err := ReadPage(context.Background(), client, u, QueryParams{
+		SortBy:      grpc.SortField_SORT_FIELD_NAME,
+		SortOrder:   grpc.SortOrder_SORT_ORDER_ASCENDING,
+		Page:        0,
+		ItemPerPage: 30,
+	})
+	s.Assert().Nil(err)
+
+	s.Assert().Equal(1, len(items))
+	s.Assert().Equal("[some artist]manga 1 here.zip", items[0].Name)
+
+	items, err = ReadPage(context.Background(), client, u, QueryParams{
+		Filter:      grpc.Filter_FILTER_BLOCKED,
+		SortBy:      grpc.SortField_SORT_FIELD_NAME,
+		SortOrder:   grpc.SortOrder_SORT_ORDER_ASCENDING,
+		Page:        0,
+		ItemPerPage: 30,
+	})
+	s.Assert().Nil(err)
+
+	s.Assert().Equal(2, len(items))
+	s.Assert().Equal("[some artist]manga 2 here.zip", items[0].Name)
+	s.Assert().Equal("[some artist]manga 3 here.zip", items[1].Name)
+
+	count, err := Count(context.Background(), client, other, QueryParams{
+		SortBy:    grpc.SortField_SORT_FIELD_NAME,
+		SortOrder: grpc.SortOrder_SORT_ORDER_ASCENDING,
+	})
+	s.Assert().Nil(err)
+	s.Assert().Equal(3, count)
+}
+
+func (s *QueryTestSuite) TestReadPageReadingFilters() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	u, err := user.GetUser(ctx, client, "")
+	s.Assert().Nil(err)
+
+	pages := make([]int, 10)
+	now := time.Now()
+
+	unread, err := client.Meta.Create().SetName("unread.zip").SetFileIndices(pages).
+		SetCreateTime(now.AddDate(0, 0, -1)).Save(ctx)
+	s.Assert().Nil(err)
+	reading, err := client.Meta.Create().SetName("reading.zip").SetFileIndices(pages).
+		SetCreateTime(now.AddDate(0, -1, 0)).Save(ctx)
+	s.Assert().Nil(err)
+	completed, err := client.Meta.Create().SetName("completed.zip").SetFileIndices(pages).
+		SetCreateTime(now.AddDate(0, -1, 0)).Save(ctx)
+	s.Assert().Nil(err)
+	updated, err := client.Meta.Create().SetName("updated.zip").SetFileIndices(pages).
+		SetCreateTime(now.AddDate(0, -1, 0)).SetPagesUpdateTime(now.Add(-time.Hour)).Save(ctx)
+	s.Assert().Nil(err)
+
+	_, err = client.Progress.Create().SetUser(u).SetItem(reading).SetPage(3).SetMax(5).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Progress.Create().SetUser(u).SetItem(completed).SetPage(2).SetMax(9).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Progress.Create().SetUser(u).SetItem(updated).SetPage(9).SetMax(9).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.History.Create().SetUser(u).SetItem(completed).SetCreateTime(now.Add(-2 * time.Hour)).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.History.Create().SetUser(u).SetItem(updated).SetCreateTime(now.Add(-2 * time.Hour)).Save(ctx)
+	s.Assert().Nil(err)
+
+	names := func(filter grpc.Filter) []string {
+		items, err := ReadPage(ctx, client, u, QueryParams{
+			Filter: filter,
+			SortBy: grpc.SortField_SORT_FIELD_NAME,
+		})
+		s.Assert().Nil(err)
+
+		out := make([]string, len(items))
+		for i, m := range items {
+			out[i] = m.Name
+		}
+
+		return out
+	}
+
+	s.Assert().Equal([]string{unread.Name}, names(grpc.Filter_FILTER_UNREAD))
+	s.Assert().Equal([]string{reading.Name}, names(grpc.Filter_FILTER_IN_PROGRESS))
+	s.Assert().Equal([]string{completed.Name, updated.Name}, names(grpc.Filter_FILTER_COMPLETED))
+	s.Assert().Equal([]string{unread.Name}, names(grpc.Filter_FILTER_RECENTLY_ADDED))
+	s.Assert().Equal([]string{updated.Name}, names(grpc.Filter_FILTER_NEW_PAGES))
+
+	_, err = client.History.Create().SetUser(u).SetItem(updated).Save(ctx)
+	s.Assert().Nil(err)
+	s.Assert().Empty(names(grpc.Filter_FILTER_NEW_PAGES))
+}
+
+func (s *QueryTestSuite) TestReadPageHistorySorts() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	u, err := user.GetUser(ctx, client, "")
+	s.Assert().Nil(err)
+	other, err := user.GetUser(ctx, client, "other")
+	s.Assert().Nil(err)
+
+	pages := make([]int, 10)
+	now := time.Now()
+
+	vol2, err := client.Meta.Create().SetName("Vol 2.zip").SetFileIndices(pages).Save(ctx)
+	s.Assert().Nil(err)
+	vol10, err := client.Meta.Create().SetName("Vol 10.zip").SetFileIndices(pages).Save(ctx)
+	s.Assert().Nil(err)
+	vol1, err := client.Meta.Create().SetName("Vol 1.zip").SetFileIndices(pages).Save(ctx)
+	s.Assert().Nil(err)
+
+	_, err = client.Progress.Create().SetUser(u).SetItem(vol2).SetMax(9).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Progress.Create().SetUser(u).SetItem(vol10).SetMax(4).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.History.Create().SetUser(u).SetItem(vol2).SetCreateTime(now.Add(-2 * time.Hour)).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.History.Create().SetUser(u).SetItem(vol10).SetCreateTime(now.Add(-time.Hour)).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.History.Create().SetUser(other).SetItem(vol2).SetCreateTime(now).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.History.Create().SetUser(other).SetItem(vol1).SetCreateTime(now).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.History.Create().SetUser(other).SetItem(vol1).SetCreateTime(now).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.History.Create().SetUser(other).SetItem(vol1).SetCreateTime(now).Save(ctx)
+	s.Assert().Nil(err)
+
+	names := func(field grpc.SortField, order grpc.SortOrder, seed int64, page int) []string {
+		items, err := ReadPage(ctx, client, u, QueryParams{
+			SortBy:      field,
+			SortOrder:   order,
+			RandomSeed:  seed,
+			Page:        page,
+			ItemPerPage: 2,
+		})
+		s.Assert().Nil(err)
+
+		out := make([]string, len(items))
+		for i, m := range items {
+			out[i] = m.Name
+		}
+
+		return out
+	}
+
+	asc, desc := grpc.SortOrder_SORT_ORDER_ASCENDING, grpc.SortOrder_SORT_ORDER_DESCENDING
+
+	s.Assert().Equal([]string{"Vol 1.zip", "Vol 10.zip"}, names(grpc.SortField_SORT_FIELD_NAME, asc, 0, 0))
+	s.Assert().Equal([]string{"Vol 1.zip", "Vol 2.zip"}, names(grpc.SortField_SORT_FIELD_NATURAL_NAME, asc, 0, 0))
+	s.Assert().Equal([]string{"Vol 10.zip"}, names(grpc.SortField_SORT_FIELD_NATURAL_NAME, asc, 0, 1))
+	s.Assert().Equal([]string{"Vol 10.zip", "Vol 2.zip"}, names(grpc.SortField_SORT_FIELD_NATURAL_NAME, desc, 0, 0))
+
+	s.Assert().Equal([]string{"Vol 10.zip", "Vol 2.zip"}, names(grpc.SortField_SORT_FIELD_LAST_READ, desc, 0, 0))
+	s.Assert().Equal([]string{"Vol 1.zip"}, names(grpc.SortField_SORT_FIELD_LAST_READ, desc, 0, 1))
+	s.Assert().Equal([]string{"Vol 2.zip", "Vol 10.zip"}, names(grpc.SortField_SORT_FIELD_LAST_READ, asc, 0, 0))
+
+	s.Assert().Equal([]string{"Vol 2.zip", "Vol 10.zip"}, names(grpc.SortField_SORT_FIELD_PROGRESS, desc, 0, 0))
+	s.Assert().Equal([]string{"Vol 1.zip", "Vol 10.zip"}, names(grpc.SortField_SORT_FIELD_PROGRESS, asc, 0, 0))
+
+	s.Assert().Equal([]string{"Vol 1.zip", "Vol 2.zip"}, names(grpc.SortField_SORT_FIELD_POPULARITY, desc, 0, 0))
+	s.Assert().Equal([]string{"Vol 10.zip"}, names(grpc.SortField_SORT_FIELD_POPULARITY, desc, 0, 1))
+
+	for _, seed := range []int64{0, 1, 42} {
+		first := names(grpc.SortField_SORT_FIELD_RANDOM, asc, seed, 0)
+		s.Assert().Equal(first, names(grpc.SortField_SORT_FIELD_RANDOM, asc, seed, 0))
+
+		all := append(first, names(grpc.SortField_SORT_FIELD_RANDOM, asc, seed, 1)...)
+		s.Assert().ElementsMatch([]string{vol1.Name, vol2.Name, vol10.Name}, all)
+	}
+}
+
+func (s *QueryTestSuite) TestReadPageCursor() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	u, err := user.GetUser(ctx, client, "")
+	s.Assert().Nil(err)
+
+	now := time.Now()
+	for i, name := range []string{"Vol 3.zip", "Vol 1.zip", "Vol 2.zip", "Vol 10.zip", "Vol 5.zip"} {
+		m, err := client.Meta.Create().
+			SetName(name).
+			SetCreateTime(now.Add(time.Duration(i%2) * time.Hour)).
+			SetFileIndices(make([]int, i%3+1)).
+			SetSeries("Series").
+			Save(ctx)
+		s.Assert().Nil(err)
+
+		if i%2 == 0 {
+			_, err = client.History.Create().SetUser(u).SetItem(m).SetCreateTime(now.Add(-time.Duration(i) * time.Hour)).Save(ctx)
+			s.Assert().Nil(err)
+		}
+	}
+
+	walk := func(q QueryParams) []string {
+		out := make([]string, 0)
+		for page := 0; page < 10; page++ {
+			items, err := ReadPage(ctx, client, u, q)
+			s.Assert().Nil(err)
+			for _, m := range items {
+				out = append(out, m.Name)
+			}
+
+			q.Cursor, err = NextCursor(u, items, q)
+			s.Assert().Nil(err)
+			if q.Cursor == "" {
+				break
+			}
+		}
+
+		return out
+	}
+
+	fields := []grpc.SortField{
+		grpc.SortField_SORT_FIELD_NAME,
+		grpc.SortField_SORT_FIELD_CREATION_TIME,
+		grpc.SortField_SORT_FIELD_PAGECOUNT,
+		grpc.SortField_SORT_FIELD_SERIES,
+		grpc.SortField_SORT_FIELD_LAST_READ,
+		grpc.SortField_SORT_FIELD_PROGRESS,
+		grpc.SortField_SORT_FIELD_POPULARITY,
+		grpc.SortField_SORT_FIELD_NATURAL_NAME,
+		grpc.SortField_SORT_FIELD_RANDOM,
+	}
+	for _, field := range fields {
+		for _, order := range []grpc.SortOrder{grpc.SortOrder_SORT_ORDER_ASCENDING, grpc.SortOrder_SORT_ORDER_DESCENDING} {
+			q := QueryParams{SortBy: field, SortOrder: order, RandomSeed: 7}
+			all, err := ReadPage(ctx, client, u, q)
+			s.Assert().Nil(err)
+
+			names := make([]string, len(all))
+			for i, m := range all {
+				names[i] = m.Name
+			}
+
+			q.ItemPerPage = 2
+			s.Assert().Equal(names, walk(q), "%v %v", field, order)
+		}
+	}
+
+	q := QueryParams{SortBy: grpc.SortField_SORT_FIELD_NAME, SortOrder: grpc.SortOrder_SORT_ORDER_ASCENDING, ItemPerPage: 2}
+	first, err := ReadPage(ctx, client, u, q)
+	s.Assert().Nil(err)
+	q.Cursor, err = NextCursor(u, first, q)
+	s.Assert().Nil(err)
+
+	// Items added before the cursor do not shift the next page.
+	_, err = client.Meta.Create().SetName("Vol 0.zip").Save(ctx)
+	s.Assert().Nil(err)
+
+	next, err := ReadPage(ctx, client, u, q)
+	s.Assert().Nil(err)
+	s.Assert().Equal("Vol 2.zip", next[0].Name)
+
+	q.SortOrder = grpc.SortOrder_SORT_ORDER_DESCENDING
+	_, err = ReadPage(ctx, client, u, q)
+	s.Assert().ErrorContains(err, "cursor")
+
+	q.Cursor = "not a cursor"
+	_, err = ReadPage(ctx, client, u, q)
+	s.Assert().ErrorContains(err, "cursor")
+}
+
+func (s *QueryTestSuite) TestReadPageRestricted() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	// An editor, so that the hidden items can be listed too.
+	u, err := user.Create(ctx, client, "editor", ent_user.RoleEditor)
+	s.Assert().Nil(err)
+	other, err := user.GetUser(ctx, client, "other")
+	s.Assert().Nil(err)
+
+	denied, err := client.Tag.Create().SetName("denied").Save(ctx)
+	s.Assert().Nil(err)
+
+	allowed, err := client.Meta.Create().SetName("kids/manga 1.zip").SetAgeRating(12).Save(ctx)
+	s.Assert().Nil(err)
+	mature, err := client.Meta.Create().SetName("kids/manga 2.zip").SetAgeRating(18).Save(ctx)
+	s.Assert().Nil(err)
+	tagged, err := client.Meta.Create().SetName("kids/manga 3.zip").SetAgeRating(6).AddTags(denied).Save(ctx)
+	s.Assert().Nil(err)
+	outside, err := client.Meta.Create().SetName("kidsmanga 4.zip").SetAgeRating(6).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("kids/manga 5.zip").SetAgeRating(6).SetHidden(true).Save(ctx)
+	s.Assert().Nil(err)
+	unrated, err := client.Meta.Create().SetName("kids/manga 6.zip").Save(ctx)
+	s.Assert().Nil(err)
+
+	rating := 13
+	u, err = user.SetRestrictions(ctx, client, u, user.Restrictions{
+		MaxAgeRating: &rating,
+		AllowedPaths: []string{"kids"},
+		DeniedTags:   []int{denied.ID},
+	})
+	s.Assert().Nil(err)
+
+	items, err := ReadPage(ctx, client, u, QueryParams{
+		SortBy:    grpc.SortField_SORT_FIELD_NAME,
+		SortOrder: grpc.SortOrder_SORT_ORDER_ASCENDING,
+	})
+	s.Assert().Nil(err)
+	s.Assert().Equal(1, len(items))
+	s.Assert().Equal(allowed.ID, items[0].ID)
+
+	count, err := Count(ctx, client, u, QueryParams{
+		Filter:    grpc.Filter_FILTER_HIDDEN,
+		SortBy:    grpc.SortField_SORT_FIELD_NAME,
+		SortOrder: grpc.SortOrder_SORT_ORDER_ASCENDING,
+	})
+	s.Assert().Nil(err)
+	s.Assert().Equal(1, count)
+
+	// Items outside the restrictions cannot be opened directly either.
+	_, err = ReadAllowed(ctx, client, u, allowed.ID)
+	s.Assert().Nil(err)
+	for _, m := range []*ent.Meta{mature, tagged, outside, unrated} {
+		_, err = ReadAllowed(ctx, client, u, m.ID)
+		s.Assert().True(ent.IsNotFound(err), m.Name)
+
+		_, err = ReadAllowed(ctx, client, other, m.ID)
+		s.Assert().Nil(err)
+	}
+
+	count, err = Count(ctx, client, other, QueryParams{
+		SortBy:    grpc.SortField_SORT_FIELD_NAME,
+		SortOrder: grpc.SortOrder_SORT_ORDER_ASCENDING,
+	})
+	s.Assert().Nil(err)
+	s.Assert().Equal(5, count)
+}
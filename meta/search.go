@@ -0,0 +1,413 @@
+package meta
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/mangaweb4/mangaweb4-backend/ent/predicate"
+	"github.com/mangaweb4/mangaweb4-backend/ent/progress"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tagalias"
+	"github.com/mangaweb4/mangaweb4-backend/ent/user"
+	"github.com/mangaweb4/mangaweb4-backend/fold"
+	"github.com/mangaweb4/mangaweb4-backend/search"
+	tag_util "github.com/mangaweb4/mangaweb4-backend/tag"
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	SEARCH_KEY_TAG    = "tag"
+	SEARCH_KEY_NAME   = "name"
+	SEARCH_KEY_SERIES = "series"
+	SEARCH_KEY_ARTIST = "artist"
+	SEARCH_KEY_PAGES  = "pages"
+	SEARCH_KEY_READ   = "read"
+	SEARCH_KEY_FAV    = "fav"
+	SEARCH_KEY_ADDED  = "added"
+	SEARCH_KEY_LANG   = "lang"
+)
+
+var searchKeys = []string{
+	SEARCH_KEY_TAG,
+	SEARCH_KEY_NAME,
+	SEARCH_KEY_SERIES,
+	SEARCH_KEY_ARTIST,
+	SEARCH_KEY_PAGES,
+	SEARCH_KEY_READ,
+	SEARCH_KEY_FAV,
+	SEARCH_KEY_ADDED,
+	SEARCH_KEY_LANG,
+}
+
+// SearchError is a problem found while parsing a search query. Pos is the
+// 1-based character position of the problem in the query.
+type SearchError struct {
+	Pos int
+	Msg string
+}
+
+func (e *SearchError) Error() string {
+	return fmt.Sprintf("search query: position %d: %s", e.Pos, e.Msg)
+}
+
+// GRPCStatus reports the error to gRPC clients as InvalidArgument.
+func (e *SearchError) GRPCStatus() *status.Status {
+	return status.New(codes.InvalidArgument, e.Error())
+}
+
+// SearchTerm is a single term of a search query. Key is empty for plain text.
+type SearchTerm struct {
+	Pos    int
+	Negate bool
+	Key    string
+	Op     string
+	Value  string
+}
+
+// ParseSearch splits a search query into terms. Terms are separated by
+// whitespace, a leading `-` negates a term, values may be double-quoted, and a
+// key is followed by `:`, `:<`, `:>`, `:<=`, `:>=`, `<`, `>`, `<=`, `>=` or
+// `=`. Words whose prefix is not a known key are plain text.
+func ParseSearch(query string) (terms []SearchTerm, err error) {
+	runes := []rune(query)
+	terms = make([]SearchTerm, 0)
+
+	i := 0
+	for i < len(runes) {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+
+		term := SearchTerm{Pos: i + 1}
+		if runes[i] == '-' && i+1 < len(runes) && !unicode.IsSpace(runes[i+1]) {
+			term.Negate = true
+			i++
+		}
+
+		if runes[i] != '"' {
+			start := i
+			for i < len(runes) && isSearchKeyRune(runes[i]) {
+				i++
+			}
+
+			if key := strings.ToLower(string(runes[start:i])); isSearchKey(key) && i < len(runes) {
+				if op, n := searchOperator(runes[i:]); n > 0 {
+					term.Key = key
+					term.Op = op
+					i += n
+				}
+			}
+
+			if term.Key == "" {
+				i = start
+			}
+		}
+
+		valuePos := i + 1
+		term.Value, i, err = searchValue(runes, i)
+		if err != nil {
+			return
+		}
+
+		if term.Value == "" {
+			if term.Key != "" {
+				err = &SearchError{Pos: valuePos, Msg: fmt.Sprintf("missing value for %s", term.Key)}
+				return
+			}
+
+			continue
+		}
+
+		terms = append(terms, term)
+	}
+
+	return
+}
+
+func isSearchKeyRune(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z'
+}
+
+func isSearchKey(key string) bool {
+	for _, k := range searchKeys {
+		if k == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// searchOperator reads the operator following a key and returns it together
+// with the number of runes it spans. `:` alone is returned as `=`.
+func searchOperator(runes []rune) (op string, n int) {
+	if runes[0] == ':' {
+		n = 1
+		runes = runes[1:]
+	}
+
+	for _, o := range []string{"<=", ">=", "<", ">", "="} {
+		if strings.HasPrefix(string(runes), o) {
+			if n == 1 && o == "=" {
+				break
+			}
+
+			return o, n + len(o)
+		}
+	}
+
+	if n == 1 {
+		return "=", n
+	}
+
+	return "", 0
+}
+
+// searchValue reads a quoted or unquoted value starting at i and returns it
+// with the position after it.
+func searchValue(runes []rune, i int) (value string, next int, err error) {
+	if i >= len(runes) || runes[i] != '"' {
+		start := i
+		for i < len(runes) && !unicode.IsSpace(runes[i]) {
+			i++
+		}
+
+		return string(runes[start:i]), i, nil
+	}
+
+	start := i
+	builder := strings.Builder{}
+	for i++; i < len(runes); i++ {
+		switch runes[i] {
+		case '\\':
+			if i+1 < len(runes) {
+				i++
+				builder.WriteRune(runes[i])
+			}
+		case '"':
+			return builder.String(), i + 1, nil
+		default:
+			builder.WriteRune(runes[i])
+		}
+	}
+
+	err = &SearchError{Pos: start + 1, Msg: "unterminated quote"}
+	return
+}
+
+// SearchPredicate parses a search query into a predicate over the items. The
+// terms are combined with AND; plain text is matched against the search index,
+// read and fav refer to the given user, and added durations are counted back
+// from now.
+func SearchPredicate(query string, u *ent.User, now time.Time) (p predicate.Meta, err error) {
+	terms, err := ParseSearch(query)
+	if err != nil {
+		return
+	}
+
+	predicates := make([]predicate.Meta, 0, len(terms)+1)
+	for _, term := range terms {
+		if term.Key == "" && !term.Negate {
+			continue
+		}
+
+		tp, e := termPredicate(term, u, now)
+		if e != nil {
+			err = e
+			return
+		}
+
+		predicates = append(predicates, tp)
+	}
+
+	if text := SearchText(terms); text != "" {
+		predicates = append(predicates, search.Match(text))
+	}
+
+	p = meta.And(predicates...)
+	return
+}
+
+// SearchText returns the plain text of the search terms, which is matched
+// against the search index and ranks the items by relevance.
+func SearchText(terms []SearchTerm) string {
+	texts := make([]string, 0)
+	for _, term := range terms {
+		if term.Key == "" && !term.Negate {
+			texts = append(texts, term.Value)
+		}
+	}
+
+	return strings.Join(texts, " ")
+}
+
+func termPredicate(term SearchTerm, u *ent.User, now time.Time) (p predicate.Meta, err error) {
+	switch term.Key {
+	case "", SEARCH_KEY_NAME, SEARCH_KEY_SERIES, SEARCH_KEY_ARTIST, SEARCH_KEY_TAG, SEARCH_KEY_LANG:
+		if term.Op != "" && term.Op != "=" {
+			err = &SearchError{Pos: term.Pos, Msg: fmt.Sprintf("%s does not support %s", term.Key, term.Op)}
+			return
+		}
+	}
+
+	switch term.Key {
+	case "", SEARCH_KEY_NAME:
+		p = meta.SearchKeyContains(fold.SearchKey(term.Value))
+
+	case SEARCH_KEY_SERIES:
+		p = meta.SeriesContainsFold(term.Value)
+
+	case SEARCH_KEY_ARTIST:
+		p = meta.ArtistContainsFold(term.Value)
+
+	case SEARCH_KEY_TAG:
+		key := tag_util.Normalize(term.Value)
+		p = meta.HasTagsWith(tag.Or(
+			tag.Name(term.Value),
+			tag.NormalizedName(key),
+			tag.HasAliasesWith(tagalias.Or(tagalias.Name(term.Value), tagalias.NormalizedName(key))),
+		))
+
+	case SEARCH_KEY_LANG:
+		names := []predicate.Tag{tag.NameEqualFold(term.Value)}
+		if l, e := language.Parse(term.Value); e == nil {
+			if name := display.English.Languages().Name(l); name != "" {
+				names = append(names, tag.NameEqualFold(name))
+			}
+		}
+
+		p = meta.HasTagsWith(tag.CategoryEQ(tag.CategoryLanguage), tag.Or(names...))
+
+	case SEARCH_KEY_READ:
+		var b bool
+		if b, err = searchBool(term); err != nil {
+			return
+		}
+
+		p = meta.HasProgressWith(progress.UserID(u.ID))
+		if !b {
+			p = meta.Not(p)
+		}
+
+	case SEARCH_KEY_FAV:
+		var b bool
+		if b, err = searchBool(term); err != nil {
+			return
+		}
+
+		p = meta.HasFavoriteOfUserWith(user.ID(u.ID))
+		if !b {
+			p = meta.Not(p)
+		}
+
+	case SEARCH_KEY_PAGES:
+		n, e := strconv.Atoi(term.Value)
+		if e != nil || n < 0 {
+			err = &SearchError{Pos: term.Pos, Msg: fmt.Sprintf("invalid page count %q", term.Value)}
+			return
+		}
+
+		p = pagesPredicate(term.Op, n)
+
+	case SEARCH_KEY_ADDED:
+		if p, err = addedPredicate(term, now); err != nil {
+			return
+		}
+	}
+
+	if term.Negate {
+		p = meta.Not(p)
+	}
+
+	return
+}
+
+func searchBool(term SearchTerm) (bool, error) {
+	if term.Op == "=" {
+		switch strings.ToLower(term.Value) {
+		case "yes", "true", "1":
+			return true, nil
+		case "no", "false", "0":
+			return false, nil
+		}
+	}
+
+	return false, &SearchError{Pos: term.Pos, Msg: fmt.Sprintf("%s expects yes or no", term.Key)}
+}
+
+func pagesPredicate(op string, n int) predicate.Meta {
+	return predicate.Meta(func(s *sql.Selector) {
+		column := s.C(meta.FieldFileIndices)
+		switch op {
+		case "<":
+			s.Where(sqljson.LenLT(column, n))
+		case "<=":
+			s.Where(sqljson.LenLTE(column, n))
+		case ">":
+			s.Where(sqljson.LenGT(column, n))
+		case ">=":
+			s.Where(sqljson.LenGTE(column, n))
+		default:
+			s.Where(sqljson.LenEQ(column, n))
+		}
+	})
+}
+
+var searchDurationUnits = map[byte]time.Duration{
+	'h': time.Hour,
+	'd': 24 * time.Hour,
+	'w': 7 * 24 * time.Hour,
+	'm': 30 * 24 * time.Hour,
+	'y': 365 * 24 * time.Hour,
+}
+
+// addedPredicate matches the creation time. The value is either a date, which
+// is compared as it is, or an age such as `30d`, where `<30d` means added less
+// than 30 days ago.
+func addedPredicate(term SearchTerm, now time.Time) (p predicate.Meta, err error) {
+	if d, e := time.ParseInLocation(time.DateOnly, term.Value, now.Location()); e == nil {
+		switch term.Op {
+		case "<":
+			p = meta.CreateTimeLT(d)
+		case "<=":
+			p = meta.CreateTimeLT(d.AddDate(0, 0, 1))
+		case ">":
+			p = meta.CreateTimeGTE(d.AddDate(0, 0, 1))
+		case ">=":
+			p = meta.CreateTimeGTE(d)
+		default:
+			p = meta.And(meta.CreateTimeGTE(d), meta.CreateTimeLT(d.AddDate(0, 0, 1)))
+		}
+
+		return
+	}
+
+	value := strings.ToLower(term.Value)
+	unit, ok := searchDurationUnits[value[len(value)-1]]
+	n, e := strconv.Atoi(value[:len(value)-1])
+	if !ok || e != nil || n < 0 {
+		err = &SearchError{Pos: term.Pos, Msg: fmt.Sprintf("invalid date or age %q", term.Value)}
+		return
+	}
+
+	since := now.Add(-time.Duration(n) * unit)
+	switch term.Op {
+	case "<", "<=", "=":
+		p = meta.CreateTimeGTE(since)
+	default:
+		p = meta.CreateTimeLT(since)
+	}
+
+	return
+}
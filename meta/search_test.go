@@ -0,0 +1,192 @@
+package meta
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+	"github.com/mangaweb4/mangaweb4-backend/search"
+	"github.com/mangaweb4/mangaweb4-backend/user"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SearchTestSuite struct {
+	suite.Suite
+}
+
+func TestSearchTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchTestSuite))
+}
+
+func (s *SearchTestSuite) TestParseSearch() {
+	terms, err := ParseSearch(`tag:"artist a" -tag:ntr pages>30 read:no added:<30d Re:Zero`)
+	s.Assert().Nil(err)
+	s.Assert().Equal([]SearchTerm{
+		{Pos: 1, Key: "tag", Op: "=", Value: "artist a"},
+		{Pos: 16, Negate: true, Key: "tag", Op: "=", Value: "ntr"},
+		{Pos: 25, Key: "pages", Op: ">", Value: "30"},
+		{Pos: 34, Key: "read", Op: "=", Value: "no"},
+		{Pos: 42, Key: "added", Op: "<", Value: "30d"},
+		{Pos: 53, Value: "Re:Zero"},
+	}, terms)
+}
+
+func (s *SearchTestSuite) TestParseSearchErrors() {
+	_, err := ParseSearch(`tag:"artist a`)
+	s.Assert().Equal(&SearchError{Pos: 5, Msg: "unterminated quote"}, err)
+
+	_, err = ParseSearch(`fav:yes tag: x`)
+	s.Assert().Equal(&SearchError{Pos: 13, Msg: "missing value for tag"}, err)
+
+	_, err = SearchPredicate(`pages>many`, nil, time.Now())
+	s.Assert().Equal(&SearchError{Pos: 1, Msg: `invalid page count "many"`}, err)
+
+	_, err = SearchPredicate(`tag>a`, nil, time.Now())
+	s.Assert().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *SearchTestSuite) TestReadPageQuery() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	u, err := user.GetUser(ctx, client, "")
+	s.Assert().Nil(err)
+
+	artist, err := client.Tag.Create().SetName("artist a").Save(ctx)
+	s.Assert().Nil(err)
+	ntr, err := client.Tag.Create().SetName("ntr").Save(ctx)
+	s.Assert().Nil(err)
+	english, err := client.Tag.Create().SetName("English").SetCategory(tag.CategoryLanguage).Save(ctx)
+	s.Assert().Nil(err)
+
+	pages := make([]int, 40)
+	recent := time.Now().Add(-24 * time.Hour)
+	old := time.Now().AddDate(-1, 0, 0)
+
+	_, err = client.Meta.Create().SetName("manga 1.zip").SetFileIndices(pages).SetCreateTime(recent).
+		AddTags(artist, english).AddFavoriteOfUser(u).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("manga 2.zip").SetFileIndices(pages).SetCreateTime(recent).
+		AddTags(artist, ntr, english).AddFavoriteOfUser(u).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("manga 3.zip").SetFileIndices(pages[:10]).SetCreateTime(recent).
+		AddTags(artist, english).AddFavoriteOfUser(u).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("manga 4.zip").SetFileIndices(pages).SetCreateTime(old).
+		AddTags(artist, english).AddFavoriteOfUser(u).Save(ctx)
+	s.Assert().Nil(err)
+	m5, err := client.Meta.Create().SetName("manga 5.zip").SetFileIndices(pages).SetCreateTime(recent).
+		AddTags(artist, english).AddFavoriteOfUser(u).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Progress.Create().SetUserID(u.ID).SetItemID(m5.ID).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("manga 6.zip").SetFileIndices(pages).SetCreateTime(recent).
+		AddTags(artist).AddFavoriteOfUser(u).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("manga 7.zip").SetFileIndices(pages).SetCreateTime(recent).
+		AddTags(artist, english).Save(ctx)
+	s.Assert().Nil(err)
+
+	items, err := ReadPage(ctx, client, u, QueryParams{
+		Query:     `tag:"artist a" -tag:ntr pages>30 read:no fav:yes added:<30d lang:en`,
+		SortBy:    grpc.SortField_SORT_FIELD_NAME,
+		SortOrder: grpc.SortOrder_SORT_ORDER_ASCENDING,
+	})
+	s.Assert().Nil(err)
+	s.Assert().Equal(1, len(items))
+	s.Assert().Equal("manga 1.zip", items[0].Name)
+
+	_, err = ReadPage(ctx, client, u, QueryParams{
+		Query:  `added:<30x`,
+		SortBy: grpc.SortField_SORT_FIELD_NAME,
+	})
+
+	var searchErr *SearchError
+	s.Assert().True(errors.As(err, &searchErr))
+	s.Assert().Equal(1, searchErr.Pos)
+}
+
+func (s *SearchTestSuite) TestReadPageRelevance() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	index := search.NewIndex(dialect.SQLite)
+	s.Assert().Nil(index.Create(ctx, client))
+	index.Use(client)
+
+	u, err := user.GetUser(ctx, client, "")
+	s.Assert().Nil(err)
+
+	_, err = client.Meta.Create().SetName("Boruto.zip").Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("Naruto.zip").Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("One Piece.zip").Save(ctx)
+	s.Assert().Nil(err)
+
+	params := QueryParams{
+		Query:       "naruto",
+		SortBy:      grpc.SortField_SORT_FIELD_RELEVANCE,
+		SortOrder:   grpc.SortOrder_SORT_ORDER_ASCENDING,
+		ItemPerPage: 30,
+	}
+
+	items, err := ReadPage(ctx, client, u, params)
+	s.Assert().Nil(err)
+	s.Assert().Equal(2, len(items))
+	s.Assert().Equal("Naruto.zip", items[0].Name)
+	s.Assert().Equal("Boruto.zip", items[1].Name)
+
+	count, err := Count(ctx, client, u, params)
+	s.Assert().Nil(err)
+	s.Assert().Equal(2, count)
+}
+
+func (s *SearchTestSuite) TestReadPageSearchKey() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	u, err := user.GetUser(ctx, client, "")
+	s.Assert().Nil(err)
+
+	_, err = client.Meta.Create().SetName("マンガ Café.zip").Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("Other.zip").Save(ctx)
+	s.Assert().Nil(err)
+
+	for _, params := range []QueryParams{
+		{SearchName: "ﾏﾝｶﾞ"},
+		{SearchName: "まんが"},
+		{SearchName: "CAFE"},
+		{Query: "name:cafe"},
+	} {
+		params.SortBy = grpc.SortField_SORT_FIELD_NAME
+		items, err := ReadPage(ctx, client, u, params)
+		s.Assert().Nil(err)
+		s.Assert().Equal(1, len(items))
+		s.Assert().Equal("マンガ Café.zip", items[0].Name)
+	}
+
+	s.Assert().Nil(client.Meta.Update().ClearSearchKey().Exec(ctx))
+	s.Assert().Nil(UpdateNameKeys(ctx, client))
+
+	m, err := client.Meta.Query().Where(meta.Name("マンガ Café.zip")).Only(ctx)
+	s.Assert().Nil(err)
+	s.Assert().Equal("まんが cafe.zip", m.SearchKey)
+}
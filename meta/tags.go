@@ -0,0 +1,100 @@
+package meta
+
+import (
+	"context"
+
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/metatag"
+	tag_util "github.com/mangaweb4/mangaweb4-backend/tag"
+)
+
+// ReadTags returns the tag associations of the item, with their tags loaded.
+func ReadTags(ctx context.Context, client *ent.Client, m *ent.Meta) ([]*ent.MetaTag, error) {
+	return client.MetaTag.Query().
+		Where(metatag.MetaID(m.ID)).
+		Order(metatag.ByTagID()).
+		WithTag().
+		All(ctx)
+}
+
+// AddTag adds a tag to the item manually. An existing association is marked as
+// manual, so that it is kept by PopulateTags.
+func AddTag(ctx context.Context, client *ent.Client, m *ent.Meta, t *ent.Tag) error {
+	if err := m.Update().RemoveExcludedTags(t).Exec(ctx); err != nil {
+		return err
+	}
+
+	if err := client.MetaTag.Create().
+		SetMetaID(m.ID).
+		SetTagID(t.ID).
+		SetSource(metatag.SourceManual).
+		OnConflictColumns(metatag.FieldMetaID, metatag.FieldTagID).
+		UpdateSource().
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return touchTag(ctx, m, t)
+}
+
+// touchTag updates the last update time of a tag newly added to the item, and
+// reactivates the tag when the item is active.
+func touchTag(ctx context.Context, m *ent.Meta, t *ent.Tag) error {
+	update := t.Update()
+	changed := false
+
+	if m.CreateTime.After(t.LastUpdate) {
+		update = update.SetLastUpdate(m.CreateTime)
+		changed = true
+	}
+
+	if m.Active && !t.Active {
+		update = update.SetActive(true)
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return update.Exec(ctx)
+}
+
+// AddTagByName resolves a tag name, creating the tag when it does not exist,
+// and adds it to the item manually.
+func AddTagByName(ctx context.Context, client *ent.Client, m *ent.Meta, name string) (t *ent.Tag, err error) {
+	t, err = tag_util.Resolve(ctx, client, name)
+	if ent.IsNotFound(err) {
+		t, err = tag_util.Create(ctx, client, tag_util.Parsed{Name: name})
+	}
+
+	if err != nil {
+		return
+	}
+
+	err = AddTag(ctx, client, m, t)
+	return
+}
+
+// RemoveTag removes a tag from the item. The tag is also excluded from the
+// item, so that PopulateTags does not add it back.
+func RemoveTag(ctx context.Context, client *ent.Client, m *ent.Meta, t *ent.Tag) error {
+	if _, err := client.MetaTag.Delete().
+		Where(metatag.MetaID(m.ID), metatag.TagID(t.ID)).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	excluded, err := m.QueryExcludedTags().IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range excluded {
+		if id == t.ID {
+			return nil
+		}
+	}
+
+	return m.Update().AddExcludedTags(t).Exec(ctx)
+}
@@ -0,0 +1,247 @@
+package meta
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/mangaweb4/mangaweb4-backend/configuration"
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	tag_util "github.com/mangaweb4/mangaweb4-backend/tag"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	TEMPLATE_FIELD_SERIES  = "series"
+	TEMPLATE_FIELD_VOLUME  = "volume"
+	TEMPLATE_FIELD_CHAPTER = "chapter"
+	TEMPLATE_FIELD_ARTIST  = "artist"
+	TEMPLATE_FIELD_YEAR    = "year"
+	TEMPLATE_FIELD_TAG     = "tag"
+	TEMPLATE_FIELD_ANY     = "*"
+)
+
+var placeholderRegex = regexp.MustCompile(`\{([^{}]*)\}`)
+
+var templateFieldPatterns = map[string]string{
+	TEMPLATE_FIELD_SERIES:  `([^/]+?)`,
+	TEMPLATE_FIELD_VOLUME:  `(\d+(?:\.\d+)?)`,
+	TEMPLATE_FIELD_CHAPTER: `(\d+(?:\.\d+)?)`,
+	TEMPLATE_FIELD_ARTIST:  `([^/]+?)`,
+	TEMPLATE_FIELD_YEAR:    `(\d{4})`,
+	TEMPLATE_FIELD_TAG:     `([^/]+?)`,
+	TEMPLATE_FIELD_ANY:     `[^/]*?`,
+}
+
+// Template is a compiled path template such as `{artist}/{series}/Vol.{volume}`.
+// Placeholders match within a single path segment, `{tag}` or `{tag:category}`
+// captures a tag, and `{*}` matches anything.
+type Template struct {
+	Source string
+	regex  *regexp.Regexp
+	groups []templateGroup
+}
+
+type templateGroup struct {
+	Field    string
+	Category tag.Category
+}
+
+// TemplateMatch holds the fields captured by a path template.
+type TemplateMatch struct {
+	Series  string
+	Volume  float64
+	Chapter float64
+	Artist  string
+	Year    int
+	Tags    []tag_util.Parsed
+}
+
+var (
+	templatesMutex  sync.Mutex
+	templatesSource []string
+	templates       []Template
+)
+
+// CompileTemplate validates and compiles a path template.
+func CompileTemplate(source string) (t Template, err error) {
+	pattern := strings.Builder{}
+	pattern.WriteString("^")
+
+	groups := make([]templateGroup, 0)
+	last := 0
+	for _, loc := range placeholderRegex.FindAllStringSubmatchIndex(source, -1) {
+		pattern.WriteString(regexp.QuoteMeta(source[last:loc[0]]))
+		last = loc[1]
+
+		field, category, _ := strings.Cut(source[loc[2]:loc[3]], ":")
+		field = strings.TrimSpace(field)
+
+		p, found := templateFieldPatterns[field]
+		if !found {
+			err = fmt.Errorf("template %q: unknown placeholder {%s}", source, field)
+			return
+		}
+
+		if category != "" {
+			if field != TEMPLATE_FIELD_TAG {
+				err = fmt.Errorf("template %q: only {tag} accepts a category", source)
+				return
+			}
+
+			if e := tag.CategoryValidator(tag.Category(category)); e != nil {
+				err = fmt.Errorf("template %q: %w", source, e)
+				return
+			}
+		}
+
+		pattern.WriteString(p)
+		if field != TEMPLATE_FIELD_ANY {
+			groups = append(groups, templateGroup{Field: field, Category: tag.Category(category)})
+		}
+	}
+	pattern.WriteString(regexp.QuoteMeta(source[last:]))
+	pattern.WriteString("$")
+
+	regex, err := regexp.Compile(pattern.String())
+	if err != nil {
+		err = fmt.Errorf("template %q: %w", source, err)
+		return
+	}
+
+	t = Template{
+		Source: source,
+		regex:  regex,
+		groups: groups,
+	}
+
+	return
+}
+
+// CompileTemplates validates and compiles a list of path templates.
+func CompileTemplates(sources []string) (out []Template, err error) {
+	out = make([]Template, len(sources))
+	for i, s := range sources {
+		if out[i], err = CompileTemplate(s); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+func currentTemplates() []Template {
+	templatesMutex.Lock()
+	defer templatesMutex.Unlock()
+
+	c := configuration.Get()
+	if templates != nil && slices.Equal(templatesSource, c.PathTemplates) {
+		return templates
+	}
+
+	compiled, err := CompileTemplates(c.PathTemplates)
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid path templates, ignore them.")
+		compiled = make([]Template, 0)
+	}
+
+	templatesSource = slices.Clone(c.PathTemplates)
+	templates = compiled
+
+	return templates
+}
+
+// templateSubject returns the part of an item name that templates match
+// against: a slash separated path, without the archive extension.
+func templateSubject(name string) string {
+	subject := filepath.ToSlash(name)
+
+	switch strings.ToLower(filepath.Ext(subject)) {
+	case ".zip", ".cbz":
+		subject = strings.TrimSuffix(subject, filepath.Ext(subject))
+	}
+
+	return subject
+}
+
+// Match applies the template to an item name.
+func (t Template) Match(name string) (match TemplateMatch, ok bool) {
+	values := t.regex.FindStringSubmatch(templateSubject(name))
+	if values == nil {
+		return
+	}
+
+	ok = true
+	for i, g := range t.groups {
+		value := strings.TrimSpace(values[i+1])
+		if value == "" {
+			continue
+		}
+
+		switch g.Field {
+		case TEMPLATE_FIELD_SERIES:
+			if match.Series == "" {
+				match.Series = value
+			}
+		case TEMPLATE_FIELD_VOLUME:
+			if v, e := strconv.ParseFloat(value, 64); e == nil && match.Volume == 0 {
+				match.Volume = v
+			}
+		case TEMPLATE_FIELD_CHAPTER:
+			if v, e := strconv.ParseFloat(value, 64); e == nil && match.Chapter == 0 {
+				match.Chapter = v
+			}
+		case TEMPLATE_FIELD_ARTIST:
+			if match.Artist == "" {
+				match.Artist = value
+				match.Tags = append(match.Tags, tag_util.Parsed{Name: value, Category: tag.CategoryArtist})
+			}
+		case TEMPLATE_FIELD_YEAR:
+			if v, e := strconv.Atoi(value); e == nil && match.Year == 0 {
+				match.Year = v
+			}
+		case TEMPLATE_FIELD_TAG:
+			match.Tags = append(match.Tags, tag_util.Parsed{Name: value, Category: g.Category})
+		}
+	}
+
+	return
+}
+
+// MatchTemplates applies the configured path templates in order and returns
+// the result of the first one that matches.
+func MatchTemplates(name string) (match TemplateMatch, ok bool) {
+	for _, t := range currentTemplates() {
+		if match, ok = t.Match(name); ok {
+			return
+		}
+	}
+
+	return
+}
+
+// PopulateFields sets the structured fields of the item from the configured
+// path templates, and reports whether any of them changed. Fields are cleared
+// when no template matches.
+func PopulateFields(m *ent.Meta) (changed bool) {
+	match, _ := MatchTemplates(m.Name)
+
+	changed = m.Series != match.Series ||
+		m.Volume != match.Volume ||
+		m.Chapter != match.Chapter ||
+		m.Artist != match.Artist ||
+		m.Year != match.Year
+
+	m.Series = match.Series
+	m.Volume = match.Volume
+	m.Chapter = match.Chapter
+	m.Artist = match.Artist
+	m.Year = match.Year
+
+	return
+}
@@ -0,0 +1,91 @@
+package meta
+
+import (
+	"testing"
+
+	"github.com/mangaweb4/mangaweb4-backend/configuration"
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	tag_util "github.com/mangaweb4/mangaweb4-backend/tag"
+	"github.com/stretchr/testify/suite"
+)
+
+type TemplateTestSuite struct {
+	suite.Suite
+}
+
+func TestTemplateTestSuite(t *testing.T) {
+	suite.Run(t, new(TemplateTestSuite))
+}
+
+func (s *TemplateTestSuite) SetupTest() {
+	configuration.Init(configuration.Config{
+		PathTemplates: []string{
+			"{tag:circle}/{series}/{series} v{volume}",
+			"{artist}/{series}/Vol.{volume} Ch.{chapter} ({year})",
+		},
+	})
+}
+
+func (s *TemplateTestSuite) TestMatchFirstTemplate() {
+	match, ok := MatchTemplates("Publisher/Some Series/Some Series v01.cbz")
+	s.Assert().True(ok)
+	s.Assert().Equal("Some Series", match.Series)
+	s.Assert().Equal(1.0, match.Volume)
+	s.Assert().Equal(
+		[]tag_util.Parsed{{Name: "Publisher", Category: tag.CategoryCircle}},
+		match.Tags)
+}
+
+func (s *TemplateTestSuite) TestMatchSecondTemplate() {
+	match, ok := MatchTemplates("Someone/Another Series/Vol.2 Ch.10.5 (2019)")
+	s.Assert().True(ok)
+	s.Assert().Equal("Another Series", match.Series)
+	s.Assert().Equal("Someone", match.Artist)
+	s.Assert().Equal(2.0, match.Volume)
+	s.Assert().Equal(10.5, match.Chapter)
+	s.Assert().Equal(2019, match.Year)
+	s.Assert().Equal(
+		[]tag_util.Parsed{{Name: "Someone", Category: tag.CategoryArtist}},
+		match.Tags)
+}
+
+func (s *TemplateTestSuite) TestNoMatch() {
+	_, ok := MatchTemplates("[Artist]Some weird name.zip")
+	s.Assert().False(ok)
+}
+
+func (s *TemplateTestSuite) TestPopulateFieldsClearsFields() {
+	m := &ent.Meta{
+		Name:   "[Artist]Some weird name.zip",
+		Series: "Stale",
+		Volume: 3,
+	}
+
+	s.Assert().True(PopulateFields(m))
+
+	s.Assert().Equal("", m.Series)
+	s.Assert().Equal(0.0, m.Volume)
+
+	// Scans only write items whose fields changed.
+	s.Assert().False(PopulateFields(m))
+}
+
+func (s *TemplateTestSuite) TestCompileTemplateUnknownPlaceholder() {
+	_, err := CompileTemplate("{publisher}/{series}")
+	s.Assert().Error(err)
+}
+
+func (s *TemplateTestSuite) TestCompileTemplateInvalidCategory() {
+	_, err := CompileTemplate("{tag:unknown}/{series}")
+	s.Assert().Error(err)
+}
+
+func (s *TemplateTestSuite) TestParseTagsCombinesTemplatesAndRules() {
+	s.Assert().Equal(
+		[]tag_util.Parsed{
+			{Name: "Publisher", Category: tag.CategoryCircle},
+			{Name: "Extra"},
+		},
+		parseTags("Publisher/[Extra] Some Series/[Extra] Some Series v01.cbz"))
+}
@@ -0,0 +1,194 @@
+// Package preference holds the preferences users keep on the server, so that
+// every client of a user reads and browses with the same settings, and the
+// server can fill in what a request leaves unspecified.
+package preference
+
+import (
+	"context"
+	"maps"
+	"strings"
+
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// CurrentVersion is the schema version of the documents written now. Raise it
+// when a default changes, and add the new defaults to versionDefaults.
+const CurrentVersion = 1
+
+// MaxItemsPerPage is the largest number of items per page a user can set.
+const MaxItemsPerPage = 500
+
+// DeviceMetadataKey is the metadata key of an incoming call naming the device
+// it comes from, which selects the overrides of that device.
+const DeviceMetadataKey = "mangaweb-device"
+
+// Sort is a sort field with its order.
+type Sort struct {
+	Field grpc.SortField `json:"field"`
+	Order grpc.SortOrder `json:"order"`
+}
+
+// Settings are the preferences of a user, or the overrides of one of their
+// devices. Fields left at their zero value are unset and follow the level
+// below: the device overrides follow the user, and the user follows the
+// defaults.
+type Settings struct {
+	ReadingDirection grpc.ReadingDirection `json:"reading_direction,omitempty"`
+	ImageQuality     grpc.ImageQuality     `json:"image_quality,omitempty"`
+	ItemsPerPage     int                   `json:"items_per_page,omitempty"`
+	Sort             *Sort                 `json:"sort,omitempty"`
+	HiddenTags       grpc.HiddenTagDisplay `json:"hidden_tags,omitempty"`
+}
+
+// Document is the preferences document stored on a user.
+type Document struct {
+	// Version is the schema version the document was written with. Its unset
+	// fields follow the defaults of that version, so that changing a default
+	// does not change the settings of existing users. A document that was
+	// never written has version 0 and follows the current defaults.
+	Version int `json:"version"`
+	Settings
+	// Devices are the overrides of each device of the user, by device name.
+	Devices map[string]Settings `json:"devices,omitempty"`
+}
+
+// versionDefaults are the defaults of each schema version.
+var versionDefaults = map[int]Settings{
+	1: {
+		ReadingDirection: grpc.ReadingDirection_READING_DIRECTION_RIGHT_TO_LEFT,
+		ImageQuality:     grpc.ImageQuality_IMAGE_QUALITY_HIGH,
+		ItemsPerPage:     30,
+		Sort: &Sort{
+			Field: grpc.SortField_SORT_FIELD_CREATION_TIME,
+			Order: grpc.SortOrder_SORT_ORDER_DESCENDING,
+		},
+		HiddenTags: grpc.HiddenTagDisplay_HIDDEN_TAG_DISPLAY_SHOW,
+	},
+}
+
+// Defaults returns the defaults of the schema version, or the current ones
+// for a document that was never written.
+func Defaults(version int) Settings {
+	if s, ok := versionDefaults[version]; ok {
+		return s
+	}
+
+	return versionDefaults[CurrentVersion]
+}
+
+// Merge returns the settings with the fields set in over replacing theirs.
+func (s Settings) Merge(over Settings) Settings {
+	if over.ReadingDirection != grpc.ReadingDirection_READING_DIRECTION_UNSPECIFIED {
+		s.ReadingDirection = over.ReadingDirection
+	}
+	if over.ImageQuality != grpc.ImageQuality_IMAGE_QUALITY_UNSPECIFIED {
+		s.ImageQuality = over.ImageQuality
+	}
+	if over.ItemsPerPage > 0 {
+		s.ItemsPerPage = over.ItemsPerPage
+	}
+	if over.Sort != nil {
+		s.Sort = over.Sort
+	}
+	if over.HiddenTags != grpc.HiddenTagDisplay_HIDDEN_TAG_DISPLAY_UNSPECIFIED {
+		s.HiddenTags = over.HiddenTags
+	}
+
+	return s
+}
+
+// Validate checks that the set fields of the settings hold known values.
+func (s Settings) Validate() error {
+	if _, ok := grpc.ReadingDirection_name[int32(s.ReadingDirection)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "invalid reading direction: %v", s.ReadingDirection)
+	}
+	if _, ok := grpc.ImageQuality_name[int32(s.ImageQuality)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "invalid image quality: %v", s.ImageQuality)
+	}
+	if s.ItemsPerPage < 0 || s.ItemsPerPage > MaxItemsPerPage {
+		return status.Errorf(codes.InvalidArgument, "items per page must be between 1 and %d", MaxItemsPerPage)
+	}
+	if s.Sort != nil {
+		// The sort applies to item listings, which tag sort fields cannot sort.
+		_, ok := grpc.SortField_name[int32(s.Sort.Field)]
+		if !ok || s.Sort.Field == grpc.SortField_SORT_FIELD_ITEMCOUNT || s.Sort.Field == grpc.SortField_SORT_FIELD_LAST_UPDATE {
+			return status.Errorf(codes.InvalidArgument, "invalid sort value: %v", s.Sort.Field)
+		}
+		if _, ok := grpc.SortOrder_name[int32(s.Sort.Order)]; !ok {
+			return status.Errorf(codes.InvalidArgument, "invalid sort order: %v", s.Sort.Order)
+		}
+	}
+	if _, ok := grpc.HiddenTagDisplay_name[int32(s.HiddenTags)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "invalid hidden tag display: %v", s.HiddenTags)
+	}
+
+	return nil
+}
+
+// Resolve returns the settings in effect on the device, with every field set.
+func (d Document) Resolve(device string) Settings {
+	return Defaults(d.Version).Merge(d.Settings).Merge(d.Devices[device])
+}
+
+// Upgrade returns the document at the current version. The defaults of the
+// version it was written with are copied into its unset fields, so that the
+// user keeps the settings they had.
+func (d Document) Upgrade() Document {
+	if d.Version == CurrentVersion {
+		return d
+	}
+
+	if d.Version != 0 {
+		d.Settings = Defaults(d.Version).Merge(d.Settings)
+	}
+	d.Version = CurrentVersion
+
+	return d
+}
+
+// Set returns the document with the settings of the user replaced, or the
+// overrides of the device when device is not empty. The document is upgraded
+// to the current version first.
+func (d Document) Set(device string, s Settings) (out Document, err error) {
+	if err = s.Validate(); err != nil {
+		return
+	}
+
+	out = d.Upgrade()
+	if device == "" {
+		out.Settings = s
+		return
+	}
+
+	out.Devices = maps.Clone(out.Devices)
+	if s == (Settings{}) {
+		delete(out.Devices, device)
+	} else {
+		if out.Devices == nil {
+			out.Devices = make(map[string]Settings)
+		}
+		out.Devices[device] = s
+	}
+
+	return
+}
+
+// DeviceFromContext returns the device named in the metadata of an incoming
+// call, or an empty string.
+func DeviceFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	for _, value := range md.Get(DeviceMetadataKey) {
+		if device := strings.TrimSpace(value); device != "" {
+			return device
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,84 @@
+package preference
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type PreferenceTestSuite struct {
+	suite.Suite
+}
+
+func TestPreferenceTestSuite(t *testing.T) {
+	suite.Run(t, new(PreferenceTestSuite))
+}
+
+func (s *PreferenceTestSuite) TestResolve() {
+	var d Document
+	s.Assert().Equal(Defaults(CurrentVersion), d.Resolve(""))
+
+	d, err := d.Set("", Settings{ItemsPerPage: 50, ImageQuality: grpc.ImageQuality_IMAGE_QUALITY_ORIGINAL})
+	s.Require().Nil(err)
+	s.Assert().Equal(CurrentVersion, d.Version)
+
+	d, err = d.Set("phone", Settings{ImageQuality: grpc.ImageQuality_IMAGE_QUALITY_LOW})
+	s.Require().Nil(err)
+
+	desktop := d.Resolve("desktop")
+	s.Assert().Equal(50, desktop.ItemsPerPage)
+	s.Assert().Equal(grpc.ImageQuality_IMAGE_QUALITY_ORIGINAL, desktop.ImageQuality)
+	s.Assert().Equal(Defaults(CurrentVersion).ReadingDirection, desktop.ReadingDirection)
+
+	phone := d.Resolve("phone")
+	s.Assert().Equal(50, phone.ItemsPerPage)
+	s.Assert().Equal(grpc.ImageQuality_IMAGE_QUALITY_LOW, phone.ImageQuality)
+
+	// Empty overrides remove the device.
+	d, err = d.Set("phone", Settings{})
+	s.Require().Nil(err)
+	s.Assert().NotContains(d.Devices, "phone")
+}
+
+func (s *PreferenceTestSuite) TestUpgradeKeepsOldDefaults() {
+	// A version other than the current one stands in for an older version
+	// with different defaults.
+	const oldVersion = CurrentVersion + 1
+	oldDefaults := Defaults(CurrentVersion)
+	oldDefaults.ItemsPerPage = 10
+	versionDefaults[oldVersion] = oldDefaults
+	defer delete(versionDefaults, oldVersion)
+
+	old := Document{Version: oldVersion, Settings: Settings{ImageQuality: grpc.ImageQuality_IMAGE_QUALITY_LOW}}
+	upgraded := old.Upgrade()
+	s.Assert().Equal(CurrentVersion, upgraded.Version)
+	s.Assert().Equal(10, upgraded.ItemsPerPage)
+	s.Assert().Equal(old.Resolve(""), upgraded.Resolve(""))
+}
+
+func (s *PreferenceTestSuite) TestValidate() {
+	var d Document
+	for _, settings := range []Settings{
+		{ItemsPerPage: -1},
+		{ItemsPerPage: MaxItemsPerPage + 1},
+		{ImageQuality: grpc.ImageQuality(99)},
+		{Sort: &Sort{Field: grpc.SortField(99)}},
+		{Sort: &Sort{Field: grpc.SortField_SORT_FIELD_ITEMCOUNT}},
+		{HiddenTags: grpc.HiddenTagDisplay(99)},
+	} {
+		_, err := d.Set("", settings)
+		s.Assert().Equal(codes.InvalidArgument, status.Code(err))
+	}
+}
+
+func (s *PreferenceTestSuite) TestDeviceFromContext() {
+	s.Assert().Equal("", DeviceFromContext(context.Background()))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(DeviceMetadataKey, " tablet "))
+	s.Assert().Equal("tablet", DeviceFromContext(ctx))
+}
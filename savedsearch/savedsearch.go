@@ -0,0 +1,146 @@
+package savedsearch
+
+import (
+	"context"
+	"time"
+
+	"github.com/mangaweb4/mangaweb4-backend/browse"
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	ent_meta "github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/mangaweb4/mangaweb4-backend/ent/savedsearch"
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+	"github.com/mangaweb4/mangaweb4-backend/meta"
+)
+
+// Params are the listing parameters a saved search stores.
+type Params struct {
+	Name string
+	// Search is matched against the item names as a substring.
+	Search string
+	// Query is a search query in the syntax of meta.ParseSearch.
+	Query       string
+	Filter      grpc.Filter
+	Sort        grpc.SortField
+	Order       grpc.SortOrder
+	Series      string
+	IncludeTags []int
+	TagMatch    grpc.TagMatch
+	ExcludeTags []int
+	RandomSeed  int64
+}
+
+// QueryParams returns the parameters of the item listing of the saved search,
+// without paging.
+func QueryParams(s *ent.SavedSearch) meta.QueryParams {
+	return meta.QueryParams{
+		SearchName:  s.Search,
+		Query:       s.Query,
+		IncludeTags: s.IncludeTags,
+		TagMatch:    grpc.TagMatch(s.TagMatch),
+		ExcludeTags: s.ExcludeTags,
+		Series:      s.Series,
+		SortBy:      grpc.SortField(s.Sort),
+		SortOrder:   grpc.SortOrder(s.SortOrder),
+		RandomSeed:  s.RandomSeed,
+		Filter:      grpc.Filter(s.Filter),
+	}
+}
+
+// Create saves a search for the user. The query and sort field are checked
+// first, so that a saved search can always be run.
+func Create(ctx context.Context, client *ent.Client, u *ent.User, p Params) (s *ent.SavedSearch, err error) {
+	if err = validate(u, p); err != nil {
+		return
+	}
+
+	return client.SavedSearch.Create().
+		SetUser(u).
+		SetName(p.Name).
+		SetSearch(p.Search).
+		SetQuery(p.Query).
+		SetFilter(int(p.Filter)).
+		SetSort(int(p.Sort)).
+		SetSortOrder(int(p.Order)).
+		SetSeries(p.Series).
+		SetIncludeTags(p.IncludeTags).
+		SetTagMatch(int(p.TagMatch)).
+		SetExcludeTags(p.ExcludeTags).
+		SetRandomSeed(p.RandomSeed).
+		Save(ctx)
+}
+
+// Update replaces the parameters of a saved search of the user.
+func Update(ctx context.Context, client *ent.Client, u *ent.User, id int, p Params) (s *ent.SavedSearch, err error) {
+	if err = validate(u, p); err != nil {
+		return
+	}
+
+	if s, err = Read(ctx, client, u, id); err != nil {
+		return
+	}
+
+	return s.Update().
+		SetName(p.Name).
+		SetSearch(p.Search).
+		SetQuery(p.Query).
+		SetFilter(int(p.Filter)).
+		SetSort(int(p.Sort)).
+		SetSortOrder(int(p.Order)).
+		SetSeries(p.Series).
+		SetIncludeTags(p.IncludeTags).
+		SetTagMatch(int(p.TagMatch)).
+		SetExcludeTags(p.ExcludeTags).
+		SetRandomSeed(p.RandomSeed).
+		Save(ctx)
+}
+
+// Delete deletes a saved search of the user.
+func Delete(ctx context.Context, client *ent.Client, u *ent.User, id int) error {
+	s, err := Read(ctx, client, u, id)
+	if err != nil {
+		return err
+	}
+
+	return client.SavedSearch.DeleteOne(s).Exec(ctx)
+}
+
+// Read returns a saved search of the user. Searches of other users are not
+// found.
+func Read(ctx context.Context, client *ent.Client, u *ent.User, id int) (s *ent.SavedSearch, err error) {
+	return u.QuerySavedSearches().Where(savedsearch.ID(id)).Only(ctx)
+}
+
+// ReadAll returns the saved searches of the user by name.
+func ReadAll(ctx context.Context, client *ent.Client, u *ent.User) (searches []*ent.SavedSearch, err error) {
+	return u.QuerySavedSearches().Order(savedsearch.ByName(), savedsearch.ByID()).All(ctx)
+}
+
+// Open records that the user opened the saved search, and returns the number
+// of matching items added since it was last opened.
+func Open(ctx context.Context, client *ent.Client, u *ent.User, s *ent.SavedSearch, now time.Time) (count int, err error) {
+	p, err := meta.Predicate(ctx, client, u, QueryParams(s))
+	if err != nil {
+		return
+	}
+
+	count, err = client.Meta.Query().Where(p, ent_meta.CreateTimeGT(s.LastOpenTime)).Count(ctx)
+	if err != nil {
+		return
+	}
+
+	err = s.Update().SetLastOpenTime(now).Exec(ctx)
+	return
+}
+
+func validate(u *ent.User, p Params) (err error) {
+	if err = browse.CheckFilter(u, p.Filter); err != nil {
+		return
+	}
+
+	if _, err = meta.ParseSearch(p.Query); err != nil {
+		return
+	}
+
+	_, err = meta.SortKeys(u, meta.QueryParams{Query: p.Query, SortBy: p.Sort, SortOrder: p.Order})
+	return
+}
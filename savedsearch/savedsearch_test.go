@@ -0,0 +1,134 @@
+package savedsearch
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	dialect_sql "entgo.io/ent/dialect/sql"
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/enttest"
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+	"github.com/mangaweb4/mangaweb4-backend/meta"
+	"github.com/mangaweb4/mangaweb4-backend/user"
+	"github.com/stretchr/testify/suite"
+	_ "modernc.org/sqlite"
+)
+
+type SavedSearchTestSuite struct {
+	suite.Suite
+}
+
+func TestSavedSearchTestSuite(t *testing.T) {
+	suite.Run(t, new(SavedSearchTestSuite))
+}
+
+func createTestDBClient(s suite.TestingSuite) (db *sql.DB, client *ent.Client, err error) {
+	db, err = sql.Open("sqlite", "file:ent?mode=memory&_fk=1&_pragma=foreign_keys(1)")
+	if err != nil {
+		return
+	}
+
+	client = enttest.NewClient(s.T(), enttest.WithOptions(ent.Driver(dialect_sql.OpenDB("sqlite3", db))))
+
+	return
+}
+
+func (s *SavedSearchTestSuite) TestCreateAndRun() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	u, err := user.GetUser(ctx, client, "")
+	s.Assert().Nil(err)
+	other, err := user.GetUser(ctx, client, "other")
+	s.Assert().Nil(err)
+
+	ninja, err := client.Tag.Create().SetName("ninja").Save(ctx)
+	s.Assert().Nil(err)
+
+	now := time.Now()
+	_, err = client.Meta.Create().SetName("Naruto 2.zip").SetCreateTime(now.Add(-2 * time.Hour)).AddTags(ninja).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("Naruto 1.zip").SetCreateTime(now.Add(-2 * time.Hour)).AddTags(ninja).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("Bleach 1.zip").SetCreateTime(now.Add(-2 * time.Hour)).Save(ctx)
+	s.Assert().Nil(err)
+
+	saved, err := Create(ctx, client, u, Params{
+		Name:        "Ninjas",
+		IncludeTags: []int{ninja.ID},
+		Sort:        grpc.SortField_SORT_FIELD_NAME,
+		Order:       grpc.SortOrder_SORT_ORDER_ASCENDING,
+	})
+	s.Assert().Nil(err)
+
+	items, err := meta.ReadPage(ctx, client, u, QueryParams(saved))
+	s.Assert().Nil(err)
+	s.Assert().Len(items, 2)
+	s.Assert().Equal("Naruto 1.zip", items[0].Name)
+
+	// Items added before the search was saved are not new.
+	count, err := Open(ctx, client, u, saved, now.Add(-time.Hour))
+	s.Assert().Nil(err)
+	s.Assert().Equal(0, count)
+
+	_, err = client.Meta.Create().SetName("Naruto 3.zip").SetCreateTime(now.Add(-30 * time.Minute)).AddTags(ninja).Save(ctx)
+	s.Assert().Nil(err)
+
+	saved, err = Read(ctx, client, u, saved.ID)
+	s.Assert().Nil(err)
+	count, err = Open(ctx, client, u, saved, now)
+	s.Assert().Nil(err)
+	s.Assert().Equal(1, count)
+
+	saved, err = Read(ctx, client, u, saved.ID)
+	s.Assert().Nil(err)
+	count, err = Open(ctx, client, u, saved, now)
+	s.Assert().Nil(err)
+	s.Assert().Equal(0, count)
+
+	_, err = Read(ctx, client, other, saved.ID)
+	s.Assert().True(ent.IsNotFound(err))
+	s.Assert().True(ent.IsNotFound(Delete(ctx, client, other, saved.ID)))
+}
+
+func (s *SavedSearchTestSuite) TestUpdateAndDelete() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	u, err := user.GetUser(ctx, client, "")
+	s.Assert().Nil(err)
+
+	_, err = Create(ctx, client, u, Params{Name: "Broken", Query: `name:"unterminated`})
+	s.Assert().NotNil(err)
+	_, err = Create(ctx, client, u, Params{Name: "Unsorted", Sort: grpc.SortField_SORT_FIELD_ITEMCOUNT})
+	s.Assert().NotNil(err)
+
+	b, err := Create(ctx, client, u, Params{Name: "B", Query: "naruto"})
+	s.Assert().Nil(err)
+	a, err := Create(ctx, client, u, Params{Name: "A", Filter: grpc.Filter_FILTER_FAVORITE_ITEMS})
+	s.Assert().Nil(err)
+
+	all, err := ReadAll(ctx, client, u)
+	s.Assert().Nil(err)
+	s.Assert().Equal([]int{a.ID, b.ID}, []int{all[0].ID, all[1].ID})
+
+	b, err = Update(ctx, client, u, b.ID, Params{Name: "C", Search: "vol", Query: "bleach", Series: "Bleach"})
+	s.Assert().Nil(err)
+	s.Assert().Equal("C", b.Name)
+	s.Assert().Equal("bleach", b.Query)
+	s.Assert().Equal("vol", QueryParams(b).SearchName)
+	s.Assert().Equal("Bleach", QueryParams(b).Series)
+
+	s.Assert().Nil(Delete(ctx, client, u, a.ID))
+	all, err = ReadAll(ctx, client, u)
+	s.Assert().Nil(err)
+	s.Assert().Len(all, 1)
+}
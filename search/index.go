@@ -0,0 +1,384 @@
+package search
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/hook"
+	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	"github.com/mangaweb4/mangaweb4-backend/fold"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// ItemIndexTable holds the searchable text of every item, keyed by item ID.
+	ItemIndexTable = "meta_search"
+	// TagIndexTable holds the searchable text of every tag, keyed by tag ID.
+	TagIndexTable = "tag_search"
+
+	indexContentColumn = "content"
+)
+
+// Index maintains the search index tables. On SQLite they are FTS5 tables with
+// the trigram tokenizer, on Postgres they are plain tables with pg_trgm and
+// tsvector indexes, and on other databases plain tables scanned with LIKE.
+type Index struct {
+	Dialect string
+}
+
+// NewIndex creates an index for the given database dialect.
+func NewIndex(dialectName string) *Index {
+	return &Index{Dialect: dialectName}
+}
+
+// keyColumn returns the column that holds the item or tag ID.
+func keyColumn(dialectName string) string {
+	if dialectName == dialect.SQLite {
+		return "rowid"
+	}
+
+	return "id"
+}
+
+// Text normalizes a text for indexing and searching. The text is folded into
+// its search key, everything but letters and digits separates words, and the
+// result is padded with a space on both sides so that trigrams can match the
+// start and the end of a word.
+func Text(s string) string {
+	words := strings.FieldsFunc(fold.SearchKey(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	if len(words) == 0 {
+		return ""
+	}
+
+	return " " + strings.Join(words, " ") + " "
+}
+
+// ItemText returns the indexed text of an item: its name without the file
+// extension, its series and its artist.
+func ItemText(m *ent.Meta) string {
+	name := strings.TrimSuffix(m.Name, filepath.Ext(m.Name))
+	return Text(strings.Join([]string{name, m.Series, m.Artist}, " "))
+}
+
+// TagText returns the indexed text of a tag.
+func TagText(t *ent.Tag) string {
+	return Text(t.Name)
+}
+
+// Create creates the index tables when they do not exist.
+func (ix *Index) Create(ctx context.Context, client *ent.Client) error {
+	statements := make([]string, 0)
+	for _, table := range []string{ItemIndexTable, TagIndexTable} {
+		switch ix.Dialect {
+		case dialect.SQLite:
+			statements = append(statements,
+				"CREATE VIRTUAL TABLE IF NOT EXISTS "+table+" USING fts5(content, tokenize='trigram')",
+			)
+		case dialect.Postgres:
+			statements = append(statements,
+				"CREATE EXTENSION IF NOT EXISTS pg_trgm",
+				"CREATE TABLE IF NOT EXISTS "+table+" (id bigint PRIMARY KEY, content text NOT NULL)",
+				"CREATE INDEX IF NOT EXISTS "+table+"_trgm ON "+table+" USING gin (content gin_trgm_ops)",
+				"CREATE INDEX IF NOT EXISTS "+table+"_tsv ON "+table+" USING gin (to_tsvector('simple', content))",
+			)
+		default:
+			statements = append(statements,
+				"CREATE TABLE IF NOT EXISTS "+table+" (id bigint PRIMARY KEY, content text NOT NULL)",
+			)
+		}
+	}
+
+	for _, statement := range statements {
+		if _, err := client.ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// indexBatchSize is the number of rows written by each insert of Rebuild.
+const indexBatchSize = 500
+
+// Empty reports whether either index table has no rows, as it does right
+// after it is created.
+func (ix *Index) Empty(ctx context.Context, client *ent.Client) (bool, error) {
+	for _, table := range []string{ItemIndexTable, TagIndexTable} {
+		query, args := sql.Dialect(ix.Dialect).
+			Select(keyColumn(ix.Dialect)).
+			From(sql.Table(table)).
+			Limit(1).
+			Query()
+		rows, err := client.QueryContext(ctx, query, args...)
+		if err != nil {
+			return false, err
+		}
+
+		found := rows.Next()
+		if err := rows.Close(); err != nil {
+			return false, err
+		}
+		if !found {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Rebuild replaces the content of the index tables with the text of every item
+// and tag, in a single transaction.
+func (ix *Index) Rebuild(ctx context.Context, client *ent.Client) (err error) {
+	items, err := client.Meta.Query().Select(meta.FieldName, meta.FieldSeries, meta.FieldArtist).All(ctx)
+	if err != nil {
+		return
+	}
+
+	tags, err := client.Tag.Query().Select(tag.FieldName).All(ctx)
+	if err != nil {
+		return
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			log.Err(tx.Rollback()).Msg("rollback rebuilding search index")
+		}
+	}()
+
+	for _, table := range []string{ItemIndexTable, TagIndexTable} {
+		query, args := sql.Dialect(ix.Dialect).Delete(table).Query()
+		if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+			return
+		}
+	}
+
+	itemRows := make([][]any, len(items))
+	for i, m := range items {
+		itemRows[i] = []any{m.ID, ItemText(m)}
+	}
+	if err = ix.insert(ctx, tx.Client(), ItemIndexTable, itemRows); err != nil {
+		return
+	}
+
+	tagRows := make([][]any, len(tags))
+	for i, t := range tags {
+		tagRows[i] = []any{t.ID, TagText(t)}
+	}
+	if err = ix.insert(ctx, tx.Client(), TagIndexTable, tagRows); err != nil {
+		return
+	}
+
+	err = tx.Commit()
+	return
+}
+
+// insert writes rows of ID and content into an empty index table, in batches
+// of indexBatchSize.
+func (ix *Index) insert(ctx context.Context, client *ent.Client, table string, rows [][]any) error {
+	for batch := range slices.Chunk(rows, indexBatchSize) {
+		insert := sql.Dialect(ix.Dialect).
+			Insert(table).
+			Columns(keyColumn(ix.Dialect), indexContentColumn)
+		for _, row := range batch {
+			insert = insert.Values(row...)
+		}
+
+		query, args := insert.Query()
+		if _, err := client.ExecContext(ctx, query, args...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ix *Index) remove(ctx context.Context, client *ent.Client, table string, ids ...int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	values := make([]any, len(ids))
+	for i, id := range ids {
+		values[i] = id
+	}
+
+	query, args := sql.Dialect(ix.Dialect).
+		Delete(table).
+		Where(sql.In(keyColumn(ix.Dialect), values...)).
+		Query()
+	_, err := client.ExecContext(ctx, query, args...)
+
+	return err
+}
+
+func (ix *Index) write(ctx context.Context, client *ent.Client, table string, id int, content string) error {
+	if err := ix.remove(ctx, client, table, id); err != nil {
+		return err
+	}
+
+	query, args := sql.Dialect(ix.Dialect).
+		Insert(table).
+		Columns(keyColumn(ix.Dialect), indexContentColumn).
+		Values(id, content).
+		Query()
+	_, err := client.ExecContext(ctx, query, args...)
+
+	return err
+}
+
+// ReindexItems updates the indexed text of the given items.
+func (ix *Index) ReindexItems(ctx context.Context, client *ent.Client, ids ...int) error {
+	items, err := client.Meta.Query().
+		Where(meta.IDIn(ids...)).
+		Select(meta.FieldName, meta.FieldSeries, meta.FieldArtist).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range items {
+		if err := ix.write(ctx, client, ItemIndexTable, m.ID, ItemText(m)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReindexTags updates the indexed text of the given tags.
+func (ix *Index) ReindexTags(ctx context.Context, client *ent.Client, ids ...int) error {
+	tags, err := client.Tag.Query().Where(tag.IDIn(ids...)).Select(tag.FieldName).All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range tags {
+		if err := ix.write(ctx, client, TagIndexTable, t.ID, TagText(t)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Use registers the hooks that keep the index up to date with the items and
+// tags written through the client.
+func (ix *Index) Use(client *ent.Client) {
+	client.Meta.Use(ix.metaHook)
+	client.Tag.Use(ix.tagHook)
+}
+
+func (ix *Index) metaHook(next ent.Mutator) ent.Mutator {
+	return hook.MetaFunc(func(ctx context.Context, m *ent.MetaMutation) (ent.Value, error) {
+		if !m.Op().Is(ent.OpCreate|ent.OpDelete|ent.OpDeleteOne) && !changed(m, meta.FieldName, meta.FieldSeries, meta.FieldArtist) {
+			return next.Mutate(ctx, m)
+		}
+
+		ids, err := mutationIDs(ctx, m.Op(), m.IDs)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return v, err
+		}
+
+		switch {
+		case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+			err = ix.remove(ctx, m.Client(), ItemIndexTable, ids...)
+
+		case m.Op().Is(ent.OpCreate):
+			// Upserts do not report the ID of an updated row on every
+			// dialect, so the row is found by its unique name.
+			name, _ := m.Name()
+			id, e := m.Client().Meta.Query().Where(meta.Name(name)).OnlyID(ctx)
+			if e != nil {
+				return v, e
+			}
+
+			err = ix.ReindexItems(ctx, m.Client(), id)
+
+		default:
+			err = ix.ReindexItems(ctx, m.Client(), ids...)
+		}
+
+		return v, err
+	})
+}
+
+func (ix *Index) tagHook(next ent.Mutator) ent.Mutator {
+	return hook.TagFunc(func(ctx context.Context, m *ent.TagMutation) (ent.Value, error) {
+		if !m.Op().Is(ent.OpCreate|ent.OpDelete|ent.OpDeleteOne) && !changed(m, tag.FieldName) {
+			return next.Mutate(ctx, m)
+		}
+
+		ids, err := mutationIDs(ctx, m.Op(), m.IDs)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return v, err
+		}
+
+		switch {
+		case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+			err = ix.remove(ctx, m.Client(), TagIndexTable, ids...)
+
+		case m.Op().Is(ent.OpCreate):
+			// Upserts do not report the ID of an updated row on every
+			// dialect, so the row is found by its unique name.
+			name, _ := m.Name()
+			id, e := m.Client().Tag.Query().Where(tag.Name(name)).OnlyID(ctx)
+			if e != nil {
+				return v, e
+			}
+
+			err = ix.ReindexTags(ctx, m.Client(), id)
+
+		default:
+			err = ix.ReindexTags(ctx, m.Client(), ids...)
+		}
+
+		return v, err
+	})
+}
+
+// mutationIDs returns the IDs an update or delete mutation applies to, before
+// it is executed.
+func mutationIDs(ctx context.Context, op ent.Op, ids func(context.Context) ([]int, error)) ([]int, error) {
+	if op.Is(ent.OpCreate) {
+		return nil, nil
+	}
+
+	return ids(ctx)
+}
+
+func changed(m ent.Mutation, fields ...string) bool {
+	for _, f := range fields {
+		if _, ok := m.Field(f); ok {
+			return true
+		}
+
+		if m.FieldCleared(f) {
+			return true
+		}
+	}
+
+	return false
+}
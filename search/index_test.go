@@ -0,0 +1,103 @@
+package search
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/stretchr/testify/suite"
+)
+
+type IndexTestSuite struct {
+	suite.Suite
+}
+
+func TestIndexTestSuite(t *testing.T) {
+	suite.Run(t, new(IndexTestSuite))
+}
+
+func (s *IndexTestSuite) TestText() {
+	s.Assert().Equal(" artist naruto vol 1 ", Text("[Artist] NARUTO - Vol.1"))
+	s.Assert().Equal(" abc 12 ", Text("ＡＢＣ　１２"))
+	s.Assert().Equal("", Text(" - "))
+}
+
+func (s *IndexTestSuite) TestTrigrams() {
+	s.Assert().Equal([]string{" ab", "abc", "bc ", " a "}, Trigrams("abc a"))
+}
+
+func (s *IndexTestSuite) names(ctx context.Context, client *ent.Client, text string) []string {
+	items, err := client.Meta.Query().
+		Where(Match(text)).
+		Order(ByRelevance(text), meta.ByName()).
+		All(ctx)
+	s.Assert().Nil(err)
+
+	names := make([]string, len(items))
+	for i, m := range items {
+		names[i] = m.Name
+	}
+
+	return names
+}
+
+func (s *IndexTestSuite) TestMatch() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	index := NewIndex(dialect.SQLite)
+	s.Assert().Nil(index.Create(ctx, client))
+	empty, err := index.Empty(ctx, client)
+	s.Assert().Nil(err)
+	s.Assert().True(empty)
+	index.Use(client)
+
+	ninja, err := client.Tag.Create().SetName("ninja").Save(ctx)
+	s.Assert().Nil(err)
+
+	_, err = client.Meta.Create().SetName("[Kishimoto] Naruto vol 1.zip").Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("Boruto.zip").AddTags(ninja).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("One Piece.zip").Save(ctx)
+	s.Assert().Nil(err)
+
+	s.Assert().Equal([]string{"[Kishimoto] Naruto vol 1.zip", "Boruto.zip"}, s.names(ctx, client, "naruto"))
+	s.Assert().Equal([]string{"[Kishimoto] Naruto vol 1.zip"}, s.names(ctx, client, "naruto kishimoto"))
+	s.Assert().Equal([]string{"[Kishimoto] Naruto vol 1.zip"}, s.names(ctx, client, "kishimoto naruto"))
+	s.Assert().Equal([]string{"[Kishimoto] Naruto vol 1.zip"}, s.names(ctx, client, "narutto"))
+	s.Assert().Equal([]string{"Boruto.zip"}, s.names(ctx, client, "ninja"))
+	s.Assert().Empty(s.names(ctx, client, ""))
+
+	count, err := client.Meta.Query().Where(Match("naruto")).Count(ctx)
+	s.Assert().Nil(err)
+	s.Assert().Equal(2, count)
+	count, err = client.Meta.Query().Where(Match("naruto")).Order(ByRelevance("naruto")).Count(ctx)
+	s.Assert().Nil(err)
+	s.Assert().Equal(2, count)
+
+	s.Assert().Nil(ninja.Update().SetName("shinobi").Exec(ctx))
+	s.Assert().Empty(s.names(ctx, client, "ninja"))
+	s.Assert().Equal([]string{"Boruto.zip"}, s.names(ctx, client, "shinobi"))
+
+	_, err = client.Meta.Update().Where(meta.Name("One Piece.zip")).SetSeries("Luffy").Save(ctx)
+	s.Assert().Nil(err)
+	s.Assert().Equal([]string{"One Piece.zip"}, s.names(ctx, client, "luffy"))
+
+	_, err = client.Meta.Delete().Where(meta.Name("One Piece.zip")).Exec(ctx)
+	s.Assert().Nil(err)
+	s.Assert().Empty(s.names(ctx, client, "luffy"))
+
+	s.Assert().Nil(index.Rebuild(ctx, client))
+	s.Assert().Equal([]string{"Boruto.zip"}, s.names(ctx, client, "shinobi"))
+	s.Assert().Equal([]string{"[Kishimoto] Naruto vol 1.zip", "Boruto.zip"}, s.names(ctx, client, "naruto"))
+
+	empty, err = index.Empty(ctx, client)
+	s.Assert().Nil(err)
+	s.Assert().False(empty)
+}
@@ -0,0 +1,237 @@
+package search
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mangaweb4/mangaweb4-backend/browse"
+	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/mangaweb4/mangaweb4-backend/ent/metatag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/predicate"
+)
+
+const (
+	// SimilarityThreshold is the share of the trigrams of the search text an
+	// item and its tags must contain to match.
+	SimilarityThreshold = 0.3
+
+	// MaxTrigrams bounds the number of trigrams a search text is split into.
+	MaxTrigrams = 64
+
+	scoreColumn    = "score"
+	relevanceTable = "relevance"
+)
+
+// Trigrams splits a search text into the distinct trigrams of its words. Each
+// word is padded with a space on both sides, like the indexed text.
+func Trigrams(text string) []string {
+	out := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, word := range strings.Fields(Text(text)) {
+		runes := []rune(" " + word + " ")
+		for i := 0; i+3 <= len(runes); i++ {
+			gram := string(runes[i : i+3])
+			if seen[gram] {
+				continue
+			}
+
+			seen[gram] = true
+			out = append(out, gram)
+			if len(out) == MaxTrigrams {
+				return out
+			}
+		}
+	}
+
+	return out
+}
+
+// Match matches the items whose text or tags are similar to the search text.
+// Word order does not matter, and a few mistyped characters are tolerated.
+// When the query is ordered by the relevance of the text, the scores already
+// joined for the order are reused rather than computed again.
+func Match(text string) predicate.Meta {
+	return predicate.Meta(func(s *sql.Selector) {
+		if scores, ok := s.JoinedTableView(relevanceTable); ok {
+			s.Where(sql.NotNull(scores.(*sql.Selector).C("meta_id")))
+			return
+		}
+
+		scores := scoreQuery(s.Dialect(), text)
+		s.Where(sql.In(s.C(meta.FieldID), sql.Dialect(s.Dialect()).Select("meta_id").From(scores)))
+	})
+}
+
+// joinScores left joins the scores of the search text to the items, once.
+func joinScores(s *sql.Selector, text string) {
+	if _, ok := s.JoinedTableView(relevanceTable); ok {
+		return
+	}
+
+	scores := scoreQuery(s.Dialect(), text)
+	s.LeftJoin(scores).On(s.C(meta.FieldID), scores.C("meta_id"))
+}
+
+// ByRelevance orders the items by their similarity to the search text, best
+// match first, and items that do not match last.
+func ByRelevance(text string) meta.OrderOption {
+	return func(s *sql.Selector) {
+		joinScores(s, text)
+		s.OrderExpr(sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("COALESCE(").WriteString(sql.Table(relevanceTable).C(scoreColumn)).WriteString(", 0) DESC")
+		}))
+	}
+}
+
+// RelevanceKey is the sort key of ByRelevance, for keyset pagination.
+func RelevanceKey(text string) browse.SortKey {
+	return browse.SortKey{
+		Expr: func(s *sql.Selector) sql.Querier {
+			return sql.ExprFunc(func(b *sql.Builder) {
+				b.WriteString("COALESCE(").WriteString(sql.Table(relevanceTable).C(scoreColumn)).WriteString(", 0)")
+			})
+		},
+		Desc: true,
+		Prepare: func(s *sql.Selector) {
+			joinScores(s, text)
+		},
+	}
+}
+
+// scoreQuery returns a query of the items matching the search text, with a
+// score between 0 and 1 in the `score` column.
+func scoreQuery(dialectName string, text string) *sql.Selector {
+	if dialectName == dialect.Postgres {
+		return postgresScoreQuery(text)
+	}
+
+	return trigramScoreQuery(dialectName, text)
+}
+
+// postgresScoreQuery scores with pg_trgm word similarity and the full-text rank
+// of the text, whichever is higher.
+func postgresScoreQuery(text string) *sql.Selector {
+	key := Text(text)
+
+	score := func(table *sql.SelectTable) sql.Querier {
+		return sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("GREATEST(word_similarity(").Arg(key).WriteString(", ").WriteString(table.C(indexContentColumn)).
+				WriteString("), ts_rank(to_tsvector('simple', ").WriteString(table.C(indexContentColumn)).
+				WriteString("), plainto_tsquery('simple', ").Arg(key).WriteString(")))")
+		})
+	}
+	match := func(table *sql.SelectTable) *sql.Predicate {
+		return sql.P(func(b *sql.Builder) {
+			b.WriteString("(to_tsvector('simple', ").WriteString(table.C(indexContentColumn)).
+				WriteString(") @@ plainto_tsquery('simple', ").Arg(key).
+				WriteString(") OR word_similarity(").Arg(key).WriteString(", ").WriteString(table.C(indexContentColumn)).
+				WriteString(") >= ").Arg(SimilarityThreshold).WriteString(")")
+		})
+	}
+
+	items := sql.Table(ItemIndexTable).As("item_text")
+	byItem := sql.Dialect(dialect.Postgres).
+		Select(sql.As(items.C("id"), "meta_id")).
+		AppendSelectExprAs(score(items), scoreColumn).
+		From(items).
+		Where(match(items))
+
+	tags := sql.Table(TagIndexTable).As("tag_text")
+	metaTags := sql.Table(metatag.Table)
+	byTag := sql.Dialect(dialect.Postgres).Select().From(tags)
+	byTag.Join(metaTags).On(tags.C("id"), metaTags.C(metatag.TagColumn))
+	byTag.Select(sql.As(metaTags.C(metatag.MetaColumn), "meta_id")).
+		AppendSelectExprAs(score(tags), scoreColumn).
+		Where(match(tags))
+
+	matches := byItem.UnionAll(byTag).As("matches")
+
+	return sql.Dialect(dialect.Postgres).
+		Select(matches.C("meta_id")).
+		AppendSelectExprAs(sql.Expr(fmt.Sprintf("MAX(%s)", matches.C(scoreColumn))), scoreColumn).
+		From(matches).
+		GroupBy(matches.C("meta_id")).
+		As(relevanceTable)
+}
+
+// trigramScoreQuery scores by the share of the trigrams of the text found in
+// the item or its tags. The trigrams are joined to the index tables as one
+// set of values. SQLite finds them with its FTS5 index, other databases with
+// LIKE.
+func trigramScoreQuery(dialectName string, text string) *sql.Selector {
+	grams := Trigrams(text)
+	key := keyColumn(dialectName)
+
+	if len(grams) == 0 {
+		// An empty text has no trigrams and matches nothing.
+		return sql.Dialect(dialectName).
+			Select(sql.As(sql.Table(ItemIndexTable).C(key), "meta_id")).
+			AppendSelectExprAs(sql.Expr("0"), scoreColumn).
+			From(sql.Table(ItemIndexTable)).
+			Where(sql.False()).
+			As(relevanceTable)
+	}
+
+	patterns := make([]any, len(grams))
+	for i, gram := range grams {
+		if dialectName == dialect.SQLite {
+			patterns[i] = `"` + gram + `"`
+		} else {
+			patterns[i] = "%" + gram + "%"
+		}
+	}
+
+	set, gram := gramSet(dialectName, patterns)
+	match := func(table *sql.SelectTable) *sql.Predicate {
+		op := " LIKE "
+		if dialectName == dialect.SQLite {
+			op = " MATCH "
+		}
+
+		return sql.ExprP(table.C(indexContentColumn) + op + gram)
+	}
+
+	items := sql.Table(ItemIndexTable).As("item_text")
+	byItem := sql.Dialect(dialectName).
+		Select(sql.As(items.C(key), "meta_id"), sql.As(gram, "gram")).
+		FromExpr(set)
+	byItem.Join(items).OnP(match(items))
+
+	tags := sql.Table(TagIndexTable).As("tag_text")
+	metaTags := sql.Table(metatag.Table).As("item_tags")
+	byTag := sql.Dialect(dialectName).
+		Select(sql.As(metaTags.C(metatag.MetaColumn), "meta_id"), sql.As(gram, "gram")).
+		FromExpr(set)
+	byTag.Join(tags).OnP(match(tags))
+	byTag.Join(metaTags).On(tags.C(key), metaTags.C(metatag.TagColumn))
+
+	matches := byItem.UnionAll(byTag).As("matches")
+	count := fmt.Sprintf("COUNT(DISTINCT %s)", matches.C("gram"))
+	minimum := int(math.Ceil(SimilarityThreshold * float64(len(grams))))
+
+	return sql.Dialect(dialectName).
+		Select(matches.C("meta_id")).
+		AppendSelectExprAs(sql.Expr(fmt.Sprintf("%s * 1.0 / %d", count, len(grams))), scoreColumn).
+		From(matches).
+		GroupBy(matches.C("meta_id")).
+		Having(sql.ExprP(fmt.Sprintf("%s >= %d", count, minimum))).
+		As(relevanceTable)
+}
+
+// gramSet returns a table of the given values and its column. SQLite names
+// the column of a VALUES table column1, MySQL names it column_0.
+func gramSet(dialectName string, values []any) (set sql.Querier, column string) {
+	row, name := "(?)", "column1"
+	if dialectName == dialect.MySQL {
+		row, name = "ROW(?)", "column_0"
+	}
+
+	grams := sql.Dialect(dialectName).Table("grams")
+	rows := strings.TrimSuffix(strings.Repeat(row+", ", len(values)), ", ")
+	set = sql.Expr("(VALUES "+rows+") AS grams", values...)
+	column = grams.C(name)
+	return
+}
@@ -0,0 +1,152 @@
+// Package search implements the full-text search index of the items and the
+// type-ahead suggestions of the search box.
+package search
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/mangaweb4/mangaweb4-backend/browse"
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/mangaweb4/mangaweb4-backend/ent/metatag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/user"
+	"github.com/mangaweb4/mangaweb4-backend/fold"
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+)
+
+const (
+	DefaultSuggestLimit = 10
+	MaxSuggestLimit     = 50
+)
+
+// TagSuggestion is a tag whose name matches the search text, with the number
+// of items the user can see under it.
+type TagSuggestion struct {
+	ID         int          `sql:"id"`
+	Name       string       `sql:"name"`
+	Category   tag.Category `sql:"category"`
+	ItemCount  int          `sql:"item_count"`
+	IsFavorite bool         `sql:"is_favorite"`
+}
+
+// ItemSuggestion is an item whose name matches the search text.
+type ItemSuggestion struct {
+	ID         int    `sql:"id"`
+	Name       string `sql:"name"`
+	IsFavorite bool   `sql:"is_favorite"`
+}
+
+// prefixRank returns an expression that is 0 when the column starts with the
+// key and 1 otherwise. The key is compared as a value instead of a LIKE
+// pattern, so it needs no escaping.
+func prefixRank(column string, key string) sql.Querier {
+	return sql.ExprP(
+		fmt.Sprintf("CASE WHEN SUBSTR(%s, 1, %d) = ? THEN 0 ELSE 1 END", column, utf8.RuneCountInString(key)),
+		key,
+	)
+}
+
+// favoriteRank returns an expression that is 1 when the user has the row of the
+// selector in the favorite edge table, and 0 otherwise.
+func favoriteRank(s *sql.Selector, u *ent.User, table string, primaryKey []string) sql.Querier {
+	favorites := sql.Table(table)
+	exists := sql.Exists(
+		sql.Select(favorites.C(primaryKey[1])).
+			From(favorites).
+			Where(sql.And(
+				sql.ColumnsEQ(favorites.C(primaryKey[1]), s.C("id")),
+				sql.EQ(favorites.C(primaryKey[0]), u.ID),
+			)),
+	)
+
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.WriteString("CASE WHEN ").Join(exists).WriteString(" THEN 1 ELSE 0 END")
+	})
+}
+
+func clampLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultSuggestLimit
+	}
+
+	return min(limit, MaxSuggestLimit)
+}
+
+// SuggestTags returns the tags whose search key contains the search key of the
+// text, so that tags fold the same way as items.
+// Tags whose name starts with the text come first, followed by the user's
+// favorite tags, and then by the number of items. Only items and tags the user
+// can see are counted.
+func SuggestTags(ctx context.Context, client *ent.Client, u *ent.User, text string, limit int) (out []TagSuggestion, err error) {
+	key := fold.SearchKey(text)
+	out = make([]TagSuggestion, 0)
+	if key == "" {
+		return
+	}
+
+	visible := sql.Select(meta.FieldID).From(sql.Table(meta.Table))
+	browse.Items(u, grpc.Filter_FILTER_UNKNOWN)(visible)
+
+	err = client.Tag.Query().
+		Where(
+			browse.Tags(u, grpc.Filter_FILTER_UNKNOWN),
+			tag.SearchKeyContains(key),
+		).
+		Limit(clampLimit(limit)).
+		Modify(func(s *sql.Selector) {
+			items := sql.Table(metatag.Table)
+			prefix := prefixRank(s.C(tag.FieldSearchKey), key)
+			favorite := favoriteRank(s, u, user.FavoriteTagsTable, user.FavoriteTagsPrimaryKey)
+
+			s.Join(items).
+				On(s.C(tag.FieldID), items.C(metatag.TagColumn)).
+				Where(sql.In(items.C(metatag.MetaColumn), visible)).
+				GroupBy(s.C(tag.FieldID), s.C(tag.FieldName), s.C(tag.FieldCategory), s.C(tag.FieldSearchKey)).
+				Select(
+					s.C(tag.FieldID),
+					s.C(tag.FieldName),
+					s.C(tag.FieldCategory),
+					sql.As(sql.Count("*"), "item_count"),
+				).
+				AppendSelectExprAs(favorite, "is_favorite").
+				OrderExpr(prefix).
+				OrderBy(sql.Desc("is_favorite"), sql.Desc("item_count"), s.C(tag.FieldName))
+		}).
+		Scan(ctx, &out)
+
+	return
+}
+
+// SuggestItems returns the items whose search key contains the search key of
+// the text. Items whose key starts with the text come first, followed by the
+// user's favorite items, in name order.
+func SuggestItems(ctx context.Context, client *ent.Client, u *ent.User, text string, limit int) (out []ItemSuggestion, err error) {
+	key := fold.SearchKey(text)
+	out = make([]ItemSuggestion, 0)
+	if key == "" {
+		return
+	}
+
+	err = client.Meta.Query().
+		Where(
+			browse.Items(u, grpc.Filter_FILTER_UNKNOWN),
+			meta.SearchKeyContains(key),
+		).
+		Limit(clampLimit(limit)).
+		Modify(func(s *sql.Selector) {
+			prefix := prefixRank(s.C(meta.FieldSearchKey), key)
+			favorite := favoriteRank(s, u, user.FavoriteItemsTable, user.FavoriteItemsPrimaryKey)
+
+			s.Select(s.C(meta.FieldID), s.C(meta.FieldName)).
+				AppendSelectExprAs(favorite, "is_favorite").
+				OrderExpr(prefix).
+				OrderBy(sql.Desc("is_favorite"), s.C(meta.FieldName))
+		}).
+		Scan(ctx, &out)
+
+	return
+}
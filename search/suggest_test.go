@@ -0,0 +1,145 @@
+package search
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	dialect_sql "entgo.io/ent/dialect/sql"
+	"github.com/mangaweb4/mangaweb4-backend/configuration"
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/enttest"
+	tag_util "github.com/mangaweb4/mangaweb4-backend/tag"
+	"github.com/mangaweb4/mangaweb4-backend/user"
+	"github.com/stretchr/testify/suite"
+	_ "modernc.org/sqlite"
+)
+
+type SuggestTestSuite struct {
+	suite.Suite
+}
+
+func TestSuggestTestSuite(t *testing.T) {
+	suite.Run(t, new(SuggestTestSuite))
+}
+
+func (s *SuggestTestSuite) SetupTest() {
+	configuration.Init(configuration.Config{
+		TagNormalization: configuration.TagNormalization{
+			TrimSpace: true,
+			CaseFold:  true,
+			NFKC:      true,
+		},
+	})
+}
+
+func createTestDBClient(s suite.TestingSuite) (db *sql.DB, client *ent.Client, err error) {
+	db, err = sql.Open("sqlite", "file:ent?mode=memory&_fk=1&_pragma=foreign_keys(1)")
+	if err != nil {
+		return
+	}
+
+	client = enttest.NewClient(s.T(), enttest.WithOptions(ent.Driver(dialect_sql.OpenDB("sqlite3", db))))
+
+	return
+}
+
+func (s *SuggestTestSuite) TestSuggestTags() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	u, err := user.GetUser(ctx, client, "")
+	s.Assert().Nil(err)
+
+	popular, err := tag_util.Create(ctx, client, tag_util.Parsed{Name: "Super Artist"})
+	s.Assert().Nil(err)
+	prefix, err := tag_util.Create(ctx, client, tag_util.Parsed{Name: "Artist B"})
+	s.Assert().Nil(err)
+	favorite, err := tag_util.Create(ctx, client, tag_util.Parsed{Name: "Another Artist"})
+	s.Assert().Nil(err)
+	unused, err := tag_util.Create(ctx, client, tag_util.Parsed{Name: "Artist C"})
+	s.Assert().Nil(err)
+
+	_, err = u.Update().AddFavoriteTags(favorite).Save(ctx)
+	s.Assert().Nil(err)
+
+	_, err = client.Meta.Create().SetName("manga 1.zip").AddTags(popular, prefix, favorite).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("manga 2.zip").AddTags(popular).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("manga 3.zip").AddTags(popular, unused).SetActive(false).Save(ctx)
+	s.Assert().Nil(err)
+
+	tags, err := SuggestTags(ctx, client, u, "ARTIST", 10)
+	s.Assert().Nil(err)
+
+	s.Assert().Equal(3, len(tags))
+	s.Assert().Equal(prefix.ID, tags[0].ID)
+	s.Assert().Equal(1, tags[0].ItemCount)
+	s.Assert().Equal(favorite.ID, tags[1].ID)
+	s.Assert().True(tags[1].IsFavorite)
+	s.Assert().Equal(popular.ID, tags[2].ID)
+	s.Assert().Equal(2, tags[2].ItemCount)
+	s.Assert().False(tags[2].IsFavorite)
+
+	tags, err = SuggestTags(ctx, client, u, "artist", 1)
+	s.Assert().Nil(err)
+	s.Assert().Equal(1, len(tags))
+}
+
+func (s *SuggestTestSuite) TestSuggestTagsFoldWithoutNormalization() {
+	configuration.Init(configuration.Config{})
+
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	u, err := user.GetUser(ctx, client, "")
+	s.Assert().Nil(err)
+
+	artist, err := tag_util.Create(ctx, client, tag_util.Parsed{Name: "Artist"})
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("manga 1.zip").AddTags(artist).Save(ctx)
+	s.Assert().Nil(err)
+
+	// Tags fold by their search key, like items, whatever the normalization.
+	for _, text := range []string{"artist", "ＡＲＴＩＳＴ"} {
+		tags, err := SuggestTags(ctx, client, u, text, 10)
+		s.Assert().Nil(err)
+		s.Assert().Equal(1, len(tags), text)
+	}
+}
+
+func (s *SuggestTestSuite) TestSuggestItems() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	u, err := user.GetUser(ctx, client, "")
+	s.Assert().Nil(err)
+
+	_, err = client.Meta.Create().SetName("[artist]Some Manga.zip").Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("[artist]Other Manga.zip").AddFavoriteOfUser(u).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("manga first.zip").Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("manga hidden.zip").SetHidden(true).Save(ctx)
+	s.Assert().Nil(err)
+
+	items, err := SuggestItems(ctx, client, u, "Manga", 10)
+	s.Assert().Nil(err)
+
+	s.Assert().Equal(3, len(items))
+	s.Assert().Equal("manga first.zip", items[0].Name)
+	s.Assert().Equal("[artist]Other Manga.zip", items[1].Name)
+	s.Assert().True(items[1].IsFavorite)
+	s.Assert().Equal("[artist]Some Manga.zip", items[2].Name)
+}
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/mangaweb4/mangaweb4-backend/auth"
+	"github.com/mangaweb4/mangaweb4-backend/database"
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+	"github.com/mangaweb4/mangaweb4-backend/user"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rs/zerolog/log"
+)
+
+type ApiKeyServer struct {
+	grpc.UnimplementedApiKeyServer
+}
+
+func (s *ApiKeyServer) Create(
+	ctx context.Context,
+	req *grpc.ApiKeyCreateRequest,
+) (resp *grpc.ApiKeyCreateResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("ApiKeyServer.Create") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on ApiKeyServer.Create") }()
+
+	u, err := user.Current(ctx, client)
+	if err != nil {
+		return
+	}
+
+	scopes, err := auth.ScopesFromGrpc(req.Scopes)
+	if err != nil {
+		return
+	}
+
+	var expireTime *time.Time
+	if req.ExpireTime != nil {
+		t := req.ExpireTime.AsTime()
+		expireTime = &t
+	}
+
+	key, k, err := auth.CreateAPIKey(ctx, client, u, req.Name, scopes, expireTime, time.Now())
+	if err != nil {
+		return
+	}
+
+	resp = &grpc.ApiKeyCreateResponse{
+		Key:  key,
+		Item: apiKeyItem(k),
+	}
+
+	return
+}
+
+func (s *ApiKeyServer) List(
+	ctx context.Context,
+	req *grpc.ApiKeyListRequest,
+) (resp *grpc.ApiKeyListResponse, err error) {
+	defer func() { log.Err(err).Msg("ApiKeyServer.List") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on ApiKeyServer.List") }()
+
+	u, err := user.Current(ctx, client)
+	if err != nil {
+		return
+	}
+
+	keys, err := auth.ReadAPIKeys(ctx, client, u)
+	if err != nil {
+		return
+	}
+
+	resp = &grpc.ApiKeyListResponse{
+		Items: make([]*grpc.ApiKeyItem, len(keys)),
+	}
+
+	for i, k := range keys {
+		resp.Items[i] = apiKeyItem(k)
+	}
+
+	return
+}
+
+func (s *ApiKeyServer) Revoke(
+	ctx context.Context,
+	req *grpc.ApiKeyRevokeRequest,
+) (resp *grpc.ApiKeyRevokeResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("ApiKeyServer.Revoke") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on ApiKeyServer.Revoke") }()
+
+	u, err := user.Current(ctx, client)
+	if err != nil {
+		return
+	}
+
+	if err = auth.RevokeAPIKey(ctx, client, u, int(req.Id)); err != nil {
+		return
+	}
+
+	resp = &grpc.ApiKeyRevokeResponse{
+		Id:      req.Id,
+		Success: true,
+	}
+
+	return
+}
+
+func apiKeyItem(k *ent.APIKey) *grpc.ApiKeyItem {
+	item := &grpc.ApiKeyItem{
+		Id:         int32(k.ID),
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     auth.ScopesToGrpc(k.Scopes),
+		CreateTime: timestamppb.New(k.CreateTime),
+	}
+
+	if k.ExpireTime != nil {
+		item.ExpireTime = timestamppb.New(*k.ExpireTime)
+	}
+	if k.LastUsedTime != nil {
+		item.LastUsedTime = timestamppb.New(*k.LastUsedTime)
+	}
+
+	return item
+}
@@ -0,0 +1,97 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/mangaweb4/mangaweb4-backend/auth"
+	"github.com/mangaweb4/mangaweb4-backend/database"
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+	"github.com/mangaweb4/mangaweb4-backend/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rs/zerolog/log"
+)
+
+type AuthServer struct {
+	grpc.UnimplementedAuthServer
+}
+
+func (s *AuthServer) Login(
+	ctx context.Context,
+	req *grpc.AuthLoginRequest,
+) (resp *grpc.AuthLoginResponse, err error) {
+	defer func() { log.Err(err).Str("email", req.Email).Msg("AuthServer.Login") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on AuthServer.Login") }()
+
+	token, session, err := auth.Login(ctx, client, req.Email, req.Password, time.Now())
+	if err != nil {
+		return
+	}
+
+	resp = &grpc.AuthLoginResponse{
+		Token:      token,
+		ExpireTime: timestamppb.New(session.ExpireTime),
+		UserId:     int32(session.Edges.User.ID),
+	}
+
+	return
+}
+
+func (s *AuthServer) Logout(
+	ctx context.Context,
+	req *grpc.AuthLogoutRequest,
+) (resp *grpc.AuthLogoutResponse, err error) {
+	defer func() { log.Err(err).Msg("AuthServer.Logout") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on AuthServer.Logout") }()
+
+	if err = auth.Logout(ctx, client, auth.TokenFromContext(ctx)); err != nil {
+		return
+	}
+
+	resp = &grpc.AuthLogoutResponse{
+		Success: true,
+	}
+
+	return
+}
+
+func (s *AuthServer) ChangePassword(
+	ctx context.Context,
+	req *grpc.AuthChangePasswordRequest,
+) (resp *grpc.AuthChangePasswordResponse, err error) {
+	defer func() { log.Err(err).Msg("AuthServer.ChangePassword") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on AuthServer.ChangePassword") }()
+
+	// Without a token, the call acts as the user it names or the default
+	// user, which must not be able to set their password.
+	token := auth.TokenFromContext(ctx)
+	if token == "" {
+		err = status.Error(codes.Unauthenticated, "login required")
+		return
+	}
+
+	u, err := user.Current(ctx, client)
+	if err != nil {
+		return
+	}
+
+	err = auth.ChangePassword(ctx, client, u, req.CurrentPassword, req.NewPassword, token)
+	if err != nil {
+		return
+	}
+
+	resp = &grpc.AuthChangePasswordResponse{
+		Success: true,
+	}
+
+	return
+}
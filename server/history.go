@@ -3,7 +3,7 @@ package server
 import (
 	"context"
 
-	"entgo.io/ent/dialect/sql"
+	"github.com/mangaweb4/mangaweb4-backend/browse"
 	"github.com/mangaweb4/mangaweb4-backend/database"
 	"github.com/mangaweb4/mangaweb4-backend/ent/history"
 	ent_meta "github.com/mangaweb4/mangaweb4-backend/ent/meta"
@@ -28,22 +28,50 @@ func (s *HistoryServer) List(
 	client := database.CreateEntClient()
 	defer func() { log.Err(client.Close()).Msg("database client close on History.List") }()
 
-	u, err := user.GetUser(ctx, client, req.User)
+	u, err := user.Current(ctx, client)
 	if err != nil {
 
 		return
 	}
 
-	histories, err := client.User.QueryHistories(u).
-		Order(history.ByCreateTime(sql.OrderDesc())).
-		Limit(int(req.ItemPerPage)).
-		Offset(int(req.ItemPerPage * req.Page)).All(ctx)
+	req.ItemPerPage = itemsPerPage(ctx, u, req.ItemPerPage)
 
+	// Histories are listed from the most recent, the cursor token records it
+	// as a descending creation time order.
+	keys := []browse.SortKey{
+		browse.TimeKey(history.FieldCreateTime, true),
+		browse.ColumnKey(history.FieldID, true),
+	}
+	sortField, sortOrder := grpc.SortField_SORT_FIELD_CREATION_TIME, grpc.SortOrder_SORT_ORDER_DESCENDING
+
+	query := client.User.QueryHistories(u).
+		Where(history.HasItemWith(browse.Items(u, grpc.Filter_FILTER_UNKNOWN))).
+		Order(browse.Order(keys...)).
+		Limit(int(req.ItemPerPage))
+
+	if req.Cursor != "" {
+		values, e := browse.DecodeCursor(req.Cursor, sortField, sortOrder, len(keys))
+		if e != nil {
+			err = e
+			return
+		}
+
+		query = query.Where(browse.After(values, keys...))
+	} else {
+		query = query.Offset(int(req.ItemPerPage * req.Page))
+	}
+
+	histories, err := query.All(ctx)
 	if err != nil {
 
 		return
 	}
 
+	nextCursor, err := browse.NextCursor(histories, int(req.ItemPerPage), len(keys), sortField, sortOrder)
+	if err != nil {
+		return
+	}
+
 	items := make([]*grpc.HistoryListResponseItem, len(histories))
 
 	for i, h := range histories {
@@ -75,7 +103,9 @@ func (s *HistoryServer) List(
 		}
 	}
 
-	count, err := client.History.Query().Count(ctx)
+	count, err := client.User.QueryHistories(u).
+		Where(history.HasItemWith(browse.Items(u, grpc.Filter_FILTER_UNKNOWN))).
+		Count(ctx)
 
 	if err != nil {
 
@@ -96,8 +126,9 @@ func (s *HistoryServer) List(
 		Msg("Browse")
 
 	resp = &grpc.HistoryListResponse{
-		Items:     items,
-		TotalPage: pageCount,
+		Items:      items,
+		TotalPage:  pageCount,
+		NextCursor: nextCursor,
 	}
 
 	return
@@ -60,3 +60,19 @@ func (s *MaintenanceServer) PopulateTags(
 	err = nil
 	return
 }
+
+func (s *MaintenanceServer) RebuildSearchIndex(
+	ctx context.Context,
+	req *grpc.MaintenanceRebuildSearchIndexRequest,
+) (resp *grpc.MaintenanceRebuildSearchIndexResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("MaintenanceServer.RebuildSearchIndex") }()
+
+	go maintenance.RebuildSearchIndex(context.Background())
+
+	resp = &grpc.MaintenanceRebuildSearchIndexResponse{
+		IsSuccess: true,
+	}
+
+	err = nil
+	return
+}
@@ -6,17 +6,21 @@ import (
 	"fmt"
 	"io"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 
 	"github.com/disintegration/imaging"
+	"github.com/mangaweb4/mangaweb4-backend/browse"
 	"github.com/mangaweb4/mangaweb4-backend/container"
 	"github.com/mangaweb4/mangaweb4-backend/database"
+	"github.com/mangaweb4/mangaweb4-backend/ent"
 	ent_meta "github.com/mangaweb4/mangaweb4-backend/ent/meta"
 	"github.com/mangaweb4/mangaweb4-backend/ent/progress"
 	ent_tag "github.com/mangaweb4/mangaweb4-backend/ent/tag"
 	"github.com/mangaweb4/mangaweb4-backend/grpc"
 	"github.com/mangaweb4/mangaweb4-backend/meta"
+	"github.com/mangaweb4/mangaweb4-backend/tag"
 	"github.com/mangaweb4/mangaweb4-backend/user"
 	"github.com/rs/zerolog/log"
 	grpclib "google.golang.org/grpc"
@@ -44,24 +48,68 @@ func (s *MangaServer) List(ctx context.Context, req *grpc.MangaListRequest) (
 	client := database.CreateEntClient()
 	defer func() { log.Err(client.Close()).Msg("database client close on MangaServer.List") }()
 
-	u, err := user.GetUser(ctx, client, req.User)
+	u, err := user.Current(ctx, client)
 	if err != nil {
 		return
 	}
 
-	allMeta, err := meta.ReadPage(ctx,
-		client,
-		u,
-		meta.QueryParams{
-			SearchName:  req.Search,
-			Filter:      req.Filter,
-			SortBy:      req.Sort,
-			SortOrder:   req.Order,
-			Page:        int(req.Page),
-			ItemPerPage: int(req.ItemPerPage),
-		},
-	)
+	req.Sort, req.Order = itemSort(ctx, u, req.UsePreferredSort, req.Sort, req.Order)
 
+	params := meta.QueryParams{
+		Query:       req.Query,
+		SearchName:  req.Search,
+		IncludeTags: tagIDs(req.IncludeTags),
+		TagMatch:    req.TagMatch,
+		ExcludeTags: tagIDs(req.ExcludeTags),
+		Series:      req.Series,
+		Filter:      req.Filter,
+		SortBy:      req.Sort,
+		SortOrder:   req.Order,
+		RandomSeed:  req.RandomSeed,
+		Page:        int(req.Page),
+		ItemPerPage: int(itemsPerPage(ctx, u, req.ItemPerPage)),
+		Cursor:      req.Cursor,
+	}
+
+	resp, err = listItems(ctx, client, u, params, req.IncludeFacets)
+	if err != nil {
+		return
+	}
+
+	log.Info().
+		Interface("request", req).
+		Msg("Browse")
+
+	return
+}
+
+// listItems returns a page of the item listing, with its page count. The page
+// size comes from itemsPerPage, so it is never zero. The tag facets of the
+// whole listing are only computed when includeFacets is set and the page is the
+// first one, since they do not change from page to page.
+func listItems(
+	ctx context.Context,
+	client *ent.Client,
+	u *ent.User,
+	params meta.QueryParams,
+	includeFacets bool,
+) (resp *grpc.MangaListResponse, err error) {
+	allMeta, err := meta.ReadPage(ctx, client, u, params)
+	if err != nil {
+		return
+	}
+
+	nextCursor, err := meta.NextCursor(u, allMeta, params)
+	if err != nil {
+		return
+	}
+
+	ids := make([]int, len(allMeta))
+	for i, m := range allMeta {
+		ids[i] = m.ID
+	}
+
+	hiddenIDs, err := client.Meta.Query().Where(ent_meta.IDIn(ids...), browse.HiddenItems()).IDs(ctx)
 	if err != nil {
 		return
 	}
@@ -87,6 +135,10 @@ func (s *MangaServer) List(ctx context.Context, req *grpc.MangaListRequest) (
 			PageCount:   int32(len(m.FileIndices)),
 			CurrentPage: int32(currentPage),
 			MaxProgress: int32(maxProgress),
+			Series:      m.Series,
+			Volume:      m.Volume,
+			Chapter:     m.Chapter,
+			IsHidden:    slices.Contains(hiddenIDs, m.ID),
 		}
 
 		tags, e := m.QueryTags().All(ctx)
@@ -103,42 +155,85 @@ func (s *MangaServer) List(ctx context.Context, req *grpc.MangaListRequest) (
 		}
 	}
 
-	count, err := meta.Count(ctx,
-		client,
-		u,
-		meta.QueryParams{
-			SearchName:  req.Search,
-			Filter:      req.Filter,
-			SortBy:      req.Sort,
-			SortOrder:   req.Order,
-			Page:        0,
-			ItemPerPage: 0,
-		})
+	countParams := params
+	countParams.Page = 0
+	countParams.ItemPerPage = 0
+	countParams.Cursor = ""
+
+	count, err := meta.Count(ctx, client, u, countParams)
 	if err != nil {
 		return
 	}
 
-	pageCount := int32(count) / req.ItemPerPage
-	if int32(count)%req.ItemPerPage > 0 {
-		pageCount++
+	facets := make([]meta.TagFacet, 0)
+	if includeFacets && params.Cursor == "" && params.Page == 0 {
+		if facets, err = meta.TagFacets(ctx, client, u, countParams); err != nil {
+			return
+		}
 	}
 
-	if req.Page > pageCount || req.Page < 0 {
-		req.Page = 0
+	pageCount := int32(count / params.ItemPerPage)
+	if count%params.ItemPerPage > 0 {
+		pageCount++
 	}
 
-	log.Info().
-		Interface("request", req).
-		Msg("Browse")
-
 	resp = &grpc.MangaListResponse{
-		Items:     items,
-		TotalPage: pageCount,
+		Items:      items,
+		TotalPage:  pageCount,
+		TagFacets:  make([]*grpc.MangaListResponseTagFacet, len(facets)),
+		NextCursor: nextCursor,
+	}
+
+	for i, f := range facets {
+		resp.TagFacets[i] = &grpc.MangaListResponseTagFacet{
+			Id:       int32(f.ID),
+			Name:     f.Name,
+			Category: tag.CategoryToGrpc(f.Category),
+			Count:    int32(f.Count),
+		}
 	}
 
 	return
 }
 
+// itemsPerPage returns the page size of a listing request, which is the
+// user's preference when the request leaves it unspecified.
+func itemsPerPage(ctx context.Context, u *ent.User, requested int32) int32 {
+	if requested > 0 {
+		return requested
+	}
+
+	return int32(user.Preferences(ctx, u).ItemsPerPage)
+}
+
+// itemSort returns the sort field and order of an item listing: the ones of
+// the request, or the preferred ones of the user when the request asks for
+// them.
+func itemSort(
+	ctx context.Context,
+	u *ent.User,
+	usePreferred bool,
+	field grpc.SortField,
+	order grpc.SortOrder,
+) (grpc.SortField, grpc.SortOrder) {
+	if !usePreferred {
+		return field, order
+	}
+
+	preferred := user.Preferences(ctx, u).Sort
+	return preferred.Field, preferred.Order
+}
+
+// tagIDs converts the tag IDs of a request.
+func tagIDs(ids []int32) []int {
+	out := make([]int, len(ids))
+	for i, id := range ids {
+		out[i] = int(id)
+	}
+
+	return out
+}
+
 func (s *MangaServer) Detail(
 	ctx context.Context,
 	req *grpc.MangaDetailRequest,
@@ -148,21 +243,21 @@ func (s *MangaServer) Detail(
 	client := database.CreateEntClient()
 	defer func() { log.Err(client.Close()).Msg("database client close on MangaServer.Detail") }()
 
-	m, err := client.Meta.Get(ctx, int(req.Id))
+	u, err := user.Current(ctx, client)
 	if err != nil {
 		return
 	}
 
-	log.Info().
-		Interface("request", req).
-		Msg("View Item")
-
-	tags, err := m.QueryTags().All(ctx)
+	m, err := meta.ReadAllowed(ctx, client, u, int(req.Id))
 	if err != nil {
 		return
 	}
 
-	u, err := user.GetUser(ctx, client, req.User)
+	log.Info().
+		Interface("request", req).
+		Msg("View Item")
+
+	grpcTags, err := s.tagItems(ctx, client, u, m)
 	if err != nil {
 		return
 	}
@@ -176,22 +271,18 @@ func (s *MangaServer) Detail(
 		currentPage = progress.Page
 	}
 
-	grpcTags := make([]*grpc.MangaDetailResponseTagItem, len(tags))
-	for i := range tags {
-		grpcTags[i] = &grpc.MangaDetailResponseTagItem{
-			Id:         int32(tags[i].ID),
-			Name:       tags[i].Name,
-			IsFavorite: u.QueryFavoriteTags().Where(ent_tag.ID(tags[i].ID)).ExistX(ctx),
-			IsHidden:   tags[i].Hidden,
-		}
-	}
-
 	resp = &grpc.MangaDetailResponse{
 		Name:        m.Name,
 		Favorite:    u.QueryFavoriteItems().Where(ent_meta.ID(m.ID)).ExistX(ctx),
 		Tags:        grpcTags,
 		PageCount:   int32(len(m.FileIndices)),
 		CurrentPage: int32(currentPage),
+		Series:      m.Series,
+		Volume:      m.Volume,
+		Chapter:     m.Chapter,
+		Artist:      m.Artist,
+		Year:        int32(m.Year),
+		AgeRating:   int32(m.AgeRating),
 	}
 
 	_, err = client.History.Create().
@@ -211,7 +302,12 @@ func (s *MangaServer) Thumbnail(
 	client := database.CreateEntClient()
 	defer func() { log.Err(client.Close()).Msg("database client close on MangaServer.Thumbnail") }()
 
-	m, err := client.Meta.Get(ctx, int(req.Id))
+	u, err := user.Current(ctx, client)
+	if err != nil {
+		return
+	}
+
+	m, err := meta.ReadAllowed(ctx, client, u, int(req.Id))
 	if err != nil {
 		return
 	}
@@ -238,12 +334,12 @@ func (s *MangaServer) SetFavorite(
 	client := database.CreateEntClient()
 	defer func() { log.Err(client.Close()).Msg("database client close on MangaServer.SetFavorite") }()
 
-	u, err := user.GetUser(ctx, client, req.User)
+	u, err := user.Current(ctx, client)
 	if err != nil {
 		return
 	}
 
-	m, err := client.Meta.Get(ctx, int(req.Id))
+	m, err := meta.ReadAllowed(ctx, client, u, int(req.Id))
 	if err != nil {
 		return
 	}
@@ -266,6 +362,87 @@ func (s *MangaServer) SetFavorite(
 	return
 }
 
+func (s *MangaServer) SetBlocked(
+	ctx context.Context,
+	req *grpc.MangaSetBlockedRequest,
+) (resp *grpc.MangaSetBlockedResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("MangaServer.SetBlocked") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on MangaServer.SetBlocked") }()
+
+	u, err := user.Current(ctx, client)
+	if err != nil {
+		return
+	}
+
+	m, err := meta.ReadAllowed(ctx, client, u, int(req.Id))
+	if err != nil {
+		return
+	}
+
+	if req.Blocked {
+		_, err = u.Update().AddBlockedItems(m).Save(ctx)
+	} else {
+		_, err = u.Update().RemoveBlockedItems(m).Save(ctx)
+	}
+
+	if err != nil {
+		return
+	}
+
+	resp = &grpc.MangaSetBlockedResponse{
+		Name:    m.Name,
+		Blocked: req.Blocked,
+	}
+
+	return
+}
+
+func (s *MangaServer) SetHidden(
+	ctx context.Context,
+	req *grpc.MangaSetHiddenRequest,
+) (resp *grpc.MangaSetHiddenResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("MangaServer.SetHidden") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on MangaServer.SetHidden") }()
+
+	m, err := client.Meta.UpdateOneID(int(req.Id)).SetHidden(req.Hidden).Save(ctx)
+	if err != nil {
+		return
+	}
+
+	resp = &grpc.MangaSetHiddenResponse{
+		Name:   m.Name,
+		Hidden: m.Hidden,
+	}
+
+	return
+}
+
+func (s *MangaServer) SetAgeRating(
+	ctx context.Context,
+	req *grpc.MangaSetAgeRatingRequest,
+) (resp *grpc.MangaSetAgeRatingResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("MangaServer.SetAgeRating") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on MangaServer.SetAgeRating") }()
+
+	m, err := client.Meta.UpdateOneID(int(req.Id)).SetAgeRating(int(req.AgeRating)).Save(ctx)
+	if err != nil {
+		return
+	}
+
+	resp = &grpc.MangaSetAgeRatingResponse{
+		Name:      m.Name,
+		AgeRating: int32(m.AgeRating),
+	}
+
+	return
+}
+
 func (s *MangaServer) SetProgress(
 	ctx context.Context,
 	req *grpc.MangaSetProgressRequest,
@@ -274,45 +451,48 @@ func (s *MangaServer) SetProgress(
 
 	client := database.CreateEntClient()
 	defer func() { log.Err(client.Close()).Msg("database client close on MangaServer.SetProgress") }()
-	m, err := client.Meta.Get(ctx, int(req.Id))
+
+	u, err := user.Current(ctx, client)
 	if err != nil {
 		return
 	}
 
-	u, err := user.GetUser(ctx, client, req.User)
-	if err == nil {
-		s.progressMutex.Lock()
-		defer s.progressMutex.Unlock()
+	m, err := meta.ReadAllowed(ctx, client, u, int(req.Id))
+	if err != nil {
+		return
+	}
 
-		progressRec, _ := client.Progress.Query().
-			Where(progress.UserID(u.ID), progress.ItemID(m.ID)).
-			Only(ctx)
+	s.progressMutex.Lock()
+	defer s.progressMutex.Unlock()
 
-		if progressRec == nil {
-			_, err = client.Progress.Create().
-				SetPage(int(req.Page)).
-				SetMax(int(0)).
-				SetItem(m).
-				SetUser(u).
-				Save(ctx)
-		} else {
-			max := max(progressRec.Max, int(req.Page))
-			_, err = progressRec.Update().
-				SetPage(int(req.Page)).
-				SetMax(max).
-				SetItem(m).
-				SetUser(u).
-				Save(ctx)
-		}
+	progressRec, _ := client.Progress.Query().
+		Where(progress.UserID(u.ID), progress.ItemID(m.ID)).
+		Only(ctx)
 
-		if err != nil {
-			return
-		}
+	if progressRec == nil {
+		_, err = client.Progress.Create().
+			SetPage(int(req.Page)).
+			SetMax(int(0)).
+			SetItem(m).
+			SetUser(u).
+			Save(ctx)
+	} else {
+		max := max(progressRec.Max, int(req.Page))
+		_, err = progressRec.Update().
+			SetPage(int(req.Page)).
+			SetMax(max).
+			SetItem(m).
+			SetUser(u).
+			Save(ctx)
+	}
+
+	if err != nil {
+		return
 	}
 
 	resp = &grpc.MangaSetProgressResponse{
 		Name:    m.Name,
-		User:    req.User,
+		User:    u.Email,
 		Page:    req.Page,
 		Succeed: true,
 	}
@@ -370,7 +550,7 @@ func (s *MangaServer) PageImageStream(req *grpc.MangaPageImageRequest,
 	stream grpclib.ServerStreamingServer[grpc.MangaPageImageStreamResponse]) error {
 
 	var err error
-	var ctx = context.Background()
+	var ctx = stream.Context()
 	var contentType string
 	var filename string
 
@@ -379,7 +559,12 @@ func (s *MangaServer) PageImageStream(req *grpc.MangaPageImageRequest,
 	client := database.CreateEntClient()
 	defer func() { log.Err(client.Close()).Msg("database client close on MangaServer.PageImageStream") }()
 
-	m, err := client.Meta.Get(ctx, int(req.Id))
+	u, err := user.Current(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	m, err := meta.ReadAllowed(ctx, client, u, int(req.Id))
 	if err != nil {
 		return err
 	}
@@ -399,40 +584,37 @@ func (s *MangaServer) PageImageStream(req *grpc.MangaPageImageRequest,
 		return err
 	}
 
-	u, err := user.GetUser(ctx, client, req.User)
-	if err == nil {
-		s.progressMutex.Lock()
-		defer s.progressMutex.Unlock()
+	s.progressMutex.Lock()
+	defer s.progressMutex.Unlock()
 
-		progressRec, _ := client.Progress.Query().
-			Where(progress.UserID(u.ID), progress.ItemID(m.ID)).
-			Only(ctx)
+	progressRec, _ := client.Progress.Query().
+		Where(progress.UserID(u.ID), progress.ItemID(m.ID)).
+		Only(ctx)
 
-		if progressRec == nil {
-			_, err = client.Progress.Create().
-				SetPage(int(req.Index)).
-				SetMax(int(0)).
-				SetItem(m).
-				SetUser(u).
-				Save(ctx)
-		} else {
-			max := max(progressRec.Max, int(req.Index))
-			_, err = progressRec.Update().
-				SetPage(int(req.Index)).
-				SetMax(max).
-				SetItem(m).
-				SetUser(u).
-				Save(ctx)
-		}
+	if progressRec == nil {
+		_, err = client.Progress.Create().
+			SetPage(int(req.Index)).
+			SetMax(int(0)).
+			SetItem(m).
+			SetUser(u).
+			Save(ctx)
+	} else {
+		max := max(progressRec.Max, int(req.Index))
+		_, err = progressRec.Update().
+			SetPage(int(req.Index)).
+			SetMax(max).
+			SetItem(m).
+			SetUser(u).
+			Save(ctx)
+	}
 
-		if err != nil {
-			return err
-		}
+	if err != nil {
+		return err
 	}
 
-	quality := grpc.ImageQuality_IMAGE_QUALITY_HIGH
-	if req.Quality != grpc.ImageQuality_IMAGE_QUALITY_UNSPECIFIED {
-		quality = req.Quality
+	quality := req.Quality
+	if quality == grpc.ImageQuality_IMAGE_QUALITY_UNSPECIFIED {
+		quality = user.Preferences(ctx, u).ImageQuality
 	}
 
 	if quality == grpc.ImageQuality_IMAGE_QUALITY_ORIGINAL {
@@ -525,6 +707,8 @@ func (s *MangaServer) Repair(
 		return
 	}
 
+	meta.PopulateFields(m)
+
 	if err = meta.GenerateImageIndices(m); err != nil {
 		return
 	}
@@ -552,14 +736,18 @@ func (s *MangaServer) Download(
 	var err error
 
 	defer func() { log.Err(err).Interface("request", req).Msg("MangaServer.Download") }()
-	ctx := context.Background()
+	ctx := stream.Context()
 
 	client := database.CreateEntClient()
 	defer func() { log.Err(client.Close()).Msg("database client close on MangaServer.Download") }()
 
-	m, err := client.Meta.Get(ctx, int(req.Id))
+	u, err := user.Current(ctx, client)
 	if err != nil {
+		return err
+	}
 
+	m, err := meta.ReadAllowed(ctx, client, u, int(req.Id))
+	if err != nil {
 		return err
 	}
 
@@ -597,3 +785,125 @@ func (s *MangaServer) Download(
 
 	return nil
 }
+
+func (s *MangaServer) AddTag(
+	ctx context.Context,
+	req *grpc.MangaAddTagRequest,
+) (resp *grpc.MangaAddTagResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("MangaServer.AddTag") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on MangaServer.AddTag") }()
+
+	u, err := user.Current(ctx, client)
+	if err != nil {
+		return
+	}
+
+	m, err := meta.ReadAllowed(ctx, client, u, int(req.Id))
+	if err != nil {
+		return
+	}
+
+	if req.TagId != 0 {
+		t, e := client.Tag.Get(ctx, int(req.TagId))
+		if e != nil {
+			err = e
+			return
+		}
+
+		err = meta.AddTag(ctx, client, m, t)
+	} else if req.TagName != "" {
+		_, err = meta.AddTagByName(ctx, client, m, req.TagName)
+	} else {
+		err = fmt.Errorf("either tag id or tag name is required")
+	}
+
+	if err != nil {
+		return
+	}
+
+	tags, err := s.tagItems(ctx, client, u, m)
+	if err != nil {
+		return
+	}
+
+	resp = &grpc.MangaAddTagResponse{
+		Tags: tags,
+	}
+
+	return
+}
+
+func (s *MangaServer) RemoveTag(
+	ctx context.Context,
+	req *grpc.MangaRemoveTagRequest,
+) (resp *grpc.MangaRemoveTagResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("MangaServer.RemoveTag") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on MangaServer.RemoveTag") }()
+
+	u, err := user.Current(ctx, client)
+	if err != nil {
+		return
+	}
+
+	m, err := meta.ReadAllowed(ctx, client, u, int(req.Id))
+	if err != nil {
+		return
+	}
+
+	t, err := client.Tag.Get(ctx, int(req.TagId))
+	if err != nil {
+		return
+	}
+
+	if err = meta.RemoveTag(ctx, client, m, t); err != nil {
+		return
+	}
+
+	tags, err := s.tagItems(ctx, client, u, m)
+	if err != nil {
+		return
+	}
+
+	resp = &grpc.MangaRemoveTagResponse{
+		Tags: tags,
+	}
+
+	return
+}
+
+func (s *MangaServer) tagItems(
+	ctx context.Context,
+	client *ent.Client,
+	u *ent.User,
+	m *ent.Meta,
+) (items []*grpc.MangaDetailResponseTagItem, err error) {
+	metaTags, err := meta.ReadTags(ctx, client, m)
+	if err != nil {
+		return
+	}
+
+	hideHidden := user.Preferences(ctx, u).HiddenTags == grpc.HiddenTagDisplay_HIDDEN_TAG_DISPLAY_HIDE
+
+	items = make([]*grpc.MangaDetailResponseTagItem, 0, len(metaTags))
+	for _, mt := range metaTags {
+		t := mt.Edges.Tag
+		if hideHidden && t.Hidden {
+			continue
+		}
+
+		items = append(items, &grpc.MangaDetailResponseTagItem{
+			Id:         int32(t.ID),
+			Name:       t.Name,
+			IsFavorite: u.QueryFavoriteTags().Where(ent_tag.ID(t.ID)).ExistX(ctx),
+			IsHidden:   t.Hidden,
+			Category:   tag.CategoryToGrpc(t.Category),
+			Source:     tag.SourceToGrpc(mt.Source),
+		})
+	}
+
+	return
+}
@@ -0,0 +1,226 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/mangaweb4/mangaweb4-backend/database"
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+	"github.com/mangaweb4/mangaweb4-backend/savedsearch"
+	"github.com/mangaweb4/mangaweb4-backend/user"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rs/zerolog/log"
+)
+
+type SavedSearchServer struct {
+	grpc.UnimplementedSavedSearchServer
+}
+
+func (s *SavedSearchServer) Create(
+	ctx context.Context,
+	req *grpc.SavedSearchCreateRequest,
+) (resp *grpc.SavedSearchCreateResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("SavedSearchServer.Create") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on SavedSearchServer.Create") }()
+
+	u, err := user.Current(ctx, client)
+	if err != nil {
+		return
+	}
+
+	saved, err := savedsearch.Create(ctx, client, u, savedsearch.Params{
+		Name:        req.Name,
+		Search:      req.Search,
+		Query:       req.Query,
+		Filter:      req.Filter,
+		Sort:        req.Sort,
+		Order:       req.Order,
+		Series:      req.Series,
+		IncludeTags: tagIDs(req.IncludeTags),
+		TagMatch:    req.TagMatch,
+		ExcludeTags: tagIDs(req.ExcludeTags),
+		RandomSeed:  req.RandomSeed,
+	})
+	if err != nil {
+		return
+	}
+
+	resp = &grpc.SavedSearchCreateResponse{
+		Item: savedSearchItem(saved),
+	}
+
+	return
+}
+
+func (s *SavedSearchServer) List(
+	ctx context.Context,
+	req *grpc.SavedSearchListRequest,
+) (resp *grpc.SavedSearchListResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("SavedSearchServer.List") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on SavedSearchServer.List") }()
+
+	u, err := user.Current(ctx, client)
+	if err != nil {
+		return
+	}
+
+	searches, err := savedsearch.ReadAll(ctx, client, u)
+	if err != nil {
+		return
+	}
+
+	resp = &grpc.SavedSearchListResponse{
+		Items: make([]*grpc.SavedSearchItem, len(searches)),
+	}
+
+	for i, saved := range searches {
+		resp.Items[i] = savedSearchItem(saved)
+	}
+
+	return
+}
+
+func (s *SavedSearchServer) Update(
+	ctx context.Context,
+	req *grpc.SavedSearchUpdateRequest,
+) (resp *grpc.SavedSearchUpdateResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("SavedSearchServer.Update") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on SavedSearchServer.Update") }()
+
+	u, err := user.Current(ctx, client)
+	if err != nil {
+		return
+	}
+
+	saved, err := savedsearch.Update(ctx, client, u, int(req.Id), savedsearch.Params{
+		Name:        req.Name,
+		Search:      req.Search,
+		Query:       req.Query,
+		Filter:      req.Filter,
+		Sort:        req.Sort,
+		Order:       req.Order,
+		Series:      req.Series,
+		IncludeTags: tagIDs(req.IncludeTags),
+		TagMatch:    req.TagMatch,
+		ExcludeTags: tagIDs(req.ExcludeTags),
+		RandomSeed:  req.RandomSeed,
+	})
+	if err != nil {
+		return
+	}
+
+	resp = &grpc.SavedSearchUpdateResponse{
+		Item: savedSearchItem(saved),
+	}
+
+	return
+}
+
+func (s *SavedSearchServer) Delete(
+	ctx context.Context,
+	req *grpc.SavedSearchDeleteRequest,
+) (resp *grpc.SavedSearchDeleteResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("SavedSearchServer.Delete") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on SavedSearchServer.Delete") }()
+
+	u, err := user.Current(ctx, client)
+	if err != nil {
+		return
+	}
+
+	if err = savedsearch.Delete(ctx, client, u, int(req.Id)); err != nil {
+		return
+	}
+
+	resp = &grpc.SavedSearchDeleteResponse{
+		Id:      req.Id,
+		Success: true,
+	}
+
+	return
+}
+
+func (s *SavedSearchServer) Run(
+	ctx context.Context,
+	req *grpc.SavedSearchRunRequest,
+) (resp *grpc.SavedSearchRunResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("SavedSearchServer.Run") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on SavedSearchServer.Run") }()
+
+	u, err := user.Current(ctx, client)
+	if err != nil {
+		return
+	}
+
+	saved, err := savedsearch.Read(ctx, client, u, int(req.Id))
+	if err != nil {
+		return
+	}
+
+	params := savedsearch.QueryParams(saved)
+	params.Page = int(req.Page)
+	params.ItemPerPage = int(itemsPerPage(ctx, u, req.ItemPerPage))
+	params.Cursor = req.Cursor
+
+	list, err := listItems(ctx, client, u, params, req.IncludeFacets)
+	if err != nil {
+		return
+	}
+
+	// Only the first page opens the search, so that paging through it does
+	// not reset the count of new items.
+	newCount := 0
+	if req.Cursor == "" && req.Page == 0 {
+		if newCount, err = savedsearch.Open(ctx, client, u, saved, time.Now()); err != nil {
+			return
+		}
+	}
+
+	resp = &grpc.SavedSearchRunResponse{
+		List:         list,
+		NewItemCount: int32(newCount),
+	}
+
+	return
+}
+
+func savedSearchItem(s *ent.SavedSearch) *grpc.SavedSearchItem {
+	return &grpc.SavedSearchItem{
+		Id:           int32(s.ID),
+		Name:         s.Name,
+		Search:       s.Search,
+		Query:        s.Query,
+		Filter:       grpc.Filter(s.Filter),
+		Sort:         grpc.SortField(s.Sort),
+		Order:        grpc.SortOrder(s.SortOrder),
+		Series:       s.Series,
+		IncludeTags:  tagIDsToGrpc(s.IncludeTags),
+		TagMatch:     grpc.TagMatch(s.TagMatch),
+		ExcludeTags:  tagIDsToGrpc(s.ExcludeTags),
+		RandomSeed:   s.RandomSeed,
+		CreateTime:   timestamppb.New(s.CreateTime),
+		LastOpenTime: timestamppb.New(s.LastOpenTime),
+	}
+}
+
+// tagIDsToGrpc converts tag IDs for a response.
+func tagIDsToGrpc(ids []int) []int32 {
+	out := make([]int32, len(ids))
+	for i, id := range ids {
+		out[i] = int32(id)
+	}
+
+	return out
+}
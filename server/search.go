@@ -0,0 +1,67 @@
+package server
+
+import (
+	"context"
+
+	"github.com/mangaweb4/mangaweb4-backend/database"
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+	"github.com/mangaweb4/mangaweb4-backend/search"
+	"github.com/mangaweb4/mangaweb4-backend/tag"
+	"github.com/mangaweb4/mangaweb4-backend/user"
+
+	"github.com/rs/zerolog/log"
+)
+
+type SearchServer struct {
+	grpc.UnimplementedSearchServer
+}
+
+func (s *SearchServer) Suggest(
+	ctx context.Context,
+	req *grpc.SearchSuggestRequest,
+) (resp *grpc.SearchSuggestResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("SearchServer.Suggest") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on SearchServer.Suggest") }()
+
+	u, err := user.Current(ctx, client)
+	if err != nil {
+		return
+	}
+
+	tags, err := search.SuggestTags(ctx, client, u, req.Query, int(req.Limit))
+	if err != nil {
+		return
+	}
+
+	items, err := search.SuggestItems(ctx, client, u, req.Query, int(req.Limit))
+	if err != nil {
+		return
+	}
+
+	resp = &grpc.SearchSuggestResponse{
+		Tags:  make([]*grpc.SearchSuggestTag, len(tags)),
+		Items: make([]*grpc.SearchSuggestItem, len(items)),
+	}
+
+	for i, t := range tags {
+		resp.Tags[i] = &grpc.SearchSuggestTag{
+			Id:         int32(t.ID),
+			Name:       t.Name,
+			Category:   tag.CategoryToGrpc(t.Category),
+			ItemCount:  int32(t.ItemCount),
+			IsFavorite: t.IsFavorite,
+		}
+	}
+
+	for i, m := range items {
+		resp.Items[i] = &grpc.SearchSuggestItem{
+			Id:         int32(m.ID),
+			Name:       m.Name,
+			IsFavorite: m.IsFavorite,
+		}
+	}
+
+	return
+}
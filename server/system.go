@@ -4,9 +4,8 @@ import (
 	"context"
 	"runtime/debug"
 
+	"github.com/mangaweb4/mangaweb4-backend/browse"
 	"github.com/mangaweb4/mangaweb4-backend/database"
-	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
-	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
 	"github.com/mangaweb4/mangaweb4-backend/grpc"
 
 	"github.com/rs/zerolog/log"
@@ -25,12 +24,12 @@ func (s *SystemServer) Info(
 
 	defer func() { log.Err(client.Close()).Msg("database client close on MangaServer.List") }()
 
-	countManga, err := client.Meta.Query().Where(meta.Active(true), meta.Hidden(false)).Count(ctx)
+	countManga, err := client.Meta.Query().Where(browse.VisibleItems()).Count(ctx)
 	if err != nil {
 		return
 	}
 
-	countTag, err := client.Tag.Query().Where(tag.Hidden(false)).Count(ctx)
+	countTag, err := client.Tag.Query().Where(browse.VisibleTags()).Count(ctx)
 	if err != nil {
 		return
 	}
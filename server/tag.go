@@ -3,10 +3,13 @@ package server
 import (
 	"context"
 
+	"github.com/mangaweb4/mangaweb4-backend/browse"
 	"github.com/mangaweb4/mangaweb4-backend/database"
 	"github.com/mangaweb4/mangaweb4-backend/ent"
+	ent_meta "github.com/mangaweb4/mangaweb4-backend/ent/meta"
 	"github.com/mangaweb4/mangaweb4-backend/ent/progress"
 	ent_tag "github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	ent_tagalias "github.com/mangaweb4/mangaweb4-backend/ent/tagalias"
 	ent_user "github.com/mangaweb4/mangaweb4-backend/ent/user"
 	"github.com/mangaweb4/mangaweb4-backend/grpc"
 	"github.com/mangaweb4/mangaweb4-backend/meta"
@@ -14,6 +17,8 @@ import (
 	"github.com/mangaweb4/mangaweb4-backend/user"
 
 	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type TagServer struct {
@@ -29,21 +34,25 @@ func (s *TagServer) List(
 	client := database.CreateEntClient()
 	defer func() { log.Err(client.Close()).Msg("database client close on TagServer.List") }()
 
-	u, err := user.GetUser(ctx, client, req.User)
+	u, err := user.Current(ctx, client)
 	if err != nil {
 		return
 	}
 
-	allTags, err := tag.ReadPage(ctx, client, u,
-		tag.QueryParams{
-			Filter:      req.Filter,
-			Search:      req.Search,
-			Page:        int(req.Page),
-			ItemPerPage: int(req.ItemPerPage),
-			Sort:        req.Sort,
-			Order:       req.Order,
-		})
+	req.ItemPerPage = itemsPerPage(ctx, u, req.ItemPerPage)
 
+	params := tag.QueryParams{
+		Filter:      req.Filter,
+		Search:      req.Search,
+		Page:        int(req.Page),
+		ItemPerPage: int(req.ItemPerPage),
+		Sort:        req.Sort,
+		Order:       req.Order,
+		Category:    tag.CategoryFromGrpc(req.Category),
+		Cursor:      req.Cursor,
+	}
+
+	allTags, err := tag.ReadPage(ctx, client, u, params)
 	if err != nil {
 		return
 	}
@@ -56,6 +65,7 @@ func (s *TagServer) List(
 			ItemPerPage: 0,
 			Sort:        req.Sort,
 			Order:       req.Order,
+			Category:    tag.CategoryFromGrpc(req.Category),
 		})
 
 	if err != nil {
@@ -66,9 +76,23 @@ func (s *TagServer) List(
 		TotalPage: (int32(total) / req.ItemPerPage) + 1,
 	}
 
+	resp.NextCursor, err = tag.NextCursor(allTags, params)
+	if err != nil {
+		return
+	}
+
+	// Hidden and blocked tags are listed with the items they hide.
+	itemFilter := browse.Items(u, grpc.Filter_FILTER_UNKNOWN)
+	switch req.Filter {
+	case grpc.Filter_FILTER_HIDDEN:
+		itemFilter = ent_meta.And(ent_meta.Active(true), browse.AllowedItems(u))
+	case grpc.Filter_FILTER_BLOCKED:
+		itemFilter = ent_meta.And(browse.VisibleItems(), browse.AllowedItems(u))
+	}
+
 	resp.Items = make([]*grpc.TagListResponseItem, len(allTags))
 	for i, t := range allTags {
-		items, e := t.QueryMeta().All(ctx)
+		itemCount, e := t.QueryMeta().Where(itemFilter).Count(ctx)
 		if e != nil {
 			err = e
 			return
@@ -77,7 +101,9 @@ func (s *TagServer) List(
 			Id:         int32(t.ID),
 			Name:       t.Name,
 			IsFavorite: u.QueryFavoriteTags().Where(ent_tag.ID(t.ID)).ExistX(ctx),
-			PageCount:  int32(len(items)),
+			PageCount:  int32(itemCount),
+			Category:   tag.CategoryToGrpc(t.Category),
+			IsHidden:   t.Hidden,
 		}
 	}
 
@@ -93,24 +119,36 @@ func (s *TagServer) Detail(
 	client := database.CreateEntClient()
 	defer func() { log.Err(client.Close()).Msg("database client close on TagServer.Detail") }()
 
-	t, err := client.Tag.Get(ctx, int(req.Id))
+	u, err := user.Current(ctx, client)
 	if err != nil {
 		return
 	}
 
-	u, err := user.GetUser(ctx, client, req.User)
+	t, err := tag.ReadAllowed(ctx, client, u, int(req.Id))
 	if err != nil {
 		return
 	}
 
-	items, err := tag.ReadMetaPage(ctx, client, t, u, tag.QueryMetaParams{
+	req.ItemPerPage = itemsPerPage(ctx, u, req.ItemPerPage)
+	req.Sort, req.Order = itemSort(ctx, u, req.UsePreferredSort, req.Sort, req.Order)
+
+	params := tag.QueryMetaParams{
 		SearchName:  req.Search,
 		SortBy:      req.Sort,
 		SortOrder:   req.Order,
+		RandomSeed:  req.RandomSeed,
 		Filter:      req.Filter,
 		Page:        int(req.Page),
 		ItemPerPage: int(req.ItemPerPage),
-	})
+		Cursor:      req.Cursor,
+	}
+
+	items, err := tag.ReadMetaPage(ctx, client, t, u, params)
+	if err != nil {
+		return
+	}
+
+	nextCursor, err := tag.NextMetaCursor(u, items, params)
 	if err != nil {
 		return
 	}
@@ -119,6 +157,7 @@ func (s *TagServer) Detail(
 		SearchName:  req.Search,
 		SortBy:      req.Sort,
 		SortOrder:   req.Order,
+		RandomSeed:  req.RandomSeed,
 		Filter:      req.Filter,
 		Page:        0,
 		ItemPerPage: 0,
@@ -127,9 +166,23 @@ func (s *TagServer) Detail(
 		return
 	}
 
+	aliases, err := t.QueryAliases().Order(ent_tagalias.ByName()).All(ctx)
+	if err != nil {
+		return
+	}
+
 	resp = &grpc.TagDetailResponse{
 		Name:           t.Name,
 		TotalItemCount: int32(count),
+		Description:    t.Description,
+		Links:          t.Links,
+		Category:       tag.CategoryToGrpc(t.Category),
+		Aliases:        make([]string, len(aliases)),
+		NextCursor:     nextCursor,
+	}
+
+	for i, a := range aliases {
+		resp.Aliases[i] = a.Name
 	}
 
 	resp.TagFavorite, err = u.QueryFavoriteTags().Where(ent_tag.ID(t.ID)).Exist(ctx)
@@ -182,12 +235,17 @@ func (s *TagServer) Thumbnail(
 	client := database.CreateEntClient()
 	defer func() { log.Err(client.Close()).Msg("database client close on TagServer.Thumbnail") }()
 
-	t, err := client.Tag.Get(ctx, int(req.Id))
+	u, err := user.Current(ctx, client)
 	if err != nil {
 		return
 	}
 
-	m, err := t.QueryMeta().First(ctx)
+	t, err := tag.ReadAllowed(ctx, client, u, int(req.Id))
+	if err != nil {
+		return
+	}
+
+	m, err := t.QueryMeta().Where(browse.AllowedItems(u)).First(ctx)
 	if err != nil {
 		return
 	}
@@ -214,12 +272,12 @@ func (s *TagServer) SetFavorite(
 	client := database.CreateEntClient()
 	defer func() { log.Err(client.Close()).Msg("database client close on TagServer.SetFavorite") }()
 
-	t, err := client.Tag.Get(ctx, int(req.Id))
+	u, err := user.Current(ctx, client)
 	if err != nil {
 		return
 	}
 
-	u, err := user.GetUser(ctx, client, req.User)
+	t, err := tag.ReadAllowed(ctx, client, u, int(req.Id))
 	if err != nil {
 		return
 	}
@@ -240,3 +298,270 @@ func (s *TagServer) SetFavorite(
 
 	return
 }
+
+func (s *TagServer) SetHidden(
+	ctx context.Context,
+	req *grpc.TagSetHiddenRequest,
+) (resp *grpc.TagSetHiddenResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("TagServer.SetHidden") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on TagServer.SetHidden") }()
+
+	t, err := client.Tag.UpdateOneID(int(req.Id)).
+		SetHidden(req.Hidden).
+		SetHideItems(req.Hidden && req.HideItems).
+		Save(ctx)
+	if err != nil {
+		return
+	}
+
+	resp = &grpc.TagSetHiddenResponse{
+		Tag:       t.Name,
+		Hidden:    t.Hidden,
+		HideItems: t.HideItems,
+	}
+
+	return
+}
+
+func (s *TagServer) SetBlocked(
+	ctx context.Context,
+	req *grpc.TagSetBlockedRequest,
+) (resp *grpc.TagSetBlockedResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("TagServer.SetBlocked") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on TagServer.SetBlocked") }()
+
+	u, err := user.Current(ctx, client)
+	if err != nil {
+		return
+	}
+
+	t, err := tag.ReadAllowed(ctx, client, u, int(req.Id))
+	if err != nil {
+		return
+	}
+
+	if req.Blocked {
+		_, err = u.Update().AddBlockedTags(t).Save(ctx)
+	} else {
+		_, err = u.Update().RemoveBlockedTags(t).Save(ctx)
+	}
+	if err != nil {
+		return
+	}
+
+	resp = &grpc.TagSetBlockedResponse{
+		Tag:     t.Name,
+		Blocked: req.Blocked,
+	}
+
+	return
+}
+
+func (s *TagServer) Merge(
+	ctx context.Context,
+	req *grpc.TagMergeRequest,
+) (resp *grpc.TagMergeResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("TagServer.Merge") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on TagServer.Merge") }()
+
+	source, err := client.Tag.Get(ctx, int(req.SourceId))
+	if err != nil {
+		return
+	}
+
+	target, err := client.Tag.Get(ctx, int(req.TargetId))
+	if err != nil {
+		return
+	}
+
+	t, err := tag.Merge(ctx, client, source, target)
+	if err != nil {
+		return
+	}
+
+	aliases, err := t.QueryAliases().Order(ent_tagalias.ByName()).All(ctx)
+	if err != nil {
+		return
+	}
+
+	resp = &grpc.TagMergeResponse{
+		Id:      int32(t.ID),
+		Name:    t.Name,
+		Aliases: make([]string, len(aliases)),
+	}
+
+	for i, a := range aliases {
+		resp.Aliases[i] = a.Name
+	}
+
+	return
+}
+
+func (s *TagServer) Rename(
+	ctx context.Context,
+	req *grpc.TagRenameRequest,
+) (resp *grpc.TagRenameResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("TagServer.Rename") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on TagServer.Rename") }()
+
+	t, err := client.Tag.Get(ctx, int(req.Id))
+	if err != nil {
+		return
+	}
+
+	t, err = tag.Rename(ctx, client, t, req.Name)
+	if err != nil {
+		return
+	}
+
+	aliases, err := t.QueryAliases().Order(ent_tagalias.ByName()).All(ctx)
+	if err != nil {
+		return
+	}
+
+	resp = &grpc.TagRenameResponse{
+		Id:      int32(t.ID),
+		Name:    t.Name,
+		Aliases: make([]string, len(aliases)),
+	}
+
+	for i, a := range aliases {
+		resp.Aliases[i] = a.Name
+	}
+
+	return
+}
+
+func (s *TagServer) UpdateInfo(
+	ctx context.Context,
+	req *grpc.TagUpdateInfoRequest,
+) (resp *grpc.TagUpdateInfoResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("TagServer.UpdateInfo") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on TagServer.UpdateInfo") }()
+
+	fields, err := tagInfoFields(req)
+	if err != nil {
+		return
+	}
+
+	update := client.Tag.UpdateOneID(int(req.Id))
+
+	if fields[tagInfoDescription] {
+		update = update.SetDescription(req.Description)
+	}
+
+	if fields[tagInfoLinks] {
+		update = update.SetLinks(req.Links)
+	}
+
+	if fields[tagInfoCategory] {
+		if category := tag.CategoryFromGrpc(req.Category); category != "" {
+			update = update.SetCategory(category)
+		} else {
+			update = update.ClearCategory()
+		}
+	}
+
+	t, err := update.Save(ctx)
+	if err != nil {
+		return
+	}
+
+	resp = &grpc.TagUpdateInfoResponse{
+		Id:          int32(t.ID),
+		Name:        t.Name,
+		Description: t.Description,
+		Links:       t.Links,
+		Category:    tag.CategoryToGrpc(t.Category),
+	}
+
+	return
+}
+
+// The fields of a tag that UpdateInfo can change, as named in UpdateFields.
+const (
+	tagInfoDescription = "description"
+	tagInfoLinks       = "links"
+	tagInfoCategory    = "category"
+)
+
+// tagInfoFields returns the fields an UpdateInfo request changes: the fields
+// listed in UpdateFields, which can clear them, or else the fields the request
+// sets, so that clients that only send a description keep the links and
+// category.
+func tagInfoFields(req *grpc.TagUpdateInfoRequest) (fields map[string]bool, err error) {
+	fields = make(map[string]bool)
+
+	if len(req.UpdateFields) == 0 {
+		fields[tagInfoDescription] = req.Description != ""
+		fields[tagInfoLinks] = len(req.Links) > 0
+		fields[tagInfoCategory] = req.Category != grpc.TagCategory_TAG_CATEGORY_UNSPECIFIED
+		return
+	}
+
+	for _, f := range req.UpdateFields {
+		switch f {
+		case tagInfoDescription, tagInfoLinks, tagInfoCategory:
+			fields[f] = true
+		default:
+			err = status.Errorf(codes.InvalidArgument, "unknown tag field: %q", f)
+			return
+		}
+	}
+
+	return
+}
+
+func (s *TagServer) Related(
+	ctx context.Context,
+	req *grpc.TagRelatedRequest,
+) (resp *grpc.TagRelatedResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("TagServer.Related") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on TagServer.Related") }()
+
+	u, err := user.Current(ctx, client)
+	if err != nil {
+		return
+	}
+
+	t, err := tag.ReadAllowed(ctx, client, u, int(req.Id))
+	if err != nil {
+		return
+	}
+
+	related, err := tag.Related(ctx, client, u, t, tag.RelatedParams{
+		Category:   tag.CategoryFromGrpc(req.Category),
+		MinSupport: int(req.MinSupport),
+		Limit:      int(req.Limit),
+	})
+	if err != nil {
+		return
+	}
+
+	resp = &grpc.TagRelatedResponse{
+		Tags: make([]*grpc.TagRelatedResponseItem, len(related)),
+	}
+
+	for i, r := range related {
+		resp.Tags[i] = &grpc.TagRelatedResponseItem{
+			Id:       int32(r.ID),
+			Name:     r.Name,
+			Category: tag.CategoryToGrpc(r.Category),
+			Count:    int32(r.Count),
+		}
+	}
+
+	return
+}
@@ -3,10 +3,12 @@ package server
 import (
 	"context"
 
+	"github.com/mangaweb4/mangaweb4-backend/auth"
+	"github.com/mangaweb4/mangaweb4-backend/browse"
 	"github.com/mangaweb4/mangaweb4-backend/database"
-	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
-	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	"github.com/mangaweb4/mangaweb4-backend/ent"
 	"github.com/mangaweb4/mangaweb4-backend/grpc"
+	"github.com/mangaweb4/mangaweb4-backend/preference"
 	"github.com/mangaweb4/mangaweb4-backend/user"
 	"github.com/rs/zerolog/log"
 )
@@ -25,7 +27,7 @@ func (s *UserServer) Info(
 
 	defer func() { log.Err(client.Close()).Msg("database client close on MangaServer.List") }()
 
-	u, err := user.GetUser(ctx, client, req.User)
+	u, err := user.Current(ctx, client)
 	if err != nil {
 		return
 	}
@@ -36,13 +38,13 @@ func (s *UserServer) Info(
 	}
 
 	countFavoriteManga, err := u.QueryFavoriteItems().
-		Where(meta.Active(true), meta.Hidden(false)).
+		Where(browse.VisibleItems(), browse.AllowedItems(u)).
 		Count(ctx)
 	if err != nil {
 		return
 	}
 
-	countFavoriteTag, err := u.QueryFavoriteTags().Where(tag.Hidden(false)).Count(ctx)
+	countFavoriteTag, err := u.QueryFavoriteTags().Where(browse.VisibleTags(), browse.AllowedTags(u)).Count(ctx)
 	if err != nil {
 		return
 	}
@@ -52,7 +54,341 @@ func (s *UserServer) Info(
 		FavoriteItemCount: int32(countFavoriteManga),
 		FavoriteTagCount:  int32(countFavoriteTag),
 		ReadItemCount:     int32(countReadManga),
+		Role:              user.RoleToGrpc(u.Role),
 	}
 
 	return
 }
+
+func (s *UserServer) List(
+	ctx context.Context,
+	req *grpc.UserListRequest,
+) (resp *grpc.UserListResponse, err error) {
+	defer func() { log.Err(err).Msg("UserServer.List") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on UserServer.List") }()
+
+	users, err := user.ReadAll(ctx, client)
+	if err != nil {
+		return
+	}
+
+	resp = &grpc.UserListResponse{
+		Items: make([]*grpc.UserItem, len(users)),
+	}
+
+	for i, u := range users {
+		resp.Items[i] = userItem(ctx, u)
+	}
+
+	return
+}
+
+func (s *UserServer) Create(
+	ctx context.Context,
+	req *grpc.UserCreateRequest,
+) (resp *grpc.UserCreateResponse, err error) {
+	defer func() { log.Err(err).Str("email", req.Email).Msg("UserServer.Create") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on UserServer.Create") }()
+
+	u, err := auth.CreateUser(ctx, client, req.Email, user.RoleFromGrpc(req.Role), req.Password)
+	if err != nil {
+		return
+	}
+
+	resp = &grpc.UserCreateResponse{
+		Item: userItem(ctx, u),
+	}
+
+	return
+}
+
+func (s *UserServer) SetActive(
+	ctx context.Context,
+	req *grpc.UserSetActiveRequest,
+) (resp *grpc.UserSetActiveResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("UserServer.SetActive") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on UserServer.SetActive") }()
+
+	u, err := client.User.Get(ctx, int(req.Id))
+	if err != nil {
+		return
+	}
+
+	if u, err = user.SetActive(ctx, client, u, req.Active); err != nil {
+		return
+	}
+
+	resp = &grpc.UserSetActiveResponse{
+		Item: userItem(ctx, u),
+	}
+
+	return
+}
+
+func (s *UserServer) SetRole(
+	ctx context.Context,
+	req *grpc.UserSetRoleRequest,
+) (resp *grpc.UserSetRoleResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("UserServer.SetRole") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on UserServer.SetRole") }()
+
+	u, err := client.User.Get(ctx, int(req.Id))
+	if err != nil {
+		return
+	}
+
+	if u, err = user.SetRole(ctx, client, u, user.RoleFromGrpc(req.Role)); err != nil {
+		return
+	}
+
+	resp = &grpc.UserSetRoleResponse{
+		Item: userItem(ctx, u),
+	}
+
+	return
+}
+
+func (s *UserServer) Delete(
+	ctx context.Context,
+	req *grpc.UserDeleteRequest,
+) (resp *grpc.UserDeleteResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("UserServer.Delete") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on UserServer.Delete") }()
+
+	u, err := client.User.Get(ctx, int(req.Id))
+	if err != nil {
+		return
+	}
+
+	if err = user.Delete(ctx, client, u); err != nil {
+		return
+	}
+
+	resp = &grpc.UserDeleteResponse{
+		Id:      req.Id,
+		Success: true,
+	}
+
+	return
+}
+
+func (s *UserServer) ResetPassword(
+	ctx context.Context,
+	req *grpc.UserResetPasswordRequest,
+) (resp *grpc.UserResetPasswordResponse, err error) {
+	defer func() { log.Err(err).Int32("id", req.Id).Msg("UserServer.ResetPassword") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on UserServer.ResetPassword") }()
+
+	u, err := client.User.Get(ctx, int(req.Id))
+	if err != nil {
+		return
+	}
+
+	if err = auth.ResetPassword(ctx, client, u, req.Password); err != nil {
+		return
+	}
+
+	resp = &grpc.UserResetPasswordResponse{
+		Success: true,
+	}
+
+	return
+}
+
+func (s *UserServer) Transfer(
+	ctx context.Context,
+	req *grpc.UserTransferRequest,
+) (resp *grpc.UserTransferResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("UserServer.Transfer") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on UserServer.Transfer") }()
+
+	from, err := client.User.Get(ctx, int(req.FromId))
+	if err != nil {
+		return
+	}
+
+	to, err := client.User.Get(ctx, int(req.ToId))
+	if err != nil {
+		return
+	}
+
+	count, err := user.Transfer(ctx, client, from, to)
+	if err != nil {
+		return
+	}
+
+	resp = &grpc.UserTransferResponse{
+		FavoriteItemCount: int32(count.FavoriteItems),
+		FavoriteTagCount:  int32(count.FavoriteTags),
+		ProgressCount:     int32(count.Progress),
+		HistoryCount:      int32(count.Histories),
+	}
+
+	return
+}
+
+func (s *UserServer) SetRestrictions(
+	ctx context.Context,
+	req *grpc.UserSetRestrictionsRequest,
+) (resp *grpc.UserSetRestrictionsResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("UserServer.SetRestrictions") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on UserServer.SetRestrictions") }()
+
+	u, err := client.User.Get(ctx, int(req.Id))
+	if err != nil {
+		return
+	}
+
+	if u, err = user.SetRestrictions(ctx, client, u, restrictionsFromGrpc(req.Restrictions)); err != nil {
+		return
+	}
+
+	resp = &grpc.UserSetRestrictionsResponse{
+		Item: userItem(ctx, u),
+	}
+
+	return
+}
+
+func (s *UserServer) GetPreferences(
+	ctx context.Context,
+	req *grpc.UserGetPreferencesRequest,
+) (resp *grpc.UserGetPreferencesResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("UserServer.GetPreferences") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on UserServer.GetPreferences") }()
+
+	u, err := user.Current(ctx, client)
+	if err != nil {
+		return
+	}
+
+	// The upgraded document resolves to the same settings, and shows them
+	// as they will be stored on the next update.
+	doc := u.Preferences.Upgrade()
+	resp = &grpc.UserGetPreferencesResponse{
+		Version:     int32(doc.Version),
+		Preferences: preferencesToGrpc(doc.Settings),
+		Device:      preferencesToGrpc(doc.Devices[req.Device]),
+		Effective:   preferencesToGrpc(doc.Resolve(req.Device)),
+	}
+
+	return
+}
+
+func (s *UserServer) UpdatePreferences(
+	ctx context.Context,
+	req *grpc.UserUpdatePreferencesRequest,
+) (resp *grpc.UserUpdatePreferencesResponse, err error) {
+	defer func() { log.Err(err).Interface("request", req).Msg("UserServer.UpdatePreferences") }()
+
+	client := database.CreateEntClient()
+	defer func() { log.Err(client.Close()).Msg("database client close on UserServer.UpdatePreferences") }()
+
+	u, err := user.Current(ctx, client)
+	if err != nil {
+		return
+	}
+
+	u, err = user.UpdatePreferences(ctx, u, req.Device, preferencesFromGrpc(req.Preferences))
+	if err != nil {
+		return
+	}
+
+	doc := u.Preferences
+	resp = &grpc.UserUpdatePreferencesResponse{
+		Version:     int32(doc.Version),
+		Preferences: preferencesToGrpc(doc.Settings),
+		Device:      preferencesToGrpc(doc.Devices[req.Device]),
+		Effective:   preferencesToGrpc(doc.Resolve(req.Device)),
+	}
+
+	return
+}
+
+func userItem(ctx context.Context, u *ent.User) *grpc.UserItem {
+	restrictions := &grpc.UserRestrictions{
+		AllowedPaths: u.AllowedPaths,
+		DeniedTagIds: tagIDsToGrpc(u.QueryDeniedTags().IDsX(ctx)),
+	}
+
+	if u.MaxAgeRating != nil {
+		restrictions.HasMaxAgeRating = true
+		restrictions.MaxAgeRating = int32(*u.MaxAgeRating)
+	}
+
+	return &grpc.UserItem{
+		Id:           int32(u.ID),
+		Email:        u.Email,
+		Role:         user.RoleToGrpc(u.Role),
+		Active:       u.Active,
+		HasPassword:  u.PasswordHash != "",
+		Restrictions: restrictions,
+	}
+}
+
+// restrictionsFromGrpc converts the restrictions of a request. No message
+// lifts every restriction.
+func restrictionsFromGrpc(r *grpc.UserRestrictions) (out user.Restrictions) {
+	if r.GetHasMaxAgeRating() {
+		rating := int(r.MaxAgeRating)
+		out.MaxAgeRating = &rating
+	}
+
+	out.AllowedPaths = r.GetAllowedPaths()
+	out.DeniedTags = tagIDs(r.GetDeniedTagIds())
+
+	return
+}
+
+// preferencesToGrpc converts preference settings for a response. Unset
+// fields stay unspecified.
+func preferencesToGrpc(s preference.Settings) *grpc.UserPreferences {
+	out := &grpc.UserPreferences{
+		ReadingDirection: s.ReadingDirection,
+		ImageQuality:     s.ImageQuality,
+		ItemsPerPage:     int32(s.ItemsPerPage),
+		HiddenTags:       s.HiddenTags,
+	}
+
+	if s.Sort != nil {
+		out.HasSort = true
+		out.Sort = s.Sort.Field
+		out.Order = s.Sort.Order
+	}
+
+	return out
+}
+
+// preferencesFromGrpc converts the preference settings of a request.
+func preferencesFromGrpc(p *grpc.UserPreferences) preference.Settings {
+	out := preference.Settings{
+		ReadingDirection: p.GetReadingDirection(),
+		ImageQuality:     p.GetImageQuality(),
+		ItemsPerPage:     int(p.GetItemsPerPage()),
+		HiddenTags:       p.GetHiddenTags(),
+	}
+
+	if p.GetHasSort() {
+		out.Sort = &preference.Sort{Field: p.Sort, Order: p.Order}
+	}
+
+	return out
+}
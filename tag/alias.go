@@ -0,0 +1,286 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/mangaweb4/mangaweb4-backend/ent/metatag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tagalias"
+	"github.com/mangaweb4/mangaweb4-backend/ent/user"
+	"github.com/mangaweb4/mangaweb4-backend/fold"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Resolve finds the tag a name refers to. The name is matched exactly first,
+// then by its normalized form, and finally against tag aliases. Names only
+// match in the forms MANGAWEB_TAG_NORMALIZATION folds together.
+func Resolve(ctx context.Context, client *ent.Client, name string) (t *ent.Tag, err error) {
+	if t, err = Read(ctx, client, name); !ent.IsNotFound(err) {
+		return
+	}
+
+	key := Normalize(name)
+	if key != "" {
+		if t, err = client.Tag.Query().
+			Where(tag.NormalizedName(key)).
+			Order(tag.ByID()).
+			First(ctx); !ent.IsNotFound(err) {
+			return
+		}
+	}
+
+	return client.TagAlias.Query().
+		Where(tagalias.Or(tagalias.Name(name), tagalias.NormalizedName(key))).
+		Order(tagalias.ByID()).
+		QueryTag().
+		First(ctx)
+}
+
+// Create creates a new tag from a parsed name.
+func Create(ctx context.Context, client *ent.Client, p Parsed) (*ent.Tag, error) {
+	create := client.Tag.Create().
+		SetName(Clean(p.Name)).
+		SetNormalizedName(Normalize(p.Name))
+	if p.Category != "" {
+		create = create.SetCategory(p.Category)
+	}
+
+	return create.Save(ctx)
+}
+
+// Merge moves the items, the item exclusions, the users' favorites, blocks and
+// denials, and the aliases of the source tag to the target tag, records the
+// source name as an alias of the target and deletes the source.
+func Merge(ctx context.Context, client *ent.Client, source *ent.Tag, target *ent.Tag) (out *ent.Tag, err error) {
+	if source.ID == target.ID {
+		err = fmt.Errorf("cannot merge tag %d into itself", source.ID)
+		return
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			log.Err(tx.Rollback()).Msg("rollback merging tags")
+		}
+	}()
+
+	items, err := tx.MetaTag.Query().
+		Where(
+			metatag.TagID(source.ID),
+			metatag.HasMetaWith(meta.Not(meta.HasTagsWith(tag.ID(target.ID)))),
+		).
+		All(ctx)
+	if err != nil {
+		return
+	}
+
+	excludedIDs, err := tx.Meta.Query().
+		Where(
+			meta.HasExcludedTagsWith(tag.ID(source.ID)),
+			meta.Not(meta.HasExcludedTagsWith(tag.ID(target.ID))),
+		).
+		IDs(ctx)
+	if err != nil {
+		return
+	}
+
+	userIDs, err := tx.User.Query().
+		Where(
+			user.HasFavoriteTagsWith(tag.ID(source.ID)),
+			user.Not(user.HasFavoriteTagsWith(tag.ID(target.ID))),
+		).
+		IDs(ctx)
+	if err != nil {
+		return
+	}
+
+	blockedIDs, err := tx.User.Query().
+		Where(
+			user.HasBlockedTagsWith(tag.ID(source.ID)),
+			user.Not(user.HasBlockedTagsWith(tag.ID(target.ID))),
+		).
+		IDs(ctx)
+	if err != nil {
+		return
+	}
+
+	deniedIDs, err := tx.User.Query().
+		Where(
+			user.HasDeniedTagsWith(tag.ID(source.ID)),
+			user.Not(user.HasDeniedTagsWith(tag.ID(target.ID))),
+		).
+		IDs(ctx)
+	if err != nil {
+		return
+	}
+
+	if _, err = tx.TagAlias.Update().
+		Where(tagalias.HasTagWith(tag.ID(source.ID))).
+		SetTagID(target.ID).
+		Save(ctx); err != nil {
+		return
+	}
+
+	if err = tx.Tag.DeleteOneID(source.ID).Exec(ctx); err != nil {
+		return
+	}
+
+	if _, err = tx.TagAlias.Create().
+		SetName(source.Name).
+		SetNormalizedName(Normalize(source.Name)).
+		SetTagID(target.ID).
+		Save(ctx); err != nil {
+		return
+	}
+
+	if len(items) > 0 {
+		if err = tx.MetaTag.MapCreateBulk(items, func(c *ent.MetaTagCreate, i int) {
+			c.SetMetaID(items[i].MetaID).SetTagID(target.ID).SetSource(items[i].Source)
+		}).Exec(ctx); err != nil {
+			return
+		}
+	}
+
+	update := tx.Tag.UpdateOneID(target.ID).
+		AddExcludedFromIDs(excludedIDs...).
+		AddFavoriteOfUserIDs(userIDs...).
+		AddBlockedByUserIDs(blockedIDs...).
+		AddDeniedToUserIDs(deniedIDs...)
+	if source.LastUpdate.After(target.LastUpdate) {
+		update = update.SetLastUpdate(source.LastUpdate)
+	}
+	if target.Category == "" && source.Category != "" {
+		update = update.SetCategory(source.Category)
+	}
+
+	if out, err = update.Save(ctx); err != nil {
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		return
+	}
+
+	out = out.Unwrap()
+	return
+}
+
+// Rename changes the name of the tag and records the old name as an alias, so
+// that the tag is not created again from item names on the next scan. Renaming
+// to the name of another tag fails, such tags should be merged instead.
+func Rename(ctx context.Context, client *ent.Client, t *ent.Tag, name string) (out *ent.Tag, err error) {
+	name = Clean(name)
+	if name == "" {
+		err = fmt.Errorf("tag name must not be empty")
+		return
+	}
+
+	if name == t.Name {
+		out = t
+		return
+	}
+
+	other, err := client.Tag.Query().
+		Where(tag.IDNEQ(t.ID), tag.Or(tag.Name(name), tag.NormalizedName(Normalize(name)))).
+		First(ctx)
+	if err == nil {
+		err = fmt.Errorf("tag %q already exists, merge the tags instead", other.Name)
+		return
+	} else if !ent.IsNotFound(err) {
+		return
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			log.Err(tx.Rollback()).Msg("rollback renaming tag")
+		}
+	}()
+
+	if _, err = tx.TagAlias.Delete().
+		Where(tagalias.HasTagWith(tag.ID(t.ID)), tagalias.Name(name)).
+		Exec(ctx); err != nil {
+		return
+	}
+
+	if Normalize(t.Name) != Normalize(name) {
+		alias, e := tx.TagAlias.Query().Where(tagalias.Name(t.Name)).WithTag().Only(ctx)
+		switch {
+		case ent.IsNotFound(e):
+			err = tx.TagAlias.Create().
+				SetName(t.Name).
+				SetNormalizedName(Normalize(t.Name)).
+				SetTagID(t.ID).
+				Exec(ctx)
+		case e != nil:
+			err = e
+		case alias.Edges.Tag == nil || alias.Edges.Tag.ID != t.ID:
+			// The old name is already an alias of another tag, which would
+			// lose it.
+			err = status.Errorf(codes.AlreadyExists, "%q is already an alias of another tag", t.Name)
+		}
+
+		if err != nil {
+			return
+		}
+	}
+
+	if out, err = tx.Tag.UpdateOneID(t.ID).
+		SetName(name).
+		SetNormalizedName(Normalize(name)).
+		Save(ctx); err != nil {
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		return
+	}
+
+	out = out.Unwrap()
+	return
+}
+
+// UpdateNormalizedNames recomputes the normalized names and search keys of the
+// tags, and the normalized names of the aliases, for example after the
+// normalization policy has changed.
+func UpdateNormalizedNames(ctx context.Context, client *ent.Client) error {
+	tags, err := client.Tag.Query().All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range tags {
+		key, searchKey := Normalize(t.Name), fold.SearchKey(t.Name)
+		if key != t.NormalizedName || searchKey != t.SearchKey {
+			if err := t.Update().SetNormalizedName(key).SetSearchKey(searchKey).Exec(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	aliases, err := client.TagAlias.Query().All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, a := range aliases {
+		if key := Normalize(a.Name); key != a.NormalizedName {
+			if err := a.Update().SetNormalizedName(key).Exec(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
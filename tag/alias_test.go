@@ -0,0 +1,193 @@
+package tag
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mangaweb4/mangaweb4-backend/browse"
+	"github.com/mangaweb4/mangaweb4-backend/configuration"
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tagalias"
+	"github.com/mangaweb4/mangaweb4-backend/user"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AliasTestSuite struct {
+	suite.Suite
+}
+
+func TestAliasTestSuite(t *testing.T) {
+	suite.Run(t, new(AliasTestSuite))
+}
+
+func (s *AliasTestSuite) SetupTest() {
+	configuration.Init(configuration.Config{
+		TagNormalization: configuration.TagNormalization{
+			TrimSpace: true,
+			CaseFold:  true,
+			NFKC:      true,
+		},
+	})
+}
+
+func (s *AliasTestSuite) TestResolveNormalized() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	created, err := Create(context.Background(), client, Parsed{Name: "Artist Name "})
+	s.Assert().Nil(err)
+	s.Assert().Equal("Artist Name", created.Name)
+
+	resolved, err := Resolve(context.Background(), client, "artist  name")
+	s.Assert().Nil(err)
+	s.Assert().Equal(created.ID, resolved.ID)
+}
+
+func (s *AliasTestSuite) TestResolveFollowsNormalization() {
+	configuration.Init(configuration.Config{})
+
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	created, err := Create(ctx, client, Parsed{Name: "Artist"})
+	s.Assert().Nil(err)
+
+	// Without normalization, other forms of the name are separate tags.
+	for _, name := range []string{"artist", "Ａｒｔｉｓｔ"} {
+		_, err = Resolve(ctx, client, name)
+		s.Assert().True(ent.IsNotFound(err), name)
+	}
+
+	resolved, err := Resolve(ctx, client, "Artist")
+	s.Assert().Nil(err)
+	s.Assert().Equal(created.ID, resolved.ID)
+}
+
+func (s *AliasTestSuite) TestMerge() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	u, err := user.GetUser(ctx, client, "")
+	s.Assert().Nil(err)
+
+	source, err := Create(ctx, client, Parsed{Name: "ArtistName", Category: tag.CategoryArtist})
+	s.Assert().Nil(err)
+	target, err := Create(ctx, client, Parsed{Name: "Artist Name"})
+	s.Assert().Nil(err)
+
+	_, err = client.Meta.Create().SetName("[ArtistName]manga 1.zip").AddTags(source).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("[ArtistName][Artist Name]manga 2.zip").AddTags(source, target).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = u.Update().AddFavoriteTags(source).Save(ctx)
+	s.Assert().Nil(err)
+	blocker, err := user.GetUser(ctx, client, "blocker@example.com")
+	s.Assert().Nil(err)
+	s.Assert().Nil(blocker.Update().AddBlockedTags(source).Exec(ctx))
+	restricted, err := user.GetUser(ctx, client, "restricted@example.com")
+	s.Assert().Nil(err)
+	restricted, err = user.SetRestrictions(ctx, client, restricted, user.Restrictions{DeniedTags: []int{source.ID}})
+	s.Assert().Nil(err)
+
+	merged, err := Merge(ctx, client, source, target)
+	s.Assert().Nil(err)
+	s.Assert().Equal(target.ID, merged.ID)
+	s.Assert().Equal(tag.CategoryArtist, merged.Category)
+
+	s.Assert().Equal(2, merged.QueryMeta().CountX(ctx))
+	s.Assert().True(u.QueryFavoriteTags().Where(tag.ID(target.ID)).ExistX(ctx))
+	s.Assert().True(blocker.QueryBlockedTags().Where(tag.ID(target.ID)).ExistX(ctx))
+	s.Assert().False(client.Meta.Query().Where(browse.AllowedItems(restricted)).ExistX(ctx))
+	s.Assert().False(client.Tag.Query().Where(tag.ID(source.ID)).ExistX(ctx))
+
+	resolved, err := Resolve(ctx, client, "artistname")
+	s.Assert().Nil(err)
+	s.Assert().Equal(target.ID, resolved.ID)
+}
+
+func (s *AliasTestSuite) TestMergeIntoItself() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	t, err := Create(context.Background(), client, Parsed{Name: "Artist"})
+	s.Assert().Nil(err)
+
+	_, err = Merge(context.Background(), client, t, t)
+	s.Assert().Error(err)
+}
+
+func (s *AliasTestSuite) TestRename() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	t, err := Create(ctx, client, Parsed{Name: "ArtistName"})
+	s.Assert().Nil(err)
+
+	renamed, err := Rename(ctx, client, t, " Artist  Name")
+	s.Assert().Nil(err)
+	s.Assert().Equal(t.ID, renamed.ID)
+	s.Assert().Equal("Artist Name", renamed.Name)
+
+	resolved, err := Resolve(ctx, client, "ArtistName")
+	s.Assert().Nil(err)
+	s.Assert().Equal(t.ID, resolved.ID)
+
+	renamed, err = Rename(ctx, client, renamed, "ArtistName")
+	s.Assert().Nil(err)
+	s.Assert().Equal("ArtistName", renamed.Name)
+	s.Assert().Equal([]string{"Artist Name"}, renamed.QueryAliases().Select(tagalias.FieldName).StringsX(ctx))
+}
+
+func (s *AliasTestSuite) TestRenameToExistingTag() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	t, err := Create(ctx, client, Parsed{Name: "ArtistName"})
+	s.Assert().Nil(err)
+	_, err = Create(ctx, client, Parsed{Name: "Artist Name"})
+	s.Assert().Nil(err)
+
+	_, err = Rename(ctx, client, t, "artist name")
+	s.Assert().NotNil(err)
+}
+
+func (s *AliasTestSuite) TestRenameKeepsAliasOfOtherTag() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	owner, err := Create(ctx, client, Parsed{Name: "Owner"})
+	s.Assert().Nil(err)
+	_, err = client.TagAlias.Create().SetName("Foo").SetNormalizedName(Normalize("Foo")).SetTag(owner).Save(ctx)
+	s.Assert().Nil(err)
+
+	t, err := Create(ctx, client, Parsed{Name: "Foo"})
+	s.Assert().Nil(err)
+
+	_, err = Rename(ctx, client, t, "Bar")
+	s.Assert().Equal(codes.AlreadyExists, status.Code(err))
+
+	t, err = client.Tag.Get(ctx, t.ID)
+	s.Assert().Nil(err)
+	s.Assert().Equal("Foo", t.Name)
+	s.Assert().Equal([]string{"Foo"}, owner.QueryAliases().Select(tagalias.FieldName).StringsX(ctx))
+}
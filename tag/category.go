@@ -0,0 +1,46 @@
+package tag
+
+import (
+	"github.com/mangaweb4/mangaweb4-backend/ent/metatag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+)
+
+var categoryToGrpc = map[tag.Category]grpc.TagCategory{
+	tag.CategoryArtist:   grpc.TagCategory_TAG_CATEGORY_ARTIST,
+	tag.CategoryCircle:   grpc.TagCategory_TAG_CATEGORY_CIRCLE,
+	tag.CategoryEvent:    grpc.TagCategory_TAG_CATEGORY_EVENT,
+	tag.CategoryParody:   grpc.TagCategory_TAG_CATEGORY_PARODY,
+	tag.CategoryLanguage: grpc.TagCategory_TAG_CATEGORY_LANGUAGE,
+	tag.CategoryGroup:    grpc.TagCategory_TAG_CATEGORY_GROUP,
+}
+
+// CategoryToGrpc converts a tag category into its gRPC value. Uncategorized
+// tags map to TAG_CATEGORY_UNSPECIFIED.
+func CategoryToGrpc(c tag.Category) grpc.TagCategory {
+	return categoryToGrpc[c]
+}
+
+// CategoryFromGrpc converts a gRPC tag category into its database value.
+// TAG_CATEGORY_UNSPECIFIED maps to an empty category.
+func CategoryFromGrpc(c grpc.TagCategory) tag.Category {
+	for k, v := range categoryToGrpc {
+		if v == c {
+			return k
+		}
+	}
+
+	return ""
+}
+
+var sourceToGrpc = map[metatag.Source]grpc.TagSource{
+	metatag.SourceParsed:    grpc.TagSource_TAG_SOURCE_PARSED,
+	metatag.SourceComicInfo: grpc.TagSource_TAG_SOURCE_COMIC_INFO,
+	metatag.SourceManual:    grpc.TagSource_TAG_SOURCE_MANUAL,
+}
+
+// SourceToGrpc converts the source of an item–tag association into its gRPC
+// value.
+func SourceToGrpc(s metatag.Source) grpc.TagSource {
+	return sourceToGrpc[s]
+}
@@ -0,0 +1,36 @@
+package tag
+
+import (
+	"strings"
+
+	"github.com/mangaweb4/mangaweb4-backend/configuration"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Clean returns the name a new tag is stored with. When whitespace trimming is
+// enabled, surrounding whitespace is removed and inner whitespace collapsed.
+func Clean(name string) string {
+	c := configuration.Get()
+	if c.TagNormalization.TrimSpace {
+		name = strings.Join(strings.Fields(name), " ")
+	}
+
+	return name
+}
+
+// Normalize returns the key used to decide whether two tag names refer to the
+// same tag, according to the configured normalization policy.
+func Normalize(name string) string {
+	c := configuration.Get()
+
+	name = Clean(name)
+	if c.TagNormalization.NFKC {
+		name = norm.NFKC.String(name)
+	}
+	if c.TagNormalization.CaseFold {
+		name = cases.Fold().String(name)
+	}
+
+	return name
+}
@@ -0,0 +1,54 @@
+package tag
+
+import (
+	"testing"
+
+	"github.com/mangaweb4/mangaweb4-backend/configuration"
+	"github.com/stretchr/testify/suite"
+)
+
+type NormalizeTestSuite struct {
+	suite.Suite
+}
+
+func TestNormalizeTestSuite(t *testing.T) {
+	suite.Run(t, new(NormalizeTestSuite))
+}
+
+func (s *NormalizeTestSuite) SetupTest() {
+	configuration.Init(configuration.Config{
+		TagNormalization: configuration.TagNormalization{
+			TrimSpace: true,
+			CaseFold:  true,
+			NFKC:      true,
+		},
+	})
+}
+
+func (s *NormalizeTestSuite) TestCaseFold() {
+	s.Assert().Equal(Normalize("Artist Name"), Normalize("artist name"))
+}
+
+func (s *NormalizeTestSuite) TestTrimSpace() {
+	s.Assert().Equal("artist name", Normalize("  Artist   Name "))
+	s.Assert().Equal("Artist Name", Clean("  Artist   Name "))
+}
+
+func (s *NormalizeTestSuite) TestNFKC() {
+	s.Assert().Equal(Normalize("Artist"), Normalize("Ａｒｔｉｓｔ"))
+}
+
+func (s *NormalizeTestSuite) TestDisabled() {
+	configuration.Init(configuration.Config{})
+
+	s.Assert().Equal(" Artist ", Normalize(" Artist "))
+}
+
+func (s *NormalizeTestSuite) TestParseTagNormalization() {
+	n, err := configuration.ParseTagNormalization("trim, casefold")
+	s.Assert().Nil(err)
+	s.Assert().Equal(configuration.TagNormalization{TrimSpace: true, CaseFold: true}, n)
+
+	_, err = configuration.ParseTagNormalization("trim,unknown")
+	s.Assert().Error(err)
+}
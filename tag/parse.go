@@ -1,23 +1,94 @@
 package tag
 
 import (
+	"fmt"
 	"os"
 	"regexp"
+	"slices"
 	"strings"
+	"sync"
 
 	"github.com/mangaweb4/mangaweb4-backend/configuration"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	"github.com/rs/zerolog/log"
 )
 
-var regex *regexp.Regexp
+// DefaultRules are used when no tag rules are configured. They extract every
+// `[...]` group of the name as an uncategorized tag.
+var DefaultRules = []configuration.TagRule{
+	{Pattern: "\\[(.*?)\\]"},
+}
+
+// Rule is a compiled configuration.TagRule.
+type Rule struct {
+	Regex    *regexp.Regexp
+	Category tag.Category
+}
+
+// Parsed is a tag name extracted from an item name, with the category of the
+// rule that found it.
+type Parsed struct {
+	Name     string
+	Category tag.Category
+}
+
+var (
+	rulesMutex  sync.Mutex
+	rulesSource []configuration.TagRule
+	rules       []Rule
+)
+
+// CompileRules validates and compiles the tag rules. An empty list compiles to
+// DefaultRules.
+func CompileRules(source []configuration.TagRule) (out []Rule, err error) {
+	if len(source) == 0 {
+		source = DefaultRules
+	}
+
+	out = make([]Rule, len(source))
+	for i, r := range source {
+		regex, e := regexp.Compile(r.Pattern)
+		if e != nil {
+			err = fmt.Errorf("tag rule %d: %w", i, e)
+			return
+		}
+
+		category := tag.Category(r.Category)
+		if category != "" {
+			if e := tag.CategoryValidator(category); e != nil {
+				err = fmt.Errorf("tag rule %d: %w", i, e)
+				return
+			}
+		}
+
+		out[i] = Rule{
+			Regex:    regex,
+			Category: category,
+		}
+	}
+
+	return
+}
+
+func currentRules() []Rule {
+	rulesMutex.Lock()
+	defer rulesMutex.Unlock()
 
-func init() {
-	const pattern = "\\[(.*?)\\]"
-	var err error
-	regex, err = regexp.Compile(pattern)
+	c := configuration.Get()
+	if rules != nil && slices.Equal(rulesSource, c.TagRules) {
+		return rules
+	}
 
+	compiled, err := CompileRules(c.TagRules)
 	if err != nil {
-		panic(err)
+		log.Error().Err(err).Msg("Invalid tag rules, use the default rules instead.")
+		compiled, _ = CompileRules(nil)
 	}
+
+	rulesSource = slices.Clone(c.TagRules)
+	rules = compiled
+
+	return rules
 }
 
 func parseFirstLevelDirTag(name string) string {
@@ -30,30 +101,57 @@ func parseFirstLevelDirTag(name string) string {
 	return before
 }
 
-func ParseTag(name string) []string {
+func matchName(regex *regexp.Regexp, match []string) string {
+	if i := regex.SubexpIndex("tag"); i > 0 {
+		return match[i]
+	}
+
+	if len(match) > 1 {
+		return match[1]
+	}
+
+	return match[0]
+}
+
+// Parse extracts tags from an item name by applying the configured rules in
+// order. A tag found by more than one rule keeps the category of the first.
+func Parse(name string) []Parsed {
 	c := configuration.Get()
 
-	matches := regex.FindAllStringSubmatch(name, -1)
 	tagSet := make(map[string]bool)
-	output := make([]string, 0)
+	output := make([]Parsed, 0)
 
 	if c.FirstLevelDirAsTag {
-		if tag := parseFirstLevelDirTag(name); tag != "" {
-			output = append(output, tag)
+		if t := parseFirstLevelDirTag(name); t != "" {
+			tagSet[t] = true
+			output = append(output, Parsed{Name: t})
 		}
 	}
 
-	for _, match := range matches {
-		tag := match[1]
-		if tag == "" {
-			continue
-		}
+	for _, rule := range currentRules() {
+		for _, match := range rule.Regex.FindAllStringSubmatch(name, -1) {
+			t := matchName(rule.Regex, match)
+			if t == "" {
+				continue
+			}
 
-		if _, found := tagSet[tag]; !found {
-			tagSet[tag] = true
-			output = append(output, tag)
+			if _, found := tagSet[t]; !found {
+				tagSet[t] = true
+				output = append(output, Parsed{Name: t, Category: rule.Category})
+			}
 		}
 	}
 
 	return output
 }
+
+func ParseTag(name string) []string {
+	parsed := Parse(name)
+
+	output := make([]string, len(parsed))
+	for i, p := range parsed {
+		output[i] = p.Name
+	}
+
+	return output
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/mangaweb4/mangaweb4-backend/configuration"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -86,3 +87,57 @@ func (s *ParseTagFirstLevelTestSuite) TestWithTwoLevel() {
 		ParseTag(strings.Join([]string{"dir1", "dir2", "[Test]Some weird name"}, string(os.PathSeparator))),
 		[]string{"dir1", "Test"})
 }
+
+type ParseTagRulesTestSuite struct {
+	suite.Suite
+}
+
+func TestParseTagRulesTestSuite(t *testing.T) {
+	suite.Run(t, new(ParseTagRulesTestSuite))
+}
+
+func (suite *ParseTagRulesTestSuite) SetupSuite() {
+	configuration.Init(configuration.Config{
+		TagRules: []configuration.TagRule{
+			{Pattern: "\\((C\\d+)\\)", Category: "event"},
+			{Pattern: "\\[(.*?)\\]", Category: "artist"},
+			{Pattern: "\\{(?P<tag>.*?)\\}", Category: "group"},
+			{Pattern: "\\((.*?)\\)", Category: "parody"},
+		},
+	})
+}
+
+func (s *ParseTagRulesTestSuite) TestCategories() {
+	s.Assert().Equal(
+		[]Parsed{
+			{Name: "C99", Category: tag.CategoryEvent},
+			{Name: "Artist", Category: tag.CategoryArtist},
+			{Name: "Translator", Category: tag.CategoryGroup},
+			{Name: "Some Parody", Category: tag.CategoryParody},
+		},
+		Parse("(C99) [Artist] Some weird name (Some Parody) {Translator}"))
+}
+
+func (s *ParseTagRulesTestSuite) TestFirstRuleWins() {
+	s.Assert().Equal(
+		[]Parsed{
+			{Name: "C99", Category: tag.CategoryEvent},
+		},
+		Parse("(C99) Some weird name [C99]"))
+}
+
+func (s *ParseTagRulesTestSuite) TestParseTag() {
+	s.Assert().ElementsMatch(
+		ParseTag("(C99) [Artist] Some weird name"),
+		[]string{"C99", "Artist"})
+}
+
+func (s *ParseTagRulesTestSuite) TestCompileRulesInvalidPattern() {
+	_, err := CompileRules([]configuration.TagRule{{Pattern: "(unclosed"}})
+	s.Assert().Error(err)
+}
+
+func (s *ParseTagRulesTestSuite) TestCompileRulesInvalidCategory() {
+	_, err := CompileRules([]configuration.TagRule{{Pattern: "\\[(.*?)\\]", Category: "unknown"}})
+	s.Assert().Error(err)
+}
@@ -3,11 +3,15 @@ package tag
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"github.com/mangaweb4/mangaweb4-backend/browse"
 	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/metatag"
 	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
 	"github.com/mangaweb4/mangaweb4-backend/ent/user"
+	"github.com/mangaweb4/mangaweb4-backend/fold"
 	"github.com/mangaweb4/mangaweb4-backend/grpc"
 )
 
@@ -26,6 +30,12 @@ func Read(ctx context.Context, client *ent.Client, name string) (t *ent.Tag, err
 	return client.Tag.Query().Where(tag.Name(name)).First(ctx)
 }
 
+// ReadAllowed returns the tag with the ID, which is not found when the
+// restrictions of the user hide it.
+func ReadAllowed(ctx context.Context, client *ent.Client, u *ent.User, id int) (t *ent.Tag, err error) {
+	return client.Tag.Query().Where(tag.ID(id), browse.AllowedTags(u)).Only(ctx)
+}
+
 func ReadAll(ctx context.Context, client *ent.Client) (tags []*ent.Tag, err error) {
 	return client.Tag.Query().Order(tag.ByName()).All(ctx)
 }
@@ -37,6 +47,10 @@ type QueryParams struct {
 	ItemPerPage int
 	Sort        grpc.SortField
 	Order       grpc.SortOrder
+	Category    tag.Category
+	// Cursor continues the listing after the page it was returned with. Page
+	// is ignored when it is set.
+	Cursor string
 }
 
 func CreateQuery(
@@ -44,18 +58,20 @@ func CreateQuery(
 	u *ent.User,
 	params QueryParams,
 ) (query *ent.TagQuery, err error) {
-	query = client.Tag.Query()
-	if params.ItemPerPage > 0 {
-		query = query.Limit(params.ItemPerPage).
-			Offset(params.Page * params.ItemPerPage)
+	if err = browse.CheckFilter(u, params.Filter); err != nil {
+		return
 	}
 
+	query = client.Tag.Query()
+
 	switch params.Filter {
-	case grpc.Filter_FILTER_UNKNOWN:
-		break
+	case grpc.Filter_FILTER_UNKNOWN,
+		grpc.Filter_FILTER_HIDDEN,
+		grpc.Filter_FILTER_BLOCKED:
+		query = query.Where(browse.Tags(u, params.Filter))
 
 	case grpc.Filter_FILTER_FAVORITE_TAGS:
-		query = query.Where(tag.HasFavoriteOfUserWith(user.ID(u.ID)))
+		query = query.Where(browse.Tags(u, params.Filter), tag.HasFavoriteOfUserWith(user.ID(u.ID)))
 
 	default:
 		query = nil
@@ -64,38 +80,91 @@ func CreateQuery(
 	}
 
 	if params.Search != "" {
-		query = query.Where(tag.NameContainsFold(params.Search))
+		query = query.Where(tag.SearchKeyContains(fold.SearchKey(params.Search)))
+	}
+
+	if params.Category != "" {
+		query = query.Where(tag.CategoryEQ(params.Category))
+	}
+
+	keys, err := SortKeys(params)
+	if err != nil {
+		query = nil
+		return
+	}
+
+	query = query.Order(browse.Order(keys...))
+
+	if params.Cursor != "" {
+		values, e := browse.DecodeCursor(params.Cursor, params.Sort, params.Order, len(keys))
+		if e != nil {
+			query = nil
+			err = e
+			return
+		}
+
+		query = query.Where(browse.After(values, keys...))
+		if params.ItemPerPage > 0 {
+			query = query.Limit(params.ItemPerPage)
+		}
+	} else if params.ItemPerPage > 0 {
+		query = query.Limit(params.ItemPerPage).
+			Offset(params.Page * params.ItemPerPage)
 	}
 
+	return
+}
+
+// SortKeys returns the sort keys of a tag listing, ending with the ID.
+func SortKeys(params QueryParams) (keys []browse.SortKey, err error) {
+	desc := params.Order != grpc.SortOrder_SORT_ORDER_ASCENDING
+
 	switch params.Sort {
 	case grpc.SortField_SORT_FIELD_NAME:
-		if params.Order == grpc.SortOrder_SORT_ORDER_ASCENDING {
-			query = query.Order(tag.ByName(sql.OrderAsc()))
-		} else {
-			query = query.Order(tag.ByName(sql.OrderDesc()))
-		}
+		keys = []browse.SortKey{browse.ColumnKey(tag.FieldName, desc)}
 	case grpc.SortField_SORT_FIELD_ITEMCOUNT:
-		if params.Order == grpc.SortOrder_SORT_ORDER_ASCENDING {
-			query = query.Order(tag.ByMetaCount(sql.OrderAsc()))
-		} else {
-			query = query.Order(tag.ByMetaCount(sql.OrderDesc()))
-		}
+		keys = []browse.SortKey{itemCountKey(desc)}
 	case grpc.SortField_SORT_FIELD_LAST_UPDATE:
-		if params.Order == grpc.SortOrder_SORT_ORDER_ASCENDING {
-			query = query.Order(tag.ByLastUpdate(sql.OrderAsc()))
-		} else {
-			query = query.Order(tag.ByLastUpdate(sql.OrderDesc()))
-		}
+		keys = []browse.SortKey{browse.CoalesceKey(tag.FieldLastUpdate, time.Time{}, desc)}
 
 	default:
-		query = nil
 		err = fmt.Errorf("invalid sort value: %v", params.Sort)
 		return
 	}
 
+	keys = append(keys, browse.ColumnKey(tag.FieldID, desc))
 	return
 }
 
+// NextCursor returns the cursor of the page after tags, which were read with
+// params. It is empty when there are no more tags.
+func NextCursor(tags []*ent.Tag, params QueryParams) (cursor string, err error) {
+	keys, err := SortKeys(params)
+	if err != nil {
+		return
+	}
+
+	return browse.NextCursor(tags, params.ItemPerPage, len(keys), params.Sort, params.Order)
+}
+
+// itemCountKey orders the tags by the number of items they are on.
+func itemCountKey(desc bool) browse.SortKey {
+	return browse.SortKey{
+		Expr: func(s *sql.Selector) sql.Querier {
+			t := sql.Table(metatag.Table)
+			count := sql.Dialect(s.Dialect()).
+				Select(sql.Count("*")).
+				From(t).
+				Where(sql.ColumnsEQ(t.C(metatag.TagColumn), s.C(tag.FieldID)))
+
+			return sql.ExprFunc(func(b *sql.Builder) {
+				b.Wrap(func(b *sql.Builder) { b.Join(count) })
+			})
+		},
+		Desc: desc,
+	}
+}
+
 func ReadPage(
 	ctx context.Context,
 	client *ent.Client,
@@ -126,8 +195,18 @@ func Count(
 func Write(ctx context.Context, client *ent.Client, t *ent.Tag) error {
 	return client.Tag.Create().
 		SetName(t.Name).
+		SetNormalizedName(Normalize(t.Name)).
 		SetHidden(t.Hidden).
+		SetNillableCategory(nillableCategory(t.Category)).
 		OnConflict(sql.ConflictColumns(tag.FieldName)).
 		UpdateNewValues().
 		Exec(ctx)
 }
+
+func nillableCategory(c tag.Category) *tag.Category {
+	if c == "" {
+		return nil
+	}
+
+	return &c
+}
@@ -2,23 +2,29 @@ package tag
 
 import (
 	"context"
-	"fmt"
+	"time"
 
-	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/mangaweb4/mangaweb4-backend/browse"
 	"github.com/mangaweb4/mangaweb4-backend/ent"
 	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
 	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
 	"github.com/mangaweb4/mangaweb4-backend/ent/user"
+	"github.com/mangaweb4/mangaweb4-backend/fold"
 	"github.com/mangaweb4/mangaweb4-backend/grpc"
 )
 
 type QueryMetaParams struct {
-	SearchName  string
-	SortBy      grpc.SortField
-	SortOrder   grpc.SortOrder
+	SearchName string
+	SortBy     grpc.SortField
+	SortOrder  grpc.SortOrder
+	// RandomSeed selects the order of SORT_FIELD_RANDOM.
+	RandomSeed  int64
 	Filter      grpc.Filter
 	Page        int
 	ItemPerPage int
+	// Cursor continues the listing after the page it was returned with. Page
+	// is ignored when it is set.
+	Cursor string
 }
 
 func CreateMetaQuery(
@@ -28,10 +34,14 @@ func CreateMetaQuery(
 	u *ent.User,
 	q QueryMetaParams,
 ) (query *ent.MetaQuery, err error) {
-	query = t.QueryMeta().Where(meta.Active(true), meta.Hidden(false))
+	if err = browse.CheckFilter(u, q.Filter); err != nil {
+		return
+	}
+
+	query = t.QueryMeta().Where(browse.Items(u, q.Filter))
 
 	if q.SearchName != "" {
-		query = query.Where(meta.NameContainsFold(q.SearchName))
+		query = query.Where(meta.SearchKeyContains(fold.SearchKey(q.SearchName)))
 	}
 
 	switch q.Filter {
@@ -43,37 +53,31 @@ func CreateMetaQuery(
 		query = query.Where(
 			meta.HasTagsWith(tag.HasFavoriteOfUserWith(user.ID(u.ID))),
 		)
+	default:
+		if reading := browse.ReadingItems(u, q.Filter, time.Now()); reading != nil {
+			query = query.Where(reading)
+		}
 	}
 
-	field := ""
-	switch q.SortBy {
-	case grpc.SortField_SORT_FIELD_NAME:
-		field = meta.FieldName
-	case grpc.SortField_SORT_FIELD_CREATION_TIME:
-		field = meta.FieldCreateTime
-	case grpc.SortField_SORT_FIELD_PAGECOUNT:
-		field = meta.FieldFileIndices
-
-	default:
-		err = fmt.Errorf("invalid filter value: %v", q.SortBy)
+	keys, err := browse.ItemKeys(u, q.SortBy, q.SortOrder, q.RandomSeed)
+	if err != nil {
+		return
 	}
 
-	switch q.SortOrder {
-	case grpc.SortOrder_SORT_ORDER_ASCENDING:
-		if q.SortBy == grpc.SortField_SORT_FIELD_PAGECOUNT {
-			query = query.Order(sqljson.OrderLen(meta.FieldFileIndices)).Unique(false)
-		} else {
-			query = query.Order(ent.Asc(string(field)))
-		}
-	case grpc.SortOrder_SORT_ORDER_DESCENDING:
-		if q.SortBy == grpc.SortField_SORT_FIELD_PAGECOUNT {
-			query = query.Order(sqljson.OrderLenDesc(meta.FieldFileIndices)).Unique(false)
-		} else {
-			query = query.Order(ent.Desc(string(field)))
+	query = query.Order(browse.Order(keys...)).Unique(false)
+
+	if q.Cursor != "" {
+		values, e := browse.DecodeCursor(q.Cursor, q.SortBy, q.SortOrder, len(keys))
+		if e != nil {
+			err = e
+			return
 		}
-	}
 
-	if q.ItemPerPage > 0 {
+		query = query.Where(browse.After(values, keys...))
+		if q.ItemPerPage > 0 {
+			query = query.Limit(q.ItemPerPage)
+		}
+	} else if q.ItemPerPage > 0 {
 		query = query.Limit(q.ItemPerPage).Offset(q.ItemPerPage * q.Page)
 	}
 
@@ -109,3 +113,14 @@ func MetaCount(
 
 	return query.Count(ctx)
 }
+
+// NextMetaCursor returns the cursor of the page after items, which were read
+// with q. It is empty when there are no more items.
+func NextMetaCursor(u *ent.User, items []*ent.Meta, q QueryMetaParams) (cursor string, err error) {
+	keys, err := browse.ItemKeys(u, q.SortBy, q.SortOrder, q.RandomSeed)
+	if err != nil {
+		return
+	}
+
+	return browse.NextCursor(items, q.ItemPerPage, len(keys), q.SortBy, q.SortOrder)
+}
@@ -3,14 +3,19 @@ package tag
 import (
 	"context"
 	"database/sql"
+	"path/filepath"
 	"testing"
 
 	dialect_sql "entgo.io/ent/dialect/sql"
 	"github.com/mangaweb4/mangaweb4-backend/ent"
 	"github.com/mangaweb4/mangaweb4-backend/ent/enttest"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	ent_user "github.com/mangaweb4/mangaweb4-backend/ent/user"
 	"github.com/mangaweb4/mangaweb4-backend/grpc"
 	"github.com/mangaweb4/mangaweb4-backend/user"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	_ "modernc.org/sqlite"
 )
 
@@ -22,7 +27,7 @@ func TestProviderTestSuite(t *testing.T) {
 	suite.Run(t, new(QueryTestSuite))
 }
 
-func createTestDBClient(s *QueryTestSuite) (db *sql.DB, client *ent.Client, err error) {
+func createTestDBClient(s suite.TestingSuite) (db *sql.DB, client *ent.Client, err error) {
 	db, err = sql.Open("sqlite", "file:ent?mode=memory&_fk=1&_pragma=foreign_keys(1)")
 	if err != nil {
 		return
@@ -258,3 +263,244 @@ func (s *QueryTestSuite) TestCountWithSearchFilterFavoriteTags() {
 	s.Assert().Nil(err)
 	s.Assert().Equal(1, c)
 }
+
+func (s *QueryTestSuite) TestReadPageWithCategory() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	s.Assert().NotNil(db)
+	s.Assert().NotNil(client)
+	defer func() { s.T().Log("database close", db.Close()) }()
+	defer func() { s.T().Log("database client close", db.Close()) }()
+
+	_, err = client.Tag.Create().SetName("Tag 1").SetCategory(tag.CategoryArtist).Save(context.Background())
+	s.Assert().Nil(err)
+	_, err = client.Tag.Create().SetName("Tag 2").SetCategory(tag.CategoryEvent).Save(context.Background())
+	s.Assert().Nil(err)
+	_, err = client.Tag.Create().SetName("Tag 3").Save(context.Background())
+	s.Assert().Nil(err)
+
+	u, err := user.GetUser(context.Background(), client, "")
+	s.Assert().Nil(err)
+
+	tags, err := ReadPage(context.Background(), client, u,
+		QueryParams{
+			Filter:      grpc.Filter_FILTER_UNKNOWN,
+			Page:        0,
+			ItemPerPage: 30,
+			Category:    CategoryFromGrpc(grpc.TagCategory_TAG_CATEGORY_ARTIST),
+		})
+
+	s.Assert().Nil(err)
+	s.Assert().Equal(1, len(tags))
+	s.Assert().Equal("Tag 1", tags[0].Name)
+	s.Assert().Equal(grpc.TagCategory_TAG_CATEGORY_ARTIST, CategoryToGrpc(tags[0].Category))
+}
+
+func (s *QueryTestSuite) TestReadPageHidden() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	s.Assert().NotNil(db)
+	s.Assert().NotNil(client)
+	defer func() { s.T().Log("database close", db.Close()) }()
+	defer func() { s.T().Log("database client close", db.Close()) }()
+
+	_, err = client.Tag.Create().SetName("Tag 1").Save(context.Background())
+	s.Assert().Nil(err)
+	_, err = client.Tag.Create().SetName("Tag 2").SetHidden(true).Save(context.Background())
+	s.Assert().Nil(err)
+
+	u, err := user.GetUser(context.Background(), client, "")
+	s.Assert().Nil(err)
+
+	tags, err := ReadPage(context.Background(), client, u,
+		QueryParams{
+			Filter:      grpc.Filter_FILTER_UNKNOWN,
+			Page:        0,
+			ItemPerPage: 30,
+		})
+
+	s.Assert().Nil(err)
+	s.Assert().Equal(1, len(tags))
+	s.Assert().Equal("Tag 1", tags[0].Name)
+
+	// Only editors can list what is hidden.
+	_, err = ReadPage(context.Background(), client, u, QueryParams{Filter: grpc.Filter_FILTER_HIDDEN})
+	s.Assert().Equal(codes.PermissionDenied, status.Code(err))
+
+	editor, err := user.Create(context.Background(), client, "editor@example.com", ent_user.RoleEditor)
+	s.Assert().Nil(err)
+
+	tags, err = ReadPage(context.Background(), client, editor,
+		QueryParams{
+			Filter:      grpc.Filter_FILTER_HIDDEN,
+			Page:        0,
+			ItemPerPage: 30,
+		})
+
+	s.Assert().Nil(err)
+	s.Assert().Equal(1, len(tags))
+	s.Assert().Equal("Tag 2", tags[0].Name)
+}
+
+func (s *QueryTestSuite) TestReadPageBlocked() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	s.Assert().NotNil(db)
+	s.Assert().NotNil(client)
+	defer func() { s.T().Log("database close", db.Close()) }()
+	defer func() { s.T().Log("database client close", db.Close()) }()
+
+	u, err := user.GetUser(context.Background(), client, "reader")
+	s.Assert().Nil(err)
+	other, err := user.GetUser(context.Background(), client, "other")
+	s.Assert().Nil(err)
+
+	_, err = client.Tag.Create().SetName("Tag 1").Save(context.Background())
+	s.Assert().Nil(err)
+	_, err = client.Tag.Create().SetName("Tag 2").AddBlockedByUser(u).Save(context.Background())
+	s.Assert().Nil(err)
+
+	tags, err := ReadPage(context.Background(), client, u,
+		QueryParams{
+			Filter:      grpc.Filter_FILTER_UNKNOWN,
+			Page:        0,
+			ItemPerPage: 30,
+		})
+
+	s.Assert().Nil(err)
+	s.Assert().Equal(1, len(tags))
+	s.Assert().Equal("Tag 1", tags[0].Name)
+
+	tags, err = ReadPage(context.Background(), client, u,
+		QueryParams{
+			Filter:      grpc.Filter_FILTER_BLOCKED,
+			Page:        0,
+			ItemPerPage: 30,
+		})
+
+	s.Assert().Nil(err)
+	s.Assert().Equal(1, len(tags))
+	s.Assert().Equal("Tag 2", tags[0].Name)
+
+	c, err := Count(context.Background(), client, other,
+		QueryParams{
+			Filter: grpc.Filter_FILTER_UNKNOWN,
+		})
+
+	s.Assert().Nil(err)
+	s.Assert().Equal(2, c)
+}
+
+func (s *QueryTestSuite) TestReadPageCursor() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	for _, name := range []string{"Tag 3", "Tag 1", "Tag 5", "Tag 2", "Tag 4"} {
+		_, err = client.Tag.Create().SetName(name).Save(ctx)
+		s.Assert().Nil(err)
+	}
+
+	u, err := user.GetUser(ctx, client, "")
+	s.Assert().Nil(err)
+
+	for _, sort := range []grpc.SortField{
+		grpc.SortField_SORT_FIELD_NAME,
+		grpc.SortField_SORT_FIELD_ITEMCOUNT,
+		grpc.SortField_SORT_FIELD_LAST_UPDATE,
+	} {
+		params := QueryParams{
+			Filter:      grpc.Filter_FILTER_UNKNOWN,
+			Sort:        sort,
+			Order:       grpc.SortOrder_SORT_ORDER_ASCENDING,
+			ItemPerPage: 2,
+		}
+
+		names := make([]string, 0)
+		for page := 0; page < 10; page++ {
+			tags, err := ReadPage(ctx, client, u, params)
+			s.Assert().Nil(err)
+			for _, t := range tags {
+				names = append(names, t.Name)
+			}
+
+			params.Cursor, err = NextCursor(tags, params)
+			s.Assert().Nil(err)
+			if params.Cursor == "" {
+				break
+			}
+		}
+
+		s.Assert().ElementsMatch([]string{"Tag 1", "Tag 2", "Tag 3", "Tag 4", "Tag 5"}, names, "%v", sort)
+		if sort == grpc.SortField_SORT_FIELD_NAME {
+			s.Assert().Equal([]string{"Tag 1", "Tag 2", "Tag 3", "Tag 4", "Tag 5"}, names)
+		}
+	}
+}
+
+func (s *QueryTestSuite) TestReadPageRestricted() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	u, err := user.GetUser(ctx, client, "reader")
+	s.Assert().Nil(err)
+
+	shared, err := client.Tag.Create().SetName("Tag 1").Save(ctx)
+	s.Assert().Nil(err)
+	denied, err := client.Tag.Create().SetName("Tag 2").Save(ctx)
+	s.Assert().Nil(err)
+	adult, err := client.Tag.Create().SetName("Tag 3").Save(ctx)
+	s.Assert().Nil(err)
+
+	_, err = client.Meta.Create().SetName("manga 1.zip").AddTags(shared).SetAgeRating(12).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("manga 2.zip").AddTags(shared, denied).SetAgeRating(12).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("manga 3.zip").AddTags(shared, adult).SetAgeRating(18).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("manga 4.zip").AddTags(shared).Save(ctx)
+	s.Assert().Nil(err)
+
+	rating := 16
+	u, err = user.SetRestrictions(ctx, client, u, user.Restrictions{
+		MaxAgeRating: &rating,
+		DeniedTags:   []int{denied.ID},
+	})
+	s.Assert().Nil(err)
+
+	tags, err := ReadPage(ctx, client, u, QueryParams{Filter: grpc.Filter_FILTER_UNKNOWN})
+	s.Assert().Nil(err)
+	s.Assert().Equal(1, len(tags))
+	s.Assert().Equal("Tag 1", tags[0].Name)
+
+	_, err = ReadAllowed(ctx, client, u, denied.ID)
+	s.Assert().True(ent.IsNotFound(err))
+
+	// A tag whose items are all above the age rating is hidden too.
+	_, err = ReadAllowed(ctx, client, u, adult.ID)
+	s.Assert().True(ent.IsNotFound(err))
+
+	items, err := ReadMetaPage(ctx, client, shared, u, QueryMetaParams{
+		SortBy:    grpc.SortField_SORT_FIELD_NAME,
+		SortOrder: grpc.SortOrder_SORT_ORDER_ASCENDING,
+	})
+	s.Assert().Nil(err)
+	s.Assert().Equal(1, len(items))
+	s.Assert().Equal("manga 1.zip", items[0].Name)
+
+	// With allowed paths, only the tags of the items in them are listed.
+	u, err = user.SetRestrictions(ctx, client, u, user.Restrictions{AllowedPaths: []string{"kids"}})
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName(filepath.Join("kids", "manga 5.zip")).AddTags(adult).Save(ctx)
+	s.Assert().Nil(err)
+
+	tags, err = ReadPage(ctx, client, u, QueryParams{Filter: grpc.Filter_FILTER_UNKNOWN})
+	s.Assert().Nil(err)
+	s.Assert().Equal(1, len(tags))
+	s.Assert().Equal("Tag 3", tags[0].Name)
+}
@@ -0,0 +1,130 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/mangaweb4/mangaweb4-backend/ent/metatag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+)
+
+// tagStats are the aggregates of the active items of a tag.
+type tagStats struct {
+	TagID      int     `sql:"tag_id"`
+	LastUpdate aggTime `sql:"last_update"`
+	Count      int     `sql:"count"`
+}
+
+// aggTime scans the result of an aggregate over a time column. Drivers that
+// cannot tell the type of an aggregate, such as sqlite, return it as text.
+type aggTime struct {
+	time.Time
+}
+
+// aggTimeLayouts are the text forms of the times written by the sqlite driver.
+var aggTimeLayouts = []string{
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+	"2006-01-02T15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+}
+
+func (t *aggTime) Scan(src any) error {
+	var text string
+	switch v := src.(type) {
+	case nil:
+		t.Time = time.Time{}
+		return nil
+	case time.Time:
+		t.Time = v
+		return nil
+	case string:
+		text = v
+	case []byte:
+		text = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into time", src)
+	}
+
+	for _, layout := range aggTimeLayouts {
+		if parsed, err := time.Parse(layout, text); err == nil {
+			t.Time = parsed
+			return nil
+		}
+	}
+
+	return fmt.Errorf("cannot parse time %q", text)
+}
+
+// Refresh recomputes the last update time of every tag from its active items.
+// Tags without active items are deactivated rather than deleted, so that they
+// keep their favorite and blocked edges and come back with their items. Only
+// the tags whose state changed are written.
+func Refresh(ctx context.Context, client *ent.Client) error {
+	stats := make([]tagStats, 0)
+	err := client.MetaTag.Query().
+		Modify(func(s *sql.Selector) {
+			items := sql.Table(meta.Table)
+			s.Join(items).
+				On(s.C(metatag.MetaColumn), items.C(meta.FieldID)).
+				Where(sql.EQ(items.C(meta.FieldActive), true)).
+				GroupBy(s.C(metatag.TagColumn)).
+				Select(
+					sql.As(s.C(metatag.TagColumn), "tag_id"),
+					sql.As(sql.Max(items.C(meta.FieldCreateTime)), "last_update"),
+					sql.As(sql.Count("*"), "count"),
+				)
+		}).
+		Scan(ctx, &stats)
+	if err != nil {
+		return err
+	}
+
+	byTag := make(map[int]tagStats, len(stats))
+	for _, st := range stats {
+		byTag[st.TagID] = st
+	}
+
+	tags, err := client.Tag.Query().
+		Select(tag.FieldID, tag.FieldActive, tag.FieldLastUpdate).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	deactivated := make([]int, 0)
+	for _, t := range tags {
+		st, active := byTag[t.ID]
+		if !active {
+			if t.Active || !t.LastUpdate.IsZero() {
+				deactivated = append(deactivated, t.ID)
+			}
+			continue
+		}
+
+		if t.Active && st.LastUpdate.Equal(t.LastUpdate) {
+			continue
+		}
+
+		if err := client.Tag.UpdateOneID(t.ID).
+			SetActive(true).
+			SetLastUpdate(st.LastUpdate.Time).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	if len(deactivated) == 0 {
+		return nil
+	}
+
+	return client.Tag.Update().
+		Where(tag.IDIn(deactivated...)).
+		SetActive(false).
+		SetLastUpdate(time.Time{}).
+		Exec(ctx)
+}
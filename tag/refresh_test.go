@@ -0,0 +1,78 @@
+package tag
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mangaweb4/mangaweb4-backend/user"
+	"github.com/stretchr/testify/suite"
+)
+
+type RefreshTestSuite struct {
+	suite.Suite
+}
+
+func TestRefreshTestSuite(t *testing.T) {
+	suite.Run(t, new(RefreshTestSuite))
+}
+
+func (s *RefreshTestSuite) TestRefresh() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	u, err := user.GetUser(ctx, client, "")
+	s.Assert().Nil(err)
+
+	current, err := client.Tag.Create().SetName("current").Save(ctx)
+	s.Assert().Nil(err)
+	dangling, err := client.Tag.Create().SetName("dangling").SetLastUpdate(time.Now()).Save(ctx)
+	s.Assert().Nil(err)
+	s.Assert().Nil(u.Update().AddFavoriteTags(dangling).Exec(ctx))
+
+	older := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err = client.Meta.Create().SetName("manga 1.zip").SetCreateTime(older).AddTags(current).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("manga 2.zip").SetCreateTime(newer).AddTags(current, dangling).SetActive(false).Save(ctx)
+	s.Assert().Nil(err)
+
+	s.Assert().Nil(Refresh(ctx, client))
+
+	current, err = client.Tag.Get(ctx, current.ID)
+	s.Assert().Nil(err)
+	s.Assert().True(current.Active)
+	s.Assert().True(older.Equal(current.LastUpdate))
+
+	dangling, err = client.Tag.Get(ctx, dangling.ID)
+	s.Assert().Nil(err)
+	s.Assert().False(dangling.Active)
+	s.Assert().True(dangling.LastUpdate.IsZero())
+
+	favorites, err := u.QueryFavoriteTags().Count(ctx)
+	s.Assert().Nil(err)
+	s.Assert().Equal(1, favorites)
+
+	tags, err := ReadPage(ctx, client, u, QueryParams{})
+	s.Assert().Nil(err)
+	s.Assert().Equal(1, len(tags))
+	s.Assert().Equal(current.ID, tags[0].ID)
+
+	// A second refresh picks up the newly active item of the dangling tag.
+	_, err = client.Meta.Create().SetName("manga 3.zip").SetCreateTime(newer).AddTags(dangling).Save(ctx)
+	s.Assert().Nil(err)
+	s.Assert().Nil(Refresh(ctx, client))
+
+	dangling, err = client.Tag.Get(ctx, dangling.ID)
+	s.Assert().Nil(err)
+	s.Assert().True(dangling.Active)
+	s.Assert().True(newer.Equal(dangling.LastUpdate))
+
+	current, err = client.Tag.Get(ctx, current.ID)
+	s.Assert().Nil(err)
+	s.Assert().True(older.Equal(current.LastUpdate))
+}
@@ -0,0 +1,90 @@
+package tag
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/mangaweb4/mangaweb4-backend/browse"
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/meta"
+	"github.com/mangaweb4/mangaweb4-backend/ent/metatag"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+)
+
+const (
+	DefaultRelatedLimit = 20
+	MaxRelatedLimit     = 100
+)
+
+type RelatedParams struct {
+	Category   tag.Category
+	MinSupport int
+	Limit      int
+}
+
+// RelatedTag is a tag found on the same items as another tag. Count is the
+// number of items that carry both tags.
+type RelatedTag struct {
+	ID       int          `sql:"id"`
+	Name     string       `sql:"name"`
+	Category tag.Category `sql:"category"`
+	Count    int          `sql:"count"`
+}
+
+// Related returns the tags most often found on the same items as the tag, in
+// descending order of co-occurrence. Only items and tags the user can see are
+// counted, and tags found on fewer than MinSupport shared items are left out.
+func Related(
+	ctx context.Context,
+	client *ent.Client,
+	u *ent.User,
+	t *ent.Tag,
+	params RelatedParams,
+) (out []RelatedTag, err error) {
+	limit := params.Limit
+	if limit <= 0 {
+		limit = DefaultRelatedLimit
+	}
+	limit = min(limit, MaxRelatedLimit)
+
+	minSupport := max(params.MinSupport, 1)
+
+	query := client.Tag.Query().Where(tag.IDNEQ(t.ID), browse.Tags(u, grpc.Filter_FILTER_UNKNOWN))
+	if params.Category != "" {
+		query = query.Where(tag.CategoryEQ(params.Category))
+	}
+
+	visible := sql.Select(meta.FieldID).From(sql.Table(meta.Table))
+	browse.Items(u, grpc.Filter_FILTER_UNKNOWN)(visible)
+
+	shared := sql.Table(metatag.Table)
+	tagged := sql.Select(shared.C(metatag.MetaColumn)).
+		From(shared).
+		Where(sql.EQ(shared.C(metatag.TagColumn), t.ID))
+
+	out = make([]RelatedTag, 0)
+	err = query.
+		Limit(limit).
+		Modify(func(s *sql.Selector) {
+			items := sql.Table(metatag.Table)
+			s.Join(items).
+				On(s.C(tag.FieldID), items.C(metatag.TagColumn)).
+				Where(sql.And(
+					sql.In(items.C(metatag.MetaColumn), tagged),
+					sql.In(items.C(metatag.MetaColumn), visible),
+				)).
+				GroupBy(s.C(tag.FieldID), s.C(tag.FieldName), s.C(tag.FieldCategory)).
+				Having(sql.GTE(sql.Count("*"), minSupport)).
+				Select(
+					s.C(tag.FieldID),
+					s.C(tag.FieldName),
+					s.C(tag.FieldCategory),
+					sql.As(sql.Count("*"), "count"),
+				).
+				OrderBy(sql.Desc("count"), s.C(tag.FieldName))
+		}).
+		Scan(ctx, &out)
+
+	return
+}
@@ -0,0 +1,66 @@
+package tag
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	"github.com/mangaweb4/mangaweb4-backend/user"
+	"github.com/stretchr/testify/suite"
+)
+
+type RelatedTestSuite struct {
+	suite.Suite
+}
+
+func TestRelatedTestSuite(t *testing.T) {
+	suite.Run(t, new(RelatedTestSuite))
+}
+
+func (s *RelatedTestSuite) TestRelated() {
+	db, client, err := createTestDBClient(s)
+	s.Assert().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	u, err := user.GetUser(ctx, client, "")
+	s.Assert().Nil(err)
+
+	artist, err := client.Tag.Create().SetName("artist").SetCategory(tag.CategoryArtist).Save(ctx)
+	s.Assert().Nil(err)
+	circle, err := client.Tag.Create().SetName("circle").SetCategory(tag.CategoryCircle).Save(ctx)
+	s.Assert().Nil(err)
+	parody, err := client.Tag.Create().SetName("parody").SetCategory(tag.CategoryParody).Save(ctx)
+	s.Assert().Nil(err)
+	other, err := client.Tag.Create().SetName("other").Save(ctx)
+	s.Assert().Nil(err)
+
+	_, err = client.Meta.Create().SetName("manga 1.zip").AddTags(artist, circle, parody).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("manga 2.zip").AddTags(artist, circle).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("manga 3.zip").AddTags(artist, parody).SetActive(false).Save(ctx)
+	s.Assert().Nil(err)
+	_, err = client.Meta.Create().SetName("manga 4.zip").AddTags(circle, other).Save(ctx)
+	s.Assert().Nil(err)
+
+	related, err := Related(ctx, client, u, artist, RelatedParams{})
+	s.Assert().Nil(err)
+
+	s.Assert().Equal(2, len(related))
+	s.Assert().Equal(circle.ID, related[0].ID)
+	s.Assert().Equal(2, related[0].Count)
+	s.Assert().Equal(parody.ID, related[1].ID)
+	s.Assert().Equal(1, related[1].Count)
+
+	related, err = Related(ctx, client, u, artist, RelatedParams{MinSupport: 2})
+	s.Assert().Nil(err)
+	s.Assert().Equal(1, len(related))
+	s.Assert().Equal(circle.ID, related[0].ID)
+
+	related, err = Related(ctx, client, u, artist, RelatedParams{Category: tag.CategoryParody})
+	s.Assert().Nil(err)
+	s.Assert().Equal(1, len(related))
+	s.Assert().Equal(parody.ID, related[0].ID)
+}
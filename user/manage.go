@@ -0,0 +1,219 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/apikey"
+	"github.com/mangaweb4/mangaweb4-backend/ent/history"
+	"github.com/mangaweb4/mangaweb4-backend/ent/progress"
+	"github.com/mangaweb4/mangaweb4-backend/ent/savedsearch"
+	"github.com/mangaweb4/mangaweb4-backend/ent/session"
+	"github.com/mangaweb4/mangaweb4-backend/ent/user"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrLastAdmin is returned when a change would leave the server without an
+// active admin.
+var ErrLastAdmin = status.Error(codes.FailedPrecondition, "the last active admin cannot be removed")
+
+// TransferCount is the number of records moved by Transfer.
+type TransferCount struct {
+	FavoriteItems int
+	FavoriteTags  int
+	Progress      int
+	Histories     int
+}
+
+// ReadAll returns every user by email.
+func ReadAll(ctx context.Context, client *ent.Client) ([]*ent.User, error) {
+	return client.User.Query().Order(user.ByEmail()).All(ctx)
+}
+
+// Create creates an active user with the role. Users are usually created on
+// their first call, this is for users who should exist before that.
+func Create(ctx context.Context, client *ent.Client, email string, role user.Role) (u *ent.User, err error) {
+	if role == "" {
+		role = user.DefaultRole
+	}
+
+	if err = user.RoleValidator(role); err != nil {
+		return
+	}
+
+	return client.User.Create().SetEmail(email).SetRole(role).Save(ctx)
+}
+
+// SetActive activates or deactivates the user. Deactivated users are logged
+// out and cannot log in again until they are activated.
+func SetActive(ctx context.Context, client *ent.Client, u *ent.User, active bool) (out *ent.User, err error) {
+	if !active {
+		if err = checkAdminRemains(ctx, client, u); err != nil {
+			return
+		}
+
+		if _, err = client.Session.Delete().Where(session.HasUserWith(user.ID(u.ID))).Exec(ctx); err != nil {
+			return
+		}
+	}
+
+	return u.Update().SetActive(active).Save(ctx)
+}
+
+// SetRole changes the role of the user.
+func SetRole(ctx context.Context, client *ent.Client, u *ent.User, role user.Role) (out *ent.User, err error) {
+	if err = user.RoleValidator(role); err != nil {
+		return
+	}
+
+	if role != user.RoleAdmin {
+		if err = checkAdminRemains(ctx, client, u); err != nil {
+			return
+		}
+	}
+
+	return u.Update().SetRole(role).Save(ctx)
+}
+
+// Delete deletes the user along with their reading history, progress, saved
+// searches, sessions and API keys.
+func Delete(ctx context.Context, client *ent.Client, u *ent.User) (err error) {
+	if err = checkAdminRemains(ctx, client, u); err != nil {
+		return
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			log.Err(tx.Rollback()).Msg("rollback deleting user")
+		}
+	}()
+
+	if _, err = tx.History.Delete().Where(history.HasUserWith(user.ID(u.ID))).Exec(ctx); err != nil {
+		return
+	}
+	if _, err = tx.Progress.Delete().Where(progress.UserID(u.ID)).Exec(ctx); err != nil {
+		return
+	}
+	if _, err = tx.SavedSearch.Delete().Where(savedsearch.HasUserWith(user.ID(u.ID))).Exec(ctx); err != nil {
+		return
+	}
+	if _, err = tx.Session.Delete().Where(session.HasUserWith(user.ID(u.ID))).Exec(ctx); err != nil {
+		return
+	}
+	if _, err = tx.APIKey.Delete().Where(apikey.HasUserWith(user.ID(u.ID))).Exec(ctx); err != nil {
+		return
+	}
+	if err = tx.User.DeleteOneID(u.ID).Exec(ctx); err != nil {
+		return
+	}
+
+	err = tx.Commit()
+	return
+}
+
+// Transfer moves the favorite items and tags, the reading progress and the
+// reading history of one user to another. Where both users have progress on
+// an item, the one that read further is kept.
+func Transfer(ctx context.Context, client *ent.Client, from *ent.User, to *ent.User) (count TransferCount, err error) {
+	if from.ID == to.ID {
+		err = fmt.Errorf("cannot transfer user %d to itself", from.ID)
+		return
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			log.Err(tx.Rollback()).Msg("rollback transferring user")
+		}
+	}()
+
+	itemIDs, err := tx.User.QueryFavoriteItems(from).IDs(ctx)
+	if err != nil {
+		return
+	}
+	tagIDs, err := tx.User.QueryFavoriteTags(from).IDs(ctx)
+	if err != nil {
+		return
+	}
+
+	if err = tx.User.UpdateOneID(to.ID).AddFavoriteItemIDs(itemIDs...).AddFavoriteTagIDs(tagIDs...).Exec(ctx); err != nil {
+		return
+	}
+	if err = tx.User.UpdateOneID(from.ID).ClearFavoriteItems().ClearFavoriteTags().Exec(ctx); err != nil {
+		return
+	}
+
+	records, err := tx.Progress.Query().Where(progress.UserID(from.ID)).All(ctx)
+	if err != nil {
+		return
+	}
+
+	for _, p := range records {
+		var other *ent.Progress
+		other, err = tx.Progress.Query().Where(progress.UserID(to.ID), progress.ItemID(p.ItemID)).Only(ctx)
+		if ent.IsNotFound(err) {
+			err = tx.Progress.UpdateOne(p).SetUserID(to.ID).Exec(ctx)
+		} else if err == nil {
+			if p.Max > other.Max {
+				err = tx.Progress.UpdateOne(other).SetPage(p.Page).SetMax(p.Max).Exec(ctx)
+			}
+			if err == nil {
+				err = tx.Progress.DeleteOne(p).Exec(ctx)
+			}
+		}
+
+		if err != nil {
+			return
+		}
+	}
+
+	histories, err := tx.History.Update().
+		Where(history.HasUserWith(user.ID(from.ID))).
+		SetUserID(to.ID).
+		Save(ctx)
+	if err != nil {
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		return
+	}
+
+	count = TransferCount{
+		FavoriteItems: len(itemIDs),
+		FavoriteTags:  len(tagIDs),
+		Progress:      len(records),
+		Histories:     histories,
+	}
+
+	return
+}
+
+// checkAdminRemains fails when the user is the last active admin.
+func checkAdminRemains(ctx context.Context, client *ent.Client, u *ent.User) error {
+	if u.Role != user.RoleAdmin || !u.Active {
+		return nil
+	}
+
+	others, err := client.User.Query().
+		Where(user.RoleEQ(user.RoleAdmin), user.Active(true), user.IDNEQ(u.ID)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !others {
+		return ErrLastAdmin
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package user_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	dialect_sql "entgo.io/ent/dialect/sql"
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/enttest"
+	"github.com/mangaweb4/mangaweb4-backend/ent/progress"
+	ent_user "github.com/mangaweb4/mangaweb4-backend/ent/user"
+	"github.com/mangaweb4/mangaweb4-backend/user"
+	"github.com/stretchr/testify/suite"
+	_ "modernc.org/sqlite"
+)
+
+type ManageTestSuite struct {
+	suite.Suite
+}
+
+func TestManageTestSuite(t *testing.T) {
+	suite.Run(t, new(ManageTestSuite))
+}
+
+func createTestDBClient(s suite.TestingSuite) (db *sql.DB, client *ent.Client, err error) {
+	db, err = sql.Open("sqlite", "file:ent?mode=memory&_fk=1&_pragma=foreign_keys(1)")
+	if err != nil {
+		return
+	}
+
+	client = enttest.NewClient(s.T(), enttest.WithOptions(ent.Driver(dialect_sql.OpenDB("sqlite3", db))))
+
+	return
+}
+
+func (s *ManageTestSuite) TestSetActiveAndRole() {
+	db, client, err := createTestDBClient(s)
+	s.Require().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	admin, err := user.Create(ctx, client, "admin@example.com", ent_user.RoleAdmin)
+	s.Require().Nil(err)
+	reader, err := user.Create(ctx, client, "reader@example.com", "")
+	s.Require().Nil(err)
+	s.Assert().Equal(ent_user.RoleReader, reader.Role)
+
+	_, err = user.Create(ctx, client, "someone@example.com", "owner")
+	s.Assert().NotNil(err)
+
+	// Deactivated users are rejected rather than created again.
+	_, err = user.SetActive(ctx, client, reader, false)
+	s.Assert().Nil(err)
+	_, err = user.GetUser(ctx, client, "reader@example.com")
+	s.Assert().Equal(user.ErrInactive, err)
+
+	_, err = user.SetActive(ctx, client, admin, false)
+	s.Assert().Equal(user.ErrLastAdmin, err)
+	_, err = user.SetRole(ctx, client, admin, ent_user.RoleReader)
+	s.Assert().Equal(user.ErrLastAdmin, err)
+	s.Assert().Equal(user.ErrLastAdmin, user.Delete(ctx, client, admin))
+
+	reader, err = client.User.Get(ctx, reader.ID)
+	s.Require().Nil(err)
+	reader, err = user.SetActive(ctx, client, reader, true)
+	s.Assert().Nil(err)
+	_, err = user.SetRole(ctx, client, reader, ent_user.RoleAdmin)
+	s.Assert().Nil(err)
+
+	_, err = user.SetRole(ctx, client, admin, ent_user.RoleReader)
+	s.Assert().Nil(err)
+
+	all, err := user.ReadAll(ctx, client)
+	s.Assert().Nil(err)
+	s.Assert().Equal([]string{"admin@example.com", "reader@example.com"}, []string{all[0].Email, all[1].Email})
+}
+
+func (s *ManageTestSuite) TestTransferAndDelete() {
+	db, client, err := createTestDBClient(s)
+	s.Require().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	from, err := user.GetUser(ctx, client, "old@example.com")
+	s.Require().Nil(err)
+	to, err := user.GetUser(ctx, client, "new@example.com")
+	s.Require().Nil(err)
+
+	naruto, err := client.Meta.Create().SetName("Naruto 1.zip").Save(ctx)
+	s.Require().Nil(err)
+	bleach, err := client.Meta.Create().SetName("Bleach 1.zip").Save(ctx)
+	s.Require().Nil(err)
+	ninja, err := client.Tag.Create().SetName("ninja").Save(ctx)
+	s.Require().Nil(err)
+
+	s.Require().Nil(from.Update().AddFavoriteItems(naruto).AddFavoriteTags(ninja).Exec(ctx))
+	s.Require().Nil(client.Progress.Create().SetUser(from).SetItem(naruto).SetPage(3).SetMax(5).Exec(ctx))
+	s.Require().Nil(client.Progress.Create().SetUser(from).SetItem(bleach).SetPage(1).SetMax(1).Exec(ctx))
+	s.Require().Nil(client.Progress.Create().SetUser(to).SetItem(bleach).SetPage(2).SetMax(8).Exec(ctx))
+	s.Require().Nil(client.History.Create().SetUser(from).SetItem(naruto).Exec(ctx))
+
+	count, err := user.Transfer(ctx, client, from, to)
+	s.Assert().Nil(err)
+	s.Assert().Equal(user.TransferCount{FavoriteItems: 1, FavoriteTags: 1, Progress: 2, Histories: 1}, count)
+
+	s.Assert().Equal(1, client.User.QueryFavoriteItems(to).CountX(ctx))
+	s.Assert().Equal(0, client.User.QueryFavoriteItems(from).CountX(ctx))
+	s.Assert().Equal(1, client.User.QueryHistories(to).CountX(ctx))
+
+	// The progress that read further is kept.
+	p, err := client.Progress.Query().Where(progress.UserID(to.ID), progress.ItemID(bleach.ID)).Only(ctx)
+	s.Assert().Nil(err)
+	s.Assert().Equal(8, p.Max)
+	p, err = client.Progress.Query().Where(progress.UserID(to.ID), progress.ItemID(naruto.ID)).Only(ctx)
+	s.Assert().Nil(err)
+	s.Assert().Equal(5, p.Max)
+	s.Assert().Equal(0, client.Progress.Query().Where(progress.UserID(from.ID)).CountX(ctx))
+
+	s.Assert().Nil(user.Delete(ctx, client, to))
+	s.Assert().Equal(0, client.Progress.Query().CountX(ctx))
+	s.Assert().Equal(0, client.History.Query().CountX(ctx))
+	s.Assert().Equal(1, client.User.Query().CountX(ctx))
+}
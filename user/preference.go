@@ -0,0 +1,25 @@
+package user
+
+import (
+	"context"
+
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/preference"
+)
+
+// Preferences returns the settings of the user in effect for the device the
+// call comes from, with the defaults filled in.
+func Preferences(ctx context.Context, u *ent.User) preference.Settings {
+	return u.Preferences.Resolve(preference.DeviceFromContext(ctx))
+}
+
+// UpdatePreferences replaces the preferences of the user, or the overrides of
+// the device when device is not empty.
+func UpdatePreferences(ctx context.Context, u *ent.User, device string, s preference.Settings) (out *ent.User, err error) {
+	doc, err := u.Preferences.Set(device, s)
+	if err != nil {
+		return
+	}
+
+	return u.Update().SetPreferences(doc).Save(ctx)
+}
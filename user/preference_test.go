@@ -0,0 +1,39 @@
+package user_test
+
+import (
+	"context"
+
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+	"github.com/mangaweb4/mangaweb4-backend/preference"
+	"github.com/mangaweb4/mangaweb4-backend/user"
+	"google.golang.org/grpc/metadata"
+)
+
+func (s *ManageTestSuite) TestUpdatePreferences() {
+	db, client, err := createTestDBClient(s)
+	s.Require().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	reader, err := user.Create(ctx, client, "reader@example.com", "")
+	s.Require().Nil(err)
+	s.Assert().Equal(preference.Defaults(preference.CurrentVersion), user.Preferences(ctx, reader))
+
+	// Users update their own preferences.
+	readerCtx := user.NewContext(ctx, reader)
+	reader, err = user.UpdatePreferences(readerCtx, reader, "", preference.Settings{ItemsPerPage: 60})
+	s.Require().Nil(err)
+	_, err = user.UpdatePreferences(readerCtx, reader, "phone", preference.Settings{
+		ImageQuality: grpc.ImageQuality_IMAGE_QUALITY_LOW,
+	})
+	s.Require().Nil(err)
+
+	reader, err = client.User.Get(ctx, reader.ID)
+	s.Require().Nil(err)
+
+	phoneCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(preference.DeviceMetadataKey, "phone"))
+	s.Assert().Equal(60, user.Preferences(phoneCtx, reader).ItemsPerPage)
+	s.Assert().Equal(grpc.ImageQuality_IMAGE_QUALITY_LOW, user.Preferences(phoneCtx, reader).ImageQuality)
+	s.Assert().Equal(grpc.ImageQuality_IMAGE_QUALITY_HIGH, user.Preferences(ctx, reader).ImageQuality)
+}
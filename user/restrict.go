@@ -0,0 +1,87 @@
+package user
+
+import (
+	"context"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/mangaweb4/mangaweb4-backend/ent"
+	"github.com/mangaweb4/mangaweb4-backend/ent/tag"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Restrictions limit the items a user can see, in every listing as well as
+// when an item is opened directly. They are set by admins.
+type Restrictions struct {
+	// MaxAgeRating is the highest age rating of the items the user can see,
+	// nil when it is not restricted.
+	MaxAgeRating *int
+	// AllowedPaths are the directories of the library the user can see,
+	// relative to the data path. Every directory is allowed when it is empty.
+	AllowedPaths []string
+	// DeniedTags are the IDs of the tags whose items the user cannot see.
+	DeniedTags []int
+}
+
+// ReadRestrictions returns the restrictions of the user.
+func ReadRestrictions(ctx context.Context, u *ent.User) (r Restrictions, err error) {
+	r.MaxAgeRating = u.MaxAgeRating
+	r.AllowedPaths = u.AllowedPaths
+
+	r.DeniedTags, err = u.QueryDeniedTags().Order(tag.ByID()).IDs(ctx)
+	return
+}
+
+// SetRestrictions replaces the restrictions of the user.
+func SetRestrictions(ctx context.Context, client *ent.Client, u *ent.User, r Restrictions) (out *ent.User, err error) {
+	paths := make([]string, 0, len(r.AllowedPaths))
+	for _, p := range r.AllowedPaths {
+		clean, e := cleanPath(p)
+		if e != nil {
+			err = e
+			return
+		}
+
+		if !slices.Contains(paths, clean) {
+			paths = append(paths, clean)
+		}
+	}
+
+	tags := slices.Compact(slices.Sorted(slices.Values(r.DeniedTags)))
+	count, err := client.Tag.Query().Where(tag.IDIn(tags...)).Count(ctx)
+	if err != nil {
+		return
+	}
+	if count != len(tags) {
+		err = status.Error(codes.NotFound, "denied tag not found")
+		return
+	}
+
+	update := u.Update().
+		SetAllowedPaths(paths).
+		ClearDeniedTags().
+		AddDeniedTagIDs(tags...)
+
+	if r.MaxAgeRating != nil {
+		update = update.SetMaxAgeRating(*r.MaxAgeRating)
+	} else {
+		update = update.ClearMaxAgeRating()
+	}
+
+	return update.Save(ctx)
+}
+
+// cleanPath returns an allowed path in the form of the item names, without
+// leading or trailing slashes and with the path separator of the system.
+// Paths outside the library are rejected.
+func cleanPath(p string) (string, error) {
+	clean := path.Clean(strings.Trim(p, "/"))
+	if clean == "." || clean == ".." || strings.HasPrefix(clean, "../") {
+		return "", status.Errorf(codes.InvalidArgument, "invalid allowed path: %q", p)
+	}
+
+	return filepath.FromSlash(clean), nil
+}
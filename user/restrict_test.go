@@ -0,0 +1,63 @@
+package user_test
+
+import (
+	"context"
+
+	"github.com/mangaweb4/mangaweb4-backend/ent/privacy"
+	ent_user "github.com/mangaweb4/mangaweb4-backend/ent/user"
+	"github.com/mangaweb4/mangaweb4-backend/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ManageTestSuite) TestSetRestrictions() {
+	db, client, err := createTestDBClient(s)
+	s.Require().Nil(err)
+	defer func() { s.T().Log("database close", db.Close()) }()
+
+	ctx := context.Background()
+
+	reader, err := user.Create(ctx, client, "reader@example.com", "")
+	s.Require().Nil(err)
+	adult, err := client.Tag.Create().SetName("adult").Save(ctx)
+	s.Require().Nil(err)
+
+	rating := 13
+	reader, err = user.SetRestrictions(ctx, client, reader, user.Restrictions{
+		MaxAgeRating: &rating,
+		AllowedPaths: []string{"/kids/", "kids", "family/./comics"},
+		DeniedTags:   []int{adult.ID, adult.ID},
+	})
+	s.Require().Nil(err)
+
+	r, err := user.ReadRestrictions(ctx, reader)
+	s.Require().Nil(err)
+	s.Assert().Equal(13, *r.MaxAgeRating)
+	s.Assert().Equal([]string{"kids", "family/comics"}, r.AllowedPaths)
+	s.Assert().Equal([]int{adult.ID}, r.DeniedTags)
+
+	for _, p := range []string{"", "/", "..", "../other"} {
+		_, err = user.SetRestrictions(ctx, client, reader, user.Restrictions{AllowedPaths: []string{p}})
+		s.Assert().Equal(codes.InvalidArgument, status.Code(err), p)
+	}
+
+	_, err = user.SetRestrictions(ctx, client, reader, user.Restrictions{DeniedTags: []int{adult.ID + 1}})
+	s.Assert().Equal(codes.NotFound, status.Code(err))
+
+	// Users cannot lift their own restrictions.
+	readerCtx := user.NewContext(ctx, reader)
+	s.Assert().ErrorIs(reader.Update().ClearMaxAgeRating().Exec(readerCtx), privacy.Deny)
+	s.Assert().ErrorIs(reader.Update().ClearAllowedPaths().Exec(readerCtx), privacy.Deny)
+	s.Assert().ErrorIs(reader.Update().RemoveDeniedTags(adult).Exec(readerCtx), privacy.Deny)
+
+	admin, err := user.Create(ctx, client, "admin@example.com", ent_user.RoleAdmin)
+	s.Require().Nil(err)
+	reader, err = user.SetRestrictions(user.NewContext(ctx, admin), client, reader, user.Restrictions{})
+	s.Require().Nil(err)
+
+	r, err = user.ReadRestrictions(ctx, reader)
+	s.Require().Nil(err)
+	s.Assert().Nil(r.MaxAgeRating)
+	s.Assert().Empty(r.AllowedPaths)
+	s.Assert().Empty(r.DeniedTags)
+}
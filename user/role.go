@@ -0,0 +1,44 @@
+package user
+
+import (
+	"github.com/mangaweb4/mangaweb4-backend/ent/user"
+	"github.com/mangaweb4/mangaweb4-backend/grpc"
+)
+
+// roleRanks orders the roles from the least to the most privileged.
+var roleRanks = map[user.Role]int{
+	user.RoleGuest:  1,
+	user.RoleReader: 2,
+	user.RoleEditor: 3,
+	user.RoleAdmin:  4,
+}
+
+// HasRole reports whether the role is the minimum role or a more privileged
+// one.
+func HasRole(role user.Role, minimum user.Role) bool {
+	return roleRanks[role] >= roleRanks[minimum]
+}
+
+var roleToGrpc = map[user.Role]grpc.Role{
+	user.RoleGuest:  grpc.Role_ROLE_GUEST,
+	user.RoleReader: grpc.Role_ROLE_READER,
+	user.RoleEditor: grpc.Role_ROLE_EDITOR,
+	user.RoleAdmin:  grpc.Role_ROLE_ADMIN,
+}
+
+// RoleToGrpc converts a user role into its gRPC value.
+func RoleToGrpc(r user.Role) grpc.Role {
+	return roleToGrpc[r]
+}
+
+// RoleFromGrpc converts a gRPC user role into its database value. ROLE_UNSPECIFIED
+// maps to an empty role.
+func RoleFromGrpc(r grpc.Role) user.Role {
+	for k, v := range roleToGrpc {
+		if v == r {
+			return k
+		}
+	}
+
+	return ""
+}
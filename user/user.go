@@ -5,12 +5,20 @@ import (
 
 	"github.com/mangaweb4/mangaweb4-backend/ent"
 	"github.com/mangaweb4/mangaweb4-backend/ent/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
 	DEFAULT_EMAIL = "default@example.com"
 )
 
+// ErrInactive is returned for users that were deactivated.
+var ErrInactive = status.Error(codes.Unauthenticated, "user is deactivated")
+
+// GetUser returns the user with the email, creating them if needed. An empty
+// email names the default user. Deactivated users are not returned, nor
+// created again.
 func GetUser(ctx context.Context, client *ent.Client, email string) (u *ent.User, err error) {
 	if email == "" {
 		email = DEFAULT_EMAIL
@@ -20,6 +28,11 @@ func GetUser(ctx context.Context, client *ent.Client, email string) (u *ent.User
 		user.Email(email),
 	).Only(ctx)
 
+	if err == nil && !u.Active {
+		u, err = nil, ErrInactive
+		return
+	}
+
 	if !ent.IsNotFound(err) {
 		return
 	}
@@ -28,3 +41,40 @@ func GetUser(ctx context.Context, client *ent.Client, email string) (u *ent.User
 
 	return
 }
+
+type contextKey struct{}
+
+type viewer struct {
+	id   int
+	role user.Role
+}
+
+// NewContext returns a context carrying the user making the call.
+func NewContext(ctx context.Context, u *ent.User) context.Context {
+	return context.WithValue(ctx, contextKey{}, viewer{id: u.ID, role: u.Role})
+}
+
+// IDFromContext returns the ID of the user making the call, if any.
+func IDFromContext(ctx context.Context) (id int, ok bool) {
+	v, ok := ctx.Value(contextKey{}).(viewer)
+	return v.id, ok
+}
+
+// RoleFromContext returns the role of the user making the call, if any.
+// Contexts without a user belong to the server itself, such as library scans.
+func RoleFromContext(ctx context.Context) (role user.Role, ok bool) {
+	v, ok := ctx.Value(contextKey{}).(viewer)
+	return v.role, ok
+}
+
+// Current returns the user making the call, as resolved by the authentication
+// interceptor. Calls without a user fail with Unauthenticated.
+func Current(ctx context.Context, client *ent.Client) (u *ent.User, err error) {
+	id, ok := IDFromContext(ctx)
+	if !ok {
+		err = status.Error(codes.Unauthenticated, "no user is logged in")
+		return
+	}
+
+	return client.User.Get(ctx, id)
+}